@@ -0,0 +1,179 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains app, which holds the global flags and the SDK client
+	used by the commands of a single invocation
+*/
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ecsdk"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// envAccountNumber is the environment variable that holds the default
+// account number
+const envAccountNumber = "EC_ACCOUNT_NUMBER"
+
+// app holds the state of a single invocation of ecctl
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	// Global flags
+	output  string
+	account string
+	profile string
+	dryRun  bool
+	debug   bool
+
+	client *ecsdk.Client
+	plan   edgecast.DryRunPlan
+}
+
+// run executes the command given by args and returns the exit code
+func run(
+	ctx context.Context,
+	args []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+) int {
+	a := &app{
+		stdin:   stdin,
+		stdout:  stdout,
+		stderr:  stderr,
+		output:  formatJSON,
+		account: os.Getenv(envAccountNumber),
+	}
+	defer a.close()
+
+	err := a.execute(ctx, newRootCommand(), args)
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, "ecctl: %v\n", err)
+
+	var usage usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintf(stderr, "Run '%s --help' for usage.\n", usage.path)
+		return exitUsage
+	}
+	return exitError
+}
+
+// globalFlags adds the flags accepted by every command to fs
+func (a *app) globalFlags(fs *flag.FlagSet) {
+	fs.StringVar(
+		&a.output,
+		"output",
+		a.output,
+		"output format: json, yaml or table")
+	fs.StringVar(&a.output, "o", a.output, "shorthand for --output")
+	fs.StringVar(
+		&a.account,
+		"account",
+		a.account,
+		"customer account number (default $"+envAccountNumber+")")
+	fs.StringVar(
+		&a.profile,
+		"profile",
+		a.profile,
+		"credentials file profile (default $EC_PROFILE or \"default\")")
+	fs.BoolVar(
+		&a.dryRun,
+		"dry-run",
+		a.dryRun,
+		"print the requests that would change resources instead of sending "+
+			"them")
+	fs.BoolVar(
+		&a.debug,
+		"debug",
+		a.debug,
+		"log requests and responses to standard error")
+}
+
+// sdk returns the SDK client, creating it from the loaded configuration on
+// first use
+func (a *app) sdk() (*ecsdk.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	config, err := edgecast.LoadConfig(
+		edgecast.LoadConfigOptions{Profile: a.profile})
+	if err != nil {
+		return nil, err
+	}
+
+	if a.debug {
+		config.Logger = eclog.SimpleLogger{
+			InfoLogger:    log.New(a.stderr, "[INFO] ", log.LstdFlags),
+			DebugLogger:   log.New(a.stderr, "[DEBUG] ", log.LstdFlags),
+			WarningLogger: log.New(a.stderr, "[WARN] ", log.LstdFlags),
+			ErrorLogger:   log.New(a.stderr, "[ERROR] ", log.LstdFlags),
+		}
+	}
+
+	if a.dryRun {
+		// Stop at the first request that would change a resource, since the
+		// results of later requests may depend on it
+		config.DryRun = edgecast.DryRunConfig{
+			Enabled:     true,
+			Plan:        &a.plan,
+			ReturnError: true,
+		}
+	}
+
+	a.client, err = ecsdk.NewClient(config)
+	return a.client, err
+}
+
+// accountNumber returns the account number used by the legacy APIs
+func (a *app) accountNumber() (string, error) {
+	if len(a.account) == 0 {
+		return "", fmt.Errorf(
+			"an account number is required: use --account or set %s",
+			envAccountNumber)
+	}
+	return a.account, nil
+}
+
+// write prints the result of a command, or the plan in dry-run mode
+func (a *app) write(result interface{}, err error) error {
+	if a.dryRun && errors.Is(err, edgecast.ErrDryRun) {
+		return writeOutput(a.stdout, a.output, a.plan.Requests())
+	}
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return writeOutput(a.stdout, a.output, result)
+}
+
+func (a *app) close() {
+	if a.client != nil {
+		a.client.Close()
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the command tree and the code that parses arguments,
+	finds the command to run and prints usage
+*/
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// command is a node of the command tree. Commands with a run function are
+// leaves; all others group subcommands.
+type command struct {
+	name  string
+	short string
+
+	// args describes the positional arguments, e.g. "<id>"
+	args string
+
+	// nargs is the number of positional arguments required, or -1 to allow
+	// any number
+	nargs int
+
+	// flags adds the command's own flags to fs
+	flags func(fs *flag.FlagSet)
+
+	// run executes the command and returns the result to print, if any
+	run func(ctx context.Context, a *app, args []string) (interface{}, error)
+
+	commands []*command
+
+	// hidden commands are not listed in usage or completions
+	hidden bool
+}
+
+// newRootCommand creates the command tree
+func newRootCommand() *command {
+	return &command{
+		name:  "ecctl",
+		short: "Manage Edgecast resources",
+		commands: []*command{
+			newCPSCommand(),
+			newCustomerCommand(),
+			newDNSCommand(),
+			newEdgeCnameCommand(),
+			newOriginCommand(),
+			newRTLDCommand(),
+			newWAFCommand(),
+			newCompletionCommand(),
+			newCompleteCommand(),
+		},
+	}
+}
+
+// find returns the subcommand with the given name
+func (c *command) find(name string) *command {
+	for _, sub := range c.commands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+// usageError is returned for invalid arguments
+type usageError struct {
+	// path is the command line of the command that was being parsed, e.g.
+	// "ecctl waf access-rules"
+	path string
+	msg  string
+}
+
+func (e usageError) Error() string {
+	return e.msg
+}
+
+// execute finds the command given by args, starting at root, and runs it
+func (a *app) execute(
+	ctx context.Context,
+	root *command,
+	args []string,
+) error {
+	cmd := root
+	path := root.name
+
+	for {
+		fs := a.flagSet(path, cmd)
+
+		if cmd.run != nil {
+			positional, err := parseInterspersed(fs, args)
+			if err != nil {
+				return a.flagError(path, cmd, fs, err)
+			}
+			if err := validateFormat(a.output); err != nil {
+				return usageError{path: path, msg: err.Error()}
+			}
+			if cmd.nargs >= 0 && len(positional) != cmd.nargs {
+				return usageError{
+					path: path,
+					msg: fmt.Sprintf(
+						"%q requires %d argument(s) but got %d",
+						path,
+						cmd.nargs,
+						len(positional)),
+				}
+			}
+			return a.write(cmd.run(ctx, a, positional))
+		}
+
+		if err := fs.Parse(args); err != nil {
+			return a.flagError(path, cmd, fs, err)
+		}
+
+		rest := fs.Args()
+		if len(rest) == 0 {
+			printUsage(a.stderr, path, cmd, fs)
+			return usageError{path: path, msg: "no command given"}
+		}
+
+		sub := cmd.find(rest[0])
+		if sub == nil {
+			return usageError{
+				path: path,
+				msg:  fmt.Sprintf("unknown command %q for %q", rest[0], path),
+			}
+		}
+
+		cmd = sub
+		path += " " + sub.name
+		args = rest[1:]
+	}
+}
+
+// flagSet creates the flag set of cmd, which includes the global flags
+func (a *app) flagSet(path string, cmd *command) *flag.FlagSet {
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	a.globalFlags(fs)
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+	return fs
+}
+
+// flagError prints usage if help was requested, and otherwise converts err
+// to a usageError
+func (a *app) flagError(
+	path string,
+	cmd *command,
+	fs *flag.FlagSet,
+	err error,
+) error {
+	if errors.Is(err, flag.ErrHelp) {
+		printUsage(a.stdout, path, cmd, fs)
+		return err
+	}
+	return usageError{path: path, msg: err.Error()}
+}
+
+// parseInterspersed parses the flags in args, which may appear before, after
+// or between positional arguments, and returns the positional arguments.
+// Arguments after "--" are always positional.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := fs.Args()
+		consumed := len(args) - len(rest)
+		if consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// printUsage writes the usage of cmd to w
+func printUsage(w io.Writer, path string, cmd *command, fs *flag.FlagSet) {
+	if cmd.run != nil {
+		fmt.Fprintf(
+			w,
+			"Usage: %s\n",
+			strings.TrimSpace(path+" [flags] "+cmd.args))
+	} else {
+		fmt.Fprintf(w, "Usage: %s <command> [flags]\n", path)
+	}
+
+	if len(cmd.short) > 0 {
+		fmt.Fprintf(w, "\n%s\n", cmd.short)
+	}
+
+	if len(cmd.commands) > 0 {
+		fmt.Fprintln(w, "\nCommands:")
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		for _, sub := range cmd.commands {
+			if !sub.hidden {
+				fmt.Fprintf(tw, "  %s\t%s\n", sub.name, sub.short)
+			}
+		}
+		tw.Flush()
+	}
+
+	fmt.Fprintln(w, "\nFlags:")
+	fs.VisitAll(func(f *flag.Flag) {
+		name, usage := flag.UnquoteUsage(f)
+		fmt.Fprintf(w, "  %s", flagName(f))
+		if len(name) > 0 {
+			fmt.Fprintf(w, " %s", name)
+		}
+		fmt.Fprintf(w, "\n      %s", usage)
+		if len(f.DefValue) > 0 && f.DefValue != "false" {
+			fmt.Fprintf(w, " (default %q)", f.DefValue)
+		}
+		fmt.Fprintln(w)
+	})
+}
+
+// flagName returns the name of f as it is written on the command line, with
+// one dash for shorthands and two otherwise
+func flagName(f *flag.Flag) string {
+	if len(f.Name) == 1 {
+		return "-" + f.Name
+	}
+	return "--" + f.Name
+}
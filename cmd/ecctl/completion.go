@@ -0,0 +1,164 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains shell completion. The scripts printed by the
+	completion command call the hidden __complete command, which lists the
+	words that may follow those already typed.
+*/
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var completionScripts = map[string]string{
+	"bash": `# bash completion for ecctl
+_ecctl() {
+	local cur="${COMP_WORDS[COMP_CWORD]}"
+	local words
+	words="$(ecctl __complete -- "${COMP_WORDS[@]:1:COMP_CWORD-1}")"
+	COMPREPLY=($(compgen -W "${words}" -- "${cur}"))
+}
+complete -o default -F _ecctl ecctl
+`,
+	"zsh": `#compdef ecctl
+# zsh completion for ecctl
+autoload -U +X bashcompinit && bashcompinit
+_ecctl() {
+	local cur="${COMP_WORDS[COMP_CWORD]}"
+	local words
+	words="$(ecctl __complete -- "${COMP_WORDS[@]:1:COMP_CWORD-1}")"
+	COMPREPLY=($(compgen -W "${words}" -- "${cur}"))
+}
+complete -o default -F _ecctl ecctl
+`,
+	"fish": `# fish completion for ecctl
+function __ecctl_complete
+	set -l words (commandline -opc)
+	ecctl __complete -- $words[2..-1]
+end
+complete -c ecctl -a '(__ecctl_complete)'
+`,
+}
+
+// flagValues are the values suggested for flags that accept a fixed set
+func flagValues() map[string][]string {
+	platformNames := make([]string, 0, len(platforms))
+	for _, p := range platforms {
+		platformNames = append(platformNames, p.String())
+	}
+
+	return map[string][]string{
+		"output":   formats,
+		"o":        formats,
+		"platform": platformNames,
+	}
+}
+
+func newCompletionCommand() *command {
+	shells := make([]string, 0, len(completionScripts))
+	for shell := range completionScripts {
+		shells = append(shells, shell)
+	}
+	sort.Strings(shells)
+
+	return &command{
+		name:  "completion",
+		short: "Print a shell completion script",
+		args:  strings.Join(shells, "|"),
+		nargs: 1,
+		run: func(
+			ctx context.Context,
+			a *app,
+			args []string,
+		) (interface{}, error) {
+			script, ok := completionScripts[args[0]]
+			if !ok {
+				return nil, fmt.Errorf(
+					"unsupported shell %q: must be one of %s",
+					args[0],
+					strings.Join(shells, ", "))
+			}
+			_, err := fmt.Fprint(a.stdout, script)
+			return nil, err
+		},
+	}
+}
+
+func newCompleteCommand() *command {
+	return &command{
+		name:   "__complete",
+		args:   "-- [words]",
+		nargs:  -1,
+		hidden: true,
+		run: func(
+			ctx context.Context,
+			a *app,
+			args []string,
+		) (interface{}, error) {
+			for _, c := range completions(a, newRootCommand(), args) {
+				fmt.Fprintln(a.stdout, c)
+			}
+			return nil, nil
+		},
+	}
+}
+
+// completions returns the words that may follow words, which are the
+// arguments typed so far
+func completions(a *app, root *command, words []string) []string {
+	cmd := root
+	path := root.name
+	var pending *flag.Flag
+
+	for _, w := range words {
+		if pending != nil {
+			// w is the value of the previous flag
+			pending = nil
+			continue
+		}
+
+		if strings.HasPrefix(w, "-") {
+			name := strings.TrimLeft(w, "-")
+			if strings.Contains(name, "=") {
+				continue
+			}
+			f := a.flagSet(path, cmd).Lookup(name)
+			if f != nil && !isBoolFlag(f) {
+				pending = f
+			}
+			continue
+		}
+
+		if sub := cmd.find(w); sub != nil && !sub.hidden {
+			cmd = sub
+			path += " " + sub.name
+		}
+	}
+
+	if pending != nil {
+		return flagValues()[pending.Name]
+	}
+
+	var candidates []string
+	for _, sub := range cmd.commands {
+		if !sub.hidden {
+			candidates = append(candidates, sub.name)
+		}
+	}
+	a.flagSet(path, cmd).VisitAll(func(f *flag.Flag) {
+		candidates = append(candidates, flagName(f))
+	})
+	return candidates
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Certificate Provisioning System
+	service
+*/
+
+import (
+	"context"
+	"flag"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/certificate"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/models"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+	"github.com/go-openapi/swag"
+)
+
+func newCPSCommand() *command {
+	certificates := cpsCertificates.command()
+	certificates.commands = append(
+		[]*command{newCertificateFindCommand()},
+		certificates.commands...)
+	certificates.commands = append(
+		certificates.commands,
+		newCertificateStatusCommand())
+
+	return &command{
+		name:     "cps",
+		short:    "Manage the Certificate Provisioning System",
+		commands: []*command{certificates},
+	}
+}
+
+// cpsService returns the Certificate Provisioning System service
+func cpsService(a *app) (*cps.CpsService, error) {
+	client, err := a.sdk()
+	if err != nil {
+		return nil, err
+	}
+	return client.CPS()
+}
+
+// parseCertificateID parses the ID of a certificate
+func parseCertificateID(id string) (int64, error) {
+	parsed, err := parseID(id)
+	return int64(parsed), err
+}
+
+var cpsCertificates = resource[models.CertificateCreate]{
+	name:  "cert",
+	short: "Manage certificates",
+	noun:  "certificate",
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		certID, err := parseCertificateID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := cpsService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := certificate.NewCertificateGetParams()
+		params.ID = certID
+		return svc.Certificate.CertificateGetWithContext(ctx, params)
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body models.CertificateCreate,
+	) (interface{}, error) {
+		svc, err := cpsService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := certificate.NewCertificatePostParams()
+		params.Certificate = &body
+		return svc.Certificate.CertificatePostWithContext(ctx, params)
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		certID, err := parseCertificateID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := cpsService(a)
+		if err != nil {
+			return err
+		}
+		params := certificate.NewCertificateDeleteParams()
+		params.ID = certID
+		_, err = svc.Certificate.CertificateDeleteWithContext(ctx, params)
+		return err
+	},
+}
+
+// newCertificateFindCommand creates the command that searches certificates,
+// fetching every page of results
+func newCertificateFindCommand() *command {
+	var query, sort string
+	var pageSize, limit int
+
+	return &command{
+		name:  "find",
+		short: "Search certificates",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&query, "query", "", "search query")
+			fs.StringVar(&sort, "sort", "", "sort order, e.g. name")
+			fs.IntVar(
+				&pageSize,
+				"page-size",
+				0,
+				"number of results per request")
+			fs.IntVar(&limit, "limit", 0, "maximum number of results")
+		},
+		run: func(
+			ctx context.Context,
+			a *app,
+			args []string,
+		) (interface{}, error) {
+			svc, err := cpsService(a)
+			if err != nil {
+				return nil, err
+			}
+
+			params := certificate.NewCertificateFindParams()
+			if len(query) > 0 {
+				params.Query = swag.String(query)
+			}
+			if len(sort) > 0 {
+				params.Sort = swag.String(sort)
+			}
+			if pageSize > 0 {
+				params.PageSize = swag.Int32(int32(pageSize))
+			}
+
+			it := certificate.NewCertificateFindIterator(
+				ctx,
+				svc.Certificate,
+				params,
+				ecpaging.Options{MaxItems: limit})
+			return it.All()
+		},
+	}
+}
+
+// newCertificateStatusCommand creates the command that gets the status of a
+// certificate request
+func newCertificateStatusCommand() *command {
+	return &command{
+		name:  "status",
+		short: "Get the status of a certificate request",
+		args:  "<id>",
+		nargs: 1,
+		run: func(
+			ctx context.Context,
+			a *app,
+			args []string,
+		) (interface{}, error) {
+			certID, err := parseCertificateID(args[0])
+			if err != nil {
+				return nil, err
+			}
+			svc, err := cpsService(a)
+			if err != nil {
+				return nil, err
+			}
+			params := certificate.NewCertificateGetCertificateStatusParams()
+			params.ID = certID
+			return svc.Certificate.CertificateGetCertificateStatusWithContext(
+				ctx,
+				params)
+		},
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Customer Management service
+*/
+
+import (
+	"context"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/customer"
+)
+
+func newCustomerCommand() *command {
+	return &command{
+		name:  "customer",
+		short: "Manage customer accounts",
+		commands: []*command{
+			{
+				name:  "get",
+				short: "Get the customer account given by --account",
+				run: func(
+					ctx context.Context,
+					a *app,
+					args []string,
+				) (interface{}, error) {
+					account, err := a.accountNumber()
+					if err != nil {
+						return nil, err
+					}
+					client, err := a.sdk()
+					if err != nil {
+						return nil, err
+					}
+					svc, err := client.Customer()
+					if err != nil {
+						return nil, err
+					}
+					return svc.GetCustomerWithContext(
+						ctx,
+						customer.GetCustomerParams{AccountNumber: account})
+				},
+			},
+		},
+	}
+}
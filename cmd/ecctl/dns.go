@@ -0,0 +1,191 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Route DNS service
+*/
+
+import (
+	"context"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/routedns"
+)
+
+func newDNSCommand() *command {
+	return &command{
+		name:  "dns",
+		short: "Manage Route DNS",
+		commands: []*command{
+			dnsTSIGs.command(),
+			dnsZones.command(),
+		},
+	}
+}
+
+// dnsService returns the Route DNS service and the account number
+func dnsService(a *app) (*routedns.RouteDNSService, string, error) {
+	account, err := a.accountNumber()
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := a.sdk()
+	if err != nil {
+		return nil, "", err
+	}
+
+	svc, err := client.RouteDNS()
+	return svc, account, err
+}
+
+var dnsZones = resource[routedns.Zone]{
+	name:  "zone",
+	short: "Manage primary zones",
+	noun:  "primary zone",
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		zoneID, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetZoneWithContext(
+			ctx,
+			routedns.GetZoneParams{AccountNumber: account, ZoneID: zoneID})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body routedns.Zone,
+	) (interface{}, error) {
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.AddZoneWithContext(
+			ctx,
+			routedns.AddZoneParams{AccountNumber: account, Zone: body})
+		if err != nil {
+			return nil, err
+		}
+		return created{ID: *id}, nil
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body routedns.Zone,
+	) (interface{}, error) {
+		zoneID, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.UpdateZoneWithContext(
+			ctx,
+			routedns.UpdateZoneParams{
+				AccountNumber: account,
+				Zone: routedns.ZoneGetOK{
+					Zone:        body,
+					FixedZoneID: zoneID,
+					ZoneID:      zoneID,
+				},
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		zoneID, err := parseID(id)
+		if err != nil {
+			return err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return err
+		}
+		return svc.DeleteZoneWithContext(
+			ctx,
+			routedns.DeleteZoneParams{
+				AccountNumber: account,
+				Zone:          routedns.ZoneGetOK{FixedZoneID: zoneID},
+			})
+	},
+}
+
+var dnsTSIGs = resource[routedns.TSIG]{
+	name:  "tsig",
+	short: "Manage TSIG keys",
+	noun:  "TSIG key",
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		tsigID, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetTSIGWithContext(
+			ctx,
+			routedns.GetTSIGParams{AccountNumber: account, TSIGID: tsigID})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body routedns.TSIG,
+	) (interface{}, error) {
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.AddTSIGWithContext(
+			ctx,
+			routedns.AddTSIGParams{AccountNumber: account, TSIG: body})
+		if err != nil {
+			return nil, err
+		}
+		return created{ID: *id}, nil
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body routedns.TSIG,
+	) (interface{}, error) {
+		tsigID, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.UpdateTSIGWithContext(
+			ctx,
+			routedns.UpdateTSIGParams{
+				AccountNumber: account,
+				TSIG:          routedns.TSIGGetOK{TSIG: body, ID: tsigID},
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		tsigID, err := parseID(id)
+		if err != nil {
+			return err
+		}
+		svc, account, err := dnsService(a)
+		if err != nil {
+			return err
+		}
+		return svc.DeleteTSIGWithContext(
+			ctx,
+			routedns.DeleteTSIGParams{
+				AccountNumber: account,
+				TSIG:          routedns.TSIGGetOK{ID: tsigID},
+			})
+	},
+}
@@ -0,0 +1,144 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Edge CNAME service
+*/
+
+import (
+	"context"
+	"flag"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/edgecname"
+)
+
+// edgeCnameService returns the Edge CNAME service and the account number
+func edgeCnameService(a *app) (*edgecname.EdgeCnameService, string, error) {
+	account, err := a.accountNumber()
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := a.sdk()
+	if err != nil {
+		return nil, "", err
+	}
+
+	svc, err := client.EdgeCname()
+	return svc, account, err
+}
+
+// newEdgeCnameCommand creates the command for edge CNAMEs. Listing is done
+// per platform, given by --platform; the platform of an edge CNAME is set
+// by the MediaTypeId field of its request body.
+func newEdgeCnameCommand() *command {
+	var platform string
+
+	edgeCnames := resource[edgecname.EdgeCname]{
+		name:  "edgecname",
+		short: "Manage edge CNAMEs",
+		noun:  "edge CNAME",
+		list: func(ctx context.Context, a *app) (interface{}, error) {
+			p, err := parsePlatform(platform)
+			if err != nil {
+				return nil, err
+			}
+			svc, account, err := edgeCnameService(a)
+			if err != nil {
+				return nil, err
+			}
+			return svc.GetAllEdgeCnamesWithContext(
+				ctx,
+				edgecname.GetAllEdgeCnameParams{
+					AccountNumber: account,
+					Platform:      p,
+				})
+		},
+		get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+			cnameID, err := parseID(id)
+			if err != nil {
+				return nil, err
+			}
+			svc, account, err := edgeCnameService(a)
+			if err != nil {
+				return nil, err
+			}
+			return svc.GetEdgeCnameWithContext(
+				ctx,
+				edgecname.GetEdgeCnameParams{
+					AccountNumber: account,
+					EdgeCnameID:   cnameID,
+				})
+		},
+		create: func(
+			ctx context.Context,
+			a *app,
+			body edgecname.EdgeCname,
+		) (interface{}, error) {
+			svc, account, err := edgeCnameService(a)
+			if err != nil {
+				return nil, err
+			}
+			id, err := svc.AddEdgeCnameWithContext(
+				ctx,
+				edgecname.AddEdgeCnameParams{
+					AccountNumber: account,
+					EdgeCname:     body,
+				})
+			if err != nil {
+				return nil, err
+			}
+			return created{ID: *id}, nil
+		},
+		update: func(
+			ctx context.Context,
+			a *app,
+			id string,
+			body edgecname.EdgeCname,
+		) (interface{}, error) {
+			cnameID, err := parseID(id)
+			if err != nil {
+				return nil, err
+			}
+			svc, account, err := edgeCnameService(a)
+			if err != nil {
+				return nil, err
+			}
+			_, err = svc.UpdateEdgeCnameWithContext(
+				ctx,
+				edgecname.UpdateEdgeCnameParams{
+					AccountNumber: account,
+					EdgeCname: edgecname.EdgeCnameGetOK{
+						EdgeCname: body,
+						ID:        cnameID,
+					},
+				})
+			return nil, err
+		},
+		delete: func(ctx context.Context, a *app, id string) error {
+			cnameID, err := parseID(id)
+			if err != nil {
+				return err
+			}
+			svc, account, err := edgeCnameService(a)
+			if err != nil {
+				return err
+			}
+			return svc.DeleteEdgeCnameWithContext(
+				ctx,
+				edgecname.DeleteEdgeCnameParams{
+					AccountNumber: account,
+					EdgeCname:     edgecname.EdgeCnameGetOK{ID: cnameID},
+				})
+		},
+	}
+
+	cmd := edgeCnames.command()
+	list := cmd.find("list")
+	list.flags = func(fs *flag.FlagSet) {
+		platformFlag(fs, &platform)
+	}
+	return cmd
+}
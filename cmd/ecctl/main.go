@@ -0,0 +1,48 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Ecctl manages Edgecast resources from the command line using the SDK.
+
+Usage:
+
+	ecctl [global flags] <service> <resource> <action> [flags] [args]
+
+For example:
+
+	ecctl --account ABCD waf access-rules list
+	ecctl dns zone get 12345 --output yaml
+	ecctl cps cert find --query example.com
+	ecctl rtld profiles-cdn create -f profile.json
+
+Credentials and base URLs are loaded with edgecast.LoadConfig, i.e. from the
+EC_* environment variables or a profile in ~/.edgecast/credentials. The
+account number used by the legacy APIs is taken from --account or the
+EC_ACCOUNT_NUMBER environment variable.
+
+Global flags:
+
+	--output, -o   output format: json, yaml or table (default json)
+	--account      customer account number
+	--profile      credentials file profile
+	--dry-run      print the requests that would change resources instead of
+	               sending them
+	--debug        log requests and responses to standard error
+
+Run "ecctl completion bash", "ecctl completion zsh" or "ecctl completion fish"
+to print a shell completion script.
+*/
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
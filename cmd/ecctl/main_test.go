@@ -0,0 +1,389 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+)
+
+const testAccountNumber = "ABCD"
+
+// newTestServer starts a fake API server and points the configuration
+// loaded by ecctl at it
+func newTestServer(t *testing.T) *ectest.Server {
+	server := ectest.NewServer()
+	t.Cleanup(server.Close)
+
+	url := server.URL.String()
+	env := map[string]string{
+		"HOME":                   t.TempDir(),
+		"EC_BASE_API_URL":        url,
+		"EC_BASE_API_URL_LEGACY": url,
+		"EC_BASE_IDS_URL":        url,
+		"EC_API_TOKEN":           ectest.APIToken,
+		"EC_IDS_CLIENT_ID":       ectest.ClientID,
+		"EC_IDS_CLIENT_SECRET":   ectest.ClientSecret,
+		"EC_IDS_SCOPE":           strings.Join(ectest.AllScopes(), " "),
+		"EC_PROFILE":             "",
+		"EC_CREDENTIALS_FILE":    "",
+		envAccountNumber:         testAccountNumber,
+	}
+	for k, v := range env {
+		t.Setenv(k, v)
+	}
+
+	return server
+}
+
+// ecctl runs ecctl with args and returns its output and exit code
+func ecctl(
+	t *testing.T,
+	stdin string,
+	args ...string,
+) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+	code := run(
+		context.Background(),
+		args,
+		strings.NewReader(stdin),
+		&stdout,
+		&stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+// mustECCTL runs ecctl and fails the test if it does not succeed
+func mustECCTL(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+
+	stdout, stderr, code := ecctl(t, stdin, args...)
+	if code != exitOK {
+		t.Fatalf(
+			"%s: Expected exit code %d but got %d: %s",
+			strings.Join(args, " "),
+			exitOK,
+			code,
+			stderr)
+	}
+	return stdout
+}
+
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	return path
+}
+
+func TestAccessRules(t *testing.T) {
+	server := newTestServer(t)
+
+	file := writeFile(t, "rule.yaml", "name: first\nmax_file_size: 1024\n")
+	out := mustECCTL(t, "", "waf", "access-rules", "create", "-f", file)
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Expected JSON but got %q: %v", out, err)
+	}
+	if len(result.ID) == 0 {
+		t.Fatalf("Expected an ID but got %q", out)
+	}
+
+	out = mustECCTL(
+		t, "", "waf", "access-rules", "get", result.ID, "-o", "yaml")
+	if !strings.Contains(out, "name: first") {
+		t.Fatalf("Expected the rule as YAML but got %q", out)
+	}
+
+	file = writeFile(t, "rule.json", `{"name": "renamed"}`)
+	mustECCTL(t, "", "waf", "access-rules", "update", result.ID, "--file", file)
+
+	out = mustECCTL(t, "", "--output", "table", "waf", "access-rules", "list")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 ||
+		!strings.HasPrefix(lines[0], "ID") ||
+		!strings.Contains(lines[1], "renamed") {
+		t.Fatalf("Expected a table with one rule but got %q", out)
+	}
+
+	mustECCTL(t, "", "waf", "access-rules", "delete", result.ID)
+	if items := server.Items(ectest.WAFAccessRules); len(items) != 0 {
+		t.Fatalf("Expected no rules but got %+v", items)
+	}
+}
+
+func TestDNSZone(t *testing.T) {
+	newTestServer(t)
+
+	out := mustECCTL(
+		t,
+		`{"DomainName": "example.com.", "Status": 1, "ZoneType": 1}`,
+		"dns", "zone", "create", "-f", "-")
+
+	var result struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("Expected JSON but got %q: %v", out, err)
+	}
+
+	out = mustECCTL(
+		t,
+		"",
+		"dns", "zone", "get", "--output=table", strconv.Itoa(result.ID))
+	if !strings.Contains(out, "example.com.") {
+		t.Fatalf("Expected the zone but got %q", out)
+	}
+}
+
+func TestCPSCertFind(t *testing.T) {
+	newTestServer(t)
+
+	for _, label := range []string{"first", "second"} {
+		mustECCTL(
+			t,
+			`{"certificate_label": "`+label+`", "domains": []}`,
+			"cps", "cert", "create", "-f", "-")
+	}
+
+	out := mustECCTL(
+		t,
+		"",
+		"cps", "cert", "find", "--query", "example.com", "--page-size", "1")
+
+	var certs []map[string]interface{}
+	if err := json.Unmarshal([]byte(out), &certs); err != nil {
+		t.Fatalf("Expected JSON but got %q: %v", out, err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("Expected 2 certificates but got %d", len(certs))
+	}
+
+	out = mustECCTL(t, "", "cps", "cert", "find", "--limit", "1")
+	if err := json.Unmarshal([]byte(out), &certs); err != nil {
+		t.Fatalf("Expected JSON but got %q: %v", out, err)
+	}
+	if len(certs) != 1 {
+		t.Fatalf("Expected 1 certificate but got %d", len(certs))
+	}
+}
+
+func TestRTLDProfilesCDN(t *testing.T) {
+	server := newTestServer(t)
+
+	file := writeFile(
+		t,
+		"profile.yaml",
+		"profile_name: logs\nplatforms:\n  - http_large\n")
+	mustECCTL(t, "", "rtld", "profiles-cdn", "create", "-f", file)
+
+	items := server.Items(ectest.RTLDCDNProfiles)
+	if len(items) != 1 || items[0]["profile_name"] != "logs" {
+		t.Fatalf("Expected the created profile but got %+v", items)
+	}
+
+	out := mustECCTL(t, "", "rtld", "profiles-cdn", "list", "-o", "table")
+	if !strings.Contains(out, "logs") {
+		t.Fatalf("Expected the profile but got %q", out)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	server := newTestServer(t)
+
+	file := writeFile(t, "rule.json", `{"name": "first"}`)
+	out := mustECCTL(
+		t,
+		"",
+		"waf", "access-rules", "create", "-f", file, "--dry-run")
+
+	var plan []struct {
+		Method string          `json:"method"`
+		URL    string          `json:"url"`
+		Body   json.RawMessage `json:"body"`
+	}
+	if err := json.Unmarshal([]byte(out), &plan); err != nil {
+		t.Fatalf("Expected a JSON plan but got %q: %v", out, err)
+	}
+	if len(plan) != 1 ||
+		plan[0].Method != "POST" ||
+		!strings.Contains(plan[0].URL, testAccountNumber) {
+		t.Fatalf("Expected a planned POST but got %+v", plan)
+	}
+
+	if items := server.Items(ectest.WAFAccessRules); len(items) != 0 {
+		t.Fatalf("Expected no rules but got %+v", items)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	newTestServer(t)
+
+	cases := []struct {
+		Name         string
+		Args         []string
+		ExpectedCode int
+		ExpectedErr  string
+	}{
+		{
+			Name:         "No command",
+			Args:         nil,
+			ExpectedCode: exitUsage,
+			ExpectedErr:  "no command given",
+		},
+		{
+			Name:         "Unknown command",
+			Args:         []string{"waf", "nope"},
+			ExpectedCode: exitUsage,
+			ExpectedErr:  `unknown command "nope" for "ecctl waf"`,
+		},
+		{
+			Name:         "Missing argument",
+			Args:         []string{"waf", "access-rules", "get"},
+			ExpectedCode: exitUsage,
+			ExpectedErr:  "requires 1 argument(s) but got 0",
+		},
+		{
+			Name:         "Invalid output",
+			Args:         []string{"-o", "xml", "waf", "access-rules", "list"},
+			ExpectedCode: exitUsage,
+			ExpectedErr:  `invalid output format "xml"`,
+		},
+		{
+			Name:         "Unknown flag",
+			Args:         []string{"waf", "access-rules", "list", "--nope"},
+			ExpectedCode: exitUsage,
+			ExpectedErr:  "flag provided but not defined: -nope",
+		},
+		{
+			Name:         "Missing account",
+			Args:         []string{"--account=", "waf", "access-rules", "list"},
+			ExpectedCode: exitError,
+			ExpectedErr:  "an account number is required",
+		},
+		{
+			Name:         "Missing file",
+			Args:         []string{"waf", "access-rules", "create"},
+			ExpectedCode: exitError,
+			ExpectedErr:  "a request body is required",
+		},
+		{
+			Name:         "Invalid ID",
+			Args:         []string{"dns", "zone", "get", "abc"},
+			ExpectedCode: exitError,
+			ExpectedErr:  `invalid ID "abc"`,
+		},
+		{
+			Name:         "Not found",
+			Args:         []string{"rtld", "profiles-cdn", "get", "1"},
+			ExpectedCode: exitError,
+			ExpectedErr:  "404",
+		},
+	}
+
+	for _, c := range cases {
+		_, stderr, code := ecctl(t, "", c.Args...)
+		if code != c.ExpectedCode {
+			t.Fatalf(
+				"%s: Expected exit code %d but got %d",
+				c.Name,
+				c.ExpectedCode,
+				code)
+		}
+		if !strings.Contains(stderr, c.ExpectedErr) {
+			t.Fatalf(
+				"%s: Expected error %q but got %q",
+				c.Name,
+				c.ExpectedErr,
+				stderr)
+		}
+	}
+}
+
+func TestHelp(t *testing.T) {
+	out := mustECCTL(t, "", "waf", "access-rules", "--help")
+	for _, expected := range []string{
+		"Usage: ecctl waf access-rules <command> [flags]",
+		"list",
+		"--output",
+		"-o",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Fatalf("Expected %q in usage but got %q", expected, out)
+		}
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Words    []string
+		Expected []string
+	}{
+		{
+			Name:     "Services",
+			Words:    nil,
+			Expected: []string{"waf", "dns", "cps", "rtld", "--output"},
+		},
+		{
+			Name:     "Resources",
+			Words:    []string{"--debug", "waf"},
+			Expected: []string{"access-rules", "scopes"},
+		},
+		{
+			Name:     "Flags",
+			Words:    []string{"cps", "cert", "find"},
+			Expected: []string{"--query", "--limit", "--dry-run"},
+		},
+		{
+			Name:     "Output formats",
+			Words:    []string{"waf", "-o"},
+			Expected: []string{"json", "yaml", "table"},
+		},
+		{
+			Name:     "Platforms",
+			Words:    []string{"origin", "list", "--platform"},
+			Expected: []string{"http-large", "http-small", "adn"},
+		},
+	}
+
+	for _, c := range cases {
+		args := append([]string{"__complete", "--"}, c.Words...)
+		out := mustECCTL(t, "", args...)
+		words := strings.Fields(out)
+		for _, expected := range c.Expected {
+			if !contains(words, expected) {
+				t.Fatalf("%s: Expected %q in %+v", c.Name, expected, words)
+			}
+		}
+		if contains(words, "__complete") {
+			t.Fatalf("%s: Expected hidden commands to be omitted", c.Name)
+		}
+	}
+
+	out := mustECCTL(t, "", "completion", "bash")
+	if !strings.Contains(out, "complete -o default -F _ecctl ecctl") {
+		t.Fatalf("Expected a bash completion script but got %q", out)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
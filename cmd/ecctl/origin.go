@@ -0,0 +1,155 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the legacy Origin service
+*/
+
+import (
+	"context"
+	"flag"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/origin"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+)
+
+// newOriginCommand creates the command for customer origins. Every action
+// applies to the platform selected by --platform.
+func newOriginCommand() *command {
+	var platform string
+
+	// originService returns the Origin service, the account number and the
+	// platform
+	originService := func(a *app) (
+		*origin.OriginService,
+		string,
+		enums.Platform,
+		error,
+	) {
+		p, err := parsePlatform(platform)
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		account, err := a.accountNumber()
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		client, err := a.sdk()
+		if err != nil {
+			return nil, "", 0, err
+		}
+
+		svc, err := client.Origin()
+		return svc, account, p, err
+	}
+
+	origins := resource[origin.Origin]{
+		name:  "origin",
+		short: "Manage customer origins",
+		noun:  "customer origin",
+		flags: func(fs *flag.FlagSet) {
+			platformFlag(fs, &platform)
+		},
+		list: func(ctx context.Context, a *app) (interface{}, error) {
+			svc, account, p, err := originService(a)
+			if err != nil {
+				return nil, err
+			}
+			return svc.GetAllOriginsWithContext(
+				ctx,
+				origin.GetAllOriginsParams{
+					AccountNumber: account,
+					MediaTypeID:   p,
+				})
+		},
+		get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+			originID, err := parseID(id)
+			if err != nil {
+				return nil, err
+			}
+			svc, account, p, err := originService(a)
+			if err != nil {
+				return nil, err
+			}
+			return svc.GetOriginWithContext(
+				ctx,
+				origin.GetOriginParams{
+					AccountNumber:    account,
+					MediaTypeID:      p,
+					CustomerOriginID: originID,
+				})
+		},
+		create: func(
+			ctx context.Context,
+			a *app,
+			body origin.Origin,
+		) (interface{}, error) {
+			svc, account, p, err := originService(a)
+			if err != nil {
+				return nil, err
+			}
+			id, err := svc.AddOriginWithContext(
+				ctx,
+				origin.AddOriginParams{
+					AccountNumber: account,
+					MediaTypeID:   p,
+					Origin:        body,
+				})
+			if err != nil {
+				return nil, err
+			}
+			return created{ID: *id}, nil
+		},
+		update: func(
+			ctx context.Context,
+			a *app,
+			id string,
+			body origin.Origin,
+		) (interface{}, error) {
+			originID, err := parseID(id)
+			if err != nil {
+				return nil, err
+			}
+			svc, account, p, err := originService(a)
+			if err != nil {
+				return nil, err
+			}
+			_, err = svc.UpdateOriginWithContext(
+				ctx,
+				origin.UpdateOriginParams{
+					AccountNumber: account,
+					Origin: origin.OriginGetOK{
+						Origin:      body,
+						ID:          originID,
+						MediaTypeID: p,
+					},
+				})
+			return nil, err
+		},
+		delete: func(ctx context.Context, a *app, id string) error {
+			originID, err := parseID(id)
+			if err != nil {
+				return err
+			}
+			svc, account, p, err := originService(a)
+			if err != nil {
+				return err
+			}
+			return svc.DeleteOriginWithContext(
+				ctx,
+				origin.DeleteOriginParams{
+					AccountNumber: account,
+					Origin: origin.OriginGetOK{
+						ID:          originID,
+						MediaTypeID: p,
+					},
+				})
+		},
+	}
+
+	return origins.command()
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the code that prints results as JSON, YAML or a table
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Output formats
+const (
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+	formatTable = "table"
+)
+
+var formats = []string{formatJSON, formatYAML, formatTable}
+
+func validateFormat(format string) error {
+	for _, f := range formats {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"invalid output format %q: must be one of %s",
+		format,
+		strings.Join(formats, ", "))
+}
+
+// writeOutput writes v to w in the given format. Fields are named as in the
+// JSON encoding of v for every format.
+func writeOutput(w io.Writer, format string, v interface{}) error {
+	switch format {
+	case formatYAML:
+		data, err := normalize(v)
+		if err != nil {
+			return err
+		}
+		out, err := yaml.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("writeOutput: %w", err)
+		}
+		_, err = w.Write(out)
+		return err
+	case formatTable:
+		data, err := normalize(v)
+		if err != nil {
+			return err
+		}
+		return writeTable(w, data)
+	default:
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("writeOutput: %w", err)
+		}
+		_, err = fmt.Fprintf(w, "%s\n", out)
+		return err
+	}
+}
+
+// normalize converts v to the maps, slices and scalars that its JSON
+// encoding decodes to. Whole numbers are decoded as int64 so that they are
+// not printed in exponent notation.
+func normalize(v interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("normalize: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("normalize: %w", err)
+	}
+	return convertNumbers(data), nil
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, item := range t {
+			t[k] = convertNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range t {
+			t[i] = convertNumbers(item)
+		}
+	}
+	return v
+}
+
+// writeTable writes a list as a table with a column for each scalar field,
+// an object as a table of fields and values, and a scalar as is
+func writeTable(w io.Writer, data interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	switch t := data.(type) {
+	case []interface{}:
+		writeRows(tw, t)
+	case map[string]interface{}:
+		for _, k := range sortedKeys(t) {
+			fmt.Fprintf(tw, "%s\t%s\n", k, formatCell(t[k]))
+		}
+	default:
+		fmt.Fprintln(tw, formatCell(t))
+	}
+
+	return tw.Flush()
+}
+
+// writeRows writes a row for each item of a list. The columns are the scalar
+// fields of the items, with ID fields first.
+func writeRows(w io.Writer, items []interface{}) {
+	seen := map[string]bool{}
+	var columns []string
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k, v := range fields {
+			if !seen[k] && isScalar(v) {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		for _, item := range items {
+			fmt.Fprintln(w, formatCell(item))
+		}
+		return
+	}
+
+	sort.Slice(columns, func(i, j int) bool {
+		iID, jID := isIDField(columns[i]), isIDField(columns[j])
+		if iID != jID {
+			return iID
+		}
+		return columns[i] < columns[j]
+	})
+
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = strings.ToUpper(c)
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		fields, _ := item.(map[string]interface{})
+		cells := make([]string, len(columns))
+		for i, c := range columns {
+			cells[i] = formatCell(fields[c])
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
+func isIDField(name string) bool {
+	return strings.EqualFold(name, "id")
+}
+
+// formatCell formats a value for a table. Objects and lists are written as
+// compact JSON.
+func formatCell(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(encoded)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
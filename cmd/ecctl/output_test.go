@@ -0,0 +1,79 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteOutput(t *testing.T) {
+	type item struct {
+		Name   string            `json:"name"`
+		ID     int               `json:"id"`
+		Large  int64             `json:"large"`
+		Labels map[string]string `json:"labels,omitempty"`
+	}
+
+	cases := []struct {
+		name     string
+		format   string
+		input    interface{}
+		expected string
+	}{
+		{
+			name:   "JSON",
+			format: formatJSON,
+			input:  item{Name: "a", ID: 1},
+			expected: "{\n  \"name\": \"a\",\n  \"id\": 1,\n" +
+				"  \"large\": 0\n}\n",
+		},
+		{
+			name:     "YAML keeps whole numbers",
+			format:   formatYAML,
+			input:    item{Name: "a", ID: 1, Large: 12345678901},
+			expected: "id: 1\nlarge: 12345678901\nname: a\n",
+		},
+		{
+			name:   "Table of a list puts the ID first",
+			format: formatTable,
+			input: []item{
+				{Name: "a", ID: 1, Labels: map[string]string{"k": "v"}},
+				{Name: "b", ID: 2},
+			},
+			expected: "ID  LARGE  NAME\n1   0      a\n2   0      b\n",
+		},
+		{
+			name:   "Table of an object",
+			format: formatTable,
+			input: item{
+				Name:   "a",
+				ID:     1,
+				Labels: map[string]string{"k": "v"},
+			},
+			expected: "id      1\nlabels  {\"k\":\"v\"}\n" +
+				"large   0\nname    a\n",
+		},
+		{
+			name:     "Table of a scalar",
+			format:   formatTable,
+			input:    "text",
+			expected: "text\n",
+		},
+	}
+
+	for _, c := range cases {
+		var out bytes.Buffer
+		if err := writeOutput(&out, c.format, c.input); err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if out.String() != c.expected {
+			t.Fatalf(
+				"%s: Expected %q but got %q",
+				c.name,
+				c.expected,
+				out.String())
+		}
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains resource, which builds the list, get, create, update
+	and delete commands of a kind of resource, and the helpers they use to
+	read request bodies and parse arguments
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+	"gopkg.in/yaml.v2"
+)
+
+// resource describes the actions available for a kind of resource whose
+// request bodies have type T. Actions whose function is nil are not offered.
+type resource[T any] struct {
+	name  string
+	short string
+
+	// noun is the singular name of the resource used in usage, e.g. "access
+	// rule"
+	noun string
+
+	list   func(ctx context.Context, a *app) (interface{}, error)
+	get    func(ctx context.Context, a *app, id string) (interface{}, error)
+	create func(ctx context.Context, a *app, body T) (interface{}, error)
+	update func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body T,
+	) (interface{}, error)
+	delete func(ctx context.Context, a *app, id string) error
+
+	// flags adds flags shared by all actions, e.g. the platform
+	flags func(fs *flag.FlagSet)
+
+	// commands are additional actions
+	commands []*command
+}
+
+// created is printed for resources whose create action only returns an ID
+type created struct {
+	ID interface{} `json:"id"`
+}
+
+// command creates the command for the resource, with a subcommand for each
+// action
+func (r resource[T]) command() *command {
+	cmd := &command{name: r.name, short: r.short}
+
+	if r.list != nil {
+		cmd.commands = append(cmd.commands, &command{
+			name:  "list",
+			short: "List every " + r.noun,
+			flags: r.flags,
+			run: func(
+				ctx context.Context,
+				a *app,
+				args []string,
+			) (interface{}, error) {
+				return r.list(ctx, a)
+			},
+		})
+	}
+
+	if r.get != nil {
+		cmd.commands = append(cmd.commands, &command{
+			name:  "get",
+			short: "Get the " + r.noun + " with the given ID",
+			args:  "<id>",
+			nargs: 1,
+			flags: r.flags,
+			run: func(
+				ctx context.Context,
+				a *app,
+				args []string,
+			) (interface{}, error) {
+				return r.get(ctx, a, args[0])
+			},
+		})
+	}
+
+	if r.create != nil {
+		var file string
+		cmd.commands = append(cmd.commands, &command{
+			name:  "create",
+			short: "Create a new " + r.noun + " from a JSON or YAML file",
+			flags: r.withFileFlag(&file),
+			run: func(
+				ctx context.Context,
+				a *app,
+				args []string,
+			) (interface{}, error) {
+				body, err := readBody[T](a, file)
+				if err != nil {
+					return nil, err
+				}
+				return r.create(ctx, a, body)
+			},
+		})
+	}
+
+	if r.update != nil {
+		var file string
+		cmd.commands = append(cmd.commands, &command{
+			name: "update",
+			short: "Update the " + r.noun + " with the given ID from a JSON " +
+				"or YAML file",
+			args:  "<id>",
+			nargs: 1,
+			flags: r.withFileFlag(&file),
+			run: func(
+				ctx context.Context,
+				a *app,
+				args []string,
+			) (interface{}, error) {
+				body, err := readBody[T](a, file)
+				if err != nil {
+					return nil, err
+				}
+				return r.update(ctx, a, args[0], body)
+			},
+		})
+	}
+
+	if r.delete != nil {
+		cmd.commands = append(cmd.commands, &command{
+			name:  "delete",
+			short: "Delete the " + r.noun + " with the given ID",
+			args:  "<id>",
+			nargs: 1,
+			flags: r.flags,
+			run: func(
+				ctx context.Context,
+				a *app,
+				args []string,
+			) (interface{}, error) {
+				return nil, r.delete(ctx, a, args[0])
+			},
+		})
+	}
+
+	cmd.commands = append(cmd.commands, r.commands...)
+	return cmd
+}
+
+// withFileFlag returns a function that adds the resource's flags and the
+// --file flag, which is stored in file
+func (r resource[T]) withFileFlag(file *string) func(fs *flag.FlagSet) {
+	return func(fs *flag.FlagSet) {
+		if r.flags != nil {
+			r.flags(fs)
+		}
+		fileFlag(fs, file)
+	}
+}
+
+// fileFlag adds the --file flag, and its shorthand -f, to fs
+func fileFlag(fs *flag.FlagSet, file *string) {
+	fs.StringVar(
+		file,
+		"file",
+		"",
+		"JSON or YAML file containing the request body, or - for standard "+
+			"input")
+	fs.StringVar(file, "f", "", "shorthand for --file")
+}
+
+// readBody reads a request body from file, or standard input if file is "-"
+func readBody[T any](a *app, file string) (T, error) {
+	var body T
+	if len(file) == 0 {
+		return body, fmt.Errorf("a request body is required: use --file")
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(a.stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return body, fmt.Errorf("reading request body: %w", err)
+	}
+
+	if err := decodeBody(data, &body); err != nil {
+		return body, fmt.Errorf("reading request body from %s: %w", file, err)
+	}
+	return body, nil
+}
+
+// decodeBody decodes JSON or YAML into v. Fields are matched using the JSON
+// field names of v for both formats, and unknown fields are rejected.
+func decodeBody(data []byte, v interface{}) error {
+	// YAML is a superset of JSON, so both can be parsed as YAML
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	converted, err := jsonCompatible(generic)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(converted)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// jsonCompatible converts the maps decoded from YAML, whose keys may be of
+// any type, to maps with string keys
+func jsonCompatible(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"unsupported key %v: keys must be strings",
+					k)
+			}
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		for i, item := range t {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = converted
+		}
+	}
+	return v, nil
+}
+
+// parseID parses a numeric resource ID
+func parseID(id string) (int, error) {
+	parsed, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: must be a number", id)
+	}
+	return parsed, nil
+}
+
+// platforms are the delivery platforms accepted by --platform
+var platforms = []enums.Platform{enums.HttpLarge, enums.HttpSmall, enums.ADN}
+
+// platformFlag adds the --platform flag to fs
+func platformFlag(fs *flag.FlagSet, platform *string) {
+	fs.StringVar(
+		platform,
+		"platform",
+		enums.HttpLarge.String(),
+		"delivery platform: http-large, http-small or adn")
+}
+
+// parsePlatform parses the value of --platform
+func parsePlatform(name string) (enums.Platform, error) {
+	for _, p := range platforms {
+		if p.String() == name || p.StringWithoutHyphen() == name {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf(
+		"invalid platform %q: must be http-large, http-small or adn",
+		name)
+}
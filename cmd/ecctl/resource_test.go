@@ -0,0 +1,62 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeBody(t *testing.T) {
+	type body struct {
+		Name  string   `json:"name"`
+		Hosts []string `json:"hosts"`
+	}
+
+	cases := []struct {
+		name     string
+		input    string
+		expected body
+		err      string
+	}{
+		{
+			name:     "JSON",
+			input:    `{"name": "a", "hosts": ["x"]}`,
+			expected: body{Name: "a", Hosts: []string{"x"}},
+		},
+		{
+			name:     "YAML",
+			input:    "name: a\nhosts:\n  - x\n",
+			expected: body{Name: "a", Hosts: []string{"x"}},
+		},
+		{
+			name:  "Unknown field",
+			input: "name: a\nhost: x\n",
+			err:   "unknown field",
+		},
+		{
+			name:  "Non-string key",
+			input: "1: a\n",
+			err:   "keys must be strings",
+		},
+	}
+
+	for _, c := range cases {
+		var actual body
+		err := decodeBody([]byte(c.input), &actual)
+		if len(c.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("%s: Expected error %q but got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
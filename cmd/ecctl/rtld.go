@@ -0,0 +1,303 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Real-Time Log Delivery service
+*/
+
+import (
+	"context"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_cdn"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_rl"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtldmodels"
+)
+
+func newRTLDCommand() *command {
+	return &command{
+		name:  "rtld",
+		short: "Manage Real-Time Log Delivery",
+		commands: []*command{
+			rtldCDNProfiles.command(),
+			rtldRateLimitingProfiles.command(),
+			rtldWAFProfiles.command(),
+		},
+	}
+}
+
+// rtldService returns the Real-Time Log Delivery service
+func rtldService(a *app) (*rtld.RtldService, error) {
+	client, err := a.sdk()
+	if err != nil {
+		return nil, err
+	}
+	return client.RTLD()
+}
+
+// parseProfileID parses the ID of a log delivery profile
+func parseProfileID(id string) (int32, error) {
+	parsed, err := parseID(id)
+	return int32(parsed), err
+}
+
+var rtldCDNProfiles = resource[rtldmodels.CdnProfileDto]{
+	name:  "profiles-cdn",
+	short: "Manage CDN log delivery profiles",
+	noun:  "CDN log delivery profile",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesCdn.ProfilesGetCustomerSettingsWithContext(
+			ctx,
+			profiles_cdn.NewProfilesGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_cdn.NewProfilesGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesCdn.ProfilesGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body rtldmodels.CdnProfileDto,
+	) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_cdn.NewProfilesAddCustomerSettingParams()
+		params.SettingDto = &body
+		return svc.ProfilesCdn.ProfilesAddCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body rtldmodels.CdnProfileDto,
+	) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_cdn.NewProfilesUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &body
+		return svc.ProfilesCdn.ProfilesUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return err
+		}
+		params := profiles_cdn.NewProfilesDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesCdn.ProfilesDeleteCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+		return err
+	},
+}
+
+var rtldRateLimitingProfiles = resource[rtldmodels.RateLimitingProfileDto]{
+	name:  "profiles-rl",
+	short: "Manage rate limiting log delivery profiles",
+	noun:  "rate limiting log delivery profile",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesRl.
+			ProfilesRateLimitingGetCustomerSettingsWithContext(
+				ctx,
+				profiles_rl.NewProfilesRateLimitingGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_rl.NewProfilesRlGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesRl.ProfilesRlGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body rtldmodels.RateLimitingProfileDto,
+	) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_rl.NewProfilesRateLimitingAddCustomerSettingParams()
+		params.SettingDto = &body
+		return svc.ProfilesRl.ProfilesRateLimitingAddCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body rtldmodels.RateLimitingProfileDto,
+	) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_rl.NewProfilesRlUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &body
+		return svc.ProfilesRl.ProfilesRlUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return err
+		}
+		params := profiles_rl.NewProfilesRlDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesRl.ProfilesRlDeleteCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+		return err
+	},
+}
+
+var rtldWAFProfiles = resource[rtldmodels.WafProfileDto]{
+	name:  "profiles-waf",
+	short: "Manage WAF log delivery profiles",
+	noun:  "WAF log delivery profile",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesWaf.ProfilesWafGetCustomerSettingsWithContext(
+			ctx,
+			profiles_waf.NewProfilesWafGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_waf.NewProfilesWafGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesWaf.ProfilesWafGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body rtldmodels.WafProfileDto,
+	) (interface{}, error) {
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_waf.NewProfilesWafAddCustomerSettingParams()
+		params.SettingDto = &body
+		return svc.ProfilesWaf.ProfilesWafAddCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body rtldmodels.WafProfileDto,
+	) (interface{}, error) {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_waf.NewProfilesWafUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &body
+		return svc.ProfilesWaf.ProfilesWafUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		profileID, err := parseProfileID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := rtldService(a)
+		if err != nil {
+			return err
+		}
+		params := profiles_waf.NewProfilesWafDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesWaf.
+			ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx, params)
+		return err
+	},
+}
@@ -0,0 +1,474 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the commands for the Web Application Firewall service
+*/
+
+import (
+	"context"
+	"flag"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/bot"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/custom"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/managed"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/rate"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/scopes"
+)
+
+func newWAFCommand() *command {
+	return &command{
+		name:  "waf",
+		short: "Manage the Web Application Firewall",
+		commands: []*command{
+			accessRules.command(),
+			botRules.command(),
+			customRules.command(),
+			managedRules.command(),
+			rateRules.command(),
+			newWAFScopesCommand(),
+		},
+	}
+}
+
+// wafService returns the WAF service and the account number
+func wafService(a *app) (*waf.WafService, string, error) {
+	account, err := a.accountNumber()
+	if err != nil {
+		return nil, "", err
+	}
+
+	client, err := a.sdk()
+	if err != nil {
+		return nil, "", err
+	}
+
+	svc, err := client.WAF()
+	return svc, account, err
+}
+
+var accessRules = resource[access.AccessRule]{
+	name:  "access-rules",
+	short: "Manage access rules",
+	noun:  "access rule",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Access.GetAllAccessRulesWithContext(
+			ctx,
+			access.GetAllAccessRulesParams{AccountNumber: account})
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Access.GetAccessRuleWithContext(
+			ctx,
+			access.GetAccessRuleParams{
+				AccountNumber: account,
+				AccessRuleID:  id,
+			})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body access.AccessRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.Access.AddAccessRuleWithContext(
+			ctx,
+			access.AddAccessRuleParams{
+				AccountNumber: account,
+				AccessRule:    body,
+			})
+		return created{ID: id}, err
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body access.AccessRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.Access.UpdateAccessRuleWithContext(
+			ctx,
+			access.UpdateAccessRuleParams{
+				AccountNumber: account,
+				AccessRuleID:  id,
+				AccessRule:    body,
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return err
+		}
+		return svc.Access.DeleteAccessRuleWithContext(
+			ctx,
+			access.DeleteAccessRuleParams{
+				AccountNumber: account,
+				AccessRuleID:  id,
+			})
+	},
+}
+
+var botRules = resource[bot.BotRuleSet]{
+	name:  "bot-rules",
+	short: "Manage bot rule sets",
+	noun:  "bot rule set",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Bot.GetAllBotRuleSetsWithContext(
+			ctx,
+			bot.GetAllBotRuleSetsParams{AccountNumber: account})
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Bot.GetBotRuleSetWithContext(
+			ctx,
+			bot.GetBotRuleSetParams{
+				AccountNumber: account,
+				BotRuleSetID:  id,
+			})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body bot.BotRuleSet,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.Bot.AddBotRuleSetWithContext(
+			ctx,
+			bot.AddBotRuleSetParams{
+				AccountNumber: account,
+				BotRuleSet:    body,
+			})
+		return created{ID: id}, err
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body bot.BotRuleSet,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.Bot.UpdateBotRuleSetWithContext(
+			ctx,
+			bot.UpdateBotRuleSetParams{
+				AccountNumber: account,
+				BotRuleSetID:  id,
+				BotRuleSet:    body,
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return err
+		}
+		return svc.Bot.DeleteBotRuleSetWithContext(
+			ctx,
+			bot.DeleteBotRuleSetParams{
+				AccountNumber: account,
+				BotRuleSetID:  id,
+			})
+	},
+}
+
+var customRules = resource[custom.CustomRuleSet]{
+	name:  "custom-rules",
+	short: "Manage custom rule sets",
+	noun:  "custom rule set",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Custom.GetAllCustomRuleSetsWithContext(
+			ctx,
+			custom.GetAllCustomRuleSetsParams{AccountNumber: account})
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Custom.GetCustomRuleSetWithContext(
+			ctx,
+			custom.GetCustomRuleSetParams{
+				AccountNumber:   account,
+				CustomRuleSetID: id,
+			})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body custom.CustomRuleSet,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.Custom.AddCustomRuleSetWithContext(
+			ctx,
+			custom.AddCustomRuleSetParams{
+				AccountNumber: account,
+				CustomRuleSet: body,
+			})
+		return created{ID: id}, err
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body custom.CustomRuleSet,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.Custom.UpdateCustomRuleSetWithContext(
+			ctx,
+			custom.UpdateCustomRuleSetParams{
+				AccountNumber:   account,
+				CustomRuleSetID: id,
+				CustomRuleSet:   body,
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return err
+		}
+		return svc.Custom.DeleteCustomRuleSetWithContext(
+			ctx,
+			custom.DeleteCustomRuleSetParams{
+				AccountNumber:   account,
+				CustomRuleSetID: id,
+			})
+	},
+}
+
+var managedRules = resource[managed.ManagedRule]{
+	name:  "managed-rules",
+	short: "Manage managed rules",
+	noun:  "managed rule",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Managed.GetAllManagedRulesWithContext(
+			ctx,
+			managed.GetAllManagedRulesParams{AccountNumber: account})
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Managed.GetManagedRuleWithContext(
+			ctx,
+			managed.GetManagedRuleParams{
+				AccountNumber: account,
+				ManagedRuleID: id,
+			})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body managed.ManagedRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.Managed.AddManagedRuleWithContext(
+			ctx,
+			managed.AddManagedRuleParams{
+				AccountNumber: account,
+				ManagedRule:   body,
+			})
+		return created{ID: id}, err
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body managed.ManagedRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.Managed.UpdateManagedRuleWithContext(
+			ctx,
+			managed.UpdateManagedRuleParams{
+				AccountNumber: account,
+				ManagedRuleID: id,
+				ManagedRule:   body,
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return err
+		}
+		return svc.Managed.DeleteManagedRuleWithContext(
+			ctx,
+			managed.DeleteManagedRuleParams{
+				AccountNumber: account,
+				ManagedRuleID: id,
+			})
+	},
+}
+
+var rateRules = resource[rate.RateRule]{
+	name:  "rate-rules",
+	short: "Manage rate rules",
+	noun:  "rate rule",
+	list: func(ctx context.Context, a *app) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Rate.GetAllRateRulesWithContext(
+			ctx,
+			rate.GetAllRateRulesParams{AccountNumber: account})
+	},
+	get: func(ctx context.Context, a *app, id string) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return svc.Rate.GetRateRuleWithContext(
+			ctx,
+			rate.GetRateRuleParams{
+				AccountNumber: account,
+				RateRuleID:    id,
+			})
+	},
+	create: func(
+		ctx context.Context,
+		a *app,
+		body rate.RateRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		id, err := svc.Rate.AddRateRuleWithContext(
+			ctx,
+			rate.AddRateRuleParams{
+				AccountNumber: account,
+				RateRule:      body,
+			})
+		return created{ID: id}, err
+	},
+	update: func(
+		ctx context.Context,
+		a *app,
+		id string,
+		body rate.RateRule,
+	) (interface{}, error) {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return nil, err
+		}
+		return nil, svc.Rate.UpdateRateRuleWithContext(
+			ctx,
+			rate.UpdateRateRuleParams{
+				AccountNumber: account,
+				RateRuleID:    id,
+				RateRule:      body,
+			})
+	},
+	delete: func(ctx context.Context, a *app, id string) error {
+		svc, account, err := wafService(a)
+		if err != nil {
+			return err
+		}
+		return svc.Rate.DeleteRateRuleWithContext(
+			ctx,
+			rate.DeleteRateRuleParams{
+				AccountNumber: account,
+				RateRuleID:    id,
+			})
+	},
+}
+
+// newWAFScopesCommand creates the command for the security application
+// manager configurations, which are read and replaced as a whole
+func newWAFScopesCommand() *command {
+	var file string
+	return &command{
+		name:  "scopes",
+		short: "Manage security application manager configurations",
+		commands: []*command{
+			{
+				name:  "get",
+				short: "Get all scopes",
+				run: func(
+					ctx context.Context,
+					a *app,
+					args []string,
+				) (interface{}, error) {
+					svc, account, err := wafService(a)
+					if err != nil {
+						return nil, err
+					}
+					return svc.Scopes.GetAllScopesWithContext(
+						ctx,
+						scopes.GetAllScopesParams{AccountNumber: account})
+				},
+			},
+			{
+				name:  "set",
+				short: "Replace all scopes with a JSON or YAML file",
+				flags: func(fs *flag.FlagSet) {
+					fileFlag(fs, &file)
+				},
+				run: func(
+					ctx context.Context,
+					a *app,
+					args []string,
+				) (interface{}, error) {
+					svc, account, err := wafService(a)
+					if err != nil {
+						return nil, err
+					}
+					body, err := readBody[scopes.Scopes](a, file)
+					if err != nil {
+						return nil, err
+					}
+					body.CustomerID = account
+					return svc.Scopes.ModifyAllScopesWithContext(ctx, body)
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"net/http"
+	"reflect"
+	"sync"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+)
+
+// AuthorizationProvider supplies the Authorization header value for API
+// requests. Implementations must be safe for concurrent use.
+type AuthorizationProvider = ecauth.AuthorizationProvider
+
+// ContextAuthorizationProvider is implemented by AuthorizationProviders that
+// use the context of the API call that needs the header
+type ContextAuthorizationProvider = ecauth.ContextAuthorizationProvider
+
+// ErrMissingScope matches any MissingScopeError when used with errors.Is
+var ErrMissingScope = ecauth.ErrMissingScope
+
+// MissingScopeError is returned when a service requires a scope that is not
+// listed in IDSCredentials.Scope
+type MissingScopeError = ecauth.MissingScopeError
+
+// authProviderKey identifies a credential set, and the token cache and
+// instrumentation of the providers created for it
+type authProviderKey struct {
+	baseIDSURL      string
+	idsCredentials  IDSCredentials
+	apiToken        string
+	tokenCache      TokenCache
+	instrumentation Instrumentation
+}
+
+// cacheable reports whether key can be used as a map key. Token caches and
+// instrumentation of types that are not comparable cannot, so providers that
+// use them are not shared.
+func (key authProviderKey) cacheable() bool {
+	for _, v := range []interface{}{key.tokenCache, key.instrumentation} {
+		if v != nil && !reflect.TypeOf(v).Comparable() {
+			return false
+		}
+	}
+	return true
+}
+
+// authProviderCache holds one AuthorizationProvider per credential set, and
+// one IDSScopeCache per set of IDS credentials, so that all services created
+// from the same SDKConfig share cached tokens. It is created by NewSDKConfig,
+// LoadConfig and SDKConfig.Shared. A nil cache, that of a configuration
+// declared as a literal, cannot be initialised on first use, as services
+// receive copies of the configuration, so it shares nothing.
+type authProviderCache struct {
+	mu          sync.Mutex
+	providers   map[authProviderKey]AuthorizationProvider
+	scopeCaches map[authProviderKey]*ecauth.IDSScopeCache
+}
+
+func newAuthProviderCache() *authProviderCache {
+	return &authProviderCache{
+		providers:   make(map[authProviderKey]AuthorizationProvider),
+		scopeCaches: make(map[authProviderKey]*ecauth.IDSScopeCache),
+	}
+}
+
+// get returns the provider for key, calling create if there is none yet.
+// Errors are not cached. A nil cache, i.e. that of a configuration declared
+// as a literal, creates a new provider on every call.
+func (ac *authProviderCache) get(
+	key authProviderKey,
+	create func() (AuthorizationProvider, error),
+) (AuthorizationProvider, error) {
+	if ac == nil || !key.cacheable() {
+		return create()
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if p, ok := ac.providers[key]; ok {
+		return p, nil
+	}
+
+	p, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	ac.providers[key] = p
+	return p, nil
+}
+
+// getScopeCache returns the IDSScopeCache for key, calling create if there is
+// none yet. Errors are not cached. A nil cache creates a new scope cache on
+// every call.
+func (ac *authProviderCache) getScopeCache(
+	key authProviderKey,
+	create func() (*ecauth.IDSScopeCache, error),
+) (*ecauth.IDSScopeCache, error) {
+	if ac == nil || !key.cacheable() {
+		return create()
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	if sc, ok := ac.scopeCaches[key]; ok {
+		return sc, nil
+	}
+
+	sc, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	ac.scopeCaches[key] = sc
+	return sc, nil
+}
+
+// IDSAuthorizationProvider returns the provider that authenticates with
+// IDSCredentials, or AuthProvider if it is set. Tokens are limited to scopes,
+// or carry every scope in IDSCredentials.Scope if none are given. A
+// MissingScopeError is returned if IDSCredentials.Scope lacks any of scopes.
+//
+// Services created from the same SDKConfig with the same credentials, scopes,
+// TokenCache and Instrumentation share a single provider, and therefore a
+// single token, unless the configuration was declared as a literal; see
+// SDKConfig. New tokens are retrieved using httpClient.
+func (c SDKConfig) IDSAuthorizationProvider(
+	httpClient *http.Client,
+	scopes ...string,
+) (AuthorizationProvider, error) {
+	if c.AuthProvider != nil {
+		return c.AuthProvider, nil
+	}
+
+	key := authProviderKey{
+		baseIDSURL:      c.BaseIDSURL.String(),
+		idsCredentials:  c.IDSCredentials,
+		tokenCache:      c.TokenCache,
+		instrumentation: c.Instrumentation,
+	}
+
+	sc, err := c.authProviders.getScopeCache(
+		key,
+		func() (*ecauth.IDSScopeCache, error) {
+			return ecauth.NewIDSScopeCache(
+				c.BaseIDSURL,
+				ecauth.OAuth2Credentials(c.IDSCredentials),
+				httpClient,
+				c.Instrumentation,
+				c.TokenCache)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := sc.Provider(scopes...)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// TokenAuthorizationProvider returns the provider that authenticates with
+// APIToken, or AuthProvider if it is set
+func (c SDKConfig) TokenAuthorizationProvider() (AuthorizationProvider, error) {
+	if c.AuthProvider != nil {
+		return c.AuthProvider, nil
+	}
+
+	key := authProviderKey{apiToken: c.APIToken}
+
+	return c.authProviders.get(key, func() (AuthorizationProvider, error) {
+		p, err := ecauth.NewTokenAuthorizationProvider(c.APIToken)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
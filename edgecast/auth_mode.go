@@ -0,0 +1,161 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+/*
+	This file contains the logic that decides how each service authenticates.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+)
+
+// AuthMode selects the credentials that services authenticate with
+type AuthMode int
+
+const (
+	// AuthModeAuto uses IDSCredentials if they are set and the service
+	// supports them, and APIToken otherwise. The choice is logged.
+	AuthModeAuto AuthMode = iota
+
+	// AuthModeIDS uses IDSCredentials. Services that do not support IDS
+	// authentication return ErrAuthModeNotSupported.
+	AuthModeIDS
+
+	// AuthModeToken uses APIToken. Services that do not support token
+	// authentication return ErrAuthModeNotSupported.
+	AuthModeToken
+)
+
+func (m AuthMode) String() string {
+	switch m {
+	case AuthModeAuto:
+		return "auto"
+	case AuthModeIDS:
+		return "ids"
+	case AuthModeToken:
+		return "token"
+	}
+	return "unknown"
+}
+
+// ErrAuthModeNotSupported is returned when AuthMode selects credentials that a
+// service does not accept
+var ErrAuthModeNotSupported = errors.New("auth mode not supported")
+
+// ServiceAuth describes the credentials a service accepts
+type ServiceAuth struct {
+	// Service names the service in log messages and errors
+	Service string
+
+	// IDS is set if the service accepts IDSCredentials
+	IDS bool
+
+	// Token is set if the service accepts APIToken
+	Token bool
+
+	// Scopes are the IDS scopes the service requires, unless replaced by
+	// SDKConfig.ServiceScopes
+	Scopes []string
+}
+
+// ServiceAuthorizationProvider returns the provider for a service that
+// accepts the credentials described by service, as selected by AuthMode.
+// AuthProvider is returned if it is set. New IDS tokens are retrieved using
+// httpClient.
+//
+// Errors are never hidden by falling back to other credentials: in
+// AuthModeAuto, the credentials are chosen by what is configured, not by
+// which provider can be created.
+func (c SDKConfig) ServiceAuthorizationProvider(
+	httpClient *http.Client,
+	service ServiceAuth,
+) (AuthorizationProvider, error) {
+	if c.AuthProvider != nil {
+		return c.AuthProvider, nil
+	}
+
+	mode := c.AuthMode
+	if mode == AuthModeAuto {
+		var reason string
+		var err error
+		mode, reason, err = c.autoAuthMode(service)
+		if err != nil {
+			return nil, err
+		}
+		eclog.Structured(c.Logger).Log(
+			eclog.LevelInfo,
+			"selected auth mode",
+			eclog.F(eclog.FieldService, service.Service),
+			eclog.F(eclog.FieldAuthMode, mode),
+			eclog.F(eclog.FieldReason, reason))
+	}
+
+	switch mode {
+	case AuthModeIDS:
+		if !service.IDS {
+			return nil, fmt.Errorf(
+				"%w: %s does not support IDS authentication",
+				ErrAuthModeNotSupported,
+				service.Service)
+		}
+		return c.IDSAuthorizationProvider(
+			httpClient,
+			c.serviceScopes(service)...)
+	case AuthModeToken:
+		if !service.Token {
+			return nil, fmt.Errorf(
+				"%w: %s does not support token authentication",
+				ErrAuthModeNotSupported,
+				service.Service)
+		}
+		return c.TokenAuthorizationProvider()
+	}
+
+	return nil, fmt.Errorf("unknown auth mode %d", mode)
+}
+
+// serviceScopes returns the IDS scopes requested for service, as replaced by
+// ServiceScopes
+func (c SDKConfig) serviceScopes(service ServiceAuth) []string {
+	if scopes, ok := c.ServiceScopes[service.Service]; ok {
+		return scopes
+	}
+	return service.Scopes
+}
+
+// autoAuthMode chooses the auth mode for service from the credentials that
+// are configured, and describes why
+func (c SDKConfig) autoAuthMode(
+	service ServiceAuth,
+) (AuthMode, string, error) {
+	hasIDS := len(c.IDSCredentials.ClientID) > 0 ||
+		len(c.IDSCredentials.ClientSecret) > 0
+	hasToken := len(c.APIToken) > 0
+
+	switch {
+	case service.IDS && !service.Token:
+		return AuthModeIDS, "the service supports only IDS", nil
+	case service.Token && !service.IDS:
+		return AuthModeToken, "the service supports only API tokens", nil
+	case hasIDS && hasToken:
+		return AuthModeIDS,
+			"IDS credentials are configured; APIToken is ignored",
+			nil
+	case hasIDS:
+		return AuthModeIDS, "IDS credentials are configured", nil
+	case hasToken:
+		return AuthModeToken,
+			"an API token is configured and IDS credentials are not",
+			nil
+	}
+
+	return AuthModeAuto, "", fmt.Errorf(
+		"%s: no IDS credentials or API token configured",
+		service.Service)
+}
@@ -0,0 +1,244 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+)
+
+// recordingLogger records structured messages with their fields
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Debug(format string, v ...interface{}) {}
+
+func (l *recordingLogger) Info(format string, v ...interface{}) {}
+
+func (l *recordingLogger) Warn(format string, v ...interface{}) {}
+
+func (l *recordingLogger) Error(format string, v ...interface{}) {}
+
+func (l *recordingLogger) Log(
+	level eclog.Level,
+	msg string,
+	fields ...eclog.Field,
+) {
+	l.messages = append(l.messages, eclog.FormatFields(msg, fields))
+}
+
+func (l *recordingLogger) Enabled(level eclog.Level) bool {
+	return true
+}
+
+// providerMode returns the auth mode of the credentials p authenticates with
+func providerMode(p AuthorizationProvider) AuthMode {
+	switch p.(type) {
+	case *ecauth.IDSAuthorizationProvider:
+		return AuthModeIDS
+	case *ecauth.TokenAuthorizationProvider:
+		return AuthModeToken
+	}
+	return AuthModeAuto
+}
+
+func TestServiceAuthorizationProvider(t *testing.T) {
+	idsCredentials := IDSCredentials{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scope:        "cdn.rtld",
+	}
+	tokenOnly := func(name string) ServiceAuth {
+		return ServiceAuth{Service: name, Token: true}
+	}
+	idsOnly := ServiceAuth{Service: "rulesengine", IDS: true}
+	both := ServiceAuth{
+		Service: "rtld",
+		IDS:     true,
+		Token:   true,
+		Scopes:  []string{"cdn.rtld"},
+	}
+
+	cases := []struct {
+		name     string
+		mode     AuthMode
+		ids      IDSCredentials
+		apiToken string
+		service  ServiceAuth
+		expected AuthMode
+		err      string
+		logged   []string
+
+		// unsupported is set if err must match ErrAuthModeNotSupported
+		unsupported bool
+	}{
+		{
+			name:     "token",
+			mode:     AuthModeToken,
+			apiToken: "token",
+			service:  tokenOnly("waf"),
+			expected: AuthModeToken,
+		},
+		{
+			name:     "token with IDS credentials",
+			mode:     AuthModeToken,
+			ids:      idsCredentials,
+			apiToken: "token",
+			service:  both,
+			expected: AuthModeToken,
+		},
+		{
+			name:        "token for an IDS service",
+			mode:        AuthModeToken,
+			apiToken:    "token",
+			service:     idsOnly,
+			err:         "rulesengine does not support token authentication",
+			unsupported: true,
+		},
+		{
+			name:        "IDS for waf",
+			mode:        AuthModeIDS,
+			ids:         idsCredentials,
+			service:     tokenOnly("waf"),
+			err:         "waf does not support IDS authentication",
+			unsupported: true,
+		},
+		{
+			name:        "IDS for customer",
+			mode:        AuthModeIDS,
+			ids:         idsCredentials,
+			service:     tokenOnly("customer"),
+			err:         "customer does not support IDS authentication",
+			unsupported: true,
+		},
+		{
+			name:        "IDS for routedns",
+			mode:        AuthModeIDS,
+			ids:         idsCredentials,
+			service:     tokenOnly("routedns"),
+			err:         "routedns does not support IDS authentication",
+			unsupported: true,
+		},
+		{
+			name:     "auto with both",
+			ids:      idsCredentials,
+			apiToken: "token",
+			service:  both,
+			expected: AuthModeIDS,
+			logged: []string{
+				`selected auth mode service=rtld auth_mode=ids ` +
+					`reason="IDS credentials are configured; ` +
+					`APIToken is ignored"`,
+			},
+		},
+		{
+			name:     "auto with both for a token service",
+			ids:      idsCredentials,
+			apiToken: "token",
+			service:  tokenOnly("waf"),
+			expected: AuthModeToken,
+			logged: []string{
+				`selected auth mode service=waf auth_mode=token ` +
+					`reason="the service supports only API tokens"`,
+			},
+		},
+		{
+			name:     "auto with a token",
+			apiToken: "token",
+			service:  both,
+			expected: AuthModeToken,
+			logged: []string{
+				`selected auth mode service=rtld auth_mode=token ` +
+					`reason="an API token is configured and IDS ` +
+					`credentials are not"`,
+			},
+		},
+		{
+			name:    "auto without credentials",
+			service: both,
+			err:     "rtld: no IDS credentials or API token configured",
+		},
+	}
+
+	for _, c := range cases {
+		logger := &recordingLogger{}
+		config := NewSDKConfig()
+		config.AuthMode = c.mode
+		config.IDSCredentials = c.ids
+		config.APIToken = c.apiToken
+		config.Logger = logger
+
+		p, err := config.ServiceAuthorizationProvider(
+			http.DefaultClient,
+			c.service)
+		if len(c.err) > 0 {
+			if err == nil || !strings.Contains(err.Error(), c.err) ||
+				errors.Is(err, ErrAuthModeNotSupported) != c.unsupported {
+				t.Fatalf(
+					"%s: Expected %q but got %v",
+					c.name,
+					c.err,
+					err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got := providerMode(p); got != c.expected {
+			t.Fatalf("%s: Expected %s but got %s", c.name, c.expected, got)
+		}
+		if !reflect.DeepEqual(logger.messages, c.logged) {
+			t.Fatalf(
+				"%s: Expected %+v but got %+v",
+				c.name,
+				c.logged,
+				logger.messages)
+		}
+	}
+}
+
+func TestServiceScopes(t *testing.T) {
+	rtld := ServiceAuth{
+		Service: "rtld",
+		IDS:     true,
+		Token:   true,
+		Scopes:  []string{"cdn.rtld"},
+	}
+	config := NewSDKConfig()
+	config.AuthMode = AuthModeIDS
+	config.IDSCredentials = IDSCredentials{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scope:        "custom.rtld",
+	}
+	_, err := config.ServiceAuthorizationProvider(http.DefaultClient, rtld)
+	if !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Expected %v but got %v", ErrMissingScope, err)
+	}
+
+	// Overrides apply only to the configuration they are set on
+	overridden := config
+	overridden.ServiceScopes = map[string][]string{"rtld": {"custom.rtld"}}
+	p, err := overridden.ServiceAuthorizationProvider(http.DefaultClient, rtld)
+	if err != nil || providerMode(p) != AuthModeIDS {
+		t.Fatalf("Expected an IDS provider but got %T, %v", p, err)
+	}
+	_, err = config.ServiceAuthorizationProvider(http.DefaultClient, rtld)
+	if !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Expected %v but got %v", ErrMissingScope, err)
+	}
+	if len(rtld.Scopes) != 1 || rtld.Scopes[0] != "cdn.rtld" {
+		t.Fatalf(
+			"Expected the default scopes to be unchanged but got %+v",
+			rtld.Scopes)
+	}
+}
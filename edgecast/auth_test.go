@@ -0,0 +1,182 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+)
+
+// recordingTokenCache is a TokenCache that counts the tokens stored in it
+type recordingTokenCache struct {
+	mu   sync.Mutex
+	puts int
+}
+
+func (c *recordingTokenCache) Get(key TokenCacheKey) (*IDSToken, error) {
+	return nil, nil
+}
+
+func (c *recordingTokenCache) Put(key TokenCacheKey, token IDSToken) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.puts++
+	return nil
+}
+
+// mapTokenCache is a TokenCache of a type that is not comparable
+type mapTokenCache map[TokenCacheKey]IDSToken
+
+func (c mapTokenCache) Get(key TokenCacheKey) (*IDSToken, error) {
+	return nil, nil
+}
+
+func (c mapTokenCache) Put(key TokenCacheKey, token IDSToken) error {
+	return nil
+}
+
+func TestAuthProviderCache(t *testing.T) {
+	var created int
+	create := func() (AuthorizationProvider, error) {
+		created++
+		return &ecauth.TokenAuthorizationProvider{APIToken: "token"}, nil
+	}
+	key := authProviderKey{apiToken: "token"}
+
+	cases := []struct {
+		name     string
+		cache    *authProviderCache
+		key      authProviderKey
+		expected int
+	}{
+		{name: "shared", cache: newAuthProviderCache(), key: key, expected: 1},
+		{name: "nil", key: key, expected: 2},
+		{
+			name:     "not comparable",
+			cache:    newAuthProviderCache(),
+			key:      authProviderKey{tokenCache: mapTokenCache{}},
+			expected: 2,
+		},
+	}
+
+	for _, c := range cases {
+		created = 0
+		first, err := c.cache.get(c.key, create)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		second, err := c.cache.get(c.key, create)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if created != c.expected || (first == second) != (c.expected == 1) {
+			t.Fatalf(
+				"%s: Expected %d providers but got %d",
+				c.name,
+				c.expected,
+				created)
+		}
+	}
+
+	// Errors are not cached
+	cache := newAuthProviderCache()
+	failure := errors.New("failure")
+	_, err := cache.get(key, func() (AuthorizationProvider, error) {
+		return nil, failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected %v but got %v", failure, err)
+	}
+	created = 0
+	if _, err := cache.get(key, create); err != nil || created != 1 {
+		t.Fatalf("Expected a new provider but got %d, %v", created, err)
+	}
+}
+
+func TestAuthProviderSharing(t *testing.T) {
+	var tokens int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&tokens, 1)
+			w.Write([]byte(`{"access_token":"abcd","expires_in":3600}`))
+		}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	// useIDS creates two providers from config and authenticates with both,
+	// returning the number of tokens retrieved
+	useIDS := func(config SDKConfig) int {
+		before := atomic.LoadInt32(&tokens)
+		for i := 0; i < 2; i++ {
+			p, err := config.IDSAuthorizationProvider(
+				http.DefaultClient,
+				"cdn.rtld")
+			if err != nil {
+				t.Fatalf("IDSAuthorizationProvider: %v", err)
+			}
+			if _, err := p.GetAuthorizationHeader(); err != nil {
+				t.Fatalf("GetAuthorizationHeader: %v", err)
+			}
+		}
+		return int(atomic.LoadInt32(&tokens) - before)
+	}
+
+	credentials := IDSCredentials{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Scope:        "cdn.rtld",
+	}
+	config := NewSDKConfig()
+	config.BaseIDSURL = *serverURL
+	config.IDSCredentials = credentials
+	if got := useIDS(config); got != 1 {
+		t.Fatalf("NewSDKConfig: Expected 1 token but got %d", got)
+	}
+
+	// Configurations declared as literals share nothing until Shared is
+	// called
+	literal := SDKConfig{
+		BaseIDSURL:     *serverURL,
+		Logger:         eclog.NewNullLogger(),
+		IDSCredentials: credentials,
+	}
+	if got := useIDS(literal); got != 2 {
+		t.Fatalf("literal: Expected 2 tokens but got %d", got)
+	}
+	shared, err := literal.Shared()
+	if err != nil {
+		t.Fatalf("Shared: %v", err)
+	}
+	if got := useIDS(shared); got != 1 {
+		t.Fatalf("Shared: Expected 1 token but got %d", got)
+	}
+
+	// A copy with its own token cache does not reuse the providers of the
+	// original, which would store tokens in the original's cache
+	cache := &recordingTokenCache{}
+	cached := config
+	cached.TokenCache = cache
+	if got := useIDS(cached); got != 1 {
+		t.Fatalf("TokenCache: Expected 1 token but got %d", got)
+	}
+	if cache.puts != 1 {
+		t.Fatalf("Expected 1 token to be cached but got %d", cache.puts)
+	}
+
+	// Token caches that are not comparable cannot be shared
+	uncomparable := config
+	uncomparable.TokenCache = mapTokenCache{}
+	if got := useIDS(uncomparable); got != 2 {
+		t.Fatalf("mapTokenCache: Expected 2 tokens but got %d", got)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package backup
+
+/*
+	This file contains Export and Restore, which read the configuration of
+	an account into a Bundle and recreate it on an account
+*/
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/declarative"
+)
+
+// Export reads the configuration of the account of an engine into a bundle.
+// See declarative.Engine.Export for the options.
+func Export(
+	ctx context.Context,
+	engine *declarative.Engine,
+	opts declarative.ExportOptions,
+) (*Bundle, error) {
+	result, err := engine.Export(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Export: %w", err)
+	}
+
+	counts := map[declarative.Kind]int{}
+	for _, r := range result.Manifest.Resources {
+		counts[r.Kind]++
+	}
+
+	return &Bundle{
+		Metadata: Metadata{
+			Version:       BundleVersion,
+			AccountNumber: engine.AccountNumber(),
+			CreatedAt:     time.Now().UTC().Truncate(time.Second),
+			Resources:     counts,
+			SourceIDs:     result.SourceIDs,
+			Warnings:      result.Warnings,
+		},
+		Manifest: result.Manifest,
+	}, nil
+}
+
+// RestoreResult is the result of Restore
+type RestoreResult struct {
+	*declarative.ApplyResult
+
+	// IDs maps the IDs of the restored resources in the source account to
+	// their IDs in the target account, by kind
+	IDs map[declarative.Kind]map[string]string
+}
+
+// Restore recreates the resources of a bundle on the account of an engine.
+// Resources that can be found by name on the account are updated rather
+// than created.
+//
+// The state of the result records the IDs of the restored resources, and
+// can be saved to manage them with package declarative. A result is returned
+// along with the error if only some resources could be restored.
+func Restore(
+	ctx context.Context,
+	engine *declarative.Engine,
+	b *Bundle,
+) (*RestoreResult, error) {
+	m := retarget(
+		b.Manifest,
+		b.Metadata.AccountNumber,
+		engine.AccountNumber())
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Restore: %w", err)
+	}
+
+	applied, applyErr := engine.Apply(ctx, plan)
+	result := &RestoreResult{
+		ApplyResult: applied,
+		IDs:         map[declarative.Kind]map[string]string{},
+	}
+	for _, r := range applied.State.Resources {
+		sourceID, ok := b.Metadata.SourceIDs[r.Address()]
+		if !ok {
+			continue
+		}
+		if result.IDs[r.Kind] == nil {
+			result.IDs[r.Kind] = map[string]string{}
+		}
+		result.IDs[r.Kind][sourceID] = r.ID
+	}
+
+	if applyErr != nil {
+		return result, fmt.Errorf("Restore: %w", applyErr)
+	}
+	return result, nil
+}
+
+// dirPathPattern matches the directory path of an edge CNAME, e.g.
+// /80ABCD/origin, capturing the account number
+var dirPathPattern = regexp.MustCompile(`^/(\d{2})([0-9A-Fa-f]+)(/.*)?$`)
+
+// retarget returns a copy of a manifest whose edge CNAMEs point at the
+// directories of the target account rather than those of the source account
+func retarget(
+	m *declarative.Manifest,
+	source string,
+	target string,
+) *declarative.Manifest {
+	if len(source) == 0 || strings.EqualFold(source, target) {
+		return m
+	}
+
+	copied := &declarative.Manifest{
+		Version:   m.Version,
+		Resources: make([]declarative.Resource, len(m.Resources)),
+	}
+	for i, r := range m.Resources {
+		copied.Resources[i] = r
+
+		dirPath, _ := r.Spec["DirPath"].(string)
+		match := dirPathPattern.FindStringSubmatch(dirPath)
+		if r.Kind != declarative.KindEdgeCname || match == nil ||
+			!strings.EqualFold(match[2], source) {
+			continue
+		}
+
+		spec := make(map[string]interface{}, len(r.Spec))
+		for k, v := range r.Spec {
+			spec[k] = v
+		}
+		spec["DirPath"] = "/" + match[1] + target + match[3]
+		copied.Resources[i].Spec = spec
+	}
+	return copied
+}
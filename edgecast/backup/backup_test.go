@@ -0,0 +1,330 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/declarative"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ecsdk"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+)
+
+const (
+	sourceAccount = "ABCD"
+	targetAccount = "ABCE"
+)
+
+const testManifest = `
+version: v1
+resources:
+  - kind: origin
+    name: web
+    spec:
+      DirectoryName: web
+      HostHeader: web.example.com
+      HttpHostnames: [{Name: "http://origin.example.com"}]
+      MediaTypeId: 3
+  - kind: edge-cname
+    name: cdn
+    spec:
+      Name: cdn.example.com
+      DirPath: /80ABCD/web
+      MediaTypeId: 3
+      OriginId: ${origin.web.id}
+  - kind: waf-access-rule
+    name: block
+    spec:
+      name: Block
+      ip: {blacklist: [192.0.2.1]}
+  - kind: waf-scopes
+    name: scopes
+    spec:
+      scopes:
+        - name: Default Scope
+          host: {type: GLOB, value: "*"}
+          path: {type: GLOB, value: "*"}
+          acl_prod_id: ${waf-access-rule.block.id}
+          acl_prod_action: {enf_type: BLOCK_REQUEST}
+  - kind: rules-engine-policy
+    name: policy
+    spec:
+      name: Policy
+      platform: http_large
+      state: locked
+      rules: [{name: Rule, matches: []}]
+`
+
+func newTestEngine(
+	t *testing.T,
+	server *ectest.Server,
+	accountNumber string,
+) *declarative.Engine {
+	client, err := ecsdk.NewClient(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("ecsdk.NewClient: %v", err)
+	}
+	engine, err := declarative.New(declarative.Config{
+		Client:        client,
+		AccountNumber: accountNumber,
+	})
+	if err != nil {
+		t.Fatalf("declarative.New: %v", err)
+	}
+	return engine
+}
+
+func TestExportAndRestore(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	source := newTestEngine(t, server, sourceAccount)
+	m, err := declarative.ParseManifest([]byte(testManifest))
+	if err != nil {
+		t.Fatalf("ParseManifest: %v", err)
+	}
+	plan, err := source.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	applied, err := source.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	// Policies cannot be listed, so their IDs are given
+	ids := map[declarative.Kind][]string{}
+	for _, r := range applied.State.Resources {
+		if r.Kind == declarative.KindRulesEnginePolicy {
+			ids[r.Kind] = append(ids[r.Kind], r.ID)
+		}
+	}
+	kinds := []declarative.Kind{
+		declarative.KindOrigin,
+		declarative.KindEdgeCname,
+		declarative.KindWAFAccessRule,
+		declarative.KindWAFScopes,
+	}
+	b, err := Export(ctx, source, declarative.ExportOptions{
+		Kinds: kinds,
+		IDs:   ids,
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if b.Metadata.AccountNumber != sourceAccount ||
+		b.Metadata.Resources[declarative.KindOrigin] != 1 ||
+		len(b.Metadata.SourceIDs) != 5 {
+		t.Fatalf("Export: Expected metadata of 5 resources but got %+v",
+			b.Metadata)
+	}
+
+	dir := filepath.Join(t.TempDir(), "bundle")
+	if err := b.Write(dir); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("os.ReadDir: %v", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	expectedFiles := []string{
+		"bundle.json",
+		"edge-cname.yaml",
+		"origin.yaml",
+		"rules-engine-policy.yaml",
+		"waf-access-rule.yaml",
+		"waf-scopes.yaml",
+	}
+	if !reflect.DeepEqual(files, expectedFiles) {
+		t.Fatalf("Write: Expected %+v but got %+v", expectedFiles, files)
+	}
+
+	read, err := Read(dir)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if !reflect.DeepEqual(read.Metadata, b.Metadata) {
+		t.Fatalf("Read: Expected %+v but got %+v", b.Metadata, read.Metadata)
+	}
+	expectedAddrs := resourceAddresses(b.Manifest)
+	if got := resourceAddresses(read.Manifest); !reflect.DeepEqual(
+		got,
+		expectedAddrs,
+	) {
+		t.Fatalf("Read: Expected %+v but got %+v", expectedAddrs, got)
+	}
+
+	target := newTestEngine(t, server, targetAccount)
+	result, err := Restore(ctx, target, read)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+
+	// Every resource is created on the target account, and mapped from its
+	// ID in the source account
+	var restored []string
+	for _, r := range result.State.Resources {
+		restored = append(restored, r.Address())
+		sourceID := read.Metadata.SourceIDs[r.Address()]
+		if got := result.IDs[r.Kind][sourceID]; got != r.ID {
+			t.Fatalf(
+				"%s: Expected ID %s for source ID %s but got %s",
+				r.Address(),
+				r.ID,
+				sourceID,
+				got)
+		}
+	}
+	sort.Strings(restored)
+	if !reflect.DeepEqual(restored, expectedAddrs) {
+		t.Fatalf("Restore: Expected %+v but got %+v", expectedAddrs, restored)
+	}
+
+	// The restored resources match the bundle, with the edge CNAME pointing
+	// at the target account's directory
+	restoredPlan, err := target.Plan(ctx, read.Manifest, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	var changed []string
+	for _, step := range restoredPlan.Steps {
+		changed = append(changed, step.Address())
+	}
+	expectedChanged := []string{"edge-cname.cdn-example-com"}
+	if !reflect.DeepEqual(changed, expectedChanged) {
+		t.Fatalf("Plan: Expected %+v but got %+v", expectedChanged, changed)
+	}
+	for _, step := range restoredPlan.Steps {
+		if step.Address() != "edge-cname.cdn-example-com" {
+			continue
+		}
+		if got := step.Changes[0].Live; got != "/80ABCE/web" {
+			t.Fatalf("DirPath: Expected /80ABCE/web but got %+v", got)
+		}
+	}
+}
+
+func resourceAddresses(m *declarative.Manifest) []string {
+	var addrs []string
+	for _, r := range m.Resources {
+		addrs = append(addrs, r.Address())
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+func TestRead(t *testing.T) {
+	cases := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			name:     "missing metadata",
+			files:    map[string]string{},
+			expected: "bundle.json",
+		},
+		{
+			name:     "unsupported version",
+			files:    map[string]string{"bundle.json": `{"version": "v0"}`},
+			expected: `unsupported bundle version "v0"`,
+		},
+		{
+			name: "wrong kind",
+			files: map[string]string{
+				"bundle.json": `{"version": "v1"}`,
+				"origin.yaml": "version: v1\nresources:\n" +
+					"  - {kind: edge-cname, name: cdn, spec: {}}\n",
+			},
+			expected: "resource edge-cname.cdn is not of kind origin",
+		},
+		{
+			name: "duplicate resource",
+			files: map[string]string{
+				"bundle.json": `{"version": "v1"}`,
+				"origin.yaml": "version: v1\nresources:\n" +
+					"  - {kind: origin, name: web, spec: {}}\n" +
+					"  - {kind: origin, name: web, spec: {}}\n",
+			},
+			expected: "origin.web",
+		},
+	}
+
+	for _, c := range cases {
+		dir := t.TempDir()
+		for name, data := range c.files {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+				t.Fatalf("os.WriteFile: %v", err)
+			}
+		}
+		_, err := Read(dir)
+		if err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Fatalf(
+				"%s: Expected an error containing %q but got %v",
+				c.name,
+				c.expected,
+				err)
+		}
+	}
+}
+
+func TestRetarget(t *testing.T) {
+	m := &declarative.Manifest{
+		Version: declarative.ManifestVersion,
+		Resources: []declarative.Resource{
+			{
+				Kind: declarative.KindEdgeCname,
+				Name: "cdn",
+				Spec: map[string]interface{}{"DirPath": "/80abcd/web"},
+			},
+			{
+				Kind: declarative.KindEdgeCname,
+				Name: "root",
+				Spec: map[string]interface{}{"DirPath": "/80ABCD"},
+			},
+			{
+				Kind: declarative.KindEdgeCname,
+				Name: "other",
+				Spec: map[string]interface{}{"DirPath": "/80ABCDE/web"},
+			},
+			{
+				Kind: declarative.KindOrigin,
+				Name: "web",
+				Spec: map[string]interface{}{"DirPath": "/80ABCD/web"},
+			},
+		},
+	}
+
+	got := retarget(m, "ABCD", "ABCE")
+	expected := []string{
+		"/80ABCE/web",
+		"/80ABCE",
+		"/80ABCDE/web",
+		"/80ABCD/web",
+	}
+	for i, r := range got.Resources {
+		if r.Spec["DirPath"] != expected[i] {
+			t.Fatalf(
+				"%s: Expected %s but got %+v",
+				r.Address(),
+				expected[i],
+				r.Spec["DirPath"])
+		}
+	}
+	if m.Resources[0].Spec["DirPath"] != "/80abcd/web" {
+		t.Fatalf("retarget: Expected the manifest to be unchanged")
+	}
+}
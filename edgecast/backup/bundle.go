@@ -0,0 +1,228 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package backup
+
+/*
+	This file contains Bundle, the exported configuration of an account, and
+	the code that writes it to and reads it from a directory
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/declarative"
+	"gopkg.in/yaml.v2"
+)
+
+// BundleVersion is the version of the bundle format written by this package
+const BundleVersion = "v1"
+
+// metadataFile is the name of the file holding the metadata of a bundle
+const metadataFile = "bundle.json"
+
+// Bundle is the exported configuration of an account
+type Bundle struct {
+	Metadata Metadata
+
+	// Manifest describes the exported resources
+	Manifest *declarative.Manifest
+}
+
+// Metadata describes a Bundle
+type Metadata struct {
+	// Version is the version of the bundle format, i.e. BundleVersion
+	Version string `json:"version"`
+
+	// AccountNumber is the account the bundle was exported from
+	AccountNumber string `json:"account_number"`
+
+	// CreatedAt is the time of the export
+	CreatedAt time.Time `json:"created_at"`
+
+	// Resources holds the number of exported resources by kind
+	Resources map[declarative.Kind]int `json:"resources"`
+
+	// SourceIDs holds the IDs of the exported resources in the source
+	// account, by address, e.g. waf-access-rule.block-countries
+	SourceIDs map[string]string `json:"source_ids"`
+
+	// Warnings lists the parts of the account that could not be exported
+	// faithfully
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Write writes the bundle to a directory, creating it if needed. Manifests
+// of the kinds that the bundle has no resources of are removed from the
+// directory, so that a bundle can be written over an older one.
+func (b *Bundle) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("Bundle.Write: %w", err)
+	}
+
+	byKind := map[declarative.Kind][]declarative.Resource{}
+	for _, r := range b.Manifest.Resources {
+		byKind[r.Kind] = append(byKind[r.Kind], r)
+	}
+
+	for _, kind := range declarative.Kinds() {
+		path := filepath.Join(dir, string(kind)+".yaml")
+		resources, ok := byKind[kind]
+		if !ok {
+			err := os.Remove(path)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("Bundle.Write: %w", err)
+			}
+			continue
+		}
+
+		data, err := marshalManifest(resources)
+		if err != nil {
+			return fmt.Errorf("Bundle.Write: %s: %w", kind, err)
+		}
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			return fmt.Errorf("Bundle.Write: %w", err)
+		}
+	}
+
+	data, err := json.MarshalIndent(b.Metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Bundle.Write: %w", err)
+	}
+	err = os.WriteFile(
+		filepath.Join(dir, metadataFile),
+		append(data, '\n'),
+		0o600)
+	if err != nil {
+		return fmt.Errorf("Bundle.Write: %w", err)
+	}
+	return nil
+}
+
+// Read reads a bundle from a directory, merging the manifests of its kinds
+// into one, which is validated
+func Read(dir string) (*Bundle, error) {
+	data, err := os.ReadFile(filepath.Join(dir, metadataFile))
+	if err != nil {
+		return nil, fmt.Errorf("Read: %w", err)
+	}
+
+	var b Bundle
+	if err := json.Unmarshal(data, &b.Metadata); err != nil {
+		return nil, fmt.Errorf("Read: %s: %w", metadataFile, err)
+	}
+	if b.Metadata.Version != BundleVersion {
+		return nil, fmt.Errorf(
+			"Read: unsupported bundle version %q: must be %q",
+			b.Metadata.Version,
+			BundleVersion)
+	}
+
+	b.Manifest = &declarative.Manifest{Version: declarative.ManifestVersion}
+	for _, kind := range declarative.Kinds() {
+		name := string(kind) + ".yaml"
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Read: %w", err)
+		}
+
+		m, err := declarative.DecodeManifest(data)
+		if err != nil {
+			return nil, fmt.Errorf("Read: %s: %w", name, err)
+		}
+		if m.Version != declarative.ManifestVersion {
+			return nil, fmt.Errorf(
+				"Read: %s: unsupported manifest version %q: must be %q",
+				name,
+				m.Version,
+				declarative.ManifestVersion)
+		}
+		for _, r := range m.Resources {
+			if r.Kind != kind {
+				return nil, fmt.Errorf(
+					"Read: %s: resource %s is not of kind %s",
+					name,
+					r.Address(),
+					kind)
+			}
+		}
+		b.Manifest.Resources = append(b.Manifest.Resources, m.Resources...)
+	}
+
+	if err := b.Manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("Read: %w", err)
+	}
+	return &b, nil
+}
+
+// marshalManifest encodes the resources of a kind as a YAML manifest. Fields
+// are written in the order of the manifest format, and the fields of specs
+// are sorted.
+func marshalManifest(resources []declarative.Resource) ([]byte, error) {
+	items := make([]yaml.MapSlice, len(resources))
+	for i, r := range resources {
+		spec, err := yamlValue(r.Spec)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = yaml.MapSlice{
+			{Key: "kind", Value: string(r.Kind)},
+			{Key: "name", Value: r.Name},
+			{Key: "spec", Value: spec},
+		}
+	}
+
+	return yaml.Marshal(yaml.MapSlice{
+		{Key: "version", Value: declarative.ManifestVersion},
+		{Key: "resources", Value: items},
+	})
+}
+
+// yamlValue converts v to the maps, slices and scalars that its JSON
+// encoding decodes to. Whole numbers are decoded as int64 so that they are
+// not written in exponent notation.
+func yamlValue(v interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return convertNumbers(data), nil
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]interface{}:
+		for k, item := range t {
+			t[k] = convertNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range t {
+			t[i] = convertNumbers(item)
+		}
+	}
+	return v
+}
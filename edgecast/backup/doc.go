@@ -0,0 +1,60 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Package backup exports the configuration of an account to a directory bundle,
+and restores a bundle to the same or another account.
+
+A bundle holds the resources that package declarative manages: WAF rules, bot
+managers and scopes, Route DNS zones, groups, TSIG keys, master server groups
+and secondary zone groups, customer origins, edge CNAMEs, Origin V3 groups and
+origins, Real-Time Log Delivery profiles and Rules Engine policies. Fields set
+by the API, such as IDs and modification dates, are left out, and the IDs of
+the resources that a resource refers to are replaced with references, e.g.
+${waf-access-rule.block-countries.id}. A bundle is a directory holding:
+
+  - bundle.json, the metadata of the bundle: the account it was exported
+    from, when, the number of resources of each kind, the IDs of the exported
+    resources and any warnings
+  - one manifest per kind, e.g. waf-access-rule.yaml, whose resources are
+    sorted by name
+
+Bundles are diffable, and can be kept under version control:
+
+	engine, err := declarative.New(declarative.Config{
+		Client:        client,
+		AccountNumber: "ABCD",
+	})
+	bundle, err := backup.Export(ctx, engine, declarative.ExportOptions{
+		IDs: map[declarative.Kind][]string{
+			declarative.KindDNSZone: {"12345"},
+		},
+	})
+	err = bundle.Write("backups/ABCD")
+
+Restore recreates the resources of a bundle on the account of an engine, in
+the order of their references, and returns the new ID of each resource:
+
+	bundle, err := backup.Read("backups/ABCD")
+	result, err := backup.Restore(ctx, targetEngine, bundle)
+	newID := result.IDs[declarative.KindWAFAccessRule][oldID]
+
+Resources of the kinds that can be listed, such as WAF rules, are matched by
+name with those of the account and updated, so restoring a bundle twice does
+not duplicate them. Resources of the other kinds, such as Route DNS zones, are
+created on each restore.
+
+The directory paths of edge CNAMEs, which include the account number, are
+rewritten for the target account. Some fields cannot be restored faithfully:
+
+  - the Real-Time Log Delivery API returns masked values in place of the
+    passwords, tokens, API keys, access keys and Sumo Logic URLs of log
+    destinations, which are not exported and must be set again after a
+    restore
+  - the master servers that secondary zone groups assign TSIG keys to are
+    identified by IDs that are not remapped, which Export reports as warnings
+  - Route DNS zones and groups, secondary zone groups and Rules Engine
+    policies cannot be listed, so their IDs must be given to Export
+  - Rules Engine policies are created on each restore, but not deployed
+*/
+package backup
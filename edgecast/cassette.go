@@ -0,0 +1,47 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/eccassette"
+
+// Cassette records HTTP interactions to a file or replays them from it. It
+// may be shared by all services.
+type Cassette = eccassette.Cassette
+
+// CassetteConfig configures a Cassette
+type CassetteConfig = eccassette.Config
+
+// CassetteMode determines whether a Cassette records or replays interactions
+type CassetteMode = eccassette.Mode
+
+// CassetteMatchOn is a set of request properties compared during replay
+type CassetteMatchOn = eccassette.MatchOn
+
+// CassetteInteraction is a single recorded request and response
+type CassetteInteraction = eccassette.Interaction
+
+// CassetteMismatchError is returned during replay when no recorded
+// interaction matches a request. It describes the closest interaction.
+type CassetteMismatchError = eccassette.MismatchError
+
+const (
+	CassetteReplay = eccassette.ModeReplay
+	CassetteRecord = eccassette.ModeRecord
+
+	CassetteMatchMethod = eccassette.MatchMethod
+	CassetteMatchPath   = eccassette.MatchPath
+	CassetteMatchQuery  = eccassette.MatchQuery
+	CassetteMatchBody   = eccassette.MatchBody
+	CassetteMatchAll    = eccassette.MatchAll
+)
+
+// ErrCassetteNoMatch matches any CassetteMismatchError when used with
+// errors.Is
+var ErrCassetteNoMatch = eccassette.ErrNoMatch
+
+// NewCassette creates a Cassette from the given configuration. In replay mode
+// the cassette file must already exist.
+func NewCassette(config CassetteConfig) (*Cassette, error) {
+	return eccassette.New(config)
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecretryablehttp"
+
+// CircuitBreaker tracks a circuit per API host or endpoint and rejects calls
+// while a circuit is open. It may be shared by all services.
+type CircuitBreaker = ecretryablehttp.CircuitBreaker
+
+// CircuitBreakerConfig configures a CircuitBreaker
+type CircuitBreakerConfig = ecretryablehttp.CircuitBreakerConfig
+
+// CircuitState is the state of a single circuit
+type CircuitState = ecretryablehttp.CircuitState
+
+// CircuitOpenError is returned when a call is rejected by the circuit breaker
+type CircuitOpenError = ecretryablehttp.CircuitOpenError
+
+const (
+	CircuitClosed   = ecretryablehttp.CircuitClosed
+	CircuitOpen     = ecretryablehttp.CircuitOpen
+	CircuitHalfOpen = ecretryablehttp.CircuitHalfOpen
+)
+
+// ErrCircuitOpen matches any CircuitOpenError when used with errors.Is
+var ErrCircuitOpen = ecretryablehttp.ErrCircuitOpen
+
+// NewCircuitBreaker creates a CircuitBreaker from the given configuration
+func NewCircuitBreaker(config CircuitBreakerConfig) (*CircuitBreaker, error) {
+	return ecretryablehttp.NewCircuitBreaker(config)
+}
@@ -4,10 +4,15 @@
 package edgecast
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
 const (
@@ -17,7 +22,13 @@ const (
 	defaultUserAgentFormat  string = "edgecast/%s:%s"
 )
 
-// Config holds the configuration for SDK services
+// Config holds the configuration for SDK services.
+//
+// Create configurations with NewSDKConfig or LoadConfig, whose copies share
+// authorization providers and therefore IDS tokens. A configuration declared
+// as a literal, e.g. SDKConfig{...}, shares nothing: every service created
+// from it retrieves its own token. Call Shared on such a configuration to
+// share providers between the services created from the result.
 type SDKConfig struct {
 
 	// APIURL contains the base URL for the EdgeCast APIs
@@ -37,6 +48,91 @@ type SDKConfig struct {
 
 	// The User Agent for outgoing HTTP requests
 	UserAgent string
+
+	// HTTPClient, if set, sends all API and IDS token requests. Transport,
+	// ProxyURL, RootCAs, ClientCertificates, MinTLSVersion and Timeout are
+	// ignored when it is set.
+	HTTPClient *http.Client
+
+	// Transport is the base RoundTripper for outgoing requests. Defaults to a
+	// pooled transport that honors the HTTP_PROXY and HTTPS_PROXY environment
+	// variables.
+	Transport http.RoundTripper
+
+	// ProxyURL, if set, routes all requests through the given proxy
+	ProxyURL *url.URL
+
+	// RootCAs is the set of root certificate authorities used to verify
+	// server certificates. The host's root CA set is used if nil.
+	RootCAs *x509.CertPool
+
+	// ClientCertificates are presented to servers that request mutual TLS
+	ClientCertificates []tls.Certificate
+
+	// MinTLSVersion is the minimum TLS version accepted, e.g. tls.VersionTLS12
+	MinTLSVersion uint16
+
+	// Timeout limits the time taken by a single HTTP request attempt. Zero
+	// means no timeout. Use a context deadline to limit a call as a whole.
+	Timeout time.Duration
+
+	// Interceptors wrap every API request made by SDK services. They run in
+	// order, so the first interceptor sees the request first and the response
+	// last.
+	Interceptors []Interceptor
+
+	// Instrumentation receives traces and metrics for every API call, HTTP
+	// attempt and IDS token refresh. Defaults to a no-op implementation.
+	Instrumentation Instrumentation
+
+	// RateLimit throttles requests to each API host. Services that call the
+	// same host, e.g. all services that use BaseAPIURLLegacy, share a single
+	// limiter.
+	RateLimit RateLimitConfig
+
+	// CircuitBreaker, if set, rejects calls to failing API hosts or endpoints
+	// immediately instead of retrying them. Create one with NewCircuitBreaker.
+	CircuitBreaker *CircuitBreaker
+
+	// Cassette, if set, records all API and IDS token requests to a file, or
+	// replays them from it without using the network. Create one with
+	// NewCassette.
+	Cassette *Cassette
+
+	// DryRun, if enabled, records POST, PUT, PATCH and DELETE requests in a
+	// plan instead of sending them. GET requests are still sent.
+	DryRun DryRunConfig
+
+	// Redaction configures the secrets that are masked in logged requests and
+	// responses, in addition to DefaultRedactedFields and
+	// DefaultRedactedHeaders
+	Redaction RedactionConfig
+
+	// AuthMode selects whether services authenticate with IDSCredentials or
+	// APIToken. Defaults to AuthModeAuto.
+	AuthMode AuthMode
+
+	// AuthProvider, if set, authorizes the requests of every service instead
+	// of providers built from APIToken and IDSCredentials, e.g. to retrieve
+	// credentials from a secret store
+	AuthProvider AuthorizationProvider
+
+	// TokenCache, if set, lets IDS tokens be reused across processes. Before
+	// requesting a token, services look for one in the cache that is not yet
+	// due for refresh. Create one with NewFileTokenCache.
+	TokenCache TokenCache
+
+	// ServiceScopes replaces the IDS scopes that services request tokens
+	// for, keyed by service name: "cps", "originv3", "rtld" or
+	// "waf_bot_manager". It is needed only if the client was granted
+	// differently named scopes; services that are not listed request the
+	// scopes returned by their package's RequiredScopes function.
+	ServiceScopes map[string][]string
+
+	// authProviders shares providers between the services created from this
+	// configuration and its copies. It is nil for configurations declared as
+	// literals, whose services each create their own providers.
+	authProviders *authProviderCache
 }
 
 // Holds a customer's OAuth 2.0 Credentials
@@ -58,7 +154,43 @@ func NewSDKConfig() SDKConfig {
 		BaseIDSURL:       *baseIDSURL,
 		Logger:           eclog.NewNullLogger(),
 		UserAgent:        getDefaultUserAgent(),
+		authProviders:    newAuthProviderCache(),
+	}
+}
+
+// NewHTTPClient creates the *http.Client that SDK services use for API calls
+// and IDS token requests
+func (c SDKConfig) NewHTTPClient() (*http.Client, error) {
+	return ecclient.NewHTTPClient(ecclient.HTTPClientConfig{
+		HTTPClient:         c.HTTPClient,
+		Transport:          c.Transport,
+		ProxyURL:           c.ProxyURL,
+		RootCAs:            c.RootCAs,
+		ClientCertificates: c.ClientCertificates,
+		MinTLSVersion:      c.MinTLSVersion,
+		Timeout:            c.Timeout,
+		Cassette:           c.Cassette,
+	})
+}
+
+// Shared returns a copy of c for creating services that share a single HTTP
+// client, and therefore its connection pool, as well as cached authorization
+// providers and IDS tokens
+func (c SDKConfig) Shared() (SDKConfig, error) {
+	httpClient, err := c.NewHTTPClient()
+	if err != nil {
+		return SDKConfig{}, fmt.Errorf("SDKConfig.Shared: %w", err)
 	}
+
+	// The cassette, if any, already wraps the client's transport
+	c.HTTPClient = httpClient
+	c.Cassette = nil
+
+	if c.authProviders == nil {
+		c.authProviders = newAuthProviderCache()
+	}
+
+	return c, nil
 }
 
 func getDefaultUserAgent() string {
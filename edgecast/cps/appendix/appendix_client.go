@@ -7,6 +7,7 @@ package appendix
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,29 +28,63 @@ type Client struct {
 type ClientService interface {
 	AppendixGet(params AppendixGetParams) (*AppendixGetOK, error)
 
+	AppendixGetWithContext(ctx context.Context, params AppendixGetParams) (*AppendixGetOK, error)
+
 	AppendixGetCancelActions(params AppendixGetCancelActionsParams) (*AppendixGetCancelActionsOK, error)
 
+	AppendixGetCancelActionsWithContext(ctx context.Context, params AppendixGetCancelActionsParams) (*AppendixGetCancelActionsOK, error)
+
 	AppendixGetCertificateAuthorities(params AppendixGetCertificateAuthoritiesParams) (*AppendixGetCertificateAuthoritiesOK, error)
 
+	AppendixGetCertificateAuthoritiesWithContext(ctx context.Context, params AppendixGetCertificateAuthoritiesParams) (*AppendixGetCertificateAuthoritiesOK, error)
+
 	AppendixGetCertificateStatuses(params AppendixGetCertificateStatusesParams) (*AppendixGetCertificateStatusesOK, error)
 
+	AppendixGetCertificateStatusesWithContext(ctx context.Context, params AppendixGetCertificateStatusesParams) (*AppendixGetCertificateStatusesOK, error)
+
 	AppendixGetDcvTypes(params AppendixGetDcvTypesParams) (*AppendixGetDcvTypesOK, error)
 
+	AppendixGetDcvTypesWithContext(ctx context.Context, params AppendixGetDcvTypesParams) (*AppendixGetDcvTypesOK, error)
+
 	AppendixGetDomainStatuses(params AppendixGetDomainStatusesParams) (*AppendixGetDomainStatusesOK, error)
 
+	AppendixGetDomainStatusesWithContext(ctx context.Context, params AppendixGetDomainStatusesParams) (*AppendixGetDomainStatusesOK, error)
+
 	AppendixGetOrderStatuses(params AppendixGetOrderStatusesParams) (*AppendixGetOrderStatusesOK, error)
 
+	AppendixGetOrderStatusesWithContext(ctx context.Context, params AppendixGetOrderStatusesParams) (*AppendixGetOrderStatusesOK, error)
+
 	AppendixGetProductTypes(params AppendixGetProductTypesParams) (*AppendixGetProductTypesOK, error)
 
+	AppendixGetProductTypesWithContext(ctx context.Context, params AppendixGetProductTypesParams) (*AppendixGetProductTypesOK, error)
+
 	AppendixGetRequestType(params AppendixGetRequestTypeParams) (*AppendixGetRequestTypeOK, error)
 
+	AppendixGetRequestTypeWithContext(ctx context.Context, params AppendixGetRequestTypeParams) (*AppendixGetRequestTypeOK, error)
+
 	AppendixGetValidationStatuses(params AppendixGetValidationStatusesParams) (*AppendixGetValidationStatusesOK, error)
 
+	AppendixGetValidationStatusesWithContext(ctx context.Context, params AppendixGetValidationStatusesParams) (*AppendixGetValidationStatusesOK, error)
+
 	AppendixGetValidationTypes(params AppendixGetValidationTypesParams) (*AppendixGetValidationTypesOK, error)
+
+	AppendixGetValidationTypesWithContext(ctx context.Context, params AppendixGetValidationTypesParams) (*AppendixGetValidationTypesOK, error)
 }
 
 // AppendixGet appendix get API
 func (a *Client) AppendixGet(params AppendixGetParams) (*AppendixGetOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetWithContext(ctx, params)
+}
+
+// AppendixGetWithContext is the same as AppendixGet with the addition of a
+// context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) AppendixGetWithContext(ctx context.Context, params AppendixGetParams) (*AppendixGetOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetParams(params)
@@ -59,12 +94,12 @@ func (a *Client) AppendixGet(params AppendixGetParams) (*AppendixGetOK, error) {
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGet: %v", err)
+		return nil, fmt.Errorf("AppendixGet: %w", err)
 	}
 
 	parsedResponse := &AppendixGetOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/country-codes",
 		RawBody:        results.Body,
@@ -74,7 +109,7 @@ func (a *Client) AppendixGet(params AppendixGetParams) (*AppendixGetOK, error) {
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGet: %v", err)
+		return nil, fmt.Errorf("AppendixGet: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -82,6 +117,18 @@ func (a *Client) AppendixGet(params AppendixGetParams) (*AppendixGetOK, error) {
 
 // AppendixGetCancelActions appendix get cancel actions API
 func (a *Client) AppendixGetCancelActions(params AppendixGetCancelActionsParams) (*AppendixGetCancelActionsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetCancelActionsWithContext(ctx, params)
+}
+
+// AppendixGetCancelActionsWithContext is the same as AppendixGetCancelActions
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) AppendixGetCancelActionsWithContext(ctx context.Context, params AppendixGetCancelActionsParams) (*AppendixGetCancelActionsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetCancelActionsParams(params)
@@ -91,12 +138,12 @@ func (a *Client) AppendixGetCancelActions(params AppendixGetCancelActionsParams)
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCancelActions: %v", err)
+		return nil, fmt.Errorf("AppendixGetCancelActions: %w", err)
 	}
 
 	parsedResponse := &AppendixGetCancelActionsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/cancel-actions",
 		RawBody:        results.Body,
@@ -106,7 +153,7 @@ func (a *Client) AppendixGetCancelActions(params AppendixGetCancelActionsParams)
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCancelActions: %v", err)
+		return nil, fmt.Errorf("AppendixGetCancelActions: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -114,6 +161,19 @@ func (a *Client) AppendixGetCancelActions(params AppendixGetCancelActionsParams)
 
 // AppendixGetCertificateAuthorities appendix get certificate authorities API
 func (a *Client) AppendixGetCertificateAuthorities(params AppendixGetCertificateAuthoritiesParams) (*AppendixGetCertificateAuthoritiesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetCertificateAuthoritiesWithContext(ctx, params)
+}
+
+// AppendixGetCertificateAuthoritiesWithContext is the same as
+// AppendixGetCertificateAuthorities with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) AppendixGetCertificateAuthoritiesWithContext(ctx context.Context, params AppendixGetCertificateAuthoritiesParams) (*AppendixGetCertificateAuthoritiesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetCertificateAuthoritiesParams(params)
@@ -123,12 +183,12 @@ func (a *Client) AppendixGetCertificateAuthorities(params AppendixGetCertificate
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCertificateAuthorities: %v", err)
+		return nil, fmt.Errorf("AppendixGetCertificateAuthorities: %w", err)
 	}
 
 	parsedResponse := &AppendixGetCertificateAuthoritiesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/certificate-authorities",
 		RawBody:        results.Body,
@@ -138,7 +198,7 @@ func (a *Client) AppendixGetCertificateAuthorities(params AppendixGetCertificate
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCertificateAuthorities: %v", err)
+		return nil, fmt.Errorf("AppendixGetCertificateAuthorities: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -146,6 +206,19 @@ func (a *Client) AppendixGetCertificateAuthorities(params AppendixGetCertificate
 
 // AppendixGetCertificateStatuses appendix get certificate statuses API
 func (a *Client) AppendixGetCertificateStatuses(params AppendixGetCertificateStatusesParams) (*AppendixGetCertificateStatusesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetCertificateStatusesWithContext(ctx, params)
+}
+
+// AppendixGetCertificateStatusesWithContext is the same as
+// AppendixGetCertificateStatuses with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) AppendixGetCertificateStatusesWithContext(ctx context.Context, params AppendixGetCertificateStatusesParams) (*AppendixGetCertificateStatusesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetCertificateStatusesParams(params)
@@ -155,12 +228,12 @@ func (a *Client) AppendixGetCertificateStatuses(params AppendixGetCertificateSta
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCertificateStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetCertificateStatuses: %w", err)
 	}
 
 	parsedResponse := &AppendixGetCertificateStatusesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/certificate-statuses",
 		RawBody:        results.Body,
@@ -170,7 +243,7 @@ func (a *Client) AppendixGetCertificateStatuses(params AppendixGetCertificateSta
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetCertificateStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetCertificateStatuses: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -178,6 +251,18 @@ func (a *Client) AppendixGetCertificateStatuses(params AppendixGetCertificateSta
 
 // AppendixGetDcvTypes appendix get dcv types API
 func (a *Client) AppendixGetDcvTypes(params AppendixGetDcvTypesParams) (*AppendixGetDcvTypesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetDcvTypesWithContext(ctx, params)
+}
+
+// AppendixGetDcvTypesWithContext is the same as AppendixGetDcvTypes with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) AppendixGetDcvTypesWithContext(ctx context.Context, params AppendixGetDcvTypesParams) (*AppendixGetDcvTypesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetDcvTypesParams(params)
@@ -187,12 +272,12 @@ func (a *Client) AppendixGetDcvTypes(params AppendixGetDcvTypesParams) (*Appendi
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetDcvTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetDcvTypes: %w", err)
 	}
 
 	parsedResponse := &AppendixGetDcvTypesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/dcv-types",
 		RawBody:        results.Body,
@@ -202,7 +287,7 @@ func (a *Client) AppendixGetDcvTypes(params AppendixGetDcvTypesParams) (*Appendi
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetDcvTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetDcvTypes: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -210,6 +295,18 @@ func (a *Client) AppendixGetDcvTypes(params AppendixGetDcvTypesParams) (*Appendi
 
 // AppendixGetDomainStatuses appendix get domain statuses API
 func (a *Client) AppendixGetDomainStatuses(params AppendixGetDomainStatusesParams) (*AppendixGetDomainStatusesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetDomainStatusesWithContext(ctx, params)
+}
+
+// AppendixGetDomainStatusesWithContext is the same as AppendixGetDomainStatuses
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) AppendixGetDomainStatusesWithContext(ctx context.Context, params AppendixGetDomainStatusesParams) (*AppendixGetDomainStatusesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetDomainStatusesParams(params)
@@ -219,12 +316,12 @@ func (a *Client) AppendixGetDomainStatuses(params AppendixGetDomainStatusesParam
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetDomainStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetDomainStatuses: %w", err)
 	}
 
 	parsedResponse := &AppendixGetDomainStatusesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/domain-statuses",
 		RawBody:        results.Body,
@@ -234,7 +331,7 @@ func (a *Client) AppendixGetDomainStatuses(params AppendixGetDomainStatusesParam
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetDomainStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetDomainStatuses: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -242,6 +339,18 @@ func (a *Client) AppendixGetDomainStatuses(params AppendixGetDomainStatusesParam
 
 // AppendixGetOrderStatuses appendix get order statuses API
 func (a *Client) AppendixGetOrderStatuses(params AppendixGetOrderStatusesParams) (*AppendixGetOrderStatusesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetOrderStatusesWithContext(ctx, params)
+}
+
+// AppendixGetOrderStatusesWithContext is the same as AppendixGetOrderStatuses
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) AppendixGetOrderStatusesWithContext(ctx context.Context, params AppendixGetOrderStatusesParams) (*AppendixGetOrderStatusesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetOrderStatusesParams(params)
@@ -251,12 +360,12 @@ func (a *Client) AppendixGetOrderStatuses(params AppendixGetOrderStatusesParams)
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetOrderStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetOrderStatuses: %w", err)
 	}
 
 	parsedResponse := &AppendixGetOrderStatusesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/order-statuses",
 		RawBody:        results.Body,
@@ -266,7 +375,7 @@ func (a *Client) AppendixGetOrderStatuses(params AppendixGetOrderStatusesParams)
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetOrderStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetOrderStatuses: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -274,6 +383,18 @@ func (a *Client) AppendixGetOrderStatuses(params AppendixGetOrderStatusesParams)
 
 // AppendixGetProductTypes appendix get product types API
 func (a *Client) AppendixGetProductTypes(params AppendixGetProductTypesParams) (*AppendixGetProductTypesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetProductTypesWithContext(ctx, params)
+}
+
+// AppendixGetProductTypesWithContext is the same as AppendixGetProductTypes
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) AppendixGetProductTypesWithContext(ctx context.Context, params AppendixGetProductTypesParams) (*AppendixGetProductTypesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetProductTypesParams(params)
@@ -283,12 +404,12 @@ func (a *Client) AppendixGetProductTypes(params AppendixGetProductTypesParams) (
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetProductTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetProductTypes: %w", err)
 	}
 
 	parsedResponse := &AppendixGetProductTypesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/product-types",
 		RawBody:        results.Body,
@@ -298,7 +419,7 @@ func (a *Client) AppendixGetProductTypes(params AppendixGetProductTypesParams) (
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetProductTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetProductTypes: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -306,6 +427,18 @@ func (a *Client) AppendixGetProductTypes(params AppendixGetProductTypesParams) (
 
 // AppendixGetRequestType appendix get request type API
 func (a *Client) AppendixGetRequestType(params AppendixGetRequestTypeParams) (*AppendixGetRequestTypeOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetRequestTypeWithContext(ctx, params)
+}
+
+// AppendixGetRequestTypeWithContext is the same as AppendixGetRequestType with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) AppendixGetRequestTypeWithContext(ctx context.Context, params AppendixGetRequestTypeParams) (*AppendixGetRequestTypeOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetRequestTypeParams(params)
@@ -315,12 +448,12 @@ func (a *Client) AppendixGetRequestType(params AppendixGetRequestTypeParams) (*A
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetRequestType: %v", err)
+		return nil, fmt.Errorf("AppendixGetRequestType: %w", err)
 	}
 
 	parsedResponse := &AppendixGetRequestTypeOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/request-types",
 		RawBody:        results.Body,
@@ -330,7 +463,7 @@ func (a *Client) AppendixGetRequestType(params AppendixGetRequestTypeParams) (*A
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetRequestType: %v", err)
+		return nil, fmt.Errorf("AppendixGetRequestType: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -338,6 +471,19 @@ func (a *Client) AppendixGetRequestType(params AppendixGetRequestTypeParams) (*A
 
 // AppendixGetValidationStatuses appendix get validation statuses API
 func (a *Client) AppendixGetValidationStatuses(params AppendixGetValidationStatusesParams) (*AppendixGetValidationStatusesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetValidationStatusesWithContext(ctx, params)
+}
+
+// AppendixGetValidationStatusesWithContext is the same as
+// AppendixGetValidationStatuses with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) AppendixGetValidationStatusesWithContext(ctx context.Context, params AppendixGetValidationStatusesParams) (*AppendixGetValidationStatusesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetValidationStatusesParams(params)
@@ -347,12 +493,12 @@ func (a *Client) AppendixGetValidationStatuses(params AppendixGetValidationStatu
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetValidationStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetValidationStatuses: %w", err)
 	}
 
 	parsedResponse := &AppendixGetValidationStatusesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/validation-statuses",
 		RawBody:        results.Body,
@@ -362,7 +508,7 @@ func (a *Client) AppendixGetValidationStatuses(params AppendixGetValidationStatu
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetValidationStatuses: %v", err)
+		return nil, fmt.Errorf("AppendixGetValidationStatuses: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -370,6 +516,19 @@ func (a *Client) AppendixGetValidationStatuses(params AppendixGetValidationStatu
 
 // AppendixGetValidationTypes appendix get validation types API
 func (a *Client) AppendixGetValidationTypes(params AppendixGetValidationTypesParams) (*AppendixGetValidationTypesOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.AppendixGetValidationTypesWithContext(ctx, params)
+}
+
+// AppendixGetValidationTypesWithContext is the same as
+// AppendixGetValidationTypes with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) AppendixGetValidationTypesWithContext(ctx context.Context, params AppendixGetValidationTypesParams) (*AppendixGetValidationTypesOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestAppendixGetValidationTypesParams(params)
@@ -379,12 +538,12 @@ func (a *Client) AppendixGetValidationTypes(params AppendixGetValidationTypesPar
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetValidationTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetValidationTypes: %w", err)
 	}
 
 	parsedResponse := &AppendixGetValidationTypesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/appendix/validation-types",
 		RawBody:        results.Body,
@@ -394,7 +553,7 @@ func (a *Client) AppendixGetValidationTypes(params AppendixGetValidationTypesPar
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("AppendixGetValidationTypes: %v", err)
+		return nil, fmt.Errorf("AppendixGetValidationTypes: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -7,6 +7,7 @@ package certificate
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,31 +28,67 @@ type Client struct {
 type ClientService interface {
 	CertificateCancel(params CertificateCancelParams) (*CertificateCancelNoContent, error)
 
+	CertificateCancelWithContext(ctx context.Context, params CertificateCancelParams) (*CertificateCancelNoContent, error)
+
 	CertificateDelete(params CertificateDeleteParams) (*CertificateDeleteNoContent, error)
 
+	CertificateDeleteWithContext(ctx context.Context, params CertificateDeleteParams) (*CertificateDeleteNoContent, error)
+
 	CertificateFind(params CertificateFindParams) (*CertificateFindOK, error)
 
+	CertificateFindWithContext(ctx context.Context, params CertificateFindParams) (*CertificateFindOK, error)
+
 	CertificateGet(params CertificateGetParams) (*CertificateGetOK, error)
 
+	CertificateGetWithContext(ctx context.Context, params CertificateGetParams) (*CertificateGetOK, error)
+
 	CertificateGetCertificateStatus(params CertificateGetCertificateStatusParams) (*CertificateGetCertificateStatusOK, error)
 
+	CertificateGetCertificateStatusWithContext(ctx context.Context, params CertificateGetCertificateStatusParams) (*CertificateGetCertificateStatusOK, error)
+
 	CertificateGetRequestNotifications(params CertificateGetRequestNotificationsParams) (*CertificateGetRequestNotificationsOK, error)
 
+	CertificateGetRequestNotificationsWithContext(ctx context.Context, params CertificateGetRequestNotificationsParams) (*CertificateGetRequestNotificationsOK, error)
+
 	CertificatePatch(params CertificatePatchParams) (*CertificatePatchOK, error)
 
+	CertificatePatchWithContext(ctx context.Context, params CertificatePatchParams) (*CertificatePatchOK, error)
+
 	CertificatePost(params CertificatePostParams) (*CertificatePostCreated, error)
 
+	CertificatePostWithContext(ctx context.Context, params CertificatePostParams) (*CertificatePostCreated, error)
+
 	CertificatePutOrganizationDetails(params CertificatePutOrganizationDetailsParams) (*CertificatePutOrganizationDetailsOK, error)
 
+	CertificatePutOrganizationDetailsWithContext(ctx context.Context, params CertificatePutOrganizationDetailsParams) (*CertificatePutOrganizationDetailsOK, error)
+
 	CertificatePutRenewal(params CertificatePutRenewalParams) (*CertificatePutRenewalNoContent, error)
 
+	CertificatePutRenewalWithContext(ctx context.Context, params CertificatePutRenewalParams) (*CertificatePutRenewalNoContent, error)
+
 	CertificatePutRetrigger(params CertificatePutRetriggerParams) (*CertificatePutRetriggerNoContent, error)
 
+	CertificatePutRetriggerWithContext(ctx context.Context, params CertificatePutRetriggerParams) (*CertificatePutRetriggerNoContent, error)
+
 	CertificateUpdateRequestNotifications(params CertificateUpdateRequestNotificationsParams) (*CertificateUpdateRequestNotificationsOK, error)
+
+	CertificateUpdateRequestNotificationsWithContext(ctx context.Context, params CertificateUpdateRequestNotificationsParams) (*CertificateUpdateRequestNotificationsOK, error)
 }
 
 // CertificateCancel certificate cancel API
 func (a *Client) CertificateCancel(params CertificateCancelParams) (*CertificateCancelNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateCancelWithContext(ctx, params)
+}
+
+// CertificateCancelWithContext is the same as CertificateCancel with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) CertificateCancelWithContext(ctx context.Context, params CertificateCancelParams) (*CertificateCancelNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateCancelParams(params)
@@ -61,12 +98,12 @@ func (a *Client) CertificateCancel(params CertificateCancelParams) (*Certificate
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateCancel: %v", err)
+		return nil, fmt.Errorf("CertificateCancel: %w", err)
 	}
 
 	parsedResponse := &CertificateCancelNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/cancel",
 		RawBody:        results.Body,
@@ -76,7 +113,7 @@ func (a *Client) CertificateCancel(params CertificateCancelParams) (*Certificate
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateCancel: %v", err)
+		return nil, fmt.Errorf("CertificateCancel: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -84,6 +121,18 @@ func (a *Client) CertificateCancel(params CertificateCancelParams) (*Certificate
 
 // CertificateDelete certificate delete API
 func (a *Client) CertificateDelete(params CertificateDeleteParams) (*CertificateDeleteNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateDeleteWithContext(ctx, params)
+}
+
+// CertificateDeleteWithContext is the same as CertificateDelete with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) CertificateDeleteWithContext(ctx context.Context, params CertificateDeleteParams) (*CertificateDeleteNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateDeleteParams(params)
@@ -93,12 +142,12 @@ func (a *Client) CertificateDelete(params CertificateDeleteParams) (*Certificate
 
 	method, err := ecclient.ToHTTPMethod("DELETE")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateDelete: %v", err)
+		return nil, fmt.Errorf("CertificateDelete: %w", err)
 	}
 
 	parsedResponse := &CertificateDeleteNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}",
 		RawBody:        results.Body,
@@ -108,7 +157,7 @@ func (a *Client) CertificateDelete(params CertificateDeleteParams) (*Certificate
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateDelete: %v", err)
+		return nil, fmt.Errorf("CertificateDelete: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -116,6 +165,18 @@ func (a *Client) CertificateDelete(params CertificateDeleteParams) (*Certificate
 
 // CertificateFind certificate find API
 func (a *Client) CertificateFind(params CertificateFindParams) (*CertificateFindOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateFindWithContext(ctx, params)
+}
+
+// CertificateFindWithContext is the same as CertificateFind with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) CertificateFindWithContext(ctx context.Context, params CertificateFindParams) (*CertificateFindOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateFindParams(params)
@@ -125,12 +186,12 @@ func (a *Client) CertificateFind(params CertificateFindParams) (*CertificateFind
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateFind: %v", err)
+		return nil, fmt.Errorf("CertificateFind: %w", err)
 	}
 
 	parsedResponse := &CertificateFindOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates",
 		RawBody:        results.Body,
@@ -140,7 +201,7 @@ func (a *Client) CertificateFind(params CertificateFindParams) (*CertificateFind
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateFind: %v", err)
+		return nil, fmt.Errorf("CertificateFind: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -148,6 +209,18 @@ func (a *Client) CertificateFind(params CertificateFindParams) (*CertificateFind
 
 // CertificateGet certificate get API
 func (a *Client) CertificateGet(params CertificateGetParams) (*CertificateGetOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateGetWithContext(ctx, params)
+}
+
+// CertificateGetWithContext is the same as CertificateGet with the addition of
+// a context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) CertificateGetWithContext(ctx context.Context, params CertificateGetParams) (*CertificateGetOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateGetParams(params)
@@ -157,12 +230,12 @@ func (a *Client) CertificateGet(params CertificateGetParams) (*CertificateGetOK,
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGet: %v", err)
+		return nil, fmt.Errorf("CertificateGet: %w", err)
 	}
 
 	parsedResponse := &CertificateGetOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}",
 		RawBody:        results.Body,
@@ -172,7 +245,7 @@ func (a *Client) CertificateGet(params CertificateGetParams) (*CertificateGetOK,
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGet: %v", err)
+		return nil, fmt.Errorf("CertificateGet: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -180,6 +253,19 @@ func (a *Client) CertificateGet(params CertificateGetParams) (*CertificateGetOK,
 
 // CertificateGetCertificateStatus certificate get certificate status API
 func (a *Client) CertificateGetCertificateStatus(params CertificateGetCertificateStatusParams) (*CertificateGetCertificateStatusOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateGetCertificateStatusWithContext(ctx, params)
+}
+
+// CertificateGetCertificateStatusWithContext is the same as
+// CertificateGetCertificateStatus with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) CertificateGetCertificateStatusWithContext(ctx context.Context, params CertificateGetCertificateStatusParams) (*CertificateGetCertificateStatusOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateGetCertificateStatusParams(params)
@@ -189,12 +275,12 @@ func (a *Client) CertificateGetCertificateStatus(params CertificateGetCertificat
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGetCertificateStatus: %v", err)
+		return nil, fmt.Errorf("CertificateGetCertificateStatus: %w", err)
 	}
 
 	parsedResponse := &CertificateGetCertificateStatusOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/status",
 		RawBody:        results.Body,
@@ -204,7 +290,7 @@ func (a *Client) CertificateGetCertificateStatus(params CertificateGetCertificat
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGetCertificateStatus: %v", err)
+		return nil, fmt.Errorf("CertificateGetCertificateStatus: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -212,6 +298,19 @@ func (a *Client) CertificateGetCertificateStatus(params CertificateGetCertificat
 
 // CertificateGetRequestNotifications certificate get request notifications API
 func (a *Client) CertificateGetRequestNotifications(params CertificateGetRequestNotificationsParams) (*CertificateGetRequestNotificationsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateGetRequestNotificationsWithContext(ctx, params)
+}
+
+// CertificateGetRequestNotificationsWithContext is the same as
+// CertificateGetRequestNotifications with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) CertificateGetRequestNotificationsWithContext(ctx context.Context, params CertificateGetRequestNotificationsParams) (*CertificateGetRequestNotificationsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateGetRequestNotificationsParams(params)
@@ -221,12 +320,12 @@ func (a *Client) CertificateGetRequestNotifications(params CertificateGetRequest
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGetRequestNotifications: %v", err)
+		return nil, fmt.Errorf("CertificateGetRequestNotifications: %w", err)
 	}
 
 	parsedResponse := &CertificateGetRequestNotificationsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/notifications",
 		RawBody:        results.Body,
@@ -236,7 +335,7 @@ func (a *Client) CertificateGetRequestNotifications(params CertificateGetRequest
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateGetRequestNotifications: %v", err)
+		return nil, fmt.Errorf("CertificateGetRequestNotifications: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -244,6 +343,18 @@ func (a *Client) CertificateGetRequestNotifications(params CertificateGetRequest
 
 // CertificatePatch certificate patch API
 func (a *Client) CertificatePatch(params CertificatePatchParams) (*CertificatePatchOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificatePatchWithContext(ctx, params)
+}
+
+// CertificatePatchWithContext is the same as CertificatePatch with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) CertificatePatchWithContext(ctx context.Context, params CertificatePatchParams) (*CertificatePatchOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificatePatchParams(params)
@@ -253,12 +364,12 @@ func (a *Client) CertificatePatch(params CertificatePatchParams) (*CertificatePa
 
 	method, err := ecclient.ToHTTPMethod("PATCH")
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePatch: %v", err)
+		return nil, fmt.Errorf("CertificatePatch: %w", err)
 	}
 
 	parsedResponse := &CertificatePatchOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}",
 		RawBody:        results.Body,
@@ -268,7 +379,7 @@ func (a *Client) CertificatePatch(params CertificatePatchParams) (*CertificatePa
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePatch: %v", err)
+		return nil, fmt.Errorf("CertificatePatch: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -276,6 +387,18 @@ func (a *Client) CertificatePatch(params CertificatePatchParams) (*CertificatePa
 
 // CertificatePost certificate post API
 func (a *Client) CertificatePost(params CertificatePostParams) (*CertificatePostCreated, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificatePostWithContext(ctx, params)
+}
+
+// CertificatePostWithContext is the same as CertificatePost with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) CertificatePostWithContext(ctx context.Context, params CertificatePostParams) (*CertificatePostCreated, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificatePostParams(params)
@@ -285,12 +408,12 @@ func (a *Client) CertificatePost(params CertificatePostParams) (*CertificatePost
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePost: %v", err)
+		return nil, fmt.Errorf("CertificatePost: %w", err)
 	}
 
 	parsedResponse := &CertificatePostCreated{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/cdnprovided",
 		RawBody:        results.Body,
@@ -300,7 +423,7 @@ func (a *Client) CertificatePost(params CertificatePostParams) (*CertificatePost
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePost: %v", err)
+		return nil, fmt.Errorf("CertificatePost: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -308,6 +431,19 @@ func (a *Client) CertificatePost(params CertificatePostParams) (*CertificatePost
 
 // CertificatePutOrganizationDetails certificate put organization details API
 func (a *Client) CertificatePutOrganizationDetails(params CertificatePutOrganizationDetailsParams) (*CertificatePutOrganizationDetailsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificatePutOrganizationDetailsWithContext(ctx, params)
+}
+
+// CertificatePutOrganizationDetailsWithContext is the same as
+// CertificatePutOrganizationDetails with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) CertificatePutOrganizationDetailsWithContext(ctx context.Context, params CertificatePutOrganizationDetailsParams) (*CertificatePutOrganizationDetailsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificatePutOrganizationDetailsParams(params)
@@ -317,12 +453,12 @@ func (a *Client) CertificatePutOrganizationDetails(params CertificatePutOrganiza
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutOrganizationDetails: %v", err)
+		return nil, fmt.Errorf("CertificatePutOrganizationDetails: %w", err)
 	}
 
 	parsedResponse := &CertificatePutOrganizationDetailsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/organization",
 		RawBody:        results.Body,
@@ -332,7 +468,7 @@ func (a *Client) CertificatePutOrganizationDetails(params CertificatePutOrganiza
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutOrganizationDetails: %v", err)
+		return nil, fmt.Errorf("CertificatePutOrganizationDetails: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -340,6 +476,18 @@ func (a *Client) CertificatePutOrganizationDetails(params CertificatePutOrganiza
 
 // CertificatePutRenewal certificate put renewal API
 func (a *Client) CertificatePutRenewal(params CertificatePutRenewalParams) (*CertificatePutRenewalNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificatePutRenewalWithContext(ctx, params)
+}
+
+// CertificatePutRenewalWithContext is the same as CertificatePutRenewal with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) CertificatePutRenewalWithContext(ctx context.Context, params CertificatePutRenewalParams) (*CertificatePutRenewalNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificatePutRenewalParams(params)
@@ -349,12 +497,12 @@ func (a *Client) CertificatePutRenewal(params CertificatePutRenewalParams) (*Cer
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutRenewal: %v", err)
+		return nil, fmt.Errorf("CertificatePutRenewal: %w", err)
 	}
 
 	parsedResponse := &CertificatePutRenewalNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/renew",
 		RawBody:        results.Body,
@@ -364,7 +512,7 @@ func (a *Client) CertificatePutRenewal(params CertificatePutRenewalParams) (*Cer
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutRenewal: %v", err)
+		return nil, fmt.Errorf("CertificatePutRenewal: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -372,6 +520,18 @@ func (a *Client) CertificatePutRenewal(params CertificatePutRenewalParams) (*Cer
 
 // CertificatePutRetrigger certificate put retrigger API
 func (a *Client) CertificatePutRetrigger(params CertificatePutRetriggerParams) (*CertificatePutRetriggerNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificatePutRetriggerWithContext(ctx, params)
+}
+
+// CertificatePutRetriggerWithContext is the same as CertificatePutRetrigger
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) CertificatePutRetriggerWithContext(ctx context.Context, params CertificatePutRetriggerParams) (*CertificatePutRetriggerNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificatePutRetriggerParams(params)
@@ -381,12 +541,12 @@ func (a *Client) CertificatePutRetrigger(params CertificatePutRetriggerParams) (
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutRetrigger: %v", err)
+		return nil, fmt.Errorf("CertificatePutRetrigger: %w", err)
 	}
 
 	parsedResponse := &CertificatePutRetriggerNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/retrigger",
 		RawBody:        results.Body,
@@ -396,7 +556,7 @@ func (a *Client) CertificatePutRetrigger(params CertificatePutRetriggerParams) (
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificatePutRetrigger: %v", err)
+		return nil, fmt.Errorf("CertificatePutRetrigger: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -404,6 +564,19 @@ func (a *Client) CertificatePutRetrigger(params CertificatePutRetriggerParams) (
 
 // CertificateUpdateRequestNotifications certificate update request notifications API
 func (a *Client) CertificateUpdateRequestNotifications(params CertificateUpdateRequestNotificationsParams) (*CertificateUpdateRequestNotificationsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CertificateUpdateRequestNotificationsWithContext(ctx, params)
+}
+
+// CertificateUpdateRequestNotificationsWithContext is the same as
+// CertificateUpdateRequestNotifications with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) CertificateUpdateRequestNotificationsWithContext(ctx context.Context, params CertificateUpdateRequestNotificationsParams) (*CertificateUpdateRequestNotificationsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCertificateUpdateRequestNotificationsParams(params)
@@ -413,12 +586,12 @@ func (a *Client) CertificateUpdateRequestNotifications(params CertificateUpdateR
 
 	method, err := ecclient.ToHTTPMethod("PATCH")
 	if err != nil {
-		return nil, fmt.Errorf("CertificateUpdateRequestNotifications: %v", err)
+		return nil, fmt.Errorf("CertificateUpdateRequestNotifications: %w", err)
 	}
 
 	parsedResponse := &CertificateUpdateRequestNotificationsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/certificates/{id}/notifications",
 		RawBody:        results.Body,
@@ -428,7 +601,7 @@ func (a *Client) CertificateUpdateRequestNotifications(params CertificateUpdateR
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CertificateUpdateRequestNotifications: %v", err)
+		return nil, fmt.Errorf("CertificateUpdateRequestNotifications: %w", err)
 	}
 
 	return parsedResponse, nil
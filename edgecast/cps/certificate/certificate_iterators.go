@@ -0,0 +1,51 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package certificate
+
+import (
+	"context"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/models"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+	"github.com/go-openapi/swag"
+)
+
+// NewCertificateFindIterator creates an iterator over the results of
+// CertificateFind, fetching pages as they are needed. Paging starts at
+// params.Page, or the first page if it is not set, and uses params.PageSize
+// items per page, or options.PageSize if it is not set.
+func NewCertificateFindIterator(
+	ctx context.Context,
+	client ClientService,
+	params CertificateFindParams,
+	options ecpaging.Options,
+) *ecpaging.Iterator[*models.CdnProvidedCertificateWithoutOrg] {
+	if params.PageSize == nil && options.PageSize > 0 {
+		params.PageSize = swag.Int32(int32(options.PageSize))
+	}
+
+	fetch := func(
+		ctx context.Context,
+		page int,
+	) (ecpaging.Page[*models.CdnProvidedCertificateWithoutOrg], error) {
+		params.Page = swag.Int32(int32(page))
+
+		resp, err := client.CertificateFindWithContext(ctx, params)
+		if err != nil {
+			return ecpaging.Page[*models.CdnProvidedCertificateWithoutOrg]{}, err
+		}
+
+		return ecpaging.Page[*models.CdnProvidedCertificateWithoutOrg]{
+			Items:      resp.Items,
+			TotalItems: int(resp.TotalItems),
+			PageSize:   int(swag.Int32Value(params.PageSize)),
+		}, nil
+	}
+
+	return ecpaging.NewIterator(
+		ctx,
+		fetch,
+		int(swag.Int32Value(params.Page)),
+		options)
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package certificate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/models"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+	"github.com/go-openapi/swag"
+)
+
+// testCertificatePages serves total certificates, using defaultPageSize if a
+// request does not set a page size, and records the page sizes requested
+type testCertificatePages struct {
+	ClientService
+
+	total           int
+	defaultPageSize int
+	pageSizes       []int32
+}
+
+func (p *testCertificatePages) CertificateFindWithContext(
+	ctx context.Context,
+	params CertificateFindParams,
+) (*CertificateFindOK, error) {
+	p.pageSizes = append(p.pageSizes, swag.Int32Value(params.PageSize))
+
+	pageSize := p.defaultPageSize
+	if params.PageSize != nil {
+		pageSize = int(*params.PageSize)
+	}
+
+	page := int(swag.Int32Value(params.Page))
+	resp := &CertificateFindOK{}
+	for i := (page-1)*pageSize + 1; i <= page*pageSize && i <= p.total; i++ {
+		resp.Items = append(
+			resp.Items,
+			&models.CdnProvidedCertificateWithoutOrg{ID: int64(i)})
+	}
+	return resp, nil
+}
+
+func TestCertificateFindIteratorPageSize(t *testing.T) {
+	cases := []struct {
+		name              string
+		pageSize          *int32
+		expectedPageSizes []int32
+	}{
+		{
+			name:              "options.PageSize is sent",
+			expectedPageSizes: []int32{5, 5},
+		},
+		{
+			name:              "params.PageSize takes precedence",
+			pageSize:          swag.Int32(4),
+			expectedPageSizes: []int32{4, 4, 4},
+		},
+	}
+
+	for _, c := range cases {
+		// The API's default page size is smaller than options.PageSize, so
+		// its pages would appear to be short if the option were not sent
+		pages := &testCertificatePages{total: 9, defaultPageSize: 2}
+		params := NewCertificateFindParams()
+		params.PageSize = c.pageSize
+
+		it := NewCertificateFindIterator(
+			context.Background(),
+			pages,
+			params,
+			ecpaging.Options{PageSize: 5})
+
+		items, err := it.All()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if len(items) != pages.total {
+			t.Fatalf(
+				"%s: Expected %d items but got %d",
+				c.name,
+				pages.total,
+				len(items))
+		}
+		if !reflect.DeepEqual(c.expectedPageSizes, pages.pageSizes) {
+			t.Fatalf(
+				"%s: Expected page sizes %+v but got %+v",
+				c.name,
+				c.expectedPageSizes,
+				pages.pageSizes)
+		}
+	}
+}
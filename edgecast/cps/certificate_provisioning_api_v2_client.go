@@ -11,7 +11,6 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/appendix"
@@ -39,56 +38,52 @@ func New(config edgecast.SDKConfig) (*CpsService, error) {
 
 	apiURL, err := url.Parse(config.BaseAPIURL.String() + DefaultBasePath)
 	if err != nil {
-		return nil, fmt.Errorf("CpsService.New(): %v", err)
+		return nil, fmt.Errorf("CpsService.New(): %w", err)
 	}
 
-	// OAuth2 authentication
-	authProvider, err := ecauth.NewIDSAuthorizationProvider(config.BaseIDSURL, ecauth.OAuth2Credentials(config.IDSCredentials))
+	httpClient, err := config.NewHTTPClient()
 	if err != nil {
+		return nil, fmt.Errorf("CpsService.New(): %w", err)
+	}
 
-		//Token authentication
-		authTokenProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
-		if err != nil {
-			return nil, fmt.Errorf("CpsService.New(): %v", err)
-		}
-		c := ecclient.New(ecclient.ClientConfig{
-			BaseAPIURL:   *apiURL,
-			UserAgent:    config.UserAgent,
-			Logger:       config.Logger,
-			AuthProvider: authTokenProvider,
-		})
-
-		return &CpsService{
-			client:       c,
-			Logger:       config.Logger,
-			Appendix:     appendix.New(c, c.Config.BaseAPIURL.String()),
-			Certificate:  certificate.New(c, c.Config.BaseAPIURL.String()),
-			Customer:     customer.New(c, c.Config.BaseAPIURL.String()),
-			Dcv:          dcv.New(c, c.Config.BaseAPIURL.String()),
-			Organization: organization.New(c, c.Config.BaseAPIURL.String()),
-			Task:         task.New(c, c.Config.BaseAPIURL.String()),
-		}, nil
-
-	} else {
-
-		c := ecclient.New(ecclient.ClientConfig{
-			BaseAPIURL:   *apiURL,
-			UserAgent:    config.UserAgent,
-			Logger:       config.Logger,
-			AuthProvider: authProvider,
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{
+			Service: "cps",
+			IDS:     true,
+			Token:   true,
+			Scopes:  requiredScopes,
 		})
-
-		return &CpsService{
-			client:       c,
-			Logger:       config.Logger,
-			Appendix:     appendix.New(c, c.Config.BaseAPIURL.String()),
-			Certificate:  certificate.New(c, c.Config.BaseAPIURL.String()),
-			Customer:     customer.New(c, c.Config.BaseAPIURL.String()),
-			Dcv:          dcv.New(c, c.Config.BaseAPIURL.String()),
-			Organization: organization.New(c, c.Config.BaseAPIURL.String()),
-			Task:         task.New(c, c.Config.BaseAPIURL.String()),
-		}, nil
+	if err != nil {
+		return nil, fmt.Errorf("CpsService.New(): %w", err)
 	}
+
+	c := ecclient.New(ecclient.ClientConfig{
+		BaseAPIURL:      *apiURL,
+		ServiceName:     "cps",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
+		AuthProvider:    authProvider,
+		ErrorDecoder:    decodeHyperionError,
+	})
+
+	return &CpsService{
+		client:       c,
+		Logger:       config.Logger,
+		Appendix:     appendix.New(c, c.Config.BaseAPIURL.String()),
+		Certificate:  certificate.New(c, c.Config.BaseAPIURL.String()),
+		Customer:     customer.New(c, c.Config.BaseAPIURL.String()),
+		Dcv:          dcv.New(c, c.Config.BaseAPIURL.String()),
+		Organization: organization.New(c, c.Config.BaseAPIURL.String()),
+		Task:         task.New(c, c.Config.BaseAPIURL.String()),
+	}, nil
 }
 
 // CpsService is a client for certificate provisioning API v2
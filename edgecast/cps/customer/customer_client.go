@@ -7,6 +7,7 @@ package customer
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,13 +28,32 @@ type Client struct {
 type ClientService interface {
 	CustomerGetCustomerCommits(params CustomerGetCustomerCommitsParams) (*CustomerGetCustomerCommitsOK, error)
 
+	CustomerGetCustomerCommitsWithContext(ctx context.Context, params CustomerGetCustomerCommitsParams) (*CustomerGetCustomerCommitsOK, error)
+
 	CustomerGetCustomerNotifications(params CustomerGetCustomerNotificationsParams) (*CustomerGetCustomerNotificationsOK, error)
 
+	CustomerGetCustomerNotificationsWithContext(ctx context.Context, params CustomerGetCustomerNotificationsParams) (*CustomerGetCustomerNotificationsOK, error)
+
 	CustomerUpdateCustomerNotifications(params CustomerUpdateCustomerNotificationsParams) (*CustomerUpdateCustomerNotificationsOK, error)
+
+	CustomerUpdateCustomerNotificationsWithContext(ctx context.Context, params CustomerUpdateCustomerNotificationsParams) (*CustomerUpdateCustomerNotificationsOK, error)
 }
 
 // CustomerGetCustomerCommits customer get customer commits API
 func (a *Client) CustomerGetCustomerCommits(params CustomerGetCustomerCommitsParams) (*CustomerGetCustomerCommitsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CustomerGetCustomerCommitsWithContext(ctx, params)
+}
+
+// CustomerGetCustomerCommitsWithContext is the same as
+// CustomerGetCustomerCommits with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) CustomerGetCustomerCommitsWithContext(ctx context.Context, params CustomerGetCustomerCommitsParams) (*CustomerGetCustomerCommitsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCustomerGetCustomerCommitsParams(params)
@@ -43,12 +63,12 @@ func (a *Client) CustomerGetCustomerCommits(params CustomerGetCustomerCommitsPar
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CustomerGetCustomerCommits: %v", err)
+		return nil, fmt.Errorf("CustomerGetCustomerCommits: %w", err)
 	}
 
 	parsedResponse := &CustomerGetCustomerCommitsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/customers/commits",
 		RawBody:        results.Body,
@@ -58,7 +78,7 @@ func (a *Client) CustomerGetCustomerCommits(params CustomerGetCustomerCommitsPar
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CustomerGetCustomerCommits: %v", err)
+		return nil, fmt.Errorf("CustomerGetCustomerCommits: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -66,6 +86,19 @@ func (a *Client) CustomerGetCustomerCommits(params CustomerGetCustomerCommitsPar
 
 // CustomerGetCustomerNotifications customer get customer notifications API
 func (a *Client) CustomerGetCustomerNotifications(params CustomerGetCustomerNotificationsParams) (*CustomerGetCustomerNotificationsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CustomerGetCustomerNotificationsWithContext(ctx, params)
+}
+
+// CustomerGetCustomerNotificationsWithContext is the same as
+// CustomerGetCustomerNotifications with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) CustomerGetCustomerNotificationsWithContext(ctx context.Context, params CustomerGetCustomerNotificationsParams) (*CustomerGetCustomerNotificationsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCustomerGetCustomerNotificationsParams(params)
@@ -75,12 +108,12 @@ func (a *Client) CustomerGetCustomerNotifications(params CustomerGetCustomerNoti
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("CustomerGetCustomerNotifications: %v", err)
+		return nil, fmt.Errorf("CustomerGetCustomerNotifications: %w", err)
 	}
 
 	parsedResponse := &CustomerGetCustomerNotificationsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/customers/notifications",
 		RawBody:        results.Body,
@@ -90,7 +123,7 @@ func (a *Client) CustomerGetCustomerNotifications(params CustomerGetCustomerNoti
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CustomerGetCustomerNotifications: %v", err)
+		return nil, fmt.Errorf("CustomerGetCustomerNotifications: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -98,6 +131,19 @@ func (a *Client) CustomerGetCustomerNotifications(params CustomerGetCustomerNoti
 
 // CustomerUpdateCustomerNotifications customer update customer notifications API
 func (a *Client) CustomerUpdateCustomerNotifications(params CustomerUpdateCustomerNotificationsParams) (*CustomerUpdateCustomerNotificationsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.CustomerUpdateCustomerNotificationsWithContext(ctx, params)
+}
+
+// CustomerUpdateCustomerNotificationsWithContext is the same as
+// CustomerUpdateCustomerNotifications with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) CustomerUpdateCustomerNotificationsWithContext(ctx context.Context, params CustomerUpdateCustomerNotificationsParams) (*CustomerUpdateCustomerNotificationsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestCustomerUpdateCustomerNotificationsParams(params)
@@ -107,12 +153,12 @@ func (a *Client) CustomerUpdateCustomerNotifications(params CustomerUpdateCustom
 
 	method, err := ecclient.ToHTTPMethod("PATCH")
 	if err != nil {
-		return nil, fmt.Errorf("CustomerUpdateCustomerNotifications: %v", err)
+		return nil, fmt.Errorf("CustomerUpdateCustomerNotifications: %w", err)
 	}
 
 	parsedResponse := &CustomerUpdateCustomerNotificationsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/customers/notifications",
 		RawBody:        results.Body,
@@ -122,7 +168,7 @@ func (a *Client) CustomerUpdateCustomerNotifications(params CustomerUpdateCustom
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("CustomerUpdateCustomerNotifications: %v", err)
+		return nil, fmt.Errorf("CustomerUpdateCustomerNotifications: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -7,6 +7,7 @@ package dcv
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,17 +28,39 @@ type Client struct {
 type ClientService interface {
 	DcvCheckDcvTokens(params DcvCheckDcvTokensParams) (*DcvCheckDcvTokensNoContent, error)
 
+	DcvCheckDcvTokensWithContext(ctx context.Context, params DcvCheckDcvTokensParams) (*DcvCheckDcvTokensNoContent, error)
+
 	DcvGetCertificateDomainDetails(params DcvGetCertificateDomainDetailsParams) (*DcvGetCertificateDomainDetailsOK, error)
 
+	DcvGetCertificateDomainDetailsWithContext(ctx context.Context, params DcvGetCertificateDomainDetailsParams) (*DcvGetCertificateDomainDetailsOK, error)
+
 	DcvPostEmailResend(params DcvPostEmailResendParams) (*DcvPostEmailResendNoContent, error)
 
+	DcvPostEmailResendWithContext(ctx context.Context, params DcvPostEmailResendParams) (*DcvPostEmailResendNoContent, error)
+
 	DcvRegenerateDcvTokens(params DcvRegenerateDcvTokensParams) (*DcvRegenerateDcvTokensOK, error)
 
+	DcvRegenerateDcvTokensWithContext(ctx context.Context, params DcvRegenerateDcvTokensParams) (*DcvRegenerateDcvTokensOK, error)
+
 	DcvSetCertificateDcvMethod(params DcvSetCertificateDcvMethodParams) (*DcvSetCertificateDcvMethodNoContent, error)
+
+	DcvSetCertificateDcvMethodWithContext(ctx context.Context, params DcvSetCertificateDcvMethodParams) (*DcvSetCertificateDcvMethodNoContent, error)
 }
 
 // DcvCheckDcvTokens dcv check dcv tokens API
 func (a *Client) DcvCheckDcvTokens(params DcvCheckDcvTokensParams) (*DcvCheckDcvTokensNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.DcvCheckDcvTokensWithContext(ctx, params)
+}
+
+// DcvCheckDcvTokensWithContext is the same as DcvCheckDcvTokens with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) DcvCheckDcvTokensWithContext(ctx context.Context, params DcvCheckDcvTokensParams) (*DcvCheckDcvTokensNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestDcvCheckDcvTokensParams(params)
@@ -47,12 +70,12 @@ func (a *Client) DcvCheckDcvTokens(params DcvCheckDcvTokensParams) (*DcvCheckDcv
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("DcvCheckDcvTokens: %v", err)
+		return nil, fmt.Errorf("DcvCheckDcvTokens: %w", err)
 	}
 
 	parsedResponse := &DcvCheckDcvTokensNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/dcv/certificates/{id}/check",
 		RawBody:        results.Body,
@@ -62,7 +85,7 @@ func (a *Client) DcvCheckDcvTokens(params DcvCheckDcvTokensParams) (*DcvCheckDcv
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("DcvCheckDcvTokens: %v", err)
+		return nil, fmt.Errorf("DcvCheckDcvTokens: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -70,6 +93,19 @@ func (a *Client) DcvCheckDcvTokens(params DcvCheckDcvTokensParams) (*DcvCheckDcv
 
 // DcvGetCertificateDomainDetails dcv get certificate domain details API
 func (a *Client) DcvGetCertificateDomainDetails(params DcvGetCertificateDomainDetailsParams) (*DcvGetCertificateDomainDetailsOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.DcvGetCertificateDomainDetailsWithContext(ctx, params)
+}
+
+// DcvGetCertificateDomainDetailsWithContext is the same as
+// DcvGetCertificateDomainDetails with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) DcvGetCertificateDomainDetailsWithContext(ctx context.Context, params DcvGetCertificateDomainDetailsParams) (*DcvGetCertificateDomainDetailsOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestDcvGetCertificateDomainDetailsParams(params)
@@ -79,12 +115,12 @@ func (a *Client) DcvGetCertificateDomainDetails(params DcvGetCertificateDomainDe
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("DcvGetCertificateDomainDetails: %v", err)
+		return nil, fmt.Errorf("DcvGetCertificateDomainDetails: %w", err)
 	}
 
 	parsedResponse := &DcvGetCertificateDomainDetailsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/dcv/certificates/{id}",
 		RawBody:        results.Body,
@@ -94,7 +130,7 @@ func (a *Client) DcvGetCertificateDomainDetails(params DcvGetCertificateDomainDe
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("DcvGetCertificateDomainDetails: %v", err)
+		return nil, fmt.Errorf("DcvGetCertificateDomainDetails: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -102,6 +138,18 @@ func (a *Client) DcvGetCertificateDomainDetails(params DcvGetCertificateDomainDe
 
 // DcvPostEmailResend dcv post email resend API
 func (a *Client) DcvPostEmailResend(params DcvPostEmailResendParams) (*DcvPostEmailResendNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.DcvPostEmailResendWithContext(ctx, params)
+}
+
+// DcvPostEmailResendWithContext is the same as DcvPostEmailResend with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) DcvPostEmailResendWithContext(ctx context.Context, params DcvPostEmailResendParams) (*DcvPostEmailResendNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestDcvPostEmailResendParams(params)
@@ -111,12 +159,12 @@ func (a *Client) DcvPostEmailResend(params DcvPostEmailResendParams) (*DcvPostEm
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("DcvPostEmailResend: %v", err)
+		return nil, fmt.Errorf("DcvPostEmailResend: %w", err)
 	}
 
 	parsedResponse := &DcvPostEmailResendNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/dcv/certificates/{id}/emails/resend",
 		RawBody:        results.Body,
@@ -126,7 +174,7 @@ func (a *Client) DcvPostEmailResend(params DcvPostEmailResendParams) (*DcvPostEm
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("DcvPostEmailResend: %v", err)
+		return nil, fmt.Errorf("DcvPostEmailResend: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -134,6 +182,18 @@ func (a *Client) DcvPostEmailResend(params DcvPostEmailResendParams) (*DcvPostEm
 
 // DcvRegenerateDcvTokens dcv regenerate dcv tokens API
 func (a *Client) DcvRegenerateDcvTokens(params DcvRegenerateDcvTokensParams) (*DcvRegenerateDcvTokensOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.DcvRegenerateDcvTokensWithContext(ctx, params)
+}
+
+// DcvRegenerateDcvTokensWithContext is the same as DcvRegenerateDcvTokens with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) DcvRegenerateDcvTokensWithContext(ctx context.Context, params DcvRegenerateDcvTokensParams) (*DcvRegenerateDcvTokensOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestDcvRegenerateDcvTokensParams(params)
@@ -143,12 +203,12 @@ func (a *Client) DcvRegenerateDcvTokens(params DcvRegenerateDcvTokensParams) (*D
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("DcvRegenerateDcvTokens: %v", err)
+		return nil, fmt.Errorf("DcvRegenerateDcvTokens: %w", err)
 	}
 
 	parsedResponse := &DcvRegenerateDcvTokensOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/dcv/certificates/{id}/token",
 		RawBody:        results.Body,
@@ -158,7 +218,7 @@ func (a *Client) DcvRegenerateDcvTokens(params DcvRegenerateDcvTokensParams) (*D
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("DcvRegenerateDcvTokens: %v", err)
+		return nil, fmt.Errorf("DcvRegenerateDcvTokens: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -166,6 +226,19 @@ func (a *Client) DcvRegenerateDcvTokens(params DcvRegenerateDcvTokensParams) (*D
 
 // DcvSetCertificateDcvMethod dcv set certificate dcv method API
 func (a *Client) DcvSetCertificateDcvMethod(params DcvSetCertificateDcvMethodParams) (*DcvSetCertificateDcvMethodNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.DcvSetCertificateDcvMethodWithContext(ctx, params)
+}
+
+// DcvSetCertificateDcvMethodWithContext is the same as
+// DcvSetCertificateDcvMethod with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) DcvSetCertificateDcvMethodWithContext(ctx context.Context, params DcvSetCertificateDcvMethodParams) (*DcvSetCertificateDcvMethodNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestDcvSetCertificateDcvMethodParams(params)
@@ -175,12 +248,12 @@ func (a *Client) DcvSetCertificateDcvMethod(params DcvSetCertificateDcvMethodPar
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("DcvSetCertificateDcvMethod: %v", err)
+		return nil, fmt.Errorf("DcvSetCertificateDcvMethod: %w", err)
 	}
 
 	parsedResponse := &DcvSetCertificateDcvMethodNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/dcv/certificates/{id}/method",
 		RawBody:        results.Body,
@@ -190,7 +263,7 @@ func (a *Client) DcvSetCertificateDcvMethod(params DcvSetCertificateDcvMethodPar
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("DcvSetCertificateDcvMethod: %v", err)
+		return nil, fmt.Errorf("DcvSetCertificateDcvMethod: %w", err)
 	}
 
 	return parsedResponse, nil
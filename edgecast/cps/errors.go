@@ -0,0 +1,32 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package cps
+
+import (
+	"encoding/json"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/models"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+)
+
+// decodeHyperionError stores the error reported by the CPS API in
+// APIError.Details as a *models.HyperionErrorReponse
+func decodeHyperionError(apiErr *ecclient.APIError) {
+	resp := &models.HyperionErrorReponse{}
+	err := json.Unmarshal(apiErr.Body, resp)
+	if err == nil && (len(resp.Code) > 0 || len(resp.Details) > 0) {
+		apiErr.Details = resp
+	}
+}
+
+// HyperionError retrieves the error details reported by the CPS API from err,
+// if err was caused by a failed CPS API call
+func HyperionError(err error) (*models.HyperionErrorReponse, bool) {
+	apiErr, ok := ecclient.AsAPIError(err)
+	if !ok {
+		return nil, false
+	}
+	details, ok := apiErr.Details.(*models.HyperionErrorReponse)
+	return details, ok
+}
@@ -7,6 +7,7 @@ package organization
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,13 +28,31 @@ type Client struct {
 type ClientService interface {
 	OrganizationFind(params OrganizationFindParams) (*OrganizationFindOK, error)
 
+	OrganizationFindWithContext(ctx context.Context, params OrganizationFindParams) (*OrganizationFindOK, error)
+
 	OrganizationGet(params OrganizationGetParams) (*OrganizationGetOK, error)
 
+	OrganizationGetWithContext(ctx context.Context, params OrganizationGetParams) (*OrganizationGetOK, error)
+
 	OrganizationGetDefaultOrganization(params OrganizationGetDefaultOrganizationParams) (*OrganizationGetDefaultOrganizationOK, error)
+
+	OrganizationGetDefaultOrganizationWithContext(ctx context.Context, params OrganizationGetDefaultOrganizationParams) (*OrganizationGetDefaultOrganizationOK, error)
 }
 
 // OrganizationFind organization find API
 func (a *Client) OrganizationFind(params OrganizationFindParams) (*OrganizationFindOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.OrganizationFindWithContext(ctx, params)
+}
+
+// OrganizationFindWithContext is the same as OrganizationFind with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) OrganizationFindWithContext(ctx context.Context, params OrganizationFindParams) (*OrganizationFindOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestOrganizationFindParams(params)
@@ -43,12 +62,12 @@ func (a *Client) OrganizationFind(params OrganizationFindParams) (*OrganizationF
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationFind: %v", err)
+		return nil, fmt.Errorf("OrganizationFind: %w", err)
 	}
 
 	parsedResponse := &OrganizationFindOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/organizations/name/{name}",
 		RawBody:        results.Body,
@@ -58,7 +77,7 @@ func (a *Client) OrganizationFind(params OrganizationFindParams) (*OrganizationF
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationFind: %v", err)
+		return nil, fmt.Errorf("OrganizationFind: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -66,6 +85,18 @@ func (a *Client) OrganizationFind(params OrganizationFindParams) (*OrganizationF
 
 // OrganizationGet organization get API
 func (a *Client) OrganizationGet(params OrganizationGetParams) (*OrganizationGetOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.OrganizationGetWithContext(ctx, params)
+}
+
+// OrganizationGetWithContext is the same as OrganizationGet with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) OrganizationGetWithContext(ctx context.Context, params OrganizationGetParams) (*OrganizationGetOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestOrganizationGetParams(params)
@@ -75,12 +106,12 @@ func (a *Client) OrganizationGet(params OrganizationGetParams) (*OrganizationGet
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationGet: %v", err)
+		return nil, fmt.Errorf("OrganizationGet: %w", err)
 	}
 
 	parsedResponse := &OrganizationGetOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/organizations/{id}",
 		RawBody:        results.Body,
@@ -90,7 +121,7 @@ func (a *Client) OrganizationGet(params OrganizationGetParams) (*OrganizationGet
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationGet: %v", err)
+		return nil, fmt.Errorf("OrganizationGet: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -98,6 +129,19 @@ func (a *Client) OrganizationGet(params OrganizationGetParams) (*OrganizationGet
 
 // OrganizationGetDefaultOrganization organization get default organization API
 func (a *Client) OrganizationGetDefaultOrganization(params OrganizationGetDefaultOrganizationParams) (*OrganizationGetDefaultOrganizationOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.OrganizationGetDefaultOrganizationWithContext(ctx, params)
+}
+
+// OrganizationGetDefaultOrganizationWithContext is the same as
+// OrganizationGetDefaultOrganization with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) OrganizationGetDefaultOrganizationWithContext(ctx context.Context, params OrganizationGetDefaultOrganizationParams) (*OrganizationGetDefaultOrganizationOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestOrganizationGetDefaultOrganizationParams(params)
@@ -107,12 +151,12 @@ func (a *Client) OrganizationGetDefaultOrganization(params OrganizationGetDefaul
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationGetDefaultOrganization: %v", err)
+		return nil, fmt.Errorf("OrganizationGetDefaultOrganization: %w", err)
 	}
 
 	parsedResponse := &OrganizationGetDefaultOrganizationOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/organizations/default",
 		RawBody:        results.Body,
@@ -122,7 +166,7 @@ func (a *Client) OrganizationGetDefaultOrganization(params OrganizationGetDefaul
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("OrganizationGetDefaultOrganization: %v", err)
+		return nil, fmt.Errorf("OrganizationGetDefaultOrganization: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -0,0 +1,14 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package cps
+
+// requiredScopes grant access to certificates and their orders
+var requiredScopes = []string{"sec.cps.certificates"}
+
+// RequiredScopes returns the IDS scopes that the Certificate Provisioning
+// System service needs by default. A client granted a differently named scope
+// can set SDKConfig.ServiceScopes["cps"] instead.
+func RequiredScopes() []string {
+	return append([]string{}, requiredScopes...)
+}
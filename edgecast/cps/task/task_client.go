@@ -7,6 +7,7 @@ package task
 // Any changes made to this file may be overwritten.
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -27,15 +28,35 @@ type Client struct {
 type ClientService interface {
 	TaskDelete(params TaskDeleteParams) (*TaskDeleteNoContent, error)
 
+	TaskDeleteWithContext(ctx context.Context, params TaskDeleteParams) (*TaskDeleteNoContent, error)
+
 	TaskGet(params TaskGetParams) (*TaskGetOK, error)
 
+	TaskGetWithContext(ctx context.Context, params TaskGetParams) (*TaskGetOK, error)
+
 	TaskGetByStatus(params TaskGetByStatusParams) (*TaskGetByStatusOK, error)
 
+	TaskGetByStatusWithContext(ctx context.Context, params TaskGetByStatusParams) (*TaskGetByStatusOK, error)
+
 	TaskPost(params TaskPostParams) (*TaskPostCreated, error)
+
+	TaskPostWithContext(ctx context.Context, params TaskPostParams) (*TaskPostCreated, error)
 }
 
 // TaskDelete task delete API
 func (a *Client) TaskDelete(params TaskDeleteParams) (*TaskDeleteNoContent, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.TaskDeleteWithContext(ctx, params)
+}
+
+// TaskDeleteWithContext is the same as TaskDelete with the addition of a
+// context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) TaskDeleteWithContext(ctx context.Context, params TaskDeleteParams) (*TaskDeleteNoContent, error) {
 
 	// Set parameters
 	results, err := WriteToRequestTaskDeleteParams(params)
@@ -45,12 +66,12 @@ func (a *Client) TaskDelete(params TaskDeleteParams) (*TaskDeleteNoContent, erro
 
 	method, err := ecclient.ToHTTPMethod("DELETE")
 	if err != nil {
-		return nil, fmt.Errorf("TaskDelete: %v", err)
+		return nil, fmt.Errorf("TaskDelete: %w", err)
 	}
 
 	parsedResponse := &TaskDeleteNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/tasks/{id}",
 		RawBody:        results.Body,
@@ -60,7 +81,7 @@ func (a *Client) TaskDelete(params TaskDeleteParams) (*TaskDeleteNoContent, erro
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("TaskDelete: %v", err)
+		return nil, fmt.Errorf("TaskDelete: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -68,6 +89,18 @@ func (a *Client) TaskDelete(params TaskDeleteParams) (*TaskDeleteNoContent, erro
 
 // TaskGet task get API
 func (a *Client) TaskGet(params TaskGetParams) (*TaskGetOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.TaskGetWithContext(ctx, params)
+}
+
+// TaskGetWithContext is the same as TaskGet with the addition of a
+// context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) TaskGetWithContext(ctx context.Context, params TaskGetParams) (*TaskGetOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestTaskGetParams(params)
@@ -77,12 +110,12 @@ func (a *Client) TaskGet(params TaskGetParams) (*TaskGetOK, error) {
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("TaskGet: %v", err)
+		return nil, fmt.Errorf("TaskGet: %w", err)
 	}
 
 	parsedResponse := &TaskGetOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/tasks/{id}",
 		RawBody:        results.Body,
@@ -92,7 +125,7 @@ func (a *Client) TaskGet(params TaskGetParams) (*TaskGetOK, error) {
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("TaskGet: %v", err)
+		return nil, fmt.Errorf("TaskGet: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -100,6 +133,18 @@ func (a *Client) TaskGet(params TaskGetParams) (*TaskGetOK, error) {
 
 // TaskGetByStatus task get by status API
 func (a *Client) TaskGetByStatus(params TaskGetByStatusParams) (*TaskGetByStatusOK, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.TaskGetByStatusWithContext(ctx, params)
+}
+
+// TaskGetByStatusWithContext is the same as TaskGetByStatus with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+// The Context field of params is ignored.
+func (a *Client) TaskGetByStatusWithContext(ctx context.Context, params TaskGetByStatusParams) (*TaskGetByStatusOK, error) {
 
 	// Set parameters
 	results, err := WriteToRequestTaskGetByStatusParams(params)
@@ -109,12 +154,12 @@ func (a *Client) TaskGetByStatus(params TaskGetByStatusParams) (*TaskGetByStatus
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("TaskGetByStatus: %v", err)
+		return nil, fmt.Errorf("TaskGetByStatus: %w", err)
 	}
 
 	parsedResponse := &TaskGetByStatusOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/tasks",
 		RawBody:        results.Body,
@@ -124,7 +169,7 @@ func (a *Client) TaskGetByStatus(params TaskGetByStatusParams) (*TaskGetByStatus
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("TaskGetByStatus: %v", err)
+		return nil, fmt.Errorf("TaskGetByStatus: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -132,6 +177,18 @@ func (a *Client) TaskGetByStatus(params TaskGetByStatusParams) (*TaskGetByStatus
 
 // TaskPost task post API
 func (a *Client) TaskPost(params TaskPostParams) (*TaskPostCreated, error) {
+	ctx := context.Background()
+	if params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.TaskPostWithContext(ctx, params)
+}
+
+// TaskPostWithContext is the same as TaskPost with the addition of a
+// context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) TaskPostWithContext(ctx context.Context, params TaskPostParams) (*TaskPostCreated, error) {
 
 	// Set parameters
 	results, err := WriteToRequestTaskPostParams(params)
@@ -141,12 +198,12 @@ func (a *Client) TaskPost(params TaskPostParams) (*TaskPostCreated, error) {
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("TaskPost: %v", err)
+		return nil, fmt.Errorf("TaskPost: %w", err)
 	}
 
 	parsedResponse := &TaskPostCreated{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v2.0/tasks",
 		RawBody:        results.Body,
@@ -156,7 +213,7 @@ func (a *Client) TaskPost(params TaskPostParams) (*TaskPostCreated, error) {
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("TaskPost: %v", err)
+		return nil, fmt.Errorf("TaskPost: %w", err)
 	}
 
 	return parsedResponse, nil
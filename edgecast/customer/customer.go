@@ -4,6 +4,7 @@
 package customer
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,6 +15,15 @@ import (
 // token used for this request.
 func (svc *CustomerService) AddCustomer(
 	params AddCustomerParams,
+) (string, error) {
+	return svc.AddCustomerWithContext(context.Background(), params)
+}
+
+// AddCustomerWithContext is the same as AddCustomer with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) AddCustomerWithContext(
+	ctx context.Context,
+	params AddCustomerParams,
 ) (string, error) {
 	parsedResponse := &struct {
 		AccountNumber string `json:"AccountNumber"`
@@ -29,7 +39,7 @@ func (svc *CustomerService) AddCustomer(
 			"partneruserid": strconv.Itoa(params.Customer.PartnerUserID),
 		}
 	}
-	_, err := svc.client.SubmitRequest(submitRequestParams)
+	_, err := svc.client.SubmitRequestWithContext(ctx, submitRequestParams)
 	if err != nil {
 		return "", fmt.Errorf("AddCustomer: %w", err)
 	}
@@ -40,16 +50,27 @@ func (svc *CustomerService) AddCustomer(
 // Hex Account Number
 func (svc *CustomerService) GetCustomer(
 	params GetCustomerParams,
+) (*CustomerGetOK, error) {
+	return svc.GetCustomerWithContext(context.Background(), params)
+}
+
+// GetCustomerWithContext is the same as GetCustomer with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) GetCustomerWithContext(
+	ctx context.Context,
+	params GetCustomerParams,
 ) (*CustomerGetOK, error) {
 	parsedResponse := &CustomerGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/pcc/customers/{account_number}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/pcc/customers/{account_number}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetCustomer: %w", err)
 	}
@@ -58,17 +79,28 @@ func (svc *CustomerService) GetCustomer(
 
 // UpdateCustomer updates a Customer's information
 func (svc *CustomerService) UpdateCustomer(params UpdateCustomerParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "/v2/pcc/customers",
-		RawBody: params.Customer,
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	return svc.UpdateCustomerWithContext(context.Background(), params)
+}
+
+// UpdateCustomerWithContext is the same as UpdateCustomer with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) UpdateCustomerWithContext(
+	ctx context.Context,
+	params UpdateCustomerParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "/v2/pcc/customers",
+			RawBody: params.Customer,
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("UpdateCustomer: %w", err)
 	}
@@ -77,16 +109,27 @@ func (svc *CustomerService) UpdateCustomer(params UpdateCustomerParams) error {
 
 // DeleteCustomer deletes the provided Customer
 func (svc *CustomerService) DeleteCustomer(params DeleteCustomerParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/pcc/customers",
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	return svc.DeleteCustomerWithContext(context.Background(), params)
+}
+
+// DeleteCustomerWithContext is the same as DeleteCustomer with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) DeleteCustomerWithContext(
+	ctx context.Context,
+	params DeleteCustomerParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/pcc/customers",
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("DeleteCustomer: %w", err)
 	}
@@ -96,12 +139,23 @@ func (svc *CustomerService) DeleteCustomer(params DeleteCustomerParams) error {
 // GetAvailableCustomerServices retrieves all services available for a partner
 // to enable on the customers they manage
 func (svc *CustomerService) GetAvailableCustomerServices() (*[]Service, error) {
+	return svc.GetAvailableCustomerServicesWithContext(context.Background())
+}
+
+// GetAvailableCustomerServicesWithContext is the same as
+// GetAvailableCustomerServices with the addition of a context.Context that
+// governs cancellation and deadlines for the call.
+func (svc *CustomerService) GetAvailableCustomerServicesWithContext(
+	ctx context.Context,
+) (*[]Service, error) {
 	parsedResponse := &[]Service{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:         ecclient.Get,
-		Path:           "/v2/pcc/customers/services",
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:         ecclient.Get,
+			Path:           "/v2/pcc/customers/services",
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil,
 			fmt.Errorf("GetAvailableCustomerServices: %w", err)
@@ -113,16 +167,28 @@ func (svc *CustomerService) GetAvailableCustomerServices() (*[]Service, error) {
 // customer and whether each service is enabled or disabled.
 func (svc *CustomerService) GetCustomerServices(
 	params GetCustomerServicesParams,
+) (*[]Service, error) {
+	return svc.GetCustomerServicesWithContext(context.Background(), params)
+}
+
+// GetCustomerServicesWithContext is the same as GetCustomerServices with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *CustomerService) GetCustomerServicesWithContext(
+	ctx context.Context,
+	params GetCustomerServicesParams,
 ) (*[]Service, error) {
 	parsedResponse := &[]Service{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/pcc/customers/{account_number}/services",
-		PathParams: map[string]string{
-			"account_number": params.Customer.HexID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/pcc/customers/{account_number}/services",
+			PathParams: map[string]string{
+				"account_number": params.Customer.HexID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil,
 			fmt.Errorf("GetCustomerServices: %w", err)
@@ -134,6 +200,16 @@ func (svc *CustomerService) GetCustomerServices(
 // status provided.
 func (svc *CustomerService) UpdateCustomerServices(
 	params UpdateCustomerServicesParams,
+) error {
+	return svc.UpdateCustomerServicesWithContext(context.Background(), params)
+}
+
+// UpdateCustomerServicesWithContext is the same as UpdateCustomerServices with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (svc *CustomerService) UpdateCustomerServicesWithContext(
+	ctx context.Context,
+	params UpdateCustomerServicesParams,
 ) error {
 	for _, serviceID := range params.ServiceIDs {
 		body := &struct {
@@ -141,15 +217,17 @@ func (svc *CustomerService) UpdateCustomerServices(
 		}{
 			Status: params.Status,
 		}
-		resp, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-			Method:  ecclient.Put,
-			Path:    "/v2/pcc/customers/{account_number}/services/{id}",
-			RawBody: body,
-			PathParams: map[string]string{
-				"account_number": params.Customer.HexID,
-				"id":             strconv.Itoa(serviceID),
-			},
-		})
+		resp, err := svc.client.SubmitRequestWithContext(
+			ctx,
+			ecclient.SubmitRequestParams{
+				Method:  ecclient.Put,
+				Path:    "/v2/pcc/customers/{account_number}/services/{id}",
+				RawBody: body,
+				PathParams: map[string]string{
+					"account_number": params.Customer.HexID,
+					"id":             strconv.Itoa(serviceID),
+				},
+			})
 		if err == nil && resp.HTTPResponse.StatusCode != 200 {
 			return fmt.Errorf(
 				"failed to set customer services, please contact an administrator",
@@ -157,7 +235,7 @@ func (svc *CustomerService) UpdateCustomerServices(
 		}
 		if err != nil {
 			return fmt.Errorf(
-				"UpdateCustomerServices send request failed. Error: %v\n RawBody: %v",
+				"UpdateCustomerServices send request failed. Error: %w\n RawBody: %v",
 				err, body)
 		}
 	}
@@ -168,16 +246,28 @@ func (svc *CustomerService) UpdateCustomerServices(
 // the provided customer
 func (svc *CustomerService) GetCustomerDeliveryRegion(
 	params GetCustomerDeliveryRegionParams,
+) (*DeliveryRegion, error) {
+	return svc.GetCustomerDeliveryRegionWithContext(context.Background(), params)
+}
+
+// GetCustomerDeliveryRegionWithContext is the same as GetCustomerDeliveryRegion
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *CustomerService) GetCustomerDeliveryRegionWithContext(
+	ctx context.Context,
+	params GetCustomerDeliveryRegionParams,
 ) (*DeliveryRegion, error) {
 	parsedResponse := &DeliveryRegion{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/pcc/customers/{account_number}/deliveryregions",
-		PathParams: map[string]string{
-			"account_number": params.Customer.HexID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/pcc/customers/{account_number}/deliveryregions",
+			PathParams: map[string]string{
+				"account_number": params.Customer.HexID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil,
 			fmt.Errorf("GetCustomerDeliveryRegion: %w", err)
@@ -189,26 +279,38 @@ func (svc *CustomerService) GetCustomerDeliveryRegion(
 // customer
 func (svc *CustomerService) UpdateCustomerDeliveryRegion(
 	params UpdateCustomerDeliveryRegionParams,
+) error {
+	return svc.UpdateCustomerDeliveryRegionWithContext(context.Background(), params)
+}
+
+// UpdateCustomerDeliveryRegionWithContext is the same as
+// UpdateCustomerDeliveryRegion with the addition of a context.Context that
+// governs cancellation and deadlines for the call.
+func (svc *CustomerService) UpdateCustomerDeliveryRegionWithContext(
+	ctx context.Context,
+	params UpdateCustomerDeliveryRegionParams,
 ) error {
 	body := &struct {
 		ID int `json:"Id"`
 	}{
 		ID: params.DeliveryRegionID,
 	}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "/v2/pcc/customers/deliveryregions",
-		RawBody: body,
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "/v2/pcc/customers/deliveryregions",
+			RawBody: body,
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf(
-			"UpdateCustomerDeliveryRegion: %v",
+			"UpdateCustomerDeliveryRegion: %w",
 			err)
 	}
 	return nil
@@ -216,12 +318,23 @@ func (svc *CustomerService) UpdateCustomerDeliveryRegion(
 
 // GetCustomerDomainTypes retrieves all available domain types
 func (svc *CustomerService) GetCustomerDomainTypes() (*[]DomainType, error) {
+	return svc.GetCustomerDomainTypesWithContext(context.Background())
+}
+
+// GetCustomerDomainTypesWithContext is the same as GetCustomerDomainTypes with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (svc *CustomerService) GetCustomerDomainTypesWithContext(
+	ctx context.Context,
+) (*[]DomainType, error) {
 	parsedResponse := &[]DomainType{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:         ecclient.Get,
-		Path:           "/v2/pcc/customers/domaintypes",
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:         ecclient.Get,
+			Path:           "/v2/pcc/customers/domaintypes",
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil,
 			fmt.Errorf("GetCustomerDomainTypes: %w", err)
@@ -234,27 +347,39 @@ func (svc *CustomerService) GetCustomerDomainTypes() (*[]DomainType, error) {
 func (svc *CustomerService) UpdateCustomerDomainURL(
 	params UpdateCustomerDomainURLParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "/v2/pcc/customers/domains/{domain_type}/url",
-		RawBody: &struct {
-			URL string `json:"Url"`
-		}{
-			URL: params.Url,
-		},
-		PathParams: map[string]string{
-			"domain_type": strconv.Itoa(params.DomainType),
-		},
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	return svc.UpdateCustomerDomainURLWithContext(context.Background(), params)
+}
+
+// UpdateCustomerDomainURLWithContext is the same as UpdateCustomerDomainURL
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *CustomerService) UpdateCustomerDomainURLWithContext(
+	ctx context.Context,
+	params UpdateCustomerDomainURLParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "/v2/pcc/customers/domains/{domain_type}/url",
+			RawBody: &struct {
+				URL string `json:"Url"`
+			}{
+				URL: params.Url,
+			},
+			PathParams: map[string]string{
+				"domain_type": strconv.Itoa(params.DomainType),
+			},
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf(
-			"UpdateCustomerDomainURL: %v",
+			"UpdateCustomerDomainURL: %w",
 			err)
 	}
 	return nil
@@ -264,16 +389,28 @@ func (svc *CustomerService) UpdateCustomerDomainURL(
 // that may be enabled or disabled for the provided customer
 func (svc *CustomerService) GetCustomerAccessModules(
 	params GetCustomerAccessModulesParams,
+) (*[]AccessModule, error) {
+	return svc.GetCustomerAccessModulesWithContext(context.Background(), params)
+}
+
+// GetCustomerAccessModulesWithContext is the same as GetCustomerAccessModules
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *CustomerService) GetCustomerAccessModulesWithContext(
+	ctx context.Context,
+	params GetCustomerAccessModulesParams,
 ) (*[]AccessModule, error) {
 	parsedResponse := &[]AccessModule{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/pcc/customers/{account_number}/accessmodules",
-		PathParams: map[string]string{
-			"account_number": params.Customer.HexID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/pcc/customers/{account_number}/accessmodules",
+			PathParams: map[string]string{
+				"account_number": params.Customer.HexID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil,
 			fmt.Errorf("GetCustomerAccessModules: %w", err)
@@ -285,30 +422,42 @@ func (svc *CustomerService) GetCustomerAccessModules(
 // access module (feature) for the provided customer
 func (svc *CustomerService) UpdateCustomerAccessModule(
 	params UpdateCustomerAccessModuleParams,
+) error {
+	return svc.UpdateCustomerAccessModuleWithContext(context.Background(), params)
+}
+
+// UpdateCustomerAccessModuleWithContext is the same as
+// UpdateCustomerAccessModule with the addition of a context.Context that
+// governs cancellation and deadlines for the call.
+func (svc *CustomerService) UpdateCustomerAccessModuleWithContext(
+	ctx context.Context,
+	params UpdateCustomerAccessModuleParams,
 ) error {
 	// TODO: support custom ids for accounts
 	for _, accessModuleID := range params.AccessModuleIDs {
-		_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-			Method: ecclient.Put,
-			Path:   "/v2/pcc/customers/accessmodules/{access_module_id}/status",
-			RawBody: &struct {
-				Status int8 `json:"Status"`
-			}{
-				Status: int8(params.Status),
-			},
-			PathParams: map[string]string{
-				"access_module_id": strconv.Itoa(accessModuleID),
-			},
-			QueryParams: map[string]string{
-				// TODO: support custom ids for accounts
-				"idtype":    "an",
-				"id":        params.Customer.HexID,
-				"partnerid": strconv.Itoa(params.Customer.PartnerID),
-			},
-		})
+		_, err := svc.client.SubmitRequestWithContext(
+			ctx,
+			ecclient.SubmitRequestParams{
+				Method: ecclient.Put,
+				Path:   "/v2/pcc/customers/accessmodules/{access_module_id}/status",
+				RawBody: &struct {
+					Status int8 `json:"Status"`
+				}{
+					Status: int8(params.Status),
+				},
+				PathParams: map[string]string{
+					"access_module_id": strconv.Itoa(accessModuleID),
+				},
+				QueryParams: map[string]string{
+					// TODO: support custom ids for accounts
+					"idtype":    "an",
+					"id":        params.Customer.HexID,
+					"partnerid": strconv.Itoa(params.Customer.PartnerID),
+				},
+			})
 		if err != nil {
 			return fmt.Errorf(
-				"UpdateCustomerAccessModule: %v",
+				"UpdateCustomerAccessModule: %w",
 				err)
 		}
 	}
@@ -4,6 +4,7 @@
 package customer
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -13,6 +14,15 @@ import (
 // AddCustomerUser creates a Customer User under the provided (parent) Customer
 func (svc *CustomerService) AddCustomerUser(
 	params AddCustomerUserParams,
+) (int, error) {
+	return svc.AddCustomerUserWithContext(context.Background(), params)
+}
+
+// AddCustomerUserWithContext is the same as AddCustomerUser with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) AddCustomerUserWithContext(
+	ctx context.Context,
+	params AddCustomerUserParams,
 ) (int, error) {
 	if params.Customer.PartnerID == 0 {
 		return 0, fmt.Errorf("PartnerID was not provided")
@@ -20,18 +30,20 @@ func (svc *CustomerService) AddCustomerUser(
 	parsedResponse := &struct {
 		CustomerUserID int `json:"CustomerUserId"`
 	}{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:         ecclient.Post,
-		Path:           "/v2/pcc/customers/users",
-		RawBody:        params.CustomerUser,
-		ParsedResponse: parsedResponse,
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:         ecclient.Post,
+			Path:           "/v2/pcc/customers/users",
+			RawBody:        params.CustomerUser,
+			ParsedResponse: parsedResponse,
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return 0, fmt.Errorf("AddCustomerUser: %w", err)
 	}
@@ -41,22 +53,33 @@ func (svc *CustomerService) AddCustomerUser(
 // GetCustomerUser retrieves a Customer User
 func (svc *CustomerService) GetCustomerUser(
 	params GetCustomerUserParams,
+) (*CustomerUserGetOK, error) {
+	return svc.GetCustomerUserWithContext(context.Background(), params)
+}
+
+// GetCustomerUserWithContext is the same as GetCustomerUser with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (svc *CustomerService) GetCustomerUserWithContext(
+	ctx context.Context,
+	params GetCustomerUserParams,
 ) (*CustomerUserGetOK, error) {
 	parsedResponse := &CustomerUserGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:         ecclient.Get,
-		Path:           "/v2/pcc/customers/users/{customer_user_id}",
-		ParsedResponse: parsedResponse,
-		PathParams: map[string]string{
-			"customer_user_id": strconv.Itoa(params.CustomerUserID),
-		},
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:         ecclient.Get,
+			Path:           "/v2/pcc/customers/users/{customer_user_id}",
+			ParsedResponse: parsedResponse,
+			PathParams: map[string]string{
+				"customer_user_id": strconv.Itoa(params.CustomerUserID),
+			},
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetCustomerUser: %w", err)
 	}
@@ -67,20 +90,32 @@ func (svc *CustomerService) GetCustomerUser(
 func (svc *CustomerService) UpdateCustomerUser(
 	params UpdateCustomerUserParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "/v2/pcc/customers/users/{customer_user_id}",
-		PathParams: map[string]string{
-			"customer_user_id": strconv.Itoa(params.CustomerUser.ID),
-		},
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-		RawBody: params.CustomerUser,
-	})
+	return svc.UpdateCustomerUserWithContext(context.Background(), params)
+}
+
+// UpdateCustomerUserWithContext is the same as UpdateCustomerUser with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *CustomerService) UpdateCustomerUserWithContext(
+	ctx context.Context,
+	params UpdateCustomerUserParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "/v2/pcc/customers/users/{customer_user_id}",
+			PathParams: map[string]string{
+				"customer_user_id": strconv.Itoa(params.CustomerUser.ID),
+			},
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+			RawBody: params.CustomerUser,
+		})
 	if err != nil {
 		return fmt.Errorf("UpdateCustomerUser: %w", err)
 	}
@@ -91,19 +126,31 @@ func (svc *CustomerService) UpdateCustomerUser(
 func (svc *CustomerService) DeleteCustomerUser(
 	params DeleteCustomerUserParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/pcc/customers/users/{customer_user_id}",
-		PathParams: map[string]string{
-			"customer_user_id": strconv.Itoa(params.CustomerUser.ID),
-		},
-		QueryParams: map[string]string{
-			// TODO: support custom ids for accounts
-			"idtype":    "an",
-			"id":        params.Customer.HexID,
-			"partnerid": strconv.Itoa(params.Customer.PartnerID),
-		},
-	})
+	return svc.DeleteCustomerUserWithContext(context.Background(), params)
+}
+
+// DeleteCustomerUserWithContext is the same as DeleteCustomerUser with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *CustomerService) DeleteCustomerUserWithContext(
+	ctx context.Context,
+	params DeleteCustomerUserParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/pcc/customers/users/{customer_user_id}",
+			PathParams: map[string]string{
+				"customer_user_id": strconv.Itoa(params.CustomerUser.ID),
+			},
+			QueryParams: map[string]string{
+				// TODO: support custom ids for accounts
+				"idtype":    "an",
+				"id":        params.Customer.HexID,
+				"partnerid": strconv.Itoa(params.Customer.PartnerID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("DeleteCustomerUser: %w", err)
 	}
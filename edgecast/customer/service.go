@@ -4,11 +4,11 @@
 package customer
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
@@ -18,19 +18,181 @@ type CustomerService struct {
 	logger eclog.Logger
 }
 
+// ClientService is the interface for CustomerService methods
+type ClientService interface {
+	AddCustomer(
+		params AddCustomerParams,
+	) (string, error)
+
+	AddCustomerWithContext(
+		ctx context.Context,
+		params AddCustomerParams,
+	) (string, error)
+
+	GetCustomer(
+		params GetCustomerParams,
+	) (*CustomerGetOK, error)
+
+	GetCustomerWithContext(
+		ctx context.Context,
+		params GetCustomerParams,
+	) (*CustomerGetOK, error)
+
+	UpdateCustomer(params UpdateCustomerParams) error
+
+	UpdateCustomerWithContext(
+		ctx context.Context,
+		params UpdateCustomerParams,
+	) error
+
+	DeleteCustomer(params DeleteCustomerParams) error
+
+	DeleteCustomerWithContext(
+		ctx context.Context,
+		params DeleteCustomerParams,
+	) error
+
+	GetAvailableCustomerServices() (*[]Service, error)
+
+	GetAvailableCustomerServicesWithContext(
+		ctx context.Context,
+	) (*[]Service, error)
+
+	GetCustomerServices(
+		params GetCustomerServicesParams,
+	) (*[]Service, error)
+
+	GetCustomerServicesWithContext(
+		ctx context.Context,
+		params GetCustomerServicesParams,
+	) (*[]Service, error)
+
+	UpdateCustomerServices(
+		params UpdateCustomerServicesParams,
+	) error
+
+	UpdateCustomerServicesWithContext(
+		ctx context.Context,
+		params UpdateCustomerServicesParams,
+	) error
+
+	GetCustomerDeliveryRegion(
+		params GetCustomerDeliveryRegionParams,
+	) (*DeliveryRegion, error)
+
+	GetCustomerDeliveryRegionWithContext(
+		ctx context.Context,
+		params GetCustomerDeliveryRegionParams,
+	) (*DeliveryRegion, error)
+
+	UpdateCustomerDeliveryRegion(
+		params UpdateCustomerDeliveryRegionParams,
+	) error
+
+	UpdateCustomerDeliveryRegionWithContext(
+		ctx context.Context,
+		params UpdateCustomerDeliveryRegionParams,
+	) error
+
+	GetCustomerDomainTypes() (*[]DomainType, error)
+
+	GetCustomerDomainTypesWithContext(
+		ctx context.Context,
+	) (*[]DomainType, error)
+
+	UpdateCustomerDomainURL(
+		params UpdateCustomerDomainURLParams,
+	) error
+
+	UpdateCustomerDomainURLWithContext(
+		ctx context.Context,
+		params UpdateCustomerDomainURLParams,
+	) error
+
+	GetCustomerAccessModules(
+		params GetCustomerAccessModulesParams,
+	) (*[]AccessModule, error)
+
+	GetCustomerAccessModulesWithContext(
+		ctx context.Context,
+		params GetCustomerAccessModulesParams,
+	) (*[]AccessModule, error)
+
+	UpdateCustomerAccessModule(
+		params UpdateCustomerAccessModuleParams,
+	) error
+
+	UpdateCustomerAccessModuleWithContext(
+		ctx context.Context,
+		params UpdateCustomerAccessModuleParams,
+	) error
+
+	AddCustomerUser(
+		params AddCustomerUserParams,
+	) (int, error)
+
+	AddCustomerUserWithContext(
+		ctx context.Context,
+		params AddCustomerUserParams,
+	) (int, error)
+
+	GetCustomerUser(
+		params GetCustomerUserParams,
+	) (*CustomerUserGetOK, error)
+
+	GetCustomerUserWithContext(
+		ctx context.Context,
+		params GetCustomerUserParams,
+	) (*CustomerUserGetOK, error)
+
+	UpdateCustomerUser(
+		params UpdateCustomerUserParams,
+	) error
+
+	UpdateCustomerUserWithContext(
+		ctx context.Context,
+		params UpdateCustomerUserParams,
+	) error
+
+	DeleteCustomerUser(
+		params DeleteCustomerUserParams,
+	) error
+
+	DeleteCustomerUserWithContext(
+		ctx context.Context,
+		params DeleteCustomerUserParams,
+	) error
+}
+
+var _ ClientService = (*CustomerService)(nil)
+
 // New creates a new Customer service
 func New(config edgecast.SDKConfig) (*CustomerService, error) {
-	authProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("customer.New(): %w", err)
+	}
 
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "customer", Token: true})
 	if err != nil {
-		return nil, fmt.Errorf("customer.New(): %v", err)
+		return nil, fmt.Errorf("customer.New(): %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURLLegacy,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURLLegacy,
+		ServiceName:     "customer",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &CustomerService{
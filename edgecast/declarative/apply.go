@@ -0,0 +1,172 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Apply, which makes the changes of a Plan
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// StepStatus is the outcome of a step of a plan
+type StepStatus string
+
+// Outcomes of a step
+const (
+	// StepApplied means that the change was made
+	StepApplied StepStatus = "applied"
+
+	// StepFailed means that the API returned an error
+	StepFailed StepStatus = "failed"
+
+	// StepSkipped means that the step was not attempted because a resource
+	// it depends on failed
+	StepSkipped StepStatus = "skipped"
+)
+
+// StepResult is the outcome of a step of a plan
+type StepResult struct {
+	Step   Step       `json:"step"`
+	Status StepStatus `json:"status"`
+
+	// ID is the ID of the resource after the step. It is empty for a delete.
+	ID string `json:"id,omitempty"`
+
+	// Err is the error returned by the API, or why the step was skipped
+	Err error `json:"-"`
+}
+
+// ApplyResult is the outcome of applying a plan
+type ApplyResult struct {
+	// Results holds the result of every step, in the order of the plan
+	Results []StepResult
+
+	// State records the resources managed after the changes. It should be
+	// saved and passed to the next Plan, even when some steps failed.
+	State *State
+}
+
+// errDependencyFailed is the error of a skipped step
+var errDependencyFailed = errors.New("a resource it depends on failed")
+
+// Apply makes the changes of a plan, in order. References to other
+// resources are resolved with the IDs of resources created by earlier
+// steps. A step that fails does not stop Apply, but the steps that depend on
+// it are skipped. The returned error, if any, wraps the first failure; the
+// result is returned in all cases.
+func (e *Engine) Apply(
+	ctx context.Context,
+	plan *Plan,
+) (*ApplyResult, error) {
+	ids := make(map[string]string, len(plan.ids))
+	for addr, id := range plan.ids {
+		ids[addr] = id
+	}
+	knownID := func(addr string) (interface{}, bool) {
+		id, ok := ids[addr]
+		if !ok {
+			return nil, false
+		}
+		return typedID(addr, id), true
+	}
+
+	result := &ApplyResult{}
+	failed := map[string]bool{}
+	var kept []StateResource
+	var firstErr error
+	var failures int
+
+	for _, step := range plan.Steps {
+		res := StepResult{Step: step, ID: step.ID}
+		for _, dep := range step.DependsOn {
+			if failed[dep] {
+				res.Status = StepSkipped
+				res.Err = fmt.Errorf("%s: %w", dep, errDependencyFailed)
+				break
+			}
+		}
+
+		if res.Status != StepSkipped {
+			id, err := e.applyStep(ctx, step, knownID)
+			if err != nil {
+				res.Status = StepFailed
+				res.Err = err
+				failures++
+				if firstErr == nil {
+					firstErr = fmt.Errorf(
+						"%s %s: %w",
+						step.Action,
+						step.Address(),
+						err)
+				}
+			} else {
+				res.Status = StepApplied
+				res.ID = id
+				if step.Action != ActionDelete {
+					ids[step.Address()] = id
+				}
+			}
+		}
+
+		if res.Status != StepApplied {
+			failed[step.Address()] = true
+			if step.Action == ActionDelete {
+				// The resource still exists, so the next plan deletes it
+				kept = append(kept, StateResource{
+					Kind: step.Kind,
+					Name: step.Name,
+					ID:   step.ID,
+				})
+			}
+		}
+		result.Results = append(result.Results, res)
+	}
+
+	result.State = &State{
+		Version:   StateVersion,
+		Resources: []StateResource{},
+	}
+	for _, r := range plan.resources {
+		if id, ok := ids[r.Address()]; ok {
+			result.State.Resources = append(
+				result.State.Resources,
+				StateResource{Kind: r.Kind, Name: r.Name, ID: id})
+		}
+	}
+	result.State.Resources = append(result.State.Resources, kept...)
+
+	if firstErr != nil {
+		return result, fmt.Errorf(
+			"Apply: %d of %d steps failed, first: %w",
+			failures,
+			len(plan.Steps),
+			firstErr)
+	}
+	return result, nil
+}
+
+// applyStep makes the change of a step and returns the ID of the resource
+func (e *Engine) applyStep(
+	ctx context.Context,
+	step Step,
+	knownID func(addr string) (interface{}, bool),
+) (string, error) {
+	h := handlers[step.Kind]
+	if step.Action == ActionDelete {
+		return "", h.delete(ctx, e, step.ID, step.live)
+	}
+
+	spec, complete := resolve(step.spec, knownID)
+	if !complete {
+		return "", errors.New("unresolved references in spec")
+	}
+	if step.Action == ActionCreate {
+		return h.create(ctx, e, spec)
+	}
+	return h.update(ctx, e, step.ID, spec)
+}
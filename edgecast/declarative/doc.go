@@ -0,0 +1,73 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Package declarative manages resources of an account from a manifest that
+describes their desired state, in the manner of infrastructure-as-code tools.
+
+A manifest is a versioned YAML or JSON file listing resources. Each has a
+kind, a name that is unique within its kind, and a spec, which is the request
+body of the SDK's model for the kind using its JSON field names. A spec may
+refer to the ID of another resource as ${<kind>.<name>.id}:
+
+	version: v1
+	resources:
+	  - kind: waf-access-rule
+	    name: block-countries
+	    spec:
+	      name: Block countries
+	      country:
+	        blacklist: [XX]
+	  - kind: waf-scopes
+	    name: scopes
+	    spec:
+	      scopes:
+	        - name: default
+	          host: {type: GLOB, value: "*"}
+	          path: {type: GLOB, value: "*"}
+	          acl_prod_id: ${waf-access-rule.block-countries.id}
+	          acl_prod_action: {name: block, enf_type: BLOCK_REQUEST}
+
+The supported kinds are listed by Kinds. They cover WAF rules, bot managers
+and scopes, Route DNS zones, groups, TSIG keys, master server groups and
+secondary zone groups, customer origins, edge CNAMEs, HTTP Large Origin V3
+groups and origins, Real-Time Log Delivery profiles and Rules Engine policies.
+
+Engine.Plan compares a manifest with the account and returns a Plan: the
+resources to create, update and delete, ordered so that resources are created
+after those they refer to. Engine.Apply makes the changes and returns a State
+recording the IDs of the managed resources, which is saved and passed to the
+next Plan. Engine.Drift reports how the account differs from a manifest
+without changing it:
+
+	client, err := ecsdk.NewClient(config)
+	engine, err := declarative.New(declarative.Config{
+		Client:        client,
+		AccountNumber: "ABCD",
+	})
+
+	manifest, err := declarative.LoadManifest("edgecast.yaml")
+	state, err := declarative.LoadState("edgecast.state.json")
+
+	plan, err := engine.Plan(ctx, manifest, state)
+	fmt.Print(plan)
+
+	result, err := engine.Apply(ctx, plan)
+	if saveErr := result.State.Save("edgecast.state.json"); saveErr != nil {
+		// ...
+	}
+
+Only the fields of a spec are compared with the live resource, so fields set
+by the API, such as IDs and modification dates, do not show as changes.
+Secrets that the API never returns, such as the passwords and tokens of log
+delivery destinations, are not compared either, so a changed secret is only
+sent when another field of the resource changes.
+
+Engine.Export does the reverse, writing the live resources of an account as a
+manifest in which the IDs of the resources they refer to are replaced with
+references. Applying it to another account recreates the resources there.
+Secrets the API does not return, such as the passwords, tokens, API keys and
+access keys of log delivery destinations, are left out of the manifest and
+must be added to it before it is applied.
+*/
+package declarative
@@ -0,0 +1,88 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Drift, which reports how an account differs from a
+	manifest
+*/
+
+import (
+	"context"
+	"fmt"
+)
+
+// DriftStatus is how a resource differs from a manifest
+type DriftStatus string
+
+// Statuses of a Drift
+const (
+	// DriftMissing means that the resource is in the manifest but does not
+	// exist
+	DriftMissing DriftStatus = "missing"
+
+	// DriftModified means that fields of the resource differ from the
+	// manifest
+	DriftModified DriftStatus = "modified"
+
+	// DriftUnmanaged means that the resource exists but is not in the
+	// manifest
+	DriftUnmanaged DriftStatus = "unmanaged"
+)
+
+// Drift is a resource that differs from a manifest
+type Drift struct {
+	Kind Kind `json:"kind"`
+
+	// Name is the name of the resource in the manifest or state. It is
+	// empty for resources that were never managed.
+	Name string `json:"name,omitempty"`
+
+	// ID is the ID of the live resource. It is empty for missing resources.
+	ID string `json:"id,omitempty"`
+
+	Status DriftStatus `json:"status"`
+
+	// Changes lists the fields that differ, for modified resources
+	Changes []Change `json:"changes,omitempty"`
+}
+
+// Drift compares a manifest with the live state of the account and returns
+// the resources that differ from it, without changing anything. It returns
+// nothing if the account matches the manifest. Unmanaged resources are
+// those of the kinds in the manifest or state that no resource of the
+// manifest manages, including resources that were removed from it.
+func (e *Engine) Drift(
+	ctx context.Context,
+	m *Manifest,
+	state *State,
+) ([]Drift, error) {
+	plan, err := e.Plan(ctx, m, state)
+	if err != nil {
+		return nil, fmt.Errorf("Drift: %w", err)
+	}
+
+	var drifts []Drift
+	for _, s := range plan.Steps {
+		d := Drift{Kind: s.Kind, Name: s.Name, ID: s.ID}
+		switch s.Action {
+		case ActionCreate:
+			d.Status = DriftMissing
+		case ActionUpdate:
+			d.Status = DriftModified
+			d.Changes = s.Changes
+		case ActionDelete:
+			d.Status = DriftUnmanaged
+		}
+		drifts = append(drifts, d)
+	}
+	for _, r := range plan.Unmanaged {
+		drifts = append(drifts, Drift{
+			Kind:   r.Kind,
+			ID:     r.ID,
+			Status: DriftUnmanaged,
+		})
+	}
+	return drifts, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handler of edge CNAMEs
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/edgecname"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+)
+
+// edgeCnamePlatforms are the platforms whose edge CNAMEs and customer origins
+// are listed
+var edgeCnamePlatforms = []enums.Platform{
+	enums.HttpLarge,
+	enums.HttpSmall,
+	enums.ADN,
+}
+
+// edgeCnameService returns the Edge CNAME service
+func (e *Engine) edgeCnameService() (*edgecname.EdgeCnameService, error) {
+	return e.client.EdgeCname()
+}
+
+// edgeCnameHandler manages edge CNAMEs, which are identified by name. The
+// platform of an edge CNAME is given by the MediaTypeId field of its spec.
+var edgeCnameHandler = kindHandler[edgecname.EdgeCname]{
+	kindInfo: kindInfo{
+		kind:      KindEdgeCname,
+		keyField:  "Name",
+		numericID: true,
+		legacy:    true,
+		refs: []refField{
+			{path: "OriginId", kind: KindOrigin},
+		},
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.edgeCnameService()
+		if err != nil {
+			return nil, err
+		}
+		var items []listed
+		for _, platform := range edgeCnamePlatforms {
+			resp, err := svc.GetAllEdgeCnamesWithContext(
+				ctx,
+				edgecname.GetAllEdgeCnameParams{
+					AccountNumber: e.accountNumber,
+					Platform:      platform,
+				})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range *resp {
+				items = append(
+					items,
+					listed{id: strconv.Itoa(item.ID), key: item.Name})
+			}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec edgecname.EdgeCname,
+	) (interface{}, error) {
+		cnameID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.edgeCnameService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetEdgeCnameWithContext(
+			ctx,
+			edgecname.GetEdgeCnameParams{
+				AccountNumber: e.accountNumber,
+				EdgeCnameID:   cnameID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec edgecname.EdgeCname,
+	) (string, error) {
+		svc, err := e.edgeCnameService()
+		if err != nil {
+			return "", err
+		}
+		id, err := svc.AddEdgeCnameWithContext(
+			ctx,
+			edgecname.AddEdgeCnameParams{
+				AccountNumber: e.accountNumber,
+				EdgeCname:     spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(*id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec edgecname.EdgeCname,
+	) (string, error) {
+		cnameID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.edgeCnameService()
+		if err != nil {
+			return "", err
+		}
+		_, err = svc.UpdateEdgeCnameWithContext(
+			ctx,
+			edgecname.UpdateEdgeCnameParams{
+				AccountNumber: e.accountNumber,
+				EdgeCname: edgecname.EdgeCnameGetOK{
+					EdgeCname: spec,
+					ID:        cnameID,
+				},
+			})
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		cnameID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.edgeCnameService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteEdgeCnameWithContext(
+			ctx,
+			edgecname.DeleteEdgeCnameParams{
+				AccountNumber: e.accountNumber,
+				EdgeCname:     edgecname.EdgeCnameGetOK{ID: cnameID},
+			})
+	},
+}
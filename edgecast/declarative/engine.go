@@ -0,0 +1,62 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Engine, which plans and applies manifests
+*/
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ecsdk"
+)
+
+// Config holds the configuration of an Engine
+type Config struct {
+	// Client provides the services used to read and change resources
+	Client *ecsdk.Client
+
+	// AccountNumber is the customer account number. It is required to manage
+	// the kinds served by the legacy API: WAF rules, bot managers and scopes,
+	// Route DNS resources, customer origins and edge CNAMEs. Rules Engine
+	// policies also require it.
+	AccountNumber string
+}
+
+// Engine compares manifests with the live state of an account, and applies
+// the changes needed to make the account match them
+type Engine struct {
+	client        *ecsdk.Client
+	accountNumber string
+}
+
+// New creates an Engine
+func New(config Config) (*Engine, error) {
+	if config.Client == nil {
+		return nil, errors.New("declarative.New: Client is required")
+	}
+
+	return &Engine{
+		client:        config.Client,
+		accountNumber: config.AccountNumber,
+	}, nil
+}
+
+// AccountNumber returns the customer account number of the engine
+func (e *Engine) AccountNumber() string {
+	return e.accountNumber
+}
+
+// checkAccountNumber returns an error if a resource of the given kind
+// cannot be managed because no account number was configured
+func (e *Engine) checkAccountNumber(kind Kind) error {
+	if handlers[kind].info().legacy && len(e.accountNumber) == 0 {
+		return fmt.Errorf(
+			"an account number is required to manage %s resources",
+			kind)
+	}
+	return nil
+}
@@ -0,0 +1,452 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ecsdk"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+)
+
+const accountNumber = "ABCD"
+
+const testManifest = `
+version: v1
+resources:
+  - kind: waf-scopes
+    name: scopes
+    spec:
+      scopes:
+        - name: default
+          host: {type: GLOB, value: "*"}
+          path: {type: GLOB, value: "*"}
+          acl_prod_id: ${waf-access-rule.block.id}
+          acl_prod_action: {name: block, enf_type: BLOCK_REQUEST}
+  - kind: waf-access-rule
+    name: block
+    spec:
+      name: Block countries
+      country:
+        blacklist: [XX]
+  - kind: edge-cname
+    name: cdn
+    spec:
+      Name: cdn.example.com
+      DirPath: /80ABCD/${originv3-group.group.id}
+      MediaTypeId: 3
+  - kind: originv3-group
+    name: group
+    spec:
+      name: group
+      host_header: example.com
+  - kind: originv3-origin
+    name: origin
+    spec:
+      name: origin
+      host: https://origin.example.com
+      is_primary: true
+      group_id: ${originv3-group.group.id}
+  - kind: dns-zone
+    name: zone
+    spec:
+      DomainName: example.com.
+      Status: 1
+      ZoneType: 1
+  - kind: dns-group
+    name: lb
+    spec:
+      Name: lb
+      GroupTypeId: 3
+      GroupProductTypeId: 1
+  - kind: rtld-cdn-profile
+    name: logs
+    spec:
+      profile_name: logs
+      platforms: [http_large]
+`
+
+func newTestEngine(t *testing.T, server *ectest.Server) *Engine {
+	client, err := ecsdk.NewClient(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("ecsdk.NewClient: %v", err)
+	}
+	engine, err := New(Config{Client: client, AccountNumber: accountNumber})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return engine
+}
+
+func parseTestManifest(t *testing.T, data string) *Manifest {
+	m, err := ParseManifest([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseManifest: %v", err)
+	}
+	return m
+}
+
+func stepAddresses(steps []Step) []string {
+	var addrs []string
+	for _, s := range steps {
+		addrs = append(addrs, string(s.Action)+" "+s.Address())
+	}
+	return addrs
+}
+
+func TestPlanAndApply(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	m := parseTestManifest(t, testManifest)
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	expected := []string{
+		"create originv3-group.group",
+		"create originv3-origin.origin",
+		"create edge-cname.cdn",
+		"create dns-zone.zone",
+		"create dns-group.lb",
+		"create waf-access-rule.block",
+		"update waf-scopes.scopes",
+		"create rtld-cdn-profile.logs",
+	}
+	if got := stepAddresses(plan.Steps); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Plan: Expected %+v but got %+v", expected, got)
+	}
+
+	result, err := engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	for _, r := range result.Results {
+		if r.Status != StepApplied || len(r.ID) == 0 {
+			t.Fatalf("Apply: Expected %s to be applied but got %+v",
+				r.Step.Address(),
+				r)
+		}
+	}
+	if len(result.State.Resources) != len(m.Resources) {
+		t.Fatalf(
+			"Apply: Expected %d resources in state but got %+v",
+			len(m.Resources),
+			result.State.Resources)
+	}
+
+	// References are resolved with the IDs of the created resources
+	ids := result.State.ids()
+	cnames := server.Items(ectest.EdgeCnames)
+	if len(cnames) != 1 ||
+		cnames[0]["DirPath"] != "/80ABCD/"+ids["originv3-group.group"] {
+		t.Fatalf("Expected the edge CNAME of the group but got %+v", cnames)
+	}
+	scopes := server.Items(ectest.WAFScopes)
+	if len(scopes) != 1 ||
+		!strings.Contains(
+			formatValue(scopes[0]),
+			`"acl_prod_id":"`+ids["waf-access-rule.block"]+`"`) {
+		t.Fatalf("Expected scopes using the access rule but got %+v", scopes)
+	}
+
+	// Applying again changes nothing
+	plan, err = engine.Plan(ctx, m, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if plan.HasChanges() || len(plan.InSync) != len(m.Resources) {
+		t.Fatalf("Plan: Expected no changes but got %s", plan)
+	}
+
+	// Resources with a key field are found without the state
+	plan, err = engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	expected = []string{"create dns-zone.zone", "create dns-group.lb"}
+	if got := stepAddresses(plan.Steps); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Plan: Expected %+v but got %+v", expected, got)
+	}
+
+	// Removing resources from the manifest deletes them, dependents first
+	removed := &Manifest{Version: m.Version}
+	for _, r := range m.Resources {
+		if r.Kind != KindDNSGroup && r.Kind != KindEdgeCname {
+			removed.Resources = append(removed.Resources, r)
+		}
+	}
+	plan, err = engine.Plan(ctx, removed, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	expected = []string{"delete dns-group.lb", "delete edge-cname.cdn"}
+	if got := stepAddresses(plan.Steps); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Plan: Expected %+v but got %+v", expected, got)
+	}
+
+	result, err = engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if items := server.Items(ectest.RouteDNSGroups); len(items) != 0 {
+		t.Fatalf("Expected no groups but got %+v", items)
+	}
+	if items := server.Items(ectest.EdgeCnames); len(items) != 0 {
+		t.Fatalf("Expected no edge CNAMEs but got %+v", items)
+	}
+	if len(result.State.Resources) != len(removed.Resources) {
+		t.Fatalf(
+			"Apply: Expected %d resources in state but got %+v",
+			len(removed.Resources),
+			result.State.Resources)
+	}
+}
+
+func TestApplyUpdatesChangedID(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	m := parseTestManifest(t, testManifest)
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	result, err := engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	oldID := result.State.ids()["dns-group.lb"]
+
+	// Route DNS assigns a new ID to a group when it is updated
+	for _, r := range m.Resources {
+		if r.Kind == KindDNSGroup {
+			r.Spec["Name"] = "renamed"
+		}
+	}
+	plan, err = engine.Plan(ctx, m, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	expected := []Step{{
+		Action:  ActionUpdate,
+		Kind:    KindDNSGroup,
+		Name:    "lb",
+		ID:      oldID,
+		Changes: []Change{{Path: "Name", Desired: "renamed", Live: "lb"}},
+	}}
+	if len(plan.Steps) != 1 ||
+		!reflect.DeepEqual(plan.Steps[0].Changes, expected[0].Changes) ||
+		plan.Steps[0].ID != oldID {
+		t.Fatalf("Plan: Expected %+v but got %+v", expected, plan.Steps)
+	}
+
+	result, err = engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	newID := result.State.ids()["dns-group.lb"]
+	if newID == oldID || newID != result.Results[0].ID {
+		t.Fatalf("Apply: Expected a new group ID but got %s", newID)
+	}
+
+	plan, err = engine.Plan(ctx, m, result.State)
+	if err != nil || plan.HasChanges() {
+		t.Fatalf("Plan: Expected no changes but got %v, %v", plan, err)
+	}
+}
+
+func TestApplySkipsDependentsOfFailures(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	m := parseTestManifest(t, testManifest)
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	server.InjectFault(ectest.Fault{
+		Method: http.MethodPost,
+		Path:   "/http-large/groups",
+		Times:  1,
+		Status: http.StatusBadRequest,
+	})
+
+	result, err := engine.Apply(ctx, plan)
+	if err == nil || !strings.Contains(err.Error(), "originv3-group.group") {
+		t.Fatalf("Apply: Expected an error for the group but got %v", err)
+	}
+
+	statuses := map[string]StepStatus{}
+	for _, r := range result.Results {
+		statuses[r.Step.Address()] = r.Status
+	}
+	expected := map[string]StepStatus{
+		"originv3-group.group":   StepFailed,
+		"originv3-origin.origin": StepSkipped,
+		"edge-cname.cdn":         StepSkipped,
+		"dns-zone.zone":          StepApplied,
+		"dns-group.lb":           StepApplied,
+		"waf-access-rule.block":  StepApplied,
+		"waf-scopes.scopes":      StepApplied,
+		"rtld-cdn-profile.logs":  StepApplied,
+	}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Fatalf("Apply: Expected %+v but got %+v", expected, statuses)
+	}
+	if len(result.State.Resources) != 5 {
+		t.Fatalf(
+			"Apply: Expected 5 resources in state but got %+v",
+			result.State.Resources)
+	}
+}
+
+func TestDrift(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	m := parseTestManifest(t, testManifest)
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	result, err := engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	drifts, err := engine.Drift(ctx, m, result.State)
+	if err != nil || len(drifts) != 0 {
+		t.Fatalf("Drift: Expected no drift but got %+v, %v", drifts, err)
+	}
+
+	svc, err := engine.wafService()
+	if err != nil {
+		t.Fatalf("wafService: %v", err)
+	}
+	ruleID := result.State.ids()["waf-access-rule.block"]
+	err = svc.Access.UpdateAccessRule(access.UpdateAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRuleID:  ruleID,
+		AccessRule:    access.AccessRule{Name: "Changed"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAccessRule: %v", err)
+	}
+	otherID, err := svc.Access.AddAccessRule(access.AddAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRule:    access.AccessRule{Name: "Other"},
+	})
+	if err != nil {
+		t.Fatalf("AddAccessRule: %v", err)
+	}
+
+	drifts, err = engine.Drift(ctx, m, result.State)
+	if err != nil {
+		t.Fatalf("Drift: %v", err)
+	}
+	expected := []Drift{
+		{
+			Kind:   KindWAFAccessRule,
+			Name:   "block",
+			ID:     ruleID,
+			Status: DriftModified,
+			Changes: []Change{
+				{
+					Path: "country",
+					Desired: map[string]interface{}{
+						"blacklist": []interface{}{"XX"},
+					},
+					Live: nil,
+				},
+				{Path: "name", Desired: "Block countries", Live: "Changed"},
+			},
+		},
+		{Kind: KindWAFAccessRule, ID: otherID, Status: DriftUnmanaged},
+	}
+	if !reflect.DeepEqual(drifts, expected) {
+		t.Fatalf("Drift: Expected %+v but got %+v", expected, drifts)
+	}
+}
+
+func TestPlanIgnoresSecrets(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	m := parseTestManifest(t, `
+version: v1
+resources:
+  - kind: rtld-cdn-profile
+    name: logs
+    spec:
+      profile_name: logs
+      http_post: {username: user, password: secret}
+      datadog: {api_key: secret}
+`)
+
+	plan, err := engine.Plan(ctx, m, nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	result, err := engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	// The API returns masked values in place of the secrets
+	plan, err = engine.Plan(ctx, m, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	expected := []string{"rtld-cdn-profile.logs"}
+	if len(plan.Steps) != 0 || !reflect.DeepEqual(plan.InSync, expected) {
+		t.Fatalf(
+			"Plan: Expected %+v in sync but got %+v",
+			expected,
+			stepAddresses(plan.Steps))
+	}
+
+	drifts, err := engine.Drift(ctx, m, result.State)
+	if err != nil || len(drifts) != 0 {
+		t.Fatalf("Drift: Expected no drift but got %+v, %v", drifts, err)
+	}
+
+	// Other fields are still compared
+	m.Resources[0].Spec["http_post"].(map[string]interface{})["username"] =
+		"other"
+	plan, err = engine.Plan(ctx, m, result.State)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if len(plan.Steps) != 1 ||
+		!reflect.DeepEqual(plan.Steps[0].Changes, []Change{{
+			Path:    "http_post.username",
+			Desired: "other",
+			Live:    "user",
+		}}) {
+		t.Fatalf(
+			"Plan: Expected a username change but got %+v",
+			plan.Steps)
+	}
+}
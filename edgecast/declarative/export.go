@@ -0,0 +1,443 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Export, which writes the live resources of an account
+	as a manifest
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+)
+
+// ExportOptions selects the resources exported by Export
+type ExportOptions struct {
+	// Kinds are the kinds of resources to export. Every kind is exported if
+	// it is empty. The resources that they refer to are exported whatever
+	// their kind.
+	Kinds []Kind
+
+	// IDs lists the IDs of resources to export in addition to those found
+	// by listing. They are needed for the kinds that cannot be listed, e.g.
+	// Route DNS zones. The kinds of IDs are exported even if they are not in
+	// Kinds.
+	IDs map[Kind][]string
+}
+
+// ExportResult is the result of Export
+type ExportResult struct {
+	// Manifest describes the exported resources, without their IDs. The IDs
+	// of the resources that they refer to are replaced with references.
+	Manifest *Manifest
+
+	// SourceIDs holds the IDs of the exported resources, by address
+	SourceIDs map[string]string
+
+	// Warnings lists the parts of the account that could not be exported
+	// faithfully, e.g. references to resources that were not exported
+	Warnings []string
+}
+
+// exportedResource is a live resource read by Export
+type exportedResource struct {
+	kind Kind
+	id   string
+	spec map[string]interface{}
+}
+
+// Export reads the live resources of an account and returns a manifest that
+// describes them. The manifest can be applied to another account, where
+// every resource is created with a new ID.
+//
+// Resources of the kinds that can be listed are found by listing. The
+// resources that exported resources refer to are exported as well, so only
+// the IDs of the top-level resources of the kinds that cannot be listed need
+// to be given, e.g. secondary zone groups but not their TSIG keys. Fields set
+// by the API, such as IDs and modification dates, are left out.
+func (e *Engine) Export(
+	ctx context.Context,
+	opts ExportOptions,
+) (*ExportResult, error) {
+	selected := map[Kind]bool{}
+	for _, kind := range opts.Kinds {
+		selected[kind] = true
+	}
+	if len(selected) == 0 {
+		for _, kind := range Kinds() {
+			selected[kind] = true
+		}
+	}
+	for kind := range opts.IDs {
+		selected[kind] = true
+	}
+
+	// The kinds that the selected kinds refer to are read too, but only the
+	// resources referred to are exported
+	var kinds []Kind
+	included := map[Kind]bool{}
+	var include func(kind Kind) error
+	include = func(kind Kind) error {
+		if included[kind] {
+			return nil
+		}
+		h, ok := handlers[kind]
+		if !ok {
+			return fmt.Errorf("unknown kind %q", kind)
+		}
+		if err := e.checkAccountNumber(kind); err != nil {
+			return err
+		}
+		included[kind] = true
+		kinds = append(kinds, kind)
+		for _, ref := range h.info().refs {
+			if err := include(ref.kind); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for kind := range selected {
+		if err := include(kind); err != nil {
+			return nil, fmt.Errorf("Export: %w", err)
+		}
+	}
+
+	// Kinds are read in the reverse order of creation, so that the IDs that
+	// a resource refers to are known before the kind they belong to is read
+	sort.Slice(kinds, func(i, j int) bool {
+		return ranks[kinds[i]] > ranks[kinds[j]]
+	})
+
+	ids := map[Kind][]string{}
+	seen := map[Kind]map[string]bool{}
+	add := func(kind Kind, id string) {
+		if seen[kind] == nil {
+			seen[kind] = map[string]bool{}
+		}
+		if !seen[kind][id] {
+			seen[kind][id] = true
+			ids[kind] = append(ids[kind], id)
+		}
+	}
+
+	for _, kind := range kinds {
+		if !selected[kind] || len(handlers[kind].info().keyField) == 0 {
+			continue
+		}
+		items, err := handlers[kind].list(ctx, e)
+		if err != nil {
+			return nil, fmt.Errorf("Export: listing %s: %w", kind, err)
+		}
+		for _, item := range items {
+			add(kind, item.id)
+		}
+	}
+	for kind, kindIDs := range opts.IDs {
+		for _, id := range kindIDs {
+			add(kind, id)
+		}
+	}
+
+	result := &ExportResult{SourceIDs: map[string]string{}}
+	warn := func(format string, args ...interface{}) {
+		result.Warnings = append(result.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	var exported []exportedResource
+	for _, kind := range kinds {
+		h := handlers[kind]
+		kindIDs := ids[kind]
+		if h.info().singleton && selected[kind] {
+			kindIDs = []string{""}
+		}
+
+		for _, id := range kindIDs {
+			obj, err := h.get(ctx, e, id, nil)
+			if edgecast.IsNotFound(err) {
+				warn("%s %s was not found", kind, id)
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Export: reading %s %s: %w",
+					kind,
+					id,
+					err)
+			}
+			if h.info().singleton {
+				id = h.info().singletonID(obj)
+			}
+
+			spec, err := h.liveSpec(obj)
+			if err != nil {
+				return nil, fmt.Errorf("Export: %s %s: %w", kind, id, err)
+			}
+
+			// Export the resources referred to along with the resource
+			for _, ref := range h.info().refs {
+				visitPath(
+					spec,
+					splitPath(ref.path),
+					func(parent map[string]interface{}, key string) {
+						if refID := referencedID(parent[key]); len(refID) > 0 {
+							add(ref.kind, refID)
+						}
+					})
+			}
+
+			exported = append(
+				exported,
+				exportedResource{kind: kind, id: id, spec: spec})
+		}
+	}
+
+	// Name the resources, then replace the IDs they refer to with references
+	names := nameResources(exported)
+	m := &Manifest{Version: ManifestVersion}
+	for _, r := range exported {
+		name := names[r.kind][r.id]
+		addr := address(r.kind, name)
+		info := handlers[r.kind].info()
+
+		for _, ref := range info.refs {
+			visitPath(
+				r.spec,
+				splitPath(ref.path),
+				func(parent map[string]interface{}, key string) {
+					refID := referencedID(parent[key])
+					if len(refID) == 0 {
+						return
+					}
+					refName, ok := names[ref.kind][refID]
+					if !ok {
+						warn(
+							"%s: %s refers to %s %s, which was not exported",
+							addr,
+							ref.path,
+							ref.kind,
+							refID)
+						return
+					}
+					parent[key] = "${" + address(ref.kind, refName) + ".id}"
+				})
+		}
+		for _, path := range info.unmapped {
+			visitPath(
+				r.spec,
+				splitPath(path),
+				func(parent map[string]interface{}, key string) {
+					if refID := referencedID(parent[key]); len(refID) > 0 {
+						warn(
+							"%s: %s holds ID %s, which cannot be remapped",
+							addr,
+							path,
+							refID)
+					}
+				})
+		}
+		removePaths(r.spec, info.readOnly)
+		pruneNulls(r.spec)
+
+		m.Resources = append(
+			m.Resources,
+			Resource{Kind: r.kind, Name: name, Spec: r.spec})
+		result.SourceIDs[addr] = r.id
+	}
+
+	sort.SliceStable(m.Resources, func(i, j int) bool {
+		a, b := m.Resources[i], m.Resources[j]
+		if a.Kind != b.Kind {
+			return ranks[a.Kind] < ranks[b.Kind]
+		}
+		return a.Name < b.Name
+	})
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("Export: %w", err)
+	}
+
+	result.Manifest = m
+	return result, nil
+}
+
+// invalidNameChars matches the runs of characters that may not be used in
+// the name of a resource
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// nameResources names exported resources after their name field, and
+// returns the names by kind and ID. Names are unique within a kind, resources
+// without a usable name being named after their ID.
+func nameResources(resources []exportedResource) map[Kind]map[string]string {
+	type candidate struct {
+		id   string
+		name string
+	}
+	byKind := map[Kind][]candidate{}
+	for _, r := range resources {
+		info := handlers[r.kind].info()
+		field := info.nameField
+		if len(field) == 0 {
+			field = info.keyField
+		}
+
+		value, _ := r.spec[field].(string)
+		name := strings.ToLower(strings.Trim(
+			invalidNameChars.ReplaceAllString(value, "-"),
+			"-"))
+		if len(name) == 0 && info.singleton {
+			name = "default"
+		}
+		if len(name) == 0 {
+			name = "id-" + strings.Trim(
+				invalidNameChars.ReplaceAllString(r.id, "-"),
+				"-")
+		}
+		byKind[r.kind] = append(byKind[r.kind], candidate{r.id, name})
+	}
+
+	names := map[Kind]map[string]string{}
+	for kind, candidates := range byKind {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].name != candidates[j].name {
+				return candidates[i].name < candidates[j].name
+			}
+			return candidates[i].id < candidates[j].id
+		})
+
+		names[kind] = map[string]string{}
+		used := map[string]bool{}
+		for _, c := range candidates {
+			name := c.name
+			for n := 2; used[name]; n++ {
+				name = c.name + "-" + strconv.Itoa(n)
+			}
+			used[name] = true
+			names[kind][c.id] = name
+		}
+	}
+	return names
+}
+
+// referencedID returns the ID held by a field that refers to another
+// resource, or an empty string if the field does not refer to a resource.
+// Zero and negative numbers are used by some APIs to mean no resource.
+func referencedID(v interface{}) string {
+	var id string
+	switch t := v.(type) {
+	case string:
+		id = t
+	case json.Number:
+		id = t.String()
+	}
+	if id == "0" || strings.HasPrefix(id, "-") {
+		return ""
+	}
+	return id
+}
+
+// splitPath splits a path into its segments
+func splitPath(path string) []string {
+	return strings.Split(path, ".")
+}
+
+// visitPath calls fn with the object and key of every field of v matching a
+// path. The segments of a path are the keys of objects, * matching any key
+// of an object or any element of an array, and ** matching any number of
+// levels, e.g. scopes.*.id or **.id. Other segments with wildcards match keys
+// as path.Match does, e.g. **.masked_*.
+func visitPath(
+	v interface{},
+	segments []string,
+	fn func(parent map[string]interface{}, key string),
+) {
+	if len(segments) == 0 {
+		return
+	}
+	segment, rest := segments[0], segments[1:]
+
+	if segment == "**" {
+		visitPath(v, rest, fn)
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for _, k := range sortedKeys(t) {
+				visitPath(t[k], segments, fn)
+			}
+		case []interface{}:
+			for _, item := range t {
+				visitPath(item, segments, fn)
+			}
+		}
+		return
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		keys := []string{segment}
+		if strings.ContainsAny(segment, "*?[") {
+			keys = nil
+			for _, k := range sortedKeys(t) {
+				if ok, _ := path.Match(segment, k); ok {
+					keys = append(keys, k)
+				}
+			}
+		}
+		for _, k := range keys {
+			child, ok := t[k]
+			if !ok {
+				continue
+			}
+			if len(rest) == 0 {
+				fn(t, k)
+			} else {
+				visitPath(child, rest, fn)
+			}
+		}
+	case []interface{}:
+		if segment != "*" {
+			return
+		}
+		for _, item := range t {
+			visitPath(item, rest, fn)
+		}
+	}
+}
+
+// removePaths removes the fields of v matching any of paths
+func removePaths(v interface{}, paths []string) {
+	for _, path := range paths {
+		visitPath(
+			v,
+			splitPath(path),
+			func(parent map[string]interface{}, key string) {
+				delete(parent, key)
+			})
+	}
+}
+
+// pruneNulls removes the null fields of the objects in v
+func pruneNulls(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, item := range t {
+			if item == nil {
+				delete(t, k)
+				continue
+			}
+			pruneNulls(item)
+		}
+	case []interface{}:
+		for _, item := range t {
+			pruneNulls(item)
+		}
+	}
+}
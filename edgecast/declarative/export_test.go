@@ -0,0 +1,353 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+)
+
+const exportManifest = `
+version: v1
+resources:
+  - kind: origin
+    name: web
+    spec:
+      DirectoryName: web
+      HostHeader: web.example.com
+      HttpHostnames: [{Name: "http://origin.example.com"}]
+      MediaTypeId: 3
+  - kind: edge-cname
+    name: cdn
+    spec:
+      Name: cdn.example.com
+      DirPath: /80ABCD/web
+      MediaTypeId: 3
+      OriginId: ${origin.web.id}
+  - kind: dns-tsig
+    name: key
+    spec:
+      Alias: Transfer Key
+      KeyName: key
+      KeyValue: c2VjcmV0
+      AlgorithmId: 3
+  - kind: dns-master-server-group
+    name: masters
+    spec:
+      Name: masters
+      MasterServers: [{Name: ns1, IPAddress: 192.0.2.1}]
+  - kind: dns-secondary-zone-group
+    name: secondary
+    spec:
+      Name: secondary
+      ZoneComposition:
+        MasterGroupId: ${dns-master-server-group.masters.id}
+        MasterServerTsigs:
+          - MasterServer: {Id: 7}
+            Tsig: {Id: "${dns-tsig.key.id}"}
+        Zones: [{DomainName: secondary.example.com., Status: 1}]
+  - kind: waf-bot-rule
+    name: bots
+    spec:
+      name: Bots
+      directive: [{include: r4000}]
+  - kind: waf-bot-manager
+    name: manager
+    spec:
+      name: Manager
+      bots_prod_id: ${waf-bot-rule.bots.id}
+  - kind: waf-scopes
+    name: scopes
+    spec:
+      scopes:
+        - name: Default Scope
+          host: {type: GLOB, value: "*"}
+          path: {type: GLOB, value: "*"}
+          bot_manager_config_id: ${waf-bot-manager.manager.id}
+  - kind: rules-engine-policy
+    name: policy
+    spec:
+      name: Policy
+      platform: http_large
+      state: locked
+      rules: [{name: Rule, matches: []}]
+`
+
+func TestExport(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	plan, err := engine.Plan(ctx, parseTestManifest(t, exportManifest), nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	applied, err := engine.Apply(ctx, plan)
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	// Secondary zone groups and policies cannot be listed. The TSIG key is
+	// exported as the secondary zone group refers to it.
+	ids := map[Kind][]string{}
+	for _, r := range applied.State.Resources {
+		if r.Kind == KindDNSSecondaryZoneGroup ||
+			r.Kind == KindRulesEnginePolicy {
+			ids[r.Kind] = append(ids[r.Kind], r.ID)
+		}
+	}
+	kinds := []Kind{
+		KindOrigin,
+		KindEdgeCname,
+		KindDNSMasterServerGroup,
+		KindWAFBotRule,
+		KindWAFBotManager,
+		KindWAFScopes,
+	}
+	result, err := engine.Export(ctx, ExportOptions{Kinds: kinds, IDs: ids})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	specs := map[string]map[string]interface{}{}
+	var addrs []string
+	for _, r := range result.Manifest.Resources {
+		addrs = append(addrs, r.Address())
+		specs[r.Address()] = r.Spec
+		if len(r.ID) > 0 || len(result.SourceIDs[r.Address()]) == 0 {
+			t.Fatalf(
+				"%s: Expected only a source ID but got %+v",
+				r.Address(),
+				r)
+		}
+	}
+	expected := []string{
+		"origin.web",
+		"edge-cname.cdn-example-com",
+		"dns-tsig.transfer-key",
+		"dns-master-server-group.masters",
+		"dns-secondary-zone-group.secondary",
+		"waf-bot-rule.bots",
+		"waf-bot-manager.manager",
+		"waf-scopes.default",
+		"rules-engine-policy.policy",
+	}
+	if !reflect.DeepEqual(addrs, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, addrs)
+	}
+
+	cases := []struct {
+		addr     string
+		path     string
+		expected interface{}
+	}{
+		{
+			addr:     "edge-cname.cdn-example-com",
+			path:     "OriginId",
+			expected: "${origin.web.id}",
+		},
+		{
+			addr:     "dns-secondary-zone-group.secondary",
+			path:     "ZoneComposition.MasterGroupId",
+			expected: "${dns-master-server-group.masters.id}",
+		},
+		{
+			addr:     "dns-secondary-zone-group.secondary",
+			path:     "ZoneComposition.MasterServerTsigs.*.Tsig.Id",
+			expected: "${dns-tsig.transfer-key.id}",
+		},
+		{
+			addr:     "waf-bot-manager.manager",
+			path:     "bots_prod_id",
+			expected: "${waf-bot-rule.bots.id}",
+		},
+		{
+			addr:     "waf-scopes.default",
+			path:     "scopes.*.bot_manager_config_id",
+			expected: "${waf-bot-manager.manager.id}",
+		},
+		{addr: "waf-bot-manager.manager", path: "id"},
+		{addr: "waf-bot-manager.manager", path: "customer_id"},
+		{addr: "waf-scopes.default", path: "id"},
+		{addr: "waf-scopes.default", path: "scopes.*.id"},
+		{addr: "dns-master-server-group.masters", path: "MasterServers.*.Id"},
+		{addr: "rules-engine-policy.policy", path: "rules.*.id"},
+		{
+			addr:     "rules-engine-policy.policy",
+			path:     "@type",
+			expected: rulesEnginePolicyType,
+		},
+	}
+	for _, c := range cases {
+		var got interface{}
+		visitPath(
+			specs[c.addr],
+			splitPath(c.path),
+			func(parent map[string]interface{}, key string) {
+				got = parent[key]
+			})
+		if got != c.expected {
+			t.Fatalf(
+				"%s %s: Expected %v but got %v",
+				c.addr,
+				c.path,
+				c.expected,
+				got)
+		}
+	}
+
+	if len(result.Warnings) != 1 ||
+		!strings.Contains(result.Warnings[0], "MasterServer.Id holds ID 7") {
+		t.Fatalf(
+			"Expected a warning about master server IDs but got %+v",
+			result.Warnings)
+	}
+}
+
+func TestExportRTLDProfile(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	plan, err := engine.Plan(ctx, parseTestManifest(t, `
+version: v1
+resources:
+  - kind: rtld-cdn-profile
+    name: logs
+    spec:
+      profile_name: logs
+      http_post: {username: user, password: secret, token: secret}
+      datadog: {api_key: secret}
+      azure_blob_storage: {access_key: secret, token: secret}
+`), nil)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if _, err := engine.Apply(ctx, plan); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	result, err := engine.Export(ctx, ExportOptions{
+		Kinds: []Kind{KindRTLDCDNProfile},
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if len(result.Manifest.Resources) != 1 {
+		t.Fatalf(
+			"Expected 1 resource but got %+v",
+			result.Manifest.Resources)
+	}
+
+	// The masked secrets are left out, and only the username remains
+	spec := result.Manifest.Resources[0].Spec
+	var masked []string
+	visitPath(
+		spec,
+		splitPath("**.masked_*"),
+		func(parent map[string]interface{}, key string) {
+			masked = append(masked, key)
+		})
+	if len(masked) > 0 {
+		t.Fatalf("Expected no masked fields but got %+v", masked)
+	}
+	expected := map[string]interface{}{"username": "user"}
+	if !reflect.DeepEqual(spec["http_post"], expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, spec["http_post"])
+	}
+}
+
+func TestExportOptions(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	engine := newTestEngine(t, server)
+	if _, err := engine.Export(ctx, ExportOptions{
+		Kinds: []Kind{"waf-rule"},
+	}); err == nil || !strings.Contains(err.Error(), `unknown kind`) {
+		t.Fatalf("Expected an unknown kind error but got %v", err)
+	}
+
+	_, err := engine.Export(ctx, ExportOptions{
+		IDs: map[Kind][]string{KindDNSZone: {"12"}},
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	engine.accountNumber = ""
+	if _, err := engine.Export(ctx, ExportOptions{
+		Kinds: []Kind{KindWAFAccessRule},
+	}); err == nil || !strings.Contains(err.Error(), "account number") {
+		t.Fatalf("Expected an account number error but got %v", err)
+	}
+}
+
+func TestVisitPath(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected []string
+	}{
+		{path: "id", expected: []string{"1"}},
+		{path: "scopes.*.id", expected: []string{"2", "3"}},
+		{path: "scopes.*.*.id", expected: []string{"4"}},
+		{path: "**.id", expected: []string{"1", "2", "4", "5", "3"}},
+		{path: "scopes.*.act*.id", expected: []string{"4"}},
+		{path: "**.i?", expected: []string{"1", "2", "4", "5", "3"}},
+		{path: "scopes.id"},
+		{path: "missing.id"},
+	}
+
+	for _, c := range cases {
+		spec := decodeTestJSON(t, `{"id": "1", "scopes": [
+			{"id": "2", "action": {"id": "4"}, "limits": [{"id": "5"}]},
+			{"id": "3"}]}`)
+		var got []string
+		visitPath(
+			spec,
+			splitPath(c.path),
+			func(parent map[string]interface{}, key string) {
+				got = append(got, parent[key].(string))
+			})
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.path, c.expected, got)
+		}
+	}
+}
+
+func TestNameResources(t *testing.T) {
+	resources := []exportedResource{
+		{
+			kind: KindWAFAccessRule,
+			id:   "b",
+			spec: map[string]interface{}{"name": "Block: countries!"},
+		},
+		{
+			kind: KindWAFAccessRule,
+			id:   "a",
+			spec: map[string]interface{}{"name": "block countries"},
+		},
+		{kind: KindWAFAccessRule, id: "c", spec: map[string]interface{}{}},
+		{kind: KindWAFScopes, id: "s", spec: map[string]interface{}{}},
+	}
+
+	expected := map[Kind]map[string]string{
+		KindWAFAccessRule: {
+			"a": "block-countries",
+			"b": "block-countries-2",
+			"c": "id-c",
+		},
+		KindWAFScopes: {"s": "default"},
+	}
+	if got := nameResources(resources); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, got)
+	}
+}
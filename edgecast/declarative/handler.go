@@ -0,0 +1,356 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the kinds of resources that can be managed, and the
+	handlers that read and change them through the SDK's services
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Kind is a type of resource that can be managed by a manifest
+type Kind string
+
+// Kinds of resources, in the order in which they are created. A kind may
+// refer to the kinds before it, e.g. edge CNAMEs to origins and WAF scopes to
+// WAF rules.
+const (
+	KindOriginV3Group           Kind = "originv3-group"
+	KindOriginV3Origin          Kind = "originv3-origin"
+	KindOrigin                  Kind = "origin"
+	KindEdgeCname               Kind = "edge-cname"
+	KindDNSZone                 Kind = "dns-zone"
+	KindDNSGroup                Kind = "dns-group"
+	KindDNSTSIG                 Kind = "dns-tsig"
+	KindDNSMasterServerGroup    Kind = "dns-master-server-group"
+	KindDNSSecondaryZoneGroup   Kind = "dns-secondary-zone-group"
+	KindWAFAccessRule           Kind = "waf-access-rule"
+	KindWAFCustomRule           Kind = "waf-custom-rule"
+	KindWAFManagedRule          Kind = "waf-managed-rule"
+	KindWAFRateRule             Kind = "waf-rate-rule"
+	KindWAFBotRule              Kind = "waf-bot-rule"
+	KindWAFBotManager           Kind = "waf-bot-manager"
+	KindWAFScopes               Kind = "waf-scopes"
+	KindRTLDCDNProfile          Kind = "rtld-cdn-profile"
+	KindRTLDWAFProfile          Kind = "rtld-waf-profile"
+	KindRTLDRateLimitingProfile Kind = "rtld-rl-profile"
+	KindRulesEnginePolicy       Kind = "rules-engine-policy"
+)
+
+// Kinds returns every kind of resource that can be managed, in the order in
+// which they are created
+func Kinds() []Kind {
+	kinds := make([]Kind, len(handlerList))
+	for i, h := range handlerList {
+		kinds[i] = h.info().kind
+	}
+	return kinds
+}
+
+// kindInfo describes a kind of resource
+type kindInfo struct {
+	kind Kind
+
+	// keyField is the JSON field, of both the spec and the listed resources,
+	// that identifies a resource whose ID is not known. It is empty if the
+	// resources of the kind cannot be listed.
+	keyField string
+
+	// numericID is true if the API uses numbers as IDs
+	numericID bool
+
+	// legacy is true if the kind is served by the legacy API, or by another
+	// API that needs an account number
+	legacy bool
+
+	// singleton is true if an account has exactly one resource of the kind,
+	// which can be updated but neither created nor deleted
+	singleton bool
+
+	// singletonID returns the ID of the live resource of a singleton kind,
+	// which is read without an ID
+	singletonID func(live interface{}) string
+
+	// nameField is the JSON field of the spec from which exported resources
+	// are named. It defaults to keyField.
+	nameField string
+
+	// readOnly lists the paths of the fields set by the API, which are
+	// removed from exported specs. See visitPath for the syntax of paths.
+	readOnly []string
+
+	// writeOnly lists the paths of the fields that the API accepts but never
+	// returns, such as secrets, which are removed from live specs and not
+	// compared with them
+	writeOnly []string
+
+	// refs lists the fields of the spec that hold the IDs of resources of
+	// other kinds, which are exported as references
+	refs []refField
+
+	// unmapped lists the paths of the fields that hold the IDs of resources
+	// that cannot be exported, which are exported as they are with a warning
+	unmapped []string
+}
+
+// refField is a field of a spec that holds the ID of a resource of another
+// kind
+type refField struct {
+	// path is the path of the field. See visitPath for the syntax of paths.
+	path string
+
+	kind Kind
+}
+
+// listed is a resource returned when listing the resources of a kind
+type listed struct {
+	id  string
+	key string
+}
+
+// handler reads and changes the resources of one kind. Specs are passed as
+// JSON with every reference resolved.
+type handler interface {
+	info() kindInfo
+
+	// validate checks that a spec can be decoded into the model of the kind
+	validate(spec map[string]interface{}) error
+
+	// liveSpec returns the fields of a live resource that are in the model
+	// of the kind, as the generic values returned by normalize
+	liveSpec(live interface{}) (map[string]interface{}, error)
+
+	// list returns every resource of the kind. It must only be called if
+	// kindInfo.keyField is set.
+	list(ctx context.Context, e *Engine) ([]listed, error)
+
+	// get returns the resource with the given ID. The spec, which may be
+	// nil, is passed for APIs that need more than the ID.
+	get(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec map[string]interface{},
+	) (interface{}, error)
+
+	// create creates a resource and returns its ID
+	create(
+		ctx context.Context,
+		e *Engine,
+		spec map[string]interface{},
+	) (string, error)
+
+	// update replaces the resource with the given ID and returns its ID,
+	// which changes on update for some kinds
+	update(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec map[string]interface{},
+	) (string, error)
+
+	// delete deletes a resource, given its ID and the live resource returned
+	// by get
+	delete(ctx context.Context, e *Engine, id string, live interface{}) error
+}
+
+// kindHandler implements handler for a kind whose specs are decoded into T
+type kindHandler[T any] struct {
+	kindInfo
+
+	listFunc func(ctx context.Context, e *Engine) ([]listed, error)
+
+	getFunc func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec T,
+	) (interface{}, error)
+
+	createFunc func(ctx context.Context, e *Engine, spec T) (string, error)
+
+	updateFunc func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec T,
+	) (string, error)
+
+	deleteFunc func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error
+
+	// specFunc converts a live resource to the model of the kind. It is only
+	// needed if their JSON fields differ.
+	specFunc func(live interface{}) (T, error)
+}
+
+func (h kindHandler[T]) info() kindInfo {
+	return h.kindInfo
+}
+
+func (h kindHandler[T]) validate(spec map[string]interface{}) error {
+	_, err := decodeSpec[T](spec)
+	return err
+}
+
+func (h kindHandler[T]) liveSpec(
+	live interface{},
+) (map[string]interface{}, error) {
+	var spec T
+	if h.specFunc != nil {
+		var err error
+		if spec, err = h.specFunc(live); err != nil {
+			return nil, err
+		}
+	} else {
+		encoded, err := json.Marshal(live)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(encoded, &spec); err != nil {
+			return nil, err
+		}
+	}
+
+	normalized, err := normalize(spec)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := normalized.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not an object", h.kind)
+	}
+	removePaths(m, h.writeOnly)
+	return m, nil
+}
+
+func (h kindHandler[T]) list(
+	ctx context.Context,
+	e *Engine,
+) ([]listed, error) {
+	return h.listFunc(ctx, e)
+}
+
+func (h kindHandler[T]) get(
+	ctx context.Context,
+	e *Engine,
+	id string,
+	spec map[string]interface{},
+) (interface{}, error) {
+	var decoded T
+	if spec != nil {
+		var err error
+		if decoded, err = decodeSpec[T](spec); err != nil {
+			return nil, err
+		}
+	}
+	return h.getFunc(ctx, e, id, decoded)
+}
+
+func (h kindHandler[T]) create(
+	ctx context.Context,
+	e *Engine,
+	spec map[string]interface{},
+) (string, error) {
+	decoded, err := decodeSpec[T](spec)
+	if err != nil {
+		return "", err
+	}
+	return h.createFunc(ctx, e, decoded)
+}
+
+func (h kindHandler[T]) update(
+	ctx context.Context,
+	e *Engine,
+	id string,
+	spec map[string]interface{},
+) (string, error) {
+	decoded, err := decodeSpec[T](spec)
+	if err != nil {
+		return "", err
+	}
+	return h.updateFunc(ctx, e, id, decoded)
+}
+
+func (h kindHandler[T]) delete(
+	ctx context.Context,
+	e *Engine,
+	id string,
+	live interface{},
+) error {
+	return h.deleteFunc(ctx, e, id, live)
+}
+
+// decodeSpec decodes a spec into the model of a kind, rejecting fields that
+// the model does not have
+func decodeSpec[T any](spec map[string]interface{}) (T, error) {
+	var decoded T
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return decoded, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	err = decoder.Decode(&decoded)
+	return decoded, err
+}
+
+// handlerList holds the handler of every kind, in the order of the Kind
+// constants
+var handlerList = []handler{
+	originV3GroupHandler,
+	originV3OriginHandler,
+	originHandler,
+	edgeCnameHandler,
+	dnsZoneHandler,
+	dnsGroupHandler,
+	dnsTSIGHandler,
+	dnsMasterServerGroupHandler,
+	dnsSecondaryZoneGroupHandler,
+	wafAccessRuleHandler,
+	wafCustomRuleHandler,
+	wafManagedRuleHandler,
+	wafRateRuleHandler,
+	wafBotRuleHandler,
+	wafBotManagerHandler,
+	wafScopesHandler,
+	rtldCDNProfileHandler,
+	rtldWAFProfileHandler,
+	rtldRateLimitingProfileHandler,
+	rulesEnginePolicyHandler,
+}
+
+// handlers holds the handler of every kind by kind
+var handlers = map[Kind]handler{}
+
+// ranks holds the position of every kind in handlerList
+var ranks = map[Kind]int{}
+
+func init() {
+	for i, h := range handlerList {
+		handlers[h.info().kind] = h
+		ranks[h.info().kind] = i
+	}
+}
+
+// parseNumericID parses the ID of a resource of an API that uses numeric IDs
+func parseNumericID(id string) (int, error) {
+	parsed, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: must be a number", id)
+	}
+	return parsed, nil
+}
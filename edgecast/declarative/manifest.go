@@ -0,0 +1,262 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Manifest, the desired state of an account's resources,
+	and the code that reads and validates it
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ManifestVersion is the version of the manifest format read by this package
+const ManifestVersion = "v1"
+
+// Manifest describes the desired state of the resources of an account
+type Manifest struct {
+	// Version is the version of the manifest format, i.e. ManifestVersion
+	Version string `json:"version"`
+
+	// Resources are the resources managed by the manifest
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is a single resource of a Manifest
+type Resource struct {
+	// Kind is the type of the resource
+	Kind Kind `json:"kind"`
+
+	// Name identifies the resource within the manifest, and is used to refer
+	// to it from other resources. Names may contain letters, digits, hyphens
+	// and underscores, and must be unique within a kind.
+	Name string `json:"name"`
+
+	// ID is the ID of an existing resource to manage. It is only needed to
+	// adopt a resource that cannot be found by its key field, i.e. a Route
+	// DNS zone or group that was not created by Apply.
+	ID ID `json:"id,omitempty"`
+
+	// Spec is the request body for the resource, using the JSON field names
+	// of the SDK's model for the kind. String values may refer to the ID of
+	// another resource as ${<kind>.<name>.id}.
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// Address returns the address of the resource, <kind>.<name>
+func (r Resource) Address() string {
+	return address(r.Kind, r.Name)
+}
+
+// ID is the ID of a resource. IDs are kept as strings whatever their type in
+// the API, but may be written as numbers in manifests.
+type ID string
+
+// UnmarshalJSON accepts both strings and numbers
+func (id *ID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = ID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("an ID must be a string or a number: %s", data)
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// LoadManifest reads a manifest from a JSON or YAML file
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("LoadManifest: %w", err)
+	}
+
+	m, err := ParseManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("LoadManifest: %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// ParseManifest parses and validates a JSON or YAML manifest
+func ParseManifest(data []byte) (*Manifest, error) {
+	m, err := DecodeManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DecodeManifest parses a JSON or YAML manifest without validating it, e.g.
+// to merge manifests that refer to each other's resources before validating
+// the result
+func DecodeManifest(data []byte) (*Manifest, error) {
+	// YAML is a superset of JSON, so both can be parsed as YAML
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+
+	converted, err := jsonCompatible(generic)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(converted)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// Validate checks that the manifest is of a supported version, that every
+// resource is of a known kind with a valid and unique name, that its spec
+// matches the model of its kind, and that references between resources
+// resolve and do not form a cycle
+func (m Manifest) Validate() error {
+	if m.Version != ManifestVersion {
+		return fmt.Errorf(
+			"unsupported manifest version %q: must be %q",
+			m.Version,
+			ManifestVersion)
+	}
+
+	resources := make(map[string]Resource, len(m.Resources))
+	singletons := map[Kind]string{}
+	for i, r := range m.Resources {
+		h, ok := handlers[r.Kind]
+		if !ok {
+			return fmt.Errorf("resources[%d]: unknown kind %q", i, r.Kind)
+		}
+		if !namePattern.MatchString(r.Name) {
+			return fmt.Errorf(
+				"resources[%d]: invalid name %q: names may only contain "+
+					"letters, digits, hyphens and underscores",
+				i,
+				r.Name)
+		}
+		if _, ok := resources[r.Address()]; ok {
+			return fmt.Errorf(
+				"resources[%d]: duplicate resource %s",
+				i,
+				r.Address())
+		}
+		if len(r.ID) > 0 && h.info().numericID {
+			if _, err := parseNumericID(string(r.ID)); err != nil {
+				return fmt.Errorf("resources[%d]: %w", i, err)
+			}
+		}
+		if h.info().singleton {
+			if other, ok := singletons[r.Kind]; ok {
+				return fmt.Errorf(
+					"resources[%d]: %s: an account has a single %s, "+
+						"already defined by %s",
+					i,
+					r.Address(),
+					r.Kind,
+					other)
+			}
+			singletons[r.Kind] = r.Address()
+		}
+		resources[r.Address()] = r
+	}
+
+	for _, r := range m.Resources {
+		refs, err := references(r.Spec)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.Address(), err)
+		}
+		for _, ref := range refs {
+			if _, ok := resources[ref]; !ok {
+				return fmt.Errorf(
+					"%s: reference to unknown resource %s",
+					r.Address(),
+					ref)
+			}
+		}
+
+		// Every reference is resolved to a placeholder of the right type so
+		// that the spec can be checked against the model
+		spec, _ := resolve(r.Spec, placeholderIDs)
+		if err := handlers[r.Kind].validate(spec); err != nil {
+			return fmt.Errorf("%s: invalid spec: %w", r.Address(), err)
+		}
+	}
+
+	if _, err := order(m.Resources); err != nil {
+		return err
+	}
+	return nil
+}
+
+// placeholderIDs resolves every reference to the zero ID of its kind
+func placeholderIDs(ref string) (interface{}, bool) {
+	kind, _ := splitAddress(ref)
+	if h, ok := handlers[kind]; ok && h.info().numericID {
+		return json.Number("0"), true
+	}
+	return "", true
+}
+
+// jsonCompatible converts the maps decoded from YAML, whose keys may be of
+// any type, to maps with string keys
+func jsonCompatible(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"unsupported key %v: keys must be strings",
+					k)
+			}
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, item := range t {
+			converted, err := jsonCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = converted
+		}
+		return s, nil
+	}
+	return v, nil
+}
+
+// errCycle is returned when the references between resources form a cycle
+var errCycle = errors.New("references between resources form a cycle")
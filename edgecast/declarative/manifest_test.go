@@ -0,0 +1,247 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseManifest(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected []Resource
+	}{
+		{
+			name: "YAML",
+			data: `
+version: v1
+resources:
+  - kind: waf-access-rule
+    name: rule
+    spec:
+      name: Rule
+  - kind: dns-zone
+    name: zone
+    id: 12
+    spec:
+      DomainName: example.com.
+`,
+			expected: []Resource{
+				{
+					Kind: KindWAFAccessRule,
+					Name: "rule",
+					Spec: map[string]interface{}{"name": "Rule"},
+				},
+				{
+					Kind: KindDNSZone,
+					Name: "zone",
+					ID:   "12",
+					Spec: map[string]interface{}{"DomainName": "example.com."},
+				},
+			},
+		},
+		{
+			name: "JSON",
+			data: `{"version": "v1", "resources": [
+				{"kind": "rtld-cdn-profile", "name": "logs", "id": "3",
+				 "spec": {"profile_name": "logs"}}]}`,
+			expected: []Resource{
+				{
+					Kind: KindRTLDCDNProfile,
+					Name: "logs",
+					ID:   "3",
+					Spec: map[string]interface{}{"profile_name": "logs"},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		m, err := ParseManifest([]byte(c.data))
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(m.Resources, c.expected) {
+			t.Fatalf(
+				"%s: Expected %+v but got %+v",
+				c.name,
+				c.expected,
+				m.Resources)
+		}
+	}
+}
+
+func TestParseManifestErrors(t *testing.T) {
+	rule := func(name string, ruleName string) string {
+		return `
+  - kind: waf-access-rule
+    name: ` + name + `
+    spec:
+      name: "` + ruleName + `"`
+	}
+
+	cases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "version",
+			data:     "version: v2\nresources: []",
+			expected: `unsupported manifest version "v2"`,
+		},
+		{
+			name:     "unknown field",
+			data:     "version: v1\nresource: []",
+			expected: `unknown field "resource"`,
+		},
+		{
+			name: "unknown kind",
+			data: `
+version: v1
+resources:
+  - kind: waf-rule
+    name: rule`,
+			expected: `unknown kind "waf-rule"`,
+		},
+		{
+			name:     "invalid name",
+			data:     "version: v1\nresources:" + rule("my.rule", ""),
+			expected: `invalid name "my.rule"`,
+		},
+		{
+			name: "duplicate",
+			data: "version: v1\nresources:" +
+				rule("rule", "") +
+				rule("rule", ""),
+			expected: "duplicate resource waf-access-rule.rule",
+		},
+		{
+			name: "singleton",
+			data: `
+version: v1
+resources:
+  - kind: waf-scopes
+    name: a
+  - kind: waf-scopes
+    name: b`,
+			expected: "already defined by waf-scopes.a",
+		},
+		{
+			name: "numeric ID",
+			data: `
+version: v1
+resources:
+  - kind: dns-zone
+    name: zone
+    id: abc`,
+			expected: `invalid ID "abc"`,
+		},
+		{
+			name: "spec field",
+			data: `
+version: v1
+resources:
+  - kind: waf-access-rule
+    name: rule
+    spec:
+      nme: Rule`,
+			expected: `unknown field "nme"`,
+		},
+		{
+			name: "spec type",
+			data: `
+version: v1
+resources:
+  - kind: dns-zone
+    name: zone
+    spec:
+      Status: active`,
+			expected: "invalid spec",
+		},
+		{
+			name: "unknown reference",
+			data: "version: v1\nresources:" +
+				rule("rule", "${waf-access-rule.other.id}"),
+			expected: "reference to unknown resource waf-access-rule.other",
+		},
+		{
+			name: "invalid reference",
+			data: "version: v1\nresources:" +
+				rule("rule", "${waf-access-rule.other}"),
+			expected: "invalid reference ${waf-access-rule.other}",
+		},
+		{
+			name: "cycle",
+			data: "version: v1\nresources:" +
+				rule("a", "${waf-access-rule.b.id}") +
+				rule("b", "${waf-access-rule.a.id}"),
+			expected: errCycle.Error(),
+		},
+	}
+
+	for _, c := range cases {
+		_, err := ParseManifest([]byte(c.data))
+		if err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Fatalf("%s: Expected %q but got %v", c.name, c.expected, err)
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	resources := []Resource{
+		{
+			Kind: KindWAFScopes,
+			Name: "scopes",
+			Spec: map[string]interface{}{
+				"scopes": []interface{}{
+					map[string]interface{}{
+						"acl_prod_id": "${waf-access-rule.acl.id}",
+					},
+				},
+			},
+		},
+		{
+			Kind: KindWAFAccessRule,
+			Name: "acl",
+			Spec: map[string]interface{}{
+				"name": "${originv3-group.group.id}",
+			},
+		},
+		{Kind: KindRTLDCDNProfile, Name: "logs"},
+		{Kind: KindOriginV3Group, Name: "group"},
+		{Kind: KindOriginV3Group, Name: "other"},
+	}
+
+	sorted, err := order(resources)
+	if err != nil {
+		t.Fatalf("order: %v", err)
+	}
+
+	var got []string
+	for _, r := range sorted {
+		got = append(got, r.Address())
+	}
+	expected := []string{
+		"originv3-group.group",
+		"originv3-group.other",
+		"waf-access-rule.acl",
+		"waf-scopes.scopes",
+		"rtld-cdn-profile.logs",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, got)
+	}
+
+	resources[3].Spec = map[string]interface{}{
+		"name": "${waf-scopes.scopes.id}",
+	}
+	if _, err := order(resources); !errors.Is(err, errCycle) {
+		t.Fatalf("Expected %v but got %v", errCycle, err)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handler of customer origins
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/origin"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+)
+
+// originSpec is the spec of a customer origin, which is created on the
+// platform given by its MediaTypeId field
+type originSpec struct {
+	origin.Origin
+
+	MediaTypeID enums.Platform `json:"MediaTypeId"`
+}
+
+// originService returns the Origin service
+func (e *Engine) originService() (*origin.OriginService, error) {
+	return e.client.Origin()
+}
+
+// originHandler manages customer origins, which are identified by their
+// directory name
+var originHandler = kindHandler[originSpec]{
+	kindInfo: kindInfo{
+		kind:      KindOrigin,
+		keyField:  "DirectoryName",
+		numericID: true,
+		legacy:    true,
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.originService()
+		if err != nil {
+			return nil, err
+		}
+		var items []listed
+		for _, platform := range edgeCnamePlatforms {
+			resp, err := svc.GetAllOriginsWithContext(
+				ctx,
+				origin.GetAllOriginsParams{
+					AccountNumber: e.accountNumber,
+					MediaTypeID:   platform,
+				})
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range *resp {
+				items = append(
+					items,
+					listed{id: strconv.Itoa(item.ID), key: item.DirectoryName})
+			}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originSpec,
+	) (interface{}, error) {
+		originID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.originService()
+		if err != nil {
+			return nil, err
+		}
+
+		// Origins are read by ID and platform. The platform is not known
+		// when exporting an origin or deleting one that was removed from the
+		// manifest, in which case every platform is tried.
+		platforms := []enums.Platform{spec.MediaTypeID}
+		if spec.MediaTypeID == 0 {
+			platforms = edgeCnamePlatforms
+		}
+
+		for _, platform := range platforms {
+			var o *origin.OriginGetOK
+			o, err = svc.GetOriginWithContext(
+				ctx,
+				origin.GetOriginParams{
+					AccountNumber:    e.accountNumber,
+					MediaTypeID:      platform,
+					CustomerOriginID: originID,
+				})
+			if err == nil || !edgecast.IsNotFound(err) {
+				return o, err
+			}
+		}
+		return nil, err
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec originSpec,
+	) (string, error) {
+		svc, err := e.originService()
+		if err != nil {
+			return "", err
+		}
+		id, err := svc.AddOriginWithContext(
+			ctx,
+			origin.AddOriginParams{
+				AccountNumber: e.accountNumber,
+				MediaTypeID:   spec.MediaTypeID,
+				Origin:        spec.Origin,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(*id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originSpec,
+	) (string, error) {
+		originID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.originService()
+		if err != nil {
+			return "", err
+		}
+		_, err = svc.UpdateOriginWithContext(
+			ctx,
+			origin.UpdateOriginParams{
+				AccountNumber: e.accountNumber,
+				Origin: origin.OriginGetOK{
+					Origin:      spec.Origin,
+					ID:          originID,
+					MediaTypeID: spec.MediaTypeID,
+				},
+			})
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		originID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.originService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteOriginWithContext(
+			ctx,
+			origin.DeleteOriginParams{
+				AccountNumber: e.accountNumber,
+				Origin:        origin.OriginGetOK{ID: originID},
+			})
+	},
+}
@@ -0,0 +1,291 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handlers of Origin V3 groups and origins. Only the
+	HTTP Large platform is supported.
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+)
+
+// originV3PageSize is the number of origins fetched per request when listing
+// origins
+const originV3PageSize = 1000
+
+// originV3MediaType is the platform of the groups and origins managed
+var originV3MediaType = enums.HttpLarge.String()
+
+// originV3Service returns the Origin V3 service
+func (e *Engine) originV3Service() (*originv3.Service, error) {
+	return e.client.OriginV3()
+}
+
+// parseInt32ID parses the ID of a resource of an API that uses 32-bit IDs
+func parseInt32ID(id string) (int32, error) {
+	parsed, err := parseNumericID(id)
+	return int32(parsed), err
+}
+
+// formatInt32ID formats the ID of a resource returned by an API that uses
+// 32-bit IDs, which may be missing
+func formatInt32ID(id *int32) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*id))
+}
+
+// stringValue returns the value of a string that may be missing
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// originV3GroupHandler manages HTTP Large origin groups, which are
+// identified by name
+var originV3GroupHandler = kindHandler[originv3.CustomerOriginGroupHTTPRequest]{
+	kindInfo: kindInfo{
+		kind:      KindOriginV3Group,
+		keyField:  "name",
+		numericID: true,
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.originV3Service()
+		if err != nil {
+			return nil, err
+		}
+		groups, err := svc.HttpLargeOnly.GetAllHttpLargeGroupsWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(groups))
+		for i, g := range groups {
+			items[i] = listed{id: formatInt32ID(g.Id), key: stringValue(g.Name)}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originv3.CustomerOriginGroupHTTPRequest,
+	) (interface{}, error) {
+		groupID, err := parseInt32ID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return nil, err
+		}
+		return svc.HttpLargeOnly.GetHttpLargeGroupWithContext(
+			ctx,
+			originv3.GetHttpLargeGroupParams{GroupId: groupID})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec originv3.CustomerOriginGroupHTTPRequest,
+	) (string, error) {
+		svc, err := e.originV3Service()
+		if err != nil {
+			return "", err
+		}
+		group, err := svc.HttpLargeOnly.AddHttpLargeGroupWithContext(
+			ctx,
+			originv3.AddHttpLargeGroupParams{
+				CustomerOriginGroupHTTPRequest: spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return formatInt32ID(group.Id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originv3.CustomerOriginGroupHTTPRequest,
+	) (string, error) {
+		groupID, err := parseInt32ID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return "", err
+		}
+		_, err = svc.HttpLargeOnly.UpdateHttpLargeGroupWithContext(
+			ctx,
+			originv3.UpdateHttpLargeGroupParams{
+				GroupId:                        groupID,
+				CustomerOriginGroupHTTPRequest: spec,
+			})
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		groupID, err := parseInt32ID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return err
+		}
+		return svc.Common.DeleteGroupWithContext(
+			ctx,
+			originv3.DeleteGroupParams{
+				MediaType: originV3MediaType,
+				GroupId:   groupID,
+			})
+	},
+}
+
+// originV3OriginHandler manages HTTP Large origins, which are identified by
+// name
+var originV3OriginHandler = kindHandler[originv3.CustomerOriginRequest]{
+	kindInfo: kindInfo{
+		kind:      KindOriginV3Origin,
+		keyField:  "name",
+		numericID: true,
+		refs: []refField{
+			{path: "group_id", kind: KindOriginV3Group},
+		},
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.originV3Service()
+		if err != nil {
+			return nil, err
+		}
+		it := ecpaging.NewIterator(
+			ctx,
+			func(
+				ctx context.Context,
+				page int,
+			) (ecpaging.Page[originv3.CustomerOrigin], error) {
+				params := originv3.NewGetAllOriginsParams()
+				params.MediaType = originV3MediaType
+				params.PageSize = originV3PageSize
+				params.PageNumber = int32(page)
+				origins, err := svc.Common.GetAllOriginsWithContext(
+					ctx,
+					params)
+				return ecpaging.Page[originv3.CustomerOrigin]{
+					Items:    origins,
+					PageSize: originV3PageSize,
+				}, err
+			},
+			1,
+			ecpaging.Options{})
+		origins, err := it.All()
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(origins))
+		for i, o := range origins {
+			items[i] = listed{id: formatInt32ID(o.Id), key: stringValue(o.Name)}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originv3.CustomerOriginRequest,
+	) (interface{}, error) {
+		originID, err := parseInt32ID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Common.GetOriginWithContext(
+			ctx,
+			originv3.GetOriginParams{
+				MediaType: originV3MediaType,
+				Id:        originID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec originv3.CustomerOriginRequest,
+	) (string, error) {
+		svc, err := e.originV3Service()
+		if err != nil {
+			return "", err
+		}
+		origin, err := svc.Common.AddOriginWithContext(
+			ctx,
+			originv3.AddOriginParams{
+				MediaType:             originV3MediaType,
+				CustomerOriginRequest: spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return formatInt32ID(origin.Id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec originv3.CustomerOriginRequest,
+	) (string, error) {
+		originID, err := parseInt32ID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return "", err
+		}
+		_, err = svc.Common.UpdateOriginWithContext(
+			ctx,
+			originv3.UpdateOriginParams{
+				MediaType:             originV3MediaType,
+				Id:                    originID,
+				CustomerOriginRequest: spec,
+			})
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		originID, err := parseInt32ID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.originV3Service()
+		if err != nil {
+			return err
+		}
+		return svc.Common.DeleteOriginWithContext(
+			ctx,
+			originv3.DeleteOriginParams{
+				MediaType: originV3MediaType,
+				Id:        originID,
+			})
+	},
+}
@@ -0,0 +1,616 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains Plan, the changes needed to make an account match a
+	manifest, and the code that computes it
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+)
+
+// Action is the change made by a Step
+type Action string
+
+// Actions of a Step
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Step is a single change of a Plan
+type Step struct {
+	Action Action `json:"action"`
+	Kind   Kind   `json:"kind"`
+	Name   string `json:"name"`
+
+	// ID is the ID of the live resource. It is empty for a create.
+	ID string `json:"id,omitempty"`
+
+	// Changes lists, for an update, the fields whose live value differs from
+	// the manifest
+	Changes []Change `json:"changes,omitempty"`
+
+	// DependsOn lists the addresses of the resources that the resource
+	// refers to. The step is skipped by Apply if any of them fails.
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// spec is the spec of the resource, whose references are resolved by
+	// Apply
+	spec map[string]interface{}
+
+	// live is the live resource, for a delete
+	live interface{}
+}
+
+// Address returns the address of the resource changed by the step
+func (s Step) Address() string {
+	return address(s.Kind, s.Name)
+}
+
+// Change is a field whose live value differs from the manifest
+type Change struct {
+	// Path is the path of the field in the spec, e.g. scopes[0].name
+	Path string `json:"path"`
+
+	// Desired is the value in the manifest. References to resources that do
+	// not exist yet are left as is.
+	Desired interface{} `json:"desired"`
+
+	// Live is the live value, or nil if the field is not set
+	Live interface{} `json:"live"`
+}
+
+// LiveResource is a live resource that no resource of a manifest manages
+type LiveResource struct {
+	Kind Kind   `json:"kind"`
+	ID   string `json:"id"`
+
+	// Key is the value of the field that identifies the resource, e.g. its
+	// name
+	Key string `json:"key"`
+}
+
+// Plan is the ordered list of changes needed to make an account match a
+// manifest. Resources are created and updated after the resources they
+// refer to, and deleted last, in the reverse order.
+type Plan struct {
+	Steps []Step `json:"steps"`
+
+	// InSync lists the addresses of the resources that match the manifest
+	InSync []string `json:"in_sync,omitempty"`
+
+	// Unmanaged lists the live resources, of the kinds in the manifest, that
+	// no resource of the manifest or state manages. They are not changed.
+	Unmanaged []LiveResource `json:"unmanaged,omitempty"`
+
+	// resources are the resources of the manifest
+	resources []Resource
+
+	// ids holds the IDs of the live resources found, by address
+	ids map[string]string
+}
+
+// HasChanges returns true if applying the plan would change the account
+func (p *Plan) HasChanges() bool {
+	return len(p.Steps) > 0
+}
+
+// String describes the plan, one step per line followed by the changes of
+// updates
+func (p *Plan) String() string {
+	if !p.HasChanges() {
+		return "No changes.\n"
+	}
+
+	symbols := map[Action]string{
+		ActionCreate: "+",
+		ActionUpdate: "~",
+		ActionDelete: "-",
+	}
+
+	var b strings.Builder
+	for _, s := range p.Steps {
+		fmt.Fprintf(&b, "%s %s %s", symbols[s.Action], s.Action, s.Address())
+		if len(s.ID) > 0 {
+			fmt.Fprintf(&b, " (%s)", s.ID)
+		}
+		b.WriteString("\n")
+		for _, c := range s.Changes {
+			fmt.Fprintf(
+				&b,
+				"    %s: %s => %s\n",
+				c.Path,
+				formatValue(c.Live),
+				formatValue(c.Desired))
+		}
+	}
+	return b.String()
+}
+
+// formatValue formats a value of a Change as JSON
+func formatValue(v interface{}) string {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(encoded)
+}
+
+// Plan compares a manifest with the live state of the account and returns
+// the changes needed to make the account match it. State is the state
+// returned by the last Apply, or nil.
+//
+// A resource of the manifest is matched with a live resource by the ID in
+// the manifest, by the ID in the state, or else by its key field, e.g. the
+// name of a WAF rule. Matched resources are updated if any field of their
+// spec differs from the live resource; fields that are not in the spec are
+// ignored. Resources that are in the state but no longer in the manifest
+// are deleted.
+func (e *Engine) Plan(
+	ctx context.Context,
+	m *Manifest,
+	state *State,
+) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("Plan: %w", err)
+	}
+
+	resources := make([]Resource, len(m.Resources))
+	for i, r := range m.Resources {
+		spec, err := normalize(r.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("Plan: %s: %w", r.Address(), err)
+		}
+		r.Spec = spec.(map[string]interface{})
+		resources[i] = r
+	}
+
+	managed := map[string]bool{}
+	for _, r := range resources {
+		managed[r.Address()] = true
+	}
+	var removed []StateResource
+	if state != nil {
+		for _, r := range state.Resources {
+			if _, ok := handlers[r.Kind]; !ok {
+				return nil, fmt.Errorf(
+					"Plan: state: unknown kind %q",
+					r.Kind)
+			}
+			if !managed[r.Address()] {
+				removed = append(removed, r)
+			}
+		}
+	}
+
+	for _, r := range resources {
+		if err := e.checkAccountNumber(r.Kind); err != nil {
+			return nil, fmt.Errorf("Plan: %w", err)
+		}
+	}
+	for _, r := range removed {
+		if err := e.checkAccountNumber(r.Kind); err != nil {
+			return nil, fmt.Errorf("Plan: %w", err)
+		}
+	}
+
+	p := &Plan{resources: resources, ids: map[string]string{}}
+	stateIDs := state.ids()
+
+	// Every kind with a key field is listed once, to find resources by key
+	// and to report unmanaged resources
+	listings := map[Kind][]listed{}
+	listing := func(kind Kind) ([]listed, error) {
+		if items, ok := listings[kind]; ok {
+			return items, nil
+		}
+		items, err := handlers[kind].list(ctx, e)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s resources: %w", kind, err)
+		}
+		listings[kind] = items
+		return items, nil
+	}
+
+	// Find the live resource of every resource of the manifest
+	live := map[string]interface{}{}
+	for _, r := range resources {
+		h := handlers[r.Kind]
+		id := string(r.ID)
+		if len(id) == 0 {
+			id = stateIDs[r.Address()]
+		}
+		if len(id) == 0 && len(h.info().keyField) > 0 {
+			items, err := listing(r.Kind)
+			if err != nil {
+				return nil, fmt.Errorf("Plan: %w", err)
+			}
+			id, err = findByKey(r, items)
+			if err != nil {
+				return nil, fmt.Errorf("Plan: %w", err)
+			}
+		}
+		if len(id) == 0 && !h.info().singleton {
+			continue
+		}
+
+		spec, _ := resolve(r.Spec, placeholderIDs)
+		obj, err := h.get(ctx, e, id, spec)
+		if edgecast.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Plan: reading %s: %w", r.Address(), err)
+		}
+		if h.info().singleton {
+			id = h.info().singletonID(obj)
+		}
+		p.ids[r.Address()] = id
+		live[r.Address()] = obj
+	}
+
+	// Create or update the resources of the manifest, after the resources
+	// they refer to
+	ordered, err := order(resources)
+	if err != nil {
+		return nil, fmt.Errorf("Plan: %w", err)
+	}
+	for _, r := range ordered {
+		deps, _ := references(r.Spec)
+		spec, _ := resolve(r.Spec, p.knownID)
+
+		obj, ok := live[r.Address()]
+		if !ok {
+			p.Steps = append(p.Steps, Step{
+				Action:    ActionCreate,
+				Kind:      r.Kind,
+				Name:      r.Name,
+				DependsOn: deps,
+				spec:      r.Spec,
+			})
+			continue
+		}
+
+		liveSpec, err := handlers[r.Kind].liveSpec(obj)
+		if err != nil {
+			return nil, fmt.Errorf("Plan: %s: %w", r.Address(), err)
+		}
+
+		// Secrets cannot be compared, as the API never returns them
+		removePaths(spec, handlers[r.Kind].info().writeOnly)
+		changes := diff("", spec, liveSpec)
+		if len(changes) == 0 {
+			p.InSync = append(p.InSync, r.Address())
+			continue
+		}
+		p.Steps = append(p.Steps, Step{
+			Action:    ActionUpdate,
+			Kind:      r.Kind,
+			Name:      r.Name,
+			ID:        p.ids[r.Address()],
+			Changes:   changes,
+			DependsOn: deps,
+			spec:      r.Spec,
+		})
+	}
+
+	// Delete the resources that were removed from the manifest, in the
+	// reverse order of creation. Singletons are no longer managed, but are
+	// left as they are.
+	sort.SliceStable(removed, func(i, j int) bool {
+		return ranks[removed[i].Kind] > ranks[removed[j].Kind]
+	})
+	deleted := map[Kind]map[string]bool{}
+	for _, r := range removed {
+		h := handlers[r.Kind]
+		if h.info().singleton {
+			continue
+		}
+		obj, err := h.get(ctx, e, r.ID, nil)
+		if edgecast.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Plan: reading %s: %w", r.Address(), err)
+		}
+		p.Steps = append(p.Steps, Step{
+			Action: ActionDelete,
+			Kind:   r.Kind,
+			Name:   r.Name,
+			ID:     r.ID,
+			live:   obj,
+		})
+		if deleted[r.Kind] == nil {
+			deleted[r.Kind] = map[string]bool{}
+		}
+		deleted[r.Kind][r.ID] = true
+	}
+
+	// Report the live resources that nothing manages, for every kind in the
+	// manifest or state that can be listed
+	kinds := map[Kind]bool{}
+	for _, r := range resources {
+		kinds[r.Kind] = true
+	}
+	for _, r := range removed {
+		kinds[r.Kind] = true
+	}
+	for _, kind := range Kinds() {
+		if !kinds[kind] || len(handlers[kind].info().keyField) == 0 {
+			continue
+		}
+		items, err := listing(kind)
+		if err != nil {
+			return nil, fmt.Errorf("Plan: %w", err)
+		}
+		found := map[string]bool{}
+		for addr, id := range p.ids {
+			if k, _ := splitAddress(addr); k == kind {
+				found[id] = true
+			}
+		}
+		for _, item := range items {
+			if !found[item.id] && !deleted[kind][item.id] {
+				p.Unmanaged = append(p.Unmanaged, LiveResource{
+					Kind: kind,
+					ID:   item.id,
+					Key:  item.key,
+				})
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// knownID resolves a reference to the ID of a live resource, as a number if
+// the API uses numeric IDs
+func (p *Plan) knownID(addr string) (interface{}, bool) {
+	id, ok := p.ids[addr]
+	if !ok {
+		return nil, false
+	}
+	return typedID(addr, id), true
+}
+
+// typedID returns an ID as a number if the API of the kind of the resource
+// uses numeric IDs, and as a string otherwise
+func typedID(addr string, id string) interface{} {
+	kind, _ := splitAddress(addr)
+	if handlers[kind].info().numericID {
+		return json.Number(id)
+	}
+	return id
+}
+
+// findByKey returns the ID of the listed resource whose key matches that of
+// a resource of the manifest, or an empty string if there is none
+func findByKey(r Resource, items []listed) (string, error) {
+	key, _ := r.Spec[handlers[r.Kind].info().keyField].(string)
+	if len(key) == 0 {
+		return "", nil
+	}
+
+	var ids []string
+	for _, item := range items {
+		if item.key == key {
+			ids = append(ids, item.id)
+		}
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf(
+			"%s: %d %s resources are named %q (%s): set the ID of the "+
+				"resource to choose one",
+			r.Address(),
+			len(ids),
+			r.Kind,
+			key,
+			strings.Join(ids, ", "))
+	}
+	if len(ids) == 1 {
+		return ids[0], nil
+	}
+	return "", nil
+}
+
+// order sorts resources so that every resource comes after the resources it
+// refers to. Resources that do not depend on each other are sorted by kind,
+// in the order of Kinds, and then by their order in the manifest.
+func order(resources []Resource) ([]Resource, error) {
+	index := make(map[string]int, len(resources))
+	for i, r := range resources {
+		index[r.Address()] = i
+	}
+
+	// pending counts the unsorted references of each resource
+	pending := make([]int, len(resources))
+	dependents := make([][]int, len(resources))
+	for i, r := range resources {
+		refs, err := references(r.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Address(), err)
+		}
+		for _, ref := range refs {
+			j, ok := index[ref]
+			if !ok {
+				continue
+			}
+			pending[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	less := func(i, j int) bool {
+		ri, rj := ranks[resources[i].Kind], ranks[resources[j].Kind]
+		if ri != rj {
+			return ri < rj
+		}
+		return i < j
+	}
+
+	var ready []int
+	for i := range resources {
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	sorted := make([]Resource, 0, len(resources))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(a, b int) bool {
+			return less(ready[a], ready[b])
+		})
+		next := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, resources[next])
+
+		for _, d := range dependents[next] {
+			pending[d]--
+			if pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	if len(sorted) < len(resources) {
+		var cycle []string
+		for i, r := range resources {
+			if pending[i] > 0 {
+				cycle = append(cycle, r.Address())
+			}
+		}
+		return nil, fmt.Errorf("%w: %s", errCycle, strings.Join(cycle, ", "))
+	}
+	return sorted, nil
+}
+
+// normalize converts v to the maps, slices and scalars that its JSON
+// encoding decodes to, with numbers decoded as json.Number
+func normalize(v interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&normalized); err != nil {
+		return nil, err
+	}
+	if normalized == nil {
+		normalized = map[string]interface{}{}
+	}
+	return normalized, nil
+}
+
+// diff returns the fields of desired whose value differs in live. Fields
+// that are missing from desired are ignored, and fields that are missing from
+// live are taken to be zero, as models omit empty fields.
+func diff(path string, desired interface{}, live interface{}) []Change {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			if live == nil && isZero(d) {
+				return nil
+			}
+			return []Change{{Path: path, Desired: desired, Live: live}}
+		}
+		var changes []Change
+		for _, k := range sortedKeys(d) {
+			changes = append(changes, diff(joinPath(path, k), d[k], l[k])...)
+		}
+		return changes
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok && live == nil && isZero(d) {
+			return nil
+		}
+		if !ok || len(l) != len(d) {
+			return []Change{{Path: path, Desired: desired, Live: live}}
+		}
+		var changes []Change
+		for i := range d {
+			changes = append(
+				changes,
+				diff(fmt.Sprintf("%s[%d]", path, i), d[i], l[i])...)
+		}
+		return changes
+	}
+
+	if equalScalars(desired, live) {
+		return nil
+	}
+	return []Change{{Path: path, Desired: desired, Live: live}}
+}
+
+// equalScalars compares scalars, treating a missing live value as zero and
+// comparing numbers by value
+func equalScalars(desired interface{}, live interface{}) bool {
+	if live == nil {
+		return isZero(desired)
+	}
+
+	dn, dok := desired.(json.Number)
+	ln, lok := live.(json.Number)
+	if dok && lok {
+		df, derr := dn.Float64()
+		lf, lerr := ln.Float64()
+		if derr == nil && lerr == nil {
+			return df == lf
+		}
+		return dn == ln
+	}
+
+	return desired == live
+}
+
+// isZero returns true if v is nil or the zero value of its JSON type
+func isZero(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !t
+	case string:
+		return len(t) == 0
+	case json.Number:
+		f, err := t.Float64()
+		return err == nil && f == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+// joinPath appends a field to the path of a Change
+func joinPath(path string, field string) string {
+	if len(path) == 0 {
+		return field
+	}
+	return path + "." + field
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
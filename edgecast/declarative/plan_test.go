@@ -0,0 +1,141 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	ids := func(addr string) (interface{}, bool) {
+		switch addr {
+		case "originv3-group.group":
+			return json.Number("12"), true
+		case "waf-access-rule.acl":
+			return "abc", true
+		}
+		return nil, false
+	}
+
+	cases := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected map[string]interface{}
+		complete bool
+	}{
+		{
+			name: "whole string",
+			spec: map[string]interface{}{
+				"id": "${originv3-group.group.id}",
+			},
+			expected: map[string]interface{}{"id": json.Number("12")},
+			complete: true,
+		},
+		{
+			name: "embedded",
+			spec: map[string]interface{}{
+				"list": []interface{}{
+					"${originv3-group.group.id}-${waf-access-rule.acl.id}",
+				},
+			},
+			expected: map[string]interface{}{
+				"list": []interface{}{"12-abc"},
+			},
+			complete: true,
+		},
+		{
+			name: "unresolved",
+			spec: map[string]interface{}{
+				"id":   "${waf-access-rule.other.id}",
+				"name": "${waf-access-rule.acl.id}",
+			},
+			expected: map[string]interface{}{
+				"id":   "${waf-access-rule.other.id}",
+				"name": "abc",
+			},
+			complete: false,
+		},
+	}
+
+	for _, c := range cases {
+		got, complete := resolve(c.spec, ids)
+		if !reflect.DeepEqual(got, c.expected) || complete != c.complete {
+			t.Fatalf(
+				"%s: Expected %+v, %v but got %+v, %v",
+				c.name,
+				c.expected,
+				c.complete,
+				got,
+				complete)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		desired  string
+		live     string
+		expected []Change
+	}{
+		{
+			name:    "ignored fields",
+			desired: `{"name": "a", "count": 1}`,
+			live:    `{"name": "a", "count": 1.0, "id": "x"}`,
+		},
+		{
+			name:    "zero values",
+			desired: `{"enabled": false, "list": [], "text": "", "n": 0}`,
+			live:    `{}`,
+		},
+		{
+			name:    "nested",
+			desired: `{"scopes": [{"name": "a", "host": {"type": "EM"}}]}`,
+			live:    `{"scopes": [{"name": "b", "host": {"type": "GLOB"}}]}`,
+			expected: []Change{
+				{Path: "scopes[0].host.type", Desired: "EM", Live: "GLOB"},
+				{Path: "scopes[0].name", Desired: "a", Live: "b"},
+			},
+		},
+		{
+			name:    "missing",
+			desired: `{"name": "a", "list": [1]}`,
+			live:    `{"list": [1, 2]}`,
+			expected: []Change{
+				{
+					Path:    "list",
+					Desired: []interface{}{json.Number("1")},
+					Live: []interface{}{
+						json.Number("1"),
+						json.Number("2"),
+					},
+				},
+				{Path: "name", Desired: "a", Live: nil},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		desired := decodeTestJSON(t, c.desired)
+		live := decodeTestJSON(t, c.live)
+		got := diff("", desired, live)
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, got)
+		}
+	}
+}
+
+func decodeTestJSON(t *testing.T, s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	normalized, err := normalize(v)
+	if err != nil {
+		t.Fatalf("normalize: %v", err)
+	}
+	return normalized
+}
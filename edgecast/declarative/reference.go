@@ -0,0 +1,152 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the code that finds and resolves references between
+	resources, written as ${<kind>.<name>.id}
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// referencePattern matches a reference, or anything else written as ${...}
+var referencePattern = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// address returns the address of a resource, <kind>.<name>
+func address(kind Kind, name string) string {
+	return string(kind) + "." + name
+}
+
+// splitAddress returns the kind and name of an address
+func splitAddress(addr string) (Kind, string) {
+	kind, name, _ := strings.Cut(addr, ".")
+	return Kind(kind), name
+}
+
+// parseReference returns the address of the resource referred to by the
+// contents of ${...}
+func parseReference(ref string) (string, error) {
+	parts := strings.Split(ref, ".")
+	if len(parts) != 3 || parts[2] != "id" ||
+		len(parts[0]) == 0 || !namePattern.MatchString(parts[1]) {
+		return "", fmt.Errorf(
+			"invalid reference ${%s}: must be ${<kind>.<name>.id}",
+			ref)
+	}
+	return address(Kind(parts[0]), parts[1]), nil
+}
+
+// references returns the sorted addresses of the resources referred to by a
+// spec
+func references(spec interface{}) ([]string, error) {
+	found := map[string]bool{}
+	var walk func(v interface{}) error
+	walk = func(v interface{}) error {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for _, item := range t {
+				if err := walk(item); err != nil {
+					return err
+				}
+			}
+		case []interface{}:
+			for _, item := range t {
+				if err := walk(item); err != nil {
+					return err
+				}
+			}
+		case string:
+			for _, m := range referencePattern.FindAllStringSubmatch(t, -1) {
+				addr, err := parseReference(m[1])
+				if err != nil {
+					return err
+				}
+				found[addr] = true
+			}
+		}
+		return nil
+	}
+
+	if err := walk(spec); err != nil {
+		return nil, err
+	}
+
+	refs := make([]string, 0, len(found))
+	for addr := range found {
+		refs = append(refs, addr)
+	}
+	sort.Strings(refs)
+	return refs, nil
+}
+
+// resolve returns a copy of spec with every reference that ids can resolve
+// replaced by the ID of the resource. A string that consists of a single
+// reference is replaced by the ID itself, so that numeric IDs stay numbers;
+// references within longer strings are replaced by the text of the ID. The
+// result reports whether every reference was resolved.
+func resolve(
+	spec map[string]interface{},
+	ids func(addr string) (interface{}, bool),
+) (map[string]interface{}, bool) {
+	complete := true
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			m := make(map[string]interface{}, len(t))
+			for k, item := range t {
+				m[k] = walk(item)
+			}
+			return m
+		case []interface{}:
+			s := make([]interface{}, len(t))
+			for i, item := range t {
+				s[i] = walk(item)
+			}
+			return s
+		case string:
+			matches := referencePattern.FindAllStringSubmatchIndex(t, -1)
+			if len(matches) == 0 {
+				return t
+			}
+
+			// A single reference that makes up the whole string
+			if len(matches) == 1 && matches[0][0] == 0 &&
+				matches[0][1] == len(t) {
+				addr, err := parseReference(t[matches[0][2]:matches[0][3]])
+				if err != nil {
+					return t
+				}
+				id, ok := ids(addr)
+				if !ok {
+					complete = false
+					return t
+				}
+				return id
+			}
+
+			replace := func(s string) string {
+				addr, err := parseReference(s[2 : len(s)-1])
+				if err != nil {
+					return s
+				}
+				id, ok := ids(addr)
+				if !ok {
+					complete = false
+					return s
+				}
+				return fmt.Sprint(id)
+			}
+			return referencePattern.ReplaceAllStringFunc(t, replace)
+		}
+		return v
+	}
+
+	return walk(spec).(map[string]interface{}), complete
+}
@@ -0,0 +1,628 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handlers of Route DNS zones, groups, TSIG keys,
+	master server groups and secondary zone groups. The Route DNS API can only
+	list master server groups, so the others are found by ID only.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/routedns"
+)
+
+// routeDNSService returns the Route DNS service
+func (e *Engine) routeDNSService() (*routedns.RouteDNSService, error) {
+	return e.client.RouteDNS()
+}
+
+// dnsReadOnly lists the fields of zones and groups, and of their records and
+// health checks, that are set by the API
+var dnsReadOnly = []string{
+	"Serial",
+	"**.ZoneId",
+	"**.FixedZoneId",
+	"**.RecordId",
+	"**.FixedRecordId",
+	"**.GroupId",
+	"**.FixedGroupId",
+	"**.GroupFixedId",
+	"**.VerifyId",
+	"**.RecordTypeName",
+	"**.StatusName",
+	"**.HealthCheck.Id",
+	"**.HealthCheck.FixedId",
+	"**.HealthCheck.Status",
+	"**.HealthCheck.UserId",
+}
+
+var dnsZoneHandler = kindHandler[routedns.Zone]{
+	kindInfo: kindInfo{
+		kind:      KindDNSZone,
+		numericID: true,
+		legacy:    true,
+		nameField: "DomainName",
+		readOnly:  dnsReadOnly,
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.Zone,
+	) (interface{}, error) {
+		zoneID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetZoneWithContext(
+			ctx,
+			routedns.GetZoneParams{
+				AccountNumber: e.accountNumber,
+				ZoneID:        zoneID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec routedns.Zone,
+	) (string, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		id, err := svc.AddZoneWithContext(
+			ctx,
+			routedns.AddZoneParams{
+				AccountNumber: e.accountNumber,
+				Zone:          spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(*id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.Zone,
+	) (string, error) {
+		zoneID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+
+		// The groups of ZoneGetOK hide those of the embedded Zone
+		zone := routedns.ZoneGetOK{
+			Zone:        spec,
+			FixedZoneID: zoneID,
+			ZoneID:      zoneID,
+		}
+		for _, g := range spec.Groups {
+			zone.Groups = append(
+				zone.Groups,
+				routedns.DnsRouteGroupOK{DnsRouteGroup: g})
+		}
+
+		return id, svc.UpdateZoneWithContext(
+			ctx,
+			routedns.UpdateZoneParams{
+				AccountNumber: e.accountNumber,
+				Zone:          zone,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		zoneID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteZoneWithContext(
+			ctx,
+			routedns.DeleteZoneParams{
+				AccountNumber: e.accountNumber,
+				Zone:          routedns.ZoneGetOK{FixedZoneID: zoneID},
+			})
+	},
+}
+
+// dnsGroupHandler manages load balancing and failover groups. The Route DNS
+// API assigns a new ID to a group each time it is updated.
+var dnsGroupHandler = kindHandler[routedns.DnsRouteGroup]{
+	kindInfo: kindInfo{
+		kind:      KindDNSGroup,
+		numericID: true,
+		legacy:    true,
+		nameField: "Name",
+		readOnly:  dnsReadOnly,
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.DnsRouteGroup,
+	) (interface{}, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+
+		// Groups are read by ID and product type. The product type is not
+		// known when deleting a group that was removed from the manifest, in
+		// which case both are tried.
+		productTypes := []routedns.GroupProductType{spec.GroupProductType}
+		if spec.GroupProductType == 0 {
+			productTypes = []routedns.GroupProductType{
+				routedns.LoadBalancing,
+				routedns.Failover,
+			}
+		}
+
+		for _, productType := range productTypes {
+			var group *routedns.DnsRouteGroupOK
+			group, err = svc.GetGroupWithContext(
+				ctx,
+				routedns.GetGroupParams{
+					AccountNumber:    e.accountNumber,
+					GroupID:          groupID,
+					GroupProductType: productType,
+				})
+			if err == nil || !edgecast.IsNotFound(err) {
+				return group, err
+			}
+		}
+		return nil, err
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec routedns.DnsRouteGroup,
+	) (string, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		id, err := svc.AddGroupWithContext(
+			ctx,
+			routedns.AddGroupParams{
+				AccountNumber: e.accountNumber,
+				Group:         spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(*id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.DnsRouteGroup,
+	) (string, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		params := &routedns.UpdateGroupParams{
+			AccountNumber: e.accountNumber,
+			Group: &routedns.DnsRouteGroupOK{
+				DnsRouteGroup: spec,
+				GroupID:       groupID,
+			},
+		}
+		if err := svc.UpdateGroupWithContext(ctx, params); err != nil {
+			return "", err
+		}
+		return strconv.Itoa(params.Group.GroupID), nil
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return err
+		}
+		group := *live.(*routedns.DnsRouteGroupOK)
+		group.GroupID = groupID
+		return svc.DeleteGroupWithContext(
+			ctx,
+			routedns.DeleteGroupParams{
+				AccountNumber: e.accountNumber,
+				Group:         group,
+			})
+	},
+}
+
+var dnsTSIGHandler = kindHandler[routedns.TSIG]{
+	kindInfo: kindInfo{
+		kind:      KindDNSTSIG,
+		numericID: true,
+		legacy:    true,
+		nameField: "Alias",
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.TSIG,
+	) (interface{}, error) {
+		tsigID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetTSIGWithContext(
+			ctx,
+			routedns.GetTSIGParams{
+				AccountNumber: e.accountNumber,
+				TSIGID:        tsigID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec routedns.TSIG,
+	) (string, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		id, err := svc.AddTSIGWithContext(
+			ctx,
+			routedns.AddTSIGParams{
+				AccountNumber: e.accountNumber,
+				TSIG:          spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(*id), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.TSIG,
+	) (string, error) {
+		tsigID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.UpdateTSIGWithContext(
+			ctx,
+			routedns.UpdateTSIGParams{
+				AccountNumber: e.accountNumber,
+				TSIG:          routedns.TSIGGetOK{TSIG: spec, ID: tsigID},
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		tsigID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteTSIGWithContext(
+			ctx,
+			routedns.DeleteTSIGParams{
+				AccountNumber: e.accountNumber,
+				TSIG:          routedns.TSIGGetOK{ID: tsigID},
+			})
+	},
+}
+
+// masterServerGroup is the spec of a master server group
+type masterServerGroup = routedns.MasterServerGroupAddRequest
+
+// dnsMasterServerGroupHandler manages master server groups, which are
+// identified by name. Master servers are created with their group, and are
+// exported without their IDs.
+var dnsMasterServerGroupHandler = kindHandler[masterServerGroup]{
+	kindInfo: kindInfo{
+		kind:      KindDNSMasterServerGroup,
+		keyField:  "Name",
+		numericID: true,
+		legacy:    true,
+		readOnly:  []string{"MasterServers.*.Id"},
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+		groups, err := svc.GetAllMasterServerGroupsWithContext(
+			ctx,
+			routedns.GetAllMasterServerGroupsParams{
+				AccountNumber: e.accountNumber,
+			})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*groups))
+		for i, g := range *groups {
+			items[i] = listed{id: strconv.Itoa(g.MasterGroupID), key: g.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec masterServerGroup,
+	) (interface{}, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetMasterServerGroupWithContext(
+			ctx,
+			routedns.GetMasterServerGroupParams{
+				AccountNumber:       e.accountNumber,
+				MasterServerGroupID: groupID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec masterServerGroup,
+	) (string, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		group, err := svc.AddMasterServerGroupWithContext(
+			ctx,
+			routedns.AddMasterServerGroupParams{
+				AccountNumber:     e.accountNumber,
+				MasterServerGroup: spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(group.MasterGroupID), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec masterServerGroup,
+	) (string, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.UpdateMasterServerGroupWithContext(
+			ctx,
+			routedns.UpdateMasterServerGroupParams{
+				AccountNumber: e.accountNumber,
+				MasterServerGroup: routedns.MasterServerGroupUpdateRequest{
+					MasterServerGroup: routedns.MasterServerGroup{
+						Name:    spec.Name,
+						Masters: spec.Masters,
+					},
+					MasterGroupID: groupID,
+				},
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteMasterServerGroupWithContext(
+			ctx,
+			routedns.DeleteMasterServerGroupParams{
+				AccountNumber: e.accountNumber,
+				MasterServerGroup: routedns.MasterServerGroupAddGetOK{
+					MasterGroupID: groupID,
+				},
+			})
+	},
+	// Live groups hold their master servers in Masters rather than
+	// MasterServers
+	specFunc: func(live interface{}) (masterServerGroup, error) {
+		group, ok := live.(*routedns.MasterServerGroupAddGetOK)
+		if !ok {
+			return masterServerGroup{}, fmt.Errorf(
+				"unexpected master server group %T",
+				live)
+		}
+		return masterServerGroup{
+			Name:    group.Name,
+			Masters: group.Masters,
+		}, nil
+	},
+}
+
+// dnsSecondaryZoneGroupHandler manages secondary zone groups. The master
+// servers to which TSIG keys are assigned are identified by the IDs of the
+// master servers of a master server group, which cannot be referred to.
+var dnsSecondaryZoneGroupHandler = kindHandler[routedns.SecondaryZoneGroup]{
+	kindInfo: kindInfo{
+		kind:      KindDNSSecondaryZoneGroup,
+		numericID: true,
+		legacy:    true,
+		nameField: "Name",
+		refs: []refField{
+			{
+				path: "ZoneComposition.MasterGroupId",
+				kind: KindDNSMasterServerGroup,
+			},
+			{
+				path: "ZoneComposition.MasterServerTsigs.*.Tsig.Id",
+				kind: KindDNSTSIG,
+			},
+		},
+		unmapped: []string{
+			"ZoneComposition.MasterServerTsigs.*.MasterServer.Id",
+		},
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.SecondaryZoneGroup,
+	) (interface{}, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetSecondaryZoneGroupWithContext(
+			ctx,
+			routedns.GetSecondaryZoneGroupParams{
+				AccountNumber: e.accountNumber,
+				ID:            groupID,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec routedns.SecondaryZoneGroup,
+	) (string, error) {
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+		group, err := svc.AddSecondaryZoneGroupWithContext(
+			ctx,
+			routedns.AddSecondaryZoneGroupParams{
+				AccountNumber:      e.accountNumber,
+				SecondaryZoneGroup: spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		return strconv.Itoa(group.ID), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec routedns.SecondaryZoneGroup,
+	) (string, error) {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return "", err
+		}
+
+		// The update request uses the model of the response, whose fields
+		// have the same JSON names
+		var group routedns.SecondaryZoneGroupResponseOK
+		encoded, err := json.Marshal(spec)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(encoded, &group); err != nil {
+			return "", err
+		}
+		group.ID = groupID
+
+		return id, svc.UpdateSecondaryZoneGroupWithContext(
+			ctx,
+			routedns.UpdateSecondaryZoneGroupParams{
+				AccountNumber:      e.accountNumber,
+				SecondaryZoneGroup: group,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		groupID, err := parseNumericID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.routeDNSService()
+		if err != nil {
+			return err
+		}
+		return svc.DeleteSecondaryZoneGroupWithContext(
+			ctx,
+			routedns.DeleteSecondaryZoneGroupParams{
+				AccountNumber: e.accountNumber,
+				SecondaryZoneGroup: routedns.SecondaryZoneGroupResponseOK{
+					ID: groupID,
+				},
+			})
+	},
+}
@@ -0,0 +1,378 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handlers of Real-Time Log Delivery profiles
+*/
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_cdn"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_rl"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtldmodels"
+)
+
+// rtldService returns the Real-Time Log Delivery service
+func (e *Engine) rtldService() (*rtld.RtldService, error) {
+	return e.client.RTLD()
+}
+
+// rtldReadOnly lists the fields of log delivery profiles that are set by the
+// API. Masked values, e.g. masked_password and masked_api_key, are returned in
+// place of the secrets of the delivery destinations, which cannot be read.
+var rtldReadOnly = []string{
+	"id",
+	"account_number",
+	"created_by",
+	"created_on",
+	"last_modified_by",
+	"last_modified_on",
+	"**.@id",
+	"**.@type",
+	"**.masked_*",
+}
+
+// rtldWriteOnly lists the secrets of the delivery destinations of log
+// delivery profiles, which the API never returns. The URL of a Sumo Logic
+// destination holds its token.
+var rtldWriteOnly = []string{
+	"*.password",
+	"*.token",
+	"*.api_key",
+	"*.access_key",
+	"sumo_logic.url",
+}
+
+// rtldProfile describes the log delivery profile kinds, which are
+// identified by profile name
+func rtldProfile(kind Kind) kindInfo {
+	return kindInfo{
+		kind:      kind,
+		keyField:  "profile_name",
+		numericID: true,
+		readOnly:  rtldReadOnly,
+		writeOnly: rtldWriteOnly,
+	}
+}
+
+// formatProfileID formats the ID of a log delivery profile
+func formatProfileID(id int32) string {
+	return strconv.Itoa(int(id))
+}
+
+var rtldCDNProfileHandler = kindHandler[rtldmodels.CdnProfileDto]{
+	kindInfo: rtldProfile(KindRTLDCDNProfile),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesCdn.ProfilesGetCustomerSettingsWithContext(
+			ctx,
+			profiles_cdn.NewProfilesGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(resp.Items))
+		for i, p := range resp.Items {
+			items[i] = listed{id: formatProfileID(p.ID), key: p.ProfileName}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rtldmodels.CdnProfileDto,
+	) (interface{}, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_cdn.NewProfilesGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesCdn.ProfilesGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec rtldmodels.CdnProfileDto,
+	) (string, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_cdn.NewProfilesAddCustomerSettingParams()
+		params.SettingDto = &spec
+		resp, err := svc.ProfilesCdn.ProfilesAddCustomerSettingWithContext(
+			ctx,
+			params)
+		if err != nil {
+			return "", err
+		}
+		return formatProfileID(resp.ID), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rtldmodels.CdnProfileDto,
+	) (string, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_cdn.NewProfilesUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &spec
+		_, err = svc.ProfilesCdn.ProfilesUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return err
+		}
+		params := profiles_cdn.NewProfilesDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesCdn.ProfilesDeleteCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+		return err
+	},
+}
+
+var rtldWAFProfileHandler = kindHandler[rtldmodels.WafProfileDto]{
+	kindInfo: rtldProfile(KindRTLDWAFProfile),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesWaf.ProfilesWafGetCustomerSettingsWithContext(
+			ctx,
+			profiles_waf.NewProfilesWafGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(resp.Items))
+		for i, p := range resp.Items {
+			items[i] = listed{id: formatProfileID(p.ID), key: p.ProfileName}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rtldmodels.WafProfileDto,
+	) (interface{}, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_waf.NewProfilesWafGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesWaf.ProfilesWafGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec rtldmodels.WafProfileDto,
+	) (string, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_waf.NewProfilesWafAddCustomerSettingParams()
+		params.SettingDto = &spec
+		resp, err := svc.ProfilesWaf.ProfilesWafAddCustomerSettingWithContext(
+			ctx,
+			params)
+		if err != nil {
+			return "", err
+		}
+		return formatProfileID(resp.ID), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rtldmodels.WafProfileDto,
+	) (string, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_waf.NewProfilesWafUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &spec
+		_, err = svc.ProfilesWaf.ProfilesWafUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return err
+		}
+		params := profiles_waf.NewProfilesWafDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesWaf.
+			ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx, params)
+		return err
+	},
+}
+
+// rateLimitingProfile is the model of rate limiting log delivery profiles
+type rateLimitingProfile = rtldmodels.RateLimitingProfileDto
+
+var rtldRateLimitingProfileHandler = kindHandler[rateLimitingProfile]{
+	kindInfo: rtldProfile(KindRTLDRateLimitingProfile),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.ProfilesRl.
+			ProfilesRateLimitingGetCustomerSettingsWithContext(
+				ctx,
+				profiles_rl.NewProfilesRateLimitingGetCustomerSettingsParams())
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(resp.Items))
+		for i, p := range resp.Items {
+			items[i] = listed{id: formatProfileID(p.ID), key: p.ProfileName}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rateLimitingProfile,
+	) (interface{}, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return nil, err
+		}
+		params := profiles_rl.NewProfilesRlGetCustomerSettingsByIDParams()
+		params.ID = profileID
+		return svc.ProfilesRl.ProfilesRlGetCustomerSettingsByIDWithContext(
+			ctx,
+			params)
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec rateLimitingProfile,
+	) (string, error) {
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_rl.
+			NewProfilesRateLimitingAddCustomerSettingParams()
+		params.SettingDto = &spec
+		resp, err := svc.ProfilesRl.
+			ProfilesRateLimitingAddCustomerSettingWithContext(ctx, params)
+		if err != nil {
+			return "", err
+		}
+		return formatProfileID(resp.ID), nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rateLimitingProfile,
+	) (string, error) {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return "", err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return "", err
+		}
+		params := profiles_rl.NewProfilesRlUpdateCustomerSettingParams()
+		params.ID = profileID
+		params.Body = &spec
+		_, err = svc.ProfilesRl.ProfilesRlUpdateCustomerSettingWithContext(
+			ctx,
+			params)
+		return id, err
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		profileID, err := parseInt32ID(id)
+		if err != nil {
+			return err
+		}
+		svc, err := e.rtldService()
+		if err != nil {
+			return err
+		}
+		params := profiles_rl.NewProfilesRlDeleteCustomerSettingsByIDParams()
+		params.ID = profileID
+		_, err = svc.ProfilesRl.
+			ProfilesRlDeleteCustomerSettingsByIDWithContext(ctx, params)
+		return err
+	},
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handler of Rules Engine policies
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rulesengine"
+)
+
+// rulesEnginePolicyType is the type of the policies that are created
+const rulesEnginePolicyType = "policy-create"
+
+// rulesEngineService returns the Rules Engine service
+func (e *Engine) rulesEngineService() (
+	*rulesengine.RulesEngineService,
+	error,
+) {
+	return e.client.RulesEngine()
+}
+
+// createRulesEnginePolicy creates a policy and returns its ID
+func createRulesEnginePolicy(
+	ctx context.Context,
+	e *Engine,
+	spec rulesengine.Policy,
+) (string, error) {
+	svc, err := e.rulesEngineService()
+	if err != nil {
+		return "", err
+	}
+	if len(spec.Type) == 0 {
+		spec.Type = rulesEnginePolicyType
+	}
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	resp, err := svc.AddPolicyWithContext(
+		ctx,
+		rulesengine.AddPolicyParams{
+			AccountNumber:  e.accountNumber,
+			PolicyAsString: string(encoded),
+		})
+	if err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// rulesEnginePolicyHandler manages Rules Engine policies. The API can
+// neither list, change nor delete policies, so a policy is updated by
+// creating a new one, which is given a new ID, and cannot be deleted.
+var rulesEnginePolicyHandler = kindHandler[rulesengine.Policy]{
+	kindInfo: kindInfo{
+		kind:      KindRulesEnginePolicy,
+		numericID: true,
+		legacy:    true,
+		nameField: "name",
+		readOnly: []string{
+			"**.id",
+			"**.@id",
+			"**.ordinal",
+			"**.created_at",
+			"**.updated_at",
+		},
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rulesengine.Policy,
+	) (interface{}, error) {
+		policyID, err := parseNumericID(id)
+		if err != nil {
+			return nil, err
+		}
+		svc, err := e.rulesEngineService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.GetPolicyWithContext(
+			ctx,
+			rulesengine.GetPolicyParams{
+				AccountNumber: e.accountNumber,
+				PolicyID:      policyID,
+			})
+	},
+	createFunc: createRulesEnginePolicy,
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rulesengine.Policy,
+	) (string, error) {
+		return createRulesEnginePolicy(ctx, e, spec)
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		return fmt.Errorf(
+			"%s resources cannot be deleted",
+			KindRulesEnginePolicy)
+	},
+	// Live policies are of another type than the policies that are created
+	specFunc: func(live interface{}) (rulesengine.Policy, error) {
+		var policy rulesengine.Policy
+		encoded, err := json.Marshal(live)
+		if err != nil {
+			return policy, err
+		}
+		if err := json.Unmarshal(encoded, &policy); err != nil {
+			return policy, err
+		}
+		policy.Type = rulesEnginePolicyType
+		return policy, nil
+	},
+}
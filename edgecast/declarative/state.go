@@ -0,0 +1,93 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains State, the record of the resources managed by a
+	manifest
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// StateVersion is the version of the state format written by this package
+const StateVersion = "v1"
+
+// State records the IDs of the resources managed by a manifest. It is
+// returned by Apply and passed to the next Plan, which uses it to find
+// resources that cannot be found by their key field, e.g. Route DNS zones,
+// and to delete the resources that were removed from the manifest.
+type State struct {
+	// Version is the version of the state format, i.e. StateVersion
+	Version string `json:"version"`
+
+	// Resources are the managed resources, in the order of the manifest
+	Resources []StateResource `json:"resources"`
+}
+
+// StateResource records the ID of a managed resource
+type StateResource struct {
+	Kind Kind   `json:"kind"`
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// Address returns the address of the resource, <kind>.<name>
+func (r StateResource) Address() string {
+	return address(r.Kind, r.Name)
+}
+
+// LoadState reads a state from a JSON file. A file that does not exist is
+// read as an empty state, as before the first Apply.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &State{Version: StateVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoadState: %w", err)
+	}
+
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("LoadState: %s: %w", path, err)
+	}
+	if s.Version != StateVersion {
+		return nil, fmt.Errorf(
+			"LoadState: %s: unsupported state version %q: must be %q",
+			path,
+			s.Version,
+			StateVersion)
+	}
+	return &s, nil
+}
+
+// Save writes the state to a JSON file
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("State.Save: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("State.Save: %w", err)
+	}
+	return nil
+}
+
+// ids returns the IDs of the resources of the state by address
+func (s *State) ids() map[string]string {
+	ids := map[string]string{}
+	if s == nil {
+		return ids
+	}
+	for _, r := range s.Resources {
+		ids[r.Address()] = r.ID
+	}
+	return ids
+}
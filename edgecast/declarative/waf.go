@@ -0,0 +1,687 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package declarative
+
+/*
+	This file contains the handlers of WAF rules, bot managers and scopes
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/bot"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/custom"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/managed"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/rate"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/scopes"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+// wafService returns the WAF service
+func (e *Engine) wafService() (*waf.WafService, error) {
+	return e.client.WAF()
+}
+
+// wafReadOnly lists the fields of WAF rules and bot managers that are set by
+// the API
+var wafReadOnly = []string{
+	"id",
+	"customer_id",
+	"created_date",
+	"last_modified_date",
+	"last_modified_by",
+	"version",
+}
+
+// wafRule describes the WAF rule kinds, which are identified by name. Fields
+// set by the API in addition to wafReadOnly are given by readOnly.
+func wafRule(kind Kind, readOnly ...string) kindInfo {
+	return kindInfo{
+		kind:     kind,
+		keyField: "name",
+		legacy:   true,
+		readOnly: append(readOnly, wafReadOnly...),
+	}
+}
+
+var wafAccessRuleHandler = kindHandler[access.AccessRule]{
+	kindInfo: wafRule(KindWAFAccessRule),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.Access.GetAllAccessRulesWithContext(
+			ctx,
+			access.GetAllAccessRulesParams{AccountNumber: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*resp))
+		for i, item := range *resp {
+			items[i] = listed{id: item.ID, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec access.AccessRule,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Access.GetAccessRuleWithContext(
+			ctx,
+			access.GetAccessRuleParams{
+				AccountNumber: e.accountNumber,
+				AccessRuleID:  id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec access.AccessRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return svc.Access.AddAccessRuleWithContext(
+			ctx,
+			access.AddAccessRuleParams{
+				AccountNumber: e.accountNumber,
+				AccessRule:    spec,
+			})
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec access.AccessRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.Access.UpdateAccessRuleWithContext(
+			ctx,
+			access.UpdateAccessRuleParams{
+				AccountNumber: e.accountNumber,
+				AccessRuleID:  id,
+				AccessRule:    spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafService()
+		if err != nil {
+			return err
+		}
+		return svc.Access.DeleteAccessRuleWithContext(
+			ctx,
+			access.DeleteAccessRuleParams{
+				AccountNumber: e.accountNumber,
+				AccessRuleID:  id,
+			})
+	},
+}
+
+var wafCustomRuleHandler = kindHandler[custom.CustomRuleSet]{
+	kindInfo: wafRule(KindWAFCustomRule),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.Custom.GetAllCustomRuleSetsWithContext(
+			ctx,
+			custom.GetAllCustomRuleSetsParams{AccountNumber: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*resp))
+		for i, item := range *resp {
+			items[i] = listed{id: item.ID, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec custom.CustomRuleSet,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Custom.GetCustomRuleSetWithContext(
+			ctx,
+			custom.GetCustomRuleSetParams{
+				AccountNumber:   e.accountNumber,
+				CustomRuleSetID: id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec custom.CustomRuleSet,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return svc.Custom.AddCustomRuleSetWithContext(
+			ctx,
+			custom.AddCustomRuleSetParams{
+				AccountNumber: e.accountNumber,
+				CustomRuleSet: spec,
+			})
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec custom.CustomRuleSet,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.Custom.UpdateCustomRuleSetWithContext(
+			ctx,
+			custom.UpdateCustomRuleSetParams{
+				AccountNumber:   e.accountNumber,
+				CustomRuleSetID: id,
+				CustomRuleSet:   spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafService()
+		if err != nil {
+			return err
+		}
+		return svc.Custom.DeleteCustomRuleSetWithContext(
+			ctx,
+			custom.DeleteCustomRuleSetParams{
+				AccountNumber:   e.accountNumber,
+				CustomRuleSetID: id,
+			})
+	},
+}
+
+var wafManagedRuleHandler = kindHandler[managed.ManagedRule]{
+	kindInfo: wafRule(KindWAFManagedRule),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.Managed.GetAllManagedRulesWithContext(
+			ctx,
+			managed.GetAllManagedRulesParams{AccountNumber: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*resp))
+		for i, item := range *resp {
+			items[i] = listed{id: item.ID, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec managed.ManagedRule,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Managed.GetManagedRuleWithContext(
+			ctx,
+			managed.GetManagedRuleParams{
+				AccountNumber: e.accountNumber,
+				ManagedRuleID: id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec managed.ManagedRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return svc.Managed.AddManagedRuleWithContext(
+			ctx,
+			managed.AddManagedRuleParams{
+				AccountNumber: e.accountNumber,
+				ManagedRule:   spec,
+			})
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec managed.ManagedRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.Managed.UpdateManagedRuleWithContext(
+			ctx,
+			managed.UpdateManagedRuleParams{
+				AccountNumber: e.accountNumber,
+				ManagedRuleID: id,
+				ManagedRule:   spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafService()
+		if err != nil {
+			return err
+		}
+		return svc.Managed.DeleteManagedRuleWithContext(
+			ctx,
+			managed.DeleteManagedRuleParams{
+				AccountNumber: e.accountNumber,
+				ManagedRuleID: id,
+			})
+	},
+}
+
+var wafRateRuleHandler = kindHandler[rate.RateRule]{
+	kindInfo: wafRule(KindWAFRateRule, "condition_groups.*.id"),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.Rate.GetAllRateRulesWithContext(
+			ctx,
+			rate.GetAllRateRulesParams{AccountNumber: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*resp))
+		for i, item := range *resp {
+			items[i] = listed{id: item.ID, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rate.RateRule,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Rate.GetRateRuleWithContext(
+			ctx,
+			rate.GetRateRuleParams{
+				AccountNumber: e.accountNumber,
+				RateRuleID:    id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec rate.RateRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return svc.Rate.AddRateRuleWithContext(
+			ctx,
+			rate.AddRateRuleParams{
+				AccountNumber: e.accountNumber,
+				RateRule:      spec,
+			})
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec rate.RateRule,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.Rate.UpdateRateRuleWithContext(
+			ctx,
+			rate.UpdateRateRuleParams{
+				AccountNumber: e.accountNumber,
+				RateRuleID:    id,
+				RateRule:      spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafService()
+		if err != nil {
+			return err
+		}
+		return svc.Rate.DeleteRateRuleWithContext(
+			ctx,
+			rate.DeleteRateRuleParams{
+				AccountNumber: e.accountNumber,
+				RateRuleID:    id,
+			})
+	},
+}
+
+var wafBotRuleHandler = kindHandler[bot.BotRuleSet]{
+	kindInfo: wafRule(KindWAFBotRule),
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.Bot.GetAllBotRuleSetsWithContext(
+			ctx,
+			bot.GetAllBotRuleSetsParams{AccountNumber: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(*resp))
+		for i, item := range *resp {
+			items[i] = listed{id: item.ID, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec bot.BotRuleSet,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Bot.GetBotRuleSetWithContext(
+			ctx,
+			bot.GetBotRuleSetParams{
+				AccountNumber: e.accountNumber,
+				BotRuleSetID:  id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec bot.BotRuleSet,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return svc.Bot.AddBotRuleSetWithContext(
+			ctx,
+			bot.AddBotRuleSetParams{
+				AccountNumber: e.accountNumber,
+				BotRuleSet:    spec,
+			})
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec bot.BotRuleSet,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.Bot.UpdateBotRuleSetWithContext(
+			ctx,
+			bot.UpdateBotRuleSetParams{
+				AccountNumber: e.accountNumber,
+				BotRuleSetID:  id,
+				BotRuleSet:    spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafService()
+		if err != nil {
+			return err
+		}
+		return svc.Bot.DeleteBotRuleSetWithContext(
+			ctx,
+			bot.DeleteBotRuleSetParams{
+				AccountNumber: e.accountNumber,
+				BotRuleSetID:  id,
+			})
+	},
+}
+
+// wafBotManagerService returns the WAF Bot Manager service
+func (e *Engine) wafBotManagerService() (*waf_bot_manager.Service, error) {
+	return e.client.WAFBotManager()
+}
+
+// wafBotManagerHandler manages bot managers, which are identified by name
+var wafBotManagerHandler = kindHandler[waf_bot_manager.BotManager]{
+	kindInfo: kindInfo{
+		kind:     KindWAFBotManager,
+		keyField: "name",
+		legacy:   true,
+		readOnly: wafReadOnly,
+		refs: []refField{
+			{path: "bots_prod_id", kind: KindWAFBotRule},
+		},
+	},
+	listFunc: func(ctx context.Context, e *Engine) ([]listed, error) {
+		svc, err := e.wafBotManagerService()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := svc.BotManagers.GetBotManagersWithContext(
+			ctx,
+			waf_bot_manager.GetBotManagersParams{CustId: e.accountNumber})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]listed, len(resp))
+		for i, item := range resp {
+			items[i] = listed{id: item.Id, key: item.Name}
+		}
+		return items, nil
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec waf_bot_manager.BotManager,
+	) (interface{}, error) {
+		svc, err := e.wafBotManagerService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.BotManagers.GetBotManagerWithContext(
+			ctx,
+			waf_bot_manager.GetBotManagerParams{
+				CustId:       e.accountNumber,
+				BotManagerId: id,
+			})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec waf_bot_manager.BotManager,
+	) (string, error) {
+		svc, err := e.wafBotManagerService()
+		if err != nil {
+			return "", err
+		}
+		resp, err := svc.BotManagers.CreateBotManagerWithContext(
+			ctx,
+			waf_bot_manager.CreateBotManagerParams{
+				CustId:         e.accountNumber,
+				BotManagerInfo: spec,
+			})
+		if err != nil {
+			return "", err
+		}
+		if resp.Id == nil {
+			return "", fmt.Errorf("bot manager created without an ID")
+		}
+		return *resp.Id, nil
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec waf_bot_manager.BotManager,
+	) (string, error) {
+		svc, err := e.wafBotManagerService()
+		if err != nil {
+			return "", err
+		}
+		return id, svc.BotManagers.UpdateBotManagerWithContext(
+			ctx,
+			waf_bot_manager.UpdateBotManagerParams{
+				CustId:         e.accountNumber,
+				BotManagerId:   id,
+				BotManagerInfo: spec,
+			})
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		svc, err := e.wafBotManagerService()
+		if err != nil {
+			return err
+		}
+		return svc.BotManagers.DeleteBotManagerWithContext(
+			ctx,
+			waf_bot_manager.DeleteBotManagerParams{
+				CustId:       e.accountNumber,
+				BotManagerId: id,
+			})
+	},
+}
+
+// wafScopesHandler manages the scopes of an account, which always exist and
+// are replaced as a whole. The ID of the scopes is that of the account's
+// scopes configuration.
+var wafScopesHandler = kindHandler[scopes.Scopes]{
+	kindInfo: kindInfo{
+		kind:      KindWAFScopes,
+		legacy:    true,
+		singleton: true,
+		singletonID: func(live interface{}) string {
+			return live.(*scopes.Scopes).ID
+		},
+		readOnly: []string{
+			"id",
+			"customer_id",
+			"last_modified_date",
+			"last_modified_by",
+			"version",
+			"scopes.*.id",
+			"scopes.*.*.id",
+		},
+		refs: []refField{
+			{path: "scopes.*.acl_prod_id", kind: KindWAFAccessRule},
+			{path: "scopes.*.acl_audit_id", kind: KindWAFAccessRule},
+			{path: "scopes.*.profile_prod_id", kind: KindWAFManagedRule},
+			{path: "scopes.*.profile_audit_id", kind: KindWAFManagedRule},
+			{path: "scopes.*.rules_prod_id", kind: KindWAFCustomRule},
+			{path: "scopes.*.rules_audit_id", kind: KindWAFCustomRule},
+			{path: "scopes.*.limits.*.id", kind: KindWAFRateRule},
+			{path: "scopes.*.bot_manager_config_id", kind: KindWAFBotManager},
+		},
+	},
+	getFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec scopes.Scopes,
+	) (interface{}, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return nil, err
+		}
+		return svc.Scopes.GetAllScopesWithContext(
+			ctx,
+			scopes.GetAllScopesParams{AccountNumber: e.accountNumber})
+	},
+	createFunc: func(
+		ctx context.Context,
+		e *Engine,
+		spec scopes.Scopes,
+	) (string, error) {
+		return "", fmt.Errorf("%s resources cannot be created", KindWAFScopes)
+	},
+	updateFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		spec scopes.Scopes,
+	) (string, error) {
+		svc, err := e.wafService()
+		if err != nil {
+			return "", err
+		}
+		spec.CustomerID = e.accountNumber
+		spec.ID = id
+		resp, err := svc.Scopes.ModifyAllScopesWithContext(ctx, spec)
+		if err != nil {
+			return "", err
+		}
+		if len(resp.ID) > 0 {
+			return resp.ID, nil
+		}
+		return id, nil
+	},
+	deleteFunc: func(
+		ctx context.Context,
+		e *Engine,
+		id string,
+		live interface{},
+	) error {
+		return fmt.Errorf("%s resources cannot be deleted", KindWAFScopes)
+	},
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+
+// DryRunConfig controls dry-run mode, in which GET requests are sent as usual
+// but POST, PUT, PATCH and DELETE requests are only recorded in a plan
+type DryRunConfig = ecclient.DryRunConfig
+
+// DryRunPlan collects the requests intercepted in dry-run mode. The zero
+// value is an empty plan ready to use.
+type DryRunPlan = ecclient.DryRunPlan
+
+// PlannedRequest describes a request that was not sent because of dry-run
+// mode
+type PlannedRequest = ecclient.PlannedRequest
+
+// DryRunError is returned for each request that was not sent because of
+// dry-run mode, if DryRunConfig.ReturnError is set
+type DryRunError = ecclient.DryRunError
+
+// DryRunPlaceholderID is the ID returned in dry-run mode for the resources
+// created by APIs that respond with a bare ID, such as Route DNS
+const DryRunPlaceholderID = ecclient.DryRunPlaceholderID
+
+// ErrDryRun matches any DryRunError when used with errors.Is
+var ErrDryRun = ecclient.ErrDryRun
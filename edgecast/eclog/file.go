@@ -0,0 +1,92 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eclog
+
+/*
+	This file contains the logger that writes to a file, which may be rotated
+	by size or age.
+*/
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecrotate"
+)
+
+// DefaultFileMode is the permissions of new log files if
+// FileLoggerOptions.FileMode is not set
+const DefaultFileMode os.FileMode = ecrotate.DefaultMode
+
+// FileLoggerOptions controls how a FileLogger writes and rotates its file.
+// The zero value appends to a single file forever.
+type FileLoggerOptions struct {
+	// MaxSize is the size in bytes after which the file is rotated. Zero
+	// disables size-based rotation.
+	MaxSize int64
+
+	// MaxAge is how long the file is written to before it is rotated. Zero
+	// disables age-based rotation.
+	MaxAge time.Duration
+
+	// MaxBackups is the number of rotated files to keep; the oldest are
+	// removed first. Zero keeps all rotated files.
+	MaxBackups int
+
+	// Compress, if true, compresses rotated files with gzip
+	Compress bool
+
+	// FileMode is the permissions of new log files. Defaults to
+	// DefaultFileMode.
+	FileMode os.FileMode
+
+	// MinLevel discards messages below the given level. Defaults to
+	// LevelDebug, i.e. every message is written.
+	MinLevel Level
+}
+
+// FileLogger is a Logger that writes to a file. Rotated files are renamed
+// with the time of rotation, e.g. sdk.log becomes
+// sdk-2022-06-01T15-04-05.000.log.
+type FileLogger struct {
+	SimpleLogger
+	file *ecrotate.File
+}
+
+// NewFileLoggerWithOptions creates a logger that writes all messages to the
+// file at filePath, creating it if it does not exist
+func NewFileLoggerWithOptions(
+	filePath string,
+	options FileLoggerOptions,
+) (*FileLogger, error) {
+	file, err := ecrotate.Open(filePath, ecrotate.Options{
+		MaxSize:    options.MaxSize,
+		MaxAge:     options.MaxAge,
+		MaxBackups: options.MaxBackups,
+		Compress:   options.Compress,
+		Mode:       options.FileMode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("NewFileLoggerWithOptions: %w", err)
+	}
+
+	logger := newSimpleLogger(file, file)
+	logger.MinLevel = options.MinLevel
+
+	return &FileLogger{
+		SimpleLogger: logger,
+		file:         file,
+	}, nil
+}
+
+// Sync commits the messages written so far to stable storage
+func (l *FileLogger) Sync() error {
+	return l.file.Sync()
+}
+
+// Close closes the log file. Messages written afterwards are discarded.
+func (l *FileLogger) Close() error {
+	return l.file.Close()
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eclog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewFileLoggerWithOptions(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sdk.log")
+
+	logger, err := NewFileLoggerWithOptions(path, FileLoggerOptions{
+		MaxSize:  200,
+		MinLevel: LevelInfo,
+	})
+	if err != nil {
+		t.Fatalf("NewFileLoggerWithOptions: %v", err)
+	}
+	defer logger.Close()
+
+	logAllLevels(logger)
+	if err := logger.Sync(); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	expected := []string{"[INFO] info 2", "[WARN] warn 3", "[ERROR] error 4"}
+	if got := logLines(readLog(t, path)); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, got)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("os.Stat: %v", err)
+	}
+	if info.Mode().Perm() != DefaultFileMode {
+		t.Fatalf(
+			"Expected mode %v but got %v",
+			DefaultFileMode,
+			info.Mode().Perm())
+	}
+
+	// The file is rotated once it exceeds MaxSize
+	for i := 0; i < 10; i++ {
+		logger.Error("message %d", i)
+	}
+	backups, err := filepath.Glob(filepath.Join(dir, "sdk-*.log"))
+	if err != nil || len(backups) == 0 {
+		t.Fatalf("Expected rotated files but got %+v, %v", backups, err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	logger.Error("discarded")
+	if strings.Contains(readLog(t, path), "discarded") {
+		t.Fatalf("Expected messages after Close to be discarded")
+	}
+}
+
+func TestNewFileLoggerWithOptionsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "sdk.log")
+
+	_, err := NewFileLoggerWithOptions(path, FileLoggerOptions{})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected %v but got %v", os.ErrNotExist, err)
+	}
+}
+
+func TestNewFileLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	if err := os.WriteFile(path, []byte("existing\n"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile: %v", err)
+	}
+
+	logger := NewFileLogger(path)
+	if _, ok := logger.(SimpleLogger); !ok {
+		t.Fatalf("Expected a SimpleLogger but got %T", logger)
+	}
+	logger.Debug("debug %d", 1)
+	logger.Warn("warn %d", 2)
+
+	// The file is appended to
+	data := readLog(t, path)
+	if !strings.HasPrefix(data, "existing\n") {
+		t.Fatalf("Expected the existing contents to be kept but got %q", data)
+	}
+	expected := []string{"[DEBUG] debug 1", "[WARN] warn 2"}
+	lines := logLines(strings.TrimPrefix(data, "existing\n"))
+	if !reflect.DeepEqual(lines, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, lines)
+	}
+}
+
+func readLog(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	return string(data)
+}
@@ -4,6 +4,7 @@
 package eclog
 
 import (
+	"io"
 	"log"
 	"os"
 )
@@ -28,29 +29,33 @@ type Logger interface {
 	Error(format string, v ...interface{})
 }
 
-// Creates a logger that writes to a single log file
+// Creates a logger that writes to a single log file, which is appended to
+// without rotation. Exits the program if the file cannot be opened; use
+// NewFileLoggerWithOptions to handle the error instead.
 func NewFileLogger(filePath string) Logger {
-	file, err := os.OpenFile(
-		filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	logger, err := NewFileLoggerWithOptions(
+		filePath,
+		FileLoggerOptions{FileMode: 0666})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	return SimpleLogger{
-		InfoLogger:    log.New(file, "[INFO] ", logFlag),
-		DebugLogger:   log.New(file, "[DEBUG] ", logFlag),
-		WarningLogger: log.New(file, "[WARN] ", logFlag),
-		ErrorLogger:   log.New(file, "[ERROR] ", logFlag),
-	}
+	return logger.SimpleLogger
 }
 
 // Creates a logger that writes to the standard output and error streams
 func NewStandardLogger() Logger {
+	return newSimpleLogger(os.Stdout, os.Stderr)
+}
+
+// newSimpleLogger creates a SimpleLogger that writes debug and info messages
+// to out and warnings and errors to errOut
+func newSimpleLogger(out io.Writer, errOut io.Writer) SimpleLogger {
 	return SimpleLogger{
-		InfoLogger:    log.New(os.Stdout, "[INFO] ", logFlag),
-		DebugLogger:   log.New(os.Stdout, "[DEBUG] ", logFlag),
-		WarningLogger: log.New(os.Stderr, "[WARN] ", logFlag),
-		ErrorLogger:   log.New(os.Stderr, "[ERROR] ", logFlag),
+		InfoLogger:    log.New(out, "[INFO] ", logFlag),
+		DebugLogger:   log.New(out, "[DEBUG] ", logFlag),
+		WarningLogger: log.New(errOut, "[WARN] ", logFlag),
+		ErrorLogger:   log.New(errOut, "[ERROR] ", logFlag),
 	}
 }
 
@@ -65,26 +70,43 @@ type SimpleLogger struct {
 	DebugLogger   *log.Logger
 	ErrorLogger   *log.Logger
 	WarningLogger *log.Logger
+
+	// MinLevel discards messages below the given level. Defaults to
+	// LevelDebug, i.e. every message is written.
+	MinLevel Level
 }
 
 // Debug writes Debug messages to stdout
 func (l SimpleLogger) Debug(format string, v ...interface{}) {
-	l.DebugLogger.Printf(format, v...)
+	if l.Enabled(LevelDebug) {
+		l.DebugLogger.Printf(format, v...)
+	}
 }
 
 // Info writes Info messages to stdout
 func (l SimpleLogger) Info(format string, v ...interface{}) {
-	l.InfoLogger.Printf(format, v...)
+	if l.Enabled(LevelInfo) {
+		l.InfoLogger.Printf(format, v...)
+	}
 }
 
 // Error writes to error messages to stderr
 func (l SimpleLogger) Error(format string, v ...interface{}) {
-	l.ErrorLogger.Printf(format, v...)
+	if l.Enabled(LevelError) {
+		l.ErrorLogger.Printf(format, v...)
+	}
 }
 
 // Warn writes to error messages to stderr
 func (l SimpleLogger) Warn(format string, v ...interface{}) {
-	l.WarningLogger.Printf(format, v...)
+	if l.Enabled(LevelWarn) {
+		l.WarningLogger.Printf(format, v...)
+	}
+}
+
+// Enabled reports whether level is at least MinLevel
+func (l SimpleLogger) Enabled(level Level) bool {
+	return level >= l.MinLevel
 }
 
 // A logger that will do nothing with messages
@@ -105,3 +127,53 @@ func (l NullLogger) Error(format string, v ...interface{}) {
 // Warn does nothing
 func (l NullLogger) Warn(format string, v ...interface{}) {
 }
+
+// WithMinLevel returns a Logger that discards messages from logger below the
+// given level
+func WithMinLevel(logger Logger, min Level) Logger {
+	if l, ok := logger.(SimpleLogger); ok {
+		l.MinLevel = min
+		return l
+	}
+	return levelFilter{logger: logger, min: min}
+}
+
+// levelFilter discards messages below min before passing them to logger
+type levelFilter struct {
+	logger Logger
+	min    Level
+}
+
+func (f levelFilter) Debug(format string, v ...interface{}) {
+	if f.Enabled(LevelDebug) {
+		f.logger.Debug(format, v...)
+	}
+}
+
+func (f levelFilter) Info(format string, v ...interface{}) {
+	if f.Enabled(LevelInfo) {
+		f.logger.Info(format, v...)
+	}
+}
+
+func (f levelFilter) Warn(format string, v ...interface{}) {
+	if f.Enabled(LevelWarn) {
+		f.logger.Warn(format, v...)
+	}
+}
+
+func (f levelFilter) Error(format string, v ...interface{}) {
+	if f.Enabled(LevelError) {
+		f.logger.Error(format, v...)
+	}
+}
+
+func (f levelFilter) Log(level Level, msg string, fields ...Field) {
+	if f.Enabled(level) {
+		Structured(f.logger).Log(level, msg, fields...)
+	}
+}
+
+func (f levelFilter) Enabled(level Level) bool {
+	return level >= f.min && Structured(f.logger).Enabled(level)
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eclog
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordingLogger records the messages it receives, prefixed with their
+// level
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Debug(format string, v ...interface{}) {
+	l.record(LevelDebug, format, v...)
+}
+
+func (l *recordingLogger) Info(format string, v ...interface{}) {
+	l.record(LevelInfo, format, v...)
+}
+
+func (l *recordingLogger) Warn(format string, v ...interface{}) {
+	l.record(LevelWarn, format, v...)
+}
+
+func (l *recordingLogger) Error(format string, v ...interface{}) {
+	l.record(LevelError, format, v...)
+}
+
+func (l *recordingLogger) record(
+	level Level,
+	format string,
+	v ...interface{},
+) {
+	l.messages = append(
+		l.messages,
+		level.String()+": "+fmt.Sprintf(format, v...))
+}
+
+func logAllLevels(logger Logger) {
+	logger.Debug("debug %d", 1)
+	logger.Info("info %d", 2)
+	logger.Warn("warn %d", 3)
+	logger.Error("error %d", 4)
+}
+
+func TestSimpleLoggerMinLevel(t *testing.T) {
+	cases := []struct {
+		min         Level
+		expectedOut []string
+		expectedErr []string
+	}{
+		{
+			min:         LevelDebug,
+			expectedOut: []string{"[DEBUG] debug 1", "[INFO] info 2"},
+			expectedErr: []string{"[WARN] warn 3", "[ERROR] error 4"},
+		},
+		{
+			min:         LevelInfo,
+			expectedOut: []string{"[INFO] info 2"},
+			expectedErr: []string{"[WARN] warn 3", "[ERROR] error 4"},
+		},
+		{
+			min:         LevelError,
+			expectedErr: []string{"[ERROR] error 4"},
+		},
+	}
+
+	for _, c := range cases {
+		var out, errOut bytes.Buffer
+		logger := newSimpleLogger(&out, &errOut)
+		logger.MinLevel = c.min
+		logAllLevels(logger)
+
+		gotOut := logLines(out.String())
+		gotErr := logLines(errOut.String())
+		if !reflect.DeepEqual(gotOut, c.expectedOut) ||
+			!reflect.DeepEqual(gotErr, c.expectedErr) {
+			t.Fatalf(
+				"%s: Expected %+v and %+v but got %+v and %+v",
+				c.min,
+				c.expectedOut,
+				c.expectedErr,
+				gotOut,
+				gotErr)
+		}
+	}
+}
+
+// logLines returns the messages written by a SimpleLogger, without the date
+// and time that follow the prefix
+func logLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.SplitN(line, " ", 4)
+		if len(fields) == 4 {
+			lines = append(lines, fields[0]+" "+fields[3])
+		}
+	}
+	return lines
+}
+
+func TestWithMinLevel(t *testing.T) {
+	// SimpleLoggers are filtered by their own MinLevel
+	var out, errOut bytes.Buffer
+	filtered := WithMinLevel(newSimpleLogger(&out, &errOut), LevelWarn)
+	if l, ok := filtered.(SimpleLogger); !ok || l.MinLevel != LevelWarn {
+		t.Fatalf("Expected a SimpleLogger at warn but got %+v", filtered)
+	}
+
+	// Other loggers are wrapped
+	recorder := &recordingLogger{}
+	filtered = WithMinLevel(recorder, LevelWarn)
+	logAllLevels(filtered)
+	expected := []string{"warn: warn 3", "error: error 4"}
+	if !reflect.DeepEqual(recorder.messages, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, recorder.messages)
+	}
+
+	structured := Structured(filtered)
+	if structured.Enabled(LevelInfo) || !structured.Enabled(LevelError) {
+		t.Fatalf("Expected only warnings and errors to be enabled")
+	}
+
+	recorder.messages = nil
+	structured.Log(LevelInfo, "dropped")
+	structured.Log(LevelError, "failed", F(FieldStatus, 500))
+	expected = []string{"error: failed status=500"}
+	if !reflect.DeepEqual(recorder.messages, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, recorder.messages)
+	}
+
+	// Filters combine with the level of the logger they wrap
+	inner := newSimpleLogger(&out, &errOut)
+	inner.MinLevel = LevelError
+	structured = Structured(levelFilter{logger: inner, min: LevelInfo})
+	if structured.Enabled(LevelWarn) || !structured.Enabled(LevelError) {
+		t.Fatalf("Expected only errors to be enabled")
+	}
+}
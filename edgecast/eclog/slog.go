@@ -0,0 +1,104 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+//go:build go1.21
+
+package eclog
+
+/*
+	This file contains the adapter that writes SDK log messages to a
+	log/slog Logger.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SlogLogger writes messages to a *slog.Logger. Fields are passed to slog as
+// attributes, so the slog handler decides how they are formatted. Levels
+// below the handler's minimum level are discarded by slog.
+type SlogLogger struct {
+	Logger *slog.Logger
+}
+
+// NewSlogLogger creates a Logger that writes to logger, or to slog.Default()
+// if logger is nil
+func NewSlogLogger(logger *slog.Logger) SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return SlogLogger{Logger: logger}
+}
+
+// Debug writes debug messages
+func (l SlogLogger) Debug(format string, v ...interface{}) {
+	l.logf(LevelDebug, format, v...)
+}
+
+// Info writes info messages
+func (l SlogLogger) Info(format string, v ...interface{}) {
+	l.logf(LevelInfo, format, v...)
+}
+
+// Warn writes warning messages
+func (l SlogLogger) Warn(format string, v ...interface{}) {
+	l.logf(LevelWarn, format, v...)
+}
+
+// Error writes error messages
+func (l SlogLogger) Error(format string, v ...interface{}) {
+	l.logf(LevelError, format, v...)
+}
+
+// Log writes msg with fields as slog attributes
+func (l SlogLogger) Log(level Level, msg string, fields ...Field) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, f := range fields {
+		attrs = append(attrs, slogAttr(f))
+	}
+
+	l.Logger.LogAttrs(context.Background(), slogLevel(level), msg, attrs...)
+}
+
+// Enabled reports whether the slog handler accepts messages at level
+func (l SlogLogger) Enabled(level Level) bool {
+	return l.Logger.Enabled(context.Background(), slogLevel(level))
+}
+
+func (l SlogLogger) logf(level Level, format string, v ...interface{}) {
+	if l.Enabled(level) {
+		l.Logger.Log(
+			context.Background(),
+			slogLevel(level),
+			fmt.Sprintf(format, v...))
+	}
+}
+
+func slogLevel(level Level) slog.Level {
+	switch level {
+	case LevelDebug:
+		return slog.LevelDebug
+	case LevelInfo:
+		return slog.LevelInfo
+	case LevelWarn:
+		return slog.LevelWarn
+	}
+	return slog.LevelError
+}
+
+func slogAttr(f Field) slog.Attr {
+	switch v := f.Value.(type) {
+	case time.Duration:
+		return slog.Duration(f.Key, v)
+	case error:
+		return slog.String(f.Key, v.Error())
+	}
+	return slog.Any(f.Key, f.Value)
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+//go:build go1.21
+
+package eclog
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := NewSlogLogger(slog.New(handler))
+
+	if logger.Enabled(LevelDebug) || !logger.Enabled(LevelInfo) {
+		t.Fatalf("Expected the handler's level to be used")
+	}
+
+	logger.Debug("debug %d", 1)
+	logger.Info("info %d", 2)
+	logger.Warn("warn %d", 3)
+	logger.Error("error %d", 4)
+	logger.Log(LevelDebug, "dropped")
+	logger.Log(
+		LevelInfo,
+		"request sent",
+		F(FieldStatus, 200),
+		F(FieldDuration, 1500*time.Millisecond),
+		F(FieldError, errors.New("reset")))
+
+	expected := []string{
+		`level=INFO msg="info 2"`,
+		`level=WARN msg="warn 3"`,
+		`level=ERROR msg="error 4"`,
+		`level=INFO msg="request sent" status=200 duration=1.5s error=reset`,
+	}
+	got := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("Expected %+v but got %+v", expected, got)
+	}
+
+	// Structured passes messages to the adapter with their fields intact
+	if _, ok := Structured(logger).(SlogLogger); !ok {
+		t.Fatalf("Expected Structured to return the SlogLogger")
+	}
+
+	if NewSlogLogger(nil).Logger != slog.Default() {
+		t.Fatalf("Expected a nil logger to default to slog.Default()")
+	}
+}
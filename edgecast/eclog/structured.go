@@ -0,0 +1,197 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eclog
+
+/*
+	This file contains the structured logging interface, in which messages
+	carry key/value fields, and the bridge that lets any Logger receive
+	structured messages.
+*/
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Level is the severity of a log message
+type Level int
+
+const (
+	// LevelDebug is for detailed messages, such as every request and response
+	LevelDebug Level = iota
+
+	// LevelInfo is for messages about the SDK's configuration and decisions
+	LevelInfo
+
+	// LevelWarn is for unexpected events the SDK recovered from
+	LevelWarn
+
+	// LevelError is for failures
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	}
+	return "unknown"
+}
+
+// ParseLevel returns the Level named s, e.g. "info". Names are
+// case-insensitive and "warning" is accepted for LevelWarn.
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	}
+	return LevelDebug, fmt.Errorf("unknown log level %q", s)
+}
+
+// Keys of the fields attached to the SDK's structured log messages
+const (
+	FieldService        = "service"
+	FieldMethod         = "method"
+	FieldPath           = "path"
+	FieldStatus         = "status"
+	FieldAttempt        = "attempt"
+	FieldDuration       = "duration"
+	FieldRequestID      = "request_id"
+	FieldError          = "error"
+	FieldRequestHeaders = "request_headers"
+	FieldRequestBody    = "request_body"
+	FieldResponseBody   = "response_body"
+	FieldAuthMode       = "auth_mode"
+	FieldReason         = "reason"
+)
+
+// Field is a key/value pair attached to a structured log message
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F creates a Field
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// StructuredLogger writes messages with key/value fields. Loggers that
+// implement it receive the SDK's messages with their fields intact; all other
+// Loggers receive them formatted as text by the bridge returned from
+// Structured.
+type StructuredLogger interface {
+	// Log writes msg with fields at level
+	Log(level Level, msg string, fields ...Field)
+
+	// Enabled reports whether messages at level are written, so that callers
+	// can skip building fields that would be discarded
+	Enabled(level Level) bool
+}
+
+// Structured returns logger as a StructuredLogger. Loggers that do not
+// implement StructuredLogger receive each message through their method for
+// its level, as the message followed by key=value pairs. A nil logger
+// discards all messages.
+func Structured(logger Logger) StructuredLogger {
+	switch l := logger.(type) {
+	case nil, NullLogger:
+		return discard{}
+	case StructuredLogger:
+		return l
+	}
+	return bridge{logger: logger}
+}
+
+// discard is a StructuredLogger that writes nothing
+type discard struct{}
+
+func (discard) Log(level Level, msg string, fields ...Field) {}
+
+func (discard) Enabled(level Level) bool {
+	return false
+}
+
+// levelEnabler is implemented by Loggers that filter messages by level, such
+// as SimpleLogger
+type levelEnabler interface {
+	Enabled(level Level) bool
+}
+
+// bridge adapts a Logger to StructuredLogger
+type bridge struct {
+	logger Logger
+}
+
+func (b bridge) Log(level Level, msg string, fields ...Field) {
+	// The message is passed as an argument so that it is never interpreted
+	// as a format string
+	text := FormatFields(msg, fields)
+	switch level {
+	case LevelDebug:
+		b.logger.Debug("%s", text)
+	case LevelInfo:
+		b.logger.Info("%s", text)
+	case LevelWarn:
+		b.logger.Warn("%s", text)
+	default:
+		b.logger.Error("%s", text)
+	}
+}
+
+func (b bridge) Enabled(level Level) bool {
+	if l, ok := b.logger.(levelEnabler); ok {
+		return l.Enabled(level)
+	}
+	return true
+}
+
+// FormatFields formats msg followed by its fields as key=value pairs. Values
+// that contain spaces, quotes or '=' are quoted.
+func FormatFields(msg string, fields []Field) string {
+	var sb strings.Builder
+	sb.WriteString(msg)
+	for _, f := range fields {
+		sb.WriteByte(' ')
+		sb.WriteString(f.Key)
+		sb.WriteByte('=')
+		sb.WriteString(formatValue(f.Value))
+	}
+	return sb.String()
+}
+
+func formatValue(v interface{}) string {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case time.Duration:
+		s = v.String()
+	case error:
+		s = v.Error()
+	case fmt.Stringer:
+		s = v.String()
+	default:
+		s = fmt.Sprintf("%+v", v)
+	}
+
+	if len(s) == 0 || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
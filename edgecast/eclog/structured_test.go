@@ -0,0 +1,113 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eclog
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseLevel(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected Level
+	}{
+		{input: "debug", expected: LevelDebug},
+		{input: "INFO", expected: LevelInfo},
+		{input: " warn ", expected: LevelWarn},
+		{input: "Warning", expected: LevelWarn},
+		{input: "error", expected: LevelError},
+	}
+
+	for _, c := range cases {
+		got, err := ParseLevel(c.input)
+		if err != nil || got != c.expected {
+			t.Fatalf(
+				"%q: Expected %s but got %s, %v",
+				c.input,
+				c.expected,
+				got,
+				err)
+		}
+		if parsed, _ := ParseLevel(got.String()); parsed != got {
+			t.Fatalf(
+				"%s: Expected String to parse back but got %s",
+				got,
+				parsed)
+		}
+	}
+
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Fatalf("Expected an error for an unknown level")
+	}
+}
+
+type testStringer struct{}
+
+func (testStringer) String() string {
+	return "stringer"
+}
+
+func TestFormatFields(t *testing.T) {
+	got := FormatFields("request sent", []Field{
+		F(FieldService, "waf"),
+		F(FieldStatus, 200),
+		F(FieldDuration, 1500*time.Millisecond),
+		F(FieldError, errors.New("connection reset")),
+		F(FieldPath, "/v2/a=b"),
+		F(FieldReason, ""),
+		F("quoted", `say "hi"`),
+		F("stringer", testStringer{}),
+		F("list", []int{1, 2}),
+	})
+	expected := `request sent service=waf status=200 duration=1.5s ` +
+		`error="connection reset" path="/v2/a=b" reason="" ` +
+		`quoted="say \"hi\"" stringer=stringer list="[1 2]"`
+	if got != expected {
+		t.Fatalf("Expected %s but got %s", expected, got)
+	}
+
+	if got := FormatFields("message", nil); got != "message" {
+		t.Fatalf("Expected message but got %s", got)
+	}
+}
+
+func TestStructured(t *testing.T) {
+	recorder := &recordingLogger{}
+	structured := Structured(recorder)
+	if !structured.Enabled(LevelDebug) {
+		t.Fatalf("Expected loggers without levels to accept every level")
+	}
+
+	// Messages are never interpreted as format strings
+	structured.Log(LevelDebug, "100%s done", F(FieldAttempt, 2))
+	structured.Log(LevelInfo, "info")
+	structured.Log(LevelWarn, "warn")
+	structured.Log(LevelError, "error")
+	expected := []string{
+		"debug: 100%s done attempt=2",
+		"info: info",
+		"warn: warn",
+		"error: error",
+	}
+	if !reflect.DeepEqual(recorder.messages, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, recorder.messages)
+	}
+
+	for _, logger := range []Logger{nil, NullLogger{}} {
+		structured := Structured(logger)
+		if structured.Enabled(LevelError) {
+			t.Fatalf("%T: Expected every level to be disabled", logger)
+		}
+		structured.Log(LevelError, "discarded")
+	}
+
+	// Loggers that implement StructuredLogger are used as is
+	filter := levelFilter{logger: recorder, min: LevelInfo}
+	if got := Structured(filter); !reflect.DeepEqual(got, filter) {
+		t.Fatalf("Expected %+v but got %+v", filter, got)
+	}
+}
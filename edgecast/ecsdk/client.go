@@ -0,0 +1,212 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecsdk
+
+/*
+	This file contains Client, which creates and holds every SDK service
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/customer"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/edgecname"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/origin"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/routedns"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rulesengine"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+// ErrClientClosed is returned when a service is requested from a Client that
+// has been closed
+var ErrClientClosed = errors.New("ecsdk: client is closed")
+
+// Client gives access to every SDK service. Each service is created on first
+// use and then reused. It is safe for concurrent use.
+type Client struct {
+	config edgecast.SDKConfig
+
+	// ownsTransport is true if the transport of the HTTP client was created
+	// by NewClient, and should therefore be closed by Close
+	ownsTransport bool
+
+	mu     sync.Mutex
+	closed bool
+
+	cps           lazy[*cps.CpsService]
+	customer      lazy[*customer.CustomerService]
+	edgeCname     lazy[*edgecname.EdgeCnameService]
+	origin        lazy[*origin.OriginService]
+	originV3      lazy[*originv3.Service]
+	routeDNS      lazy[*routedns.RouteDNSService]
+	rtld          lazy[*rtld.RtldService]
+	rulesEngine   lazy[*rulesengine.RulesEngineService]
+	waf           lazy[*waf.WafService]
+	wafBotManager lazy[*waf_bot_manager.Service]
+}
+
+// NewClient creates a Client whose services all use config
+func NewClient(config edgecast.SDKConfig) (*Client, error) {
+	// A transport provided in SDKConfig.Transport is used as is unless it
+	// had to be cloned to apply proxy or TLS settings
+	httpClient, ownsTransport, err := ecclient.BuildHTTPClient(
+		ecclient.HTTPClientConfig{
+			HTTPClient:         config.HTTPClient,
+			Transport:          config.Transport,
+			ProxyURL:           config.ProxyURL,
+			RootCAs:            config.RootCAs,
+			ClientCertificates: config.ClientCertificates,
+			MinTLSVersion:      config.MinTLSVersion,
+			Timeout:            config.Timeout,
+			Cassette:           config.Cassette,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("NewClient: %w", err)
+	}
+
+	// The cassette, if any, already wraps the client's transport
+	config.HTTPClient = httpClient
+	config.Cassette = nil
+
+	shared, err := config.Shared()
+	if err != nil {
+		return nil, fmt.Errorf("NewClient: %w", err)
+	}
+
+	return &Client{
+		config:        shared,
+		ownsTransport: ownsTransport,
+	}, nil
+}
+
+// Config returns the configuration used to create services
+func (c *Client) Config() edgecast.SDKConfig {
+	return c.config
+}
+
+// HTTPClient returns the HTTP client shared by all services
+func (c *Client) HTTPClient() *http.Client {
+	return c.config.HTTPClient
+}
+
+// CPS returns the Certificate Provisioning System service
+func (c *Client) CPS() (*cps.CpsService, error) {
+	return get(c, &c.cps, cps.New)
+}
+
+// Customer returns the Customer Management service
+func (c *Client) Customer() (*customer.CustomerService, error) {
+	return get(c, &c.customer, customer.New)
+}
+
+// EdgeCname returns the Edge CNAME service
+func (c *Client) EdgeCname() (*edgecname.EdgeCnameService, error) {
+	return get(c, &c.edgeCname, edgecname.New)
+}
+
+// Origin returns the legacy Origin service
+func (c *Client) Origin() (*origin.OriginService, error) {
+	return get(c, &c.origin, origin.New)
+}
+
+// OriginV3 returns the Origin V3 service
+func (c *Client) OriginV3() (*originv3.Service, error) {
+	return get(c, &c.originV3, originv3.New)
+}
+
+// RouteDNS returns the Route DNS service
+func (c *Client) RouteDNS() (*routedns.RouteDNSService, error) {
+	return get(c, &c.routeDNS, routedns.New)
+}
+
+// RTLD returns the Real-Time Log Delivery service
+func (c *Client) RTLD() (*rtld.RtldService, error) {
+	return get(c, &c.rtld, rtld.New)
+}
+
+// RulesEngine returns the Rules Engine service
+func (c *Client) RulesEngine() (*rulesengine.RulesEngineService, error) {
+	return get(c, &c.rulesEngine, rulesengine.New)
+}
+
+// WAF returns the Web Application Firewall service
+func (c *Client) WAF() (*waf.WafService, error) {
+	return get(c, &c.waf, waf.New)
+}
+
+// WAFBotManager returns the WAF Bot Manager service
+func (c *Client) WAFBotManager() (*waf_bot_manager.Service, error) {
+	return get(c, &c.wafBotManager, waf_bot_manager.New)
+}
+
+// Close releases the idle connections of the shared HTTP client, unless it
+// was provided in SDKConfig.HTTPClient or its transport in
+// SDKConfig.Transport. Services that were already returned keep working, but
+// no new services can be requested.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	if c.ownsTransport {
+		c.config.HTTPClient.CloseIdleConnections()
+	}
+	return nil
+}
+
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closed
+}
+
+// lazy holds a service that is created on first use. Errors are not kept, so
+// a failed creation is retried the next time the service is requested.
+type lazy[T any] struct {
+	mu      sync.Mutex
+	service T
+	created bool
+}
+
+// get returns the service held by l, creating it with newService if needed
+func get[T any](
+	c *Client,
+	l *lazy[T],
+	newService func(edgecast.SDKConfig) (T, error),
+) (T, error) {
+	var zero T
+	if c.isClosed() {
+		return zero, ErrClientClosed
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.created {
+		return l.service, nil
+	}
+
+	service, err := newService(c.config)
+	if err != nil {
+		return zero, err
+	}
+
+	l.service = service
+	l.created = true
+	return service, nil
+}
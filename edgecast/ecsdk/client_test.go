@@ -0,0 +1,269 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecsdk
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_cdn"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+)
+
+// countingTransport counts the requests sent through it by path
+type countingTransport struct {
+	base http.RoundTripper
+
+	mu     sync.Mutex
+	counts map[string]int
+	closed int
+}
+
+func (t *countingTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	t.mu.Lock()
+	if t.counts == nil {
+		t.counts = map[string]int{}
+	}
+	t.counts[req.URL.Path]++
+	t.mu.Unlock()
+
+	return t.base.RoundTrip(req)
+}
+
+func (t *countingTransport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed++
+}
+
+func (t *countingTransport) count(path string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.counts[path]
+}
+
+func (t *countingTransport) total() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	total := 0
+	for _, n := range t.counts {
+		total += n
+	}
+	return total
+}
+
+func TestClientCreatesServicesLazilyAndOnce(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	client, err := NewClient(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if client.waf.created || client.rtld.created {
+		t.Fatalf("Expected no services to be created by NewClient")
+	}
+
+	first, err := client.WAF()
+	if err != nil {
+		t.Fatalf("WAF: %v", err)
+	}
+	if !client.waf.created || client.rtld.created {
+		t.Fatalf("Expected only the WAF service to be created")
+	}
+
+	// Concurrent callers receive the same service
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			svc, err := client.WAF()
+			if err != nil || svc != first {
+				t.Errorf("WAF: Expected %p but got %p, %v", first, svc, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientServicesShareAuthAndHTTPClient(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	client, err := NewClient(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	// Requests from every service pass through the shared client
+	transport := &countingTransport{base: client.HTTPClient().Transport}
+	client.HTTPClient().Transport = transport
+
+	rtldService, err := client.RTLD()
+	if err != nil {
+		t.Fatalf("RTLD: %v", err)
+	}
+	other, err := rtld.New(client.Config())
+	if err != nil {
+		t.Fatalf("rtld.New: %v", err)
+	}
+	for _, svc := range []*rtld.RtldService{rtldService, other} {
+		params := profiles_cdn.NewProfilesGetCustomerSettingsParams()
+		_, err := svc.ProfilesCdn.ProfilesGetCustomerSettings(params)
+		if err != nil {
+			t.Fatalf("ProfilesGetCustomerSettings: %v", err)
+		}
+	}
+
+	wafService, err := client.WAF()
+	if err != nil {
+		t.Fatalf("WAF: %v", err)
+	}
+	_, err = wafService.Access.GetAllAccessRules(access.GetAllAccessRulesParams{
+		AccountNumber: "ABCD",
+	})
+	if err != nil {
+		t.Fatalf("GetAllAccessRules: %v", err)
+	}
+
+	// Services created from the client's configuration share the provider,
+	// and therefore the token, of the client's services
+	if got := transport.count("/connect/token"); got != 1 {
+		t.Fatalf("Expected 1 token request but got %d", got)
+	}
+	if got := transport.total(); got != 4 {
+		t.Fatalf(
+			"Expected 4 requests through the shared client but got %d",
+			got)
+	}
+}
+
+func TestClientClose(t *testing.T) {
+	server := ectest.NewServer()
+	defer server.Close()
+
+	client, err := NewClient(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if !client.ownsTransport {
+		t.Fatalf("Expected the client to own the transport it created")
+	}
+	transport := &countingTransport{base: client.HTTPClient().Transport}
+	client.HTTPClient().Transport = transport
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close: Expected a second call to succeed but got %v", err)
+	}
+	if transport.closed != 1 {
+		t.Fatalf(
+			"Expected idle connections to be closed once but got %d",
+			transport.closed)
+	}
+
+	if _, err := client.WAF(); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("WAF: Expected %v but got %v", ErrClientClosed, err)
+	}
+	if _, err := client.RouteDNS(); !errors.Is(err, ErrClientClosed) {
+		t.Fatalf("RouteDNS: Expected %v but got %v", ErrClientClosed, err)
+	}
+}
+
+func TestClientCloseLeavesProvidedTransport(t *testing.T) {
+	cases := []struct {
+		name   string
+		config func(transport *countingTransport) edgecast.SDKConfig
+	}{
+		{
+			name: "HTTPClient",
+			config: func(transport *countingTransport) edgecast.SDKConfig {
+				config := edgecast.NewSDKConfig()
+				config.HTTPClient = &http.Client{Transport: transport}
+				return config
+			},
+		},
+		{
+			name: "Transport",
+			config: func(transport *countingTransport) edgecast.SDKConfig {
+				config := edgecast.NewSDKConfig()
+				config.Transport = transport
+				return config
+			},
+		},
+	}
+
+	for _, c := range cases {
+		transport := &countingTransport{base: http.DefaultTransport}
+		client, err := NewClient(c.config(transport))
+		if err != nil {
+			t.Fatalf("%s: NewClient: %v", c.name, err)
+		}
+		if err := client.Close(); err != nil {
+			t.Fatalf("%s: Close: %v", c.name, err)
+		}
+		if transport.closed != 0 {
+			t.Fatalf(
+				"%s: Expected the provided transport to be left open but "+
+					"it was closed %d times",
+				c.name,
+				transport.closed)
+		}
+	}
+
+	// A provided transport that is cloned to apply TLS settings is not
+	// shared with the caller, so the clone is closed
+	config := edgecast.NewSDKConfig()
+	config.Transport = &http.Transport{}
+	config.MinTLSVersion = tls.VersionTLS12
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if !client.ownsTransport {
+		t.Fatalf("Expected the client to own the cloned transport")
+	}
+}
+
+// roundTripperFunc is a RoundTripper of a type that cannot be compared
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClientFuncTransport(t *testing.T) {
+	config := edgecast.NewSDKConfig()
+	config.Transport = roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			return http.DefaultTransport.RoundTrip(req)
+		})
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if client.ownsTransport {
+		t.Fatalf("Expected the provided transport not to be owned")
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
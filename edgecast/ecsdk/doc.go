@@ -0,0 +1,23 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Package ecsdk provides Client, a single entry point to every SDK service.
+
+Services are created the first time they are used and share one HTTP
+client, connection pool, set of authorization providers and IDS tokens,
+logger, rate limiter and instrumentation, all taken from a single
+edgecast.SDKConfig:
+
+	client, err := ecsdk.NewClient(sdkConfig)
+	if err != nil {
+		// ...
+	}
+	defer client.Close()
+
+	wafService, err := client.WAF()
+
+The facade is its own package because every service package imports
+package edgecast.
+*/
+package ecsdk
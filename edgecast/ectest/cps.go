@@ -0,0 +1,66 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the Certificate Provisioning System API
+*/
+
+import "net/http"
+
+// CPSCertificates is the kind of the certificates kept by the fake of the
+// Certificate Provisioning System API, for use with Server.Items
+const CPSCertificates = "cps-certificates"
+
+// CertificateStatus is the status reported by the fake for every certificate
+const CertificateStatus = "Processing"
+
+var cpsCertificate = resource{
+	kind:      CPSCertificates,
+	idField:   "id",
+	numericID: true,
+}
+
+func (s *Server) addCPSRoutes() {
+	const collection = "/sec/cps/v2.0/certificates"
+	const item = collection + "/{id}"
+
+	s.add(
+		http.MethodPost,
+		collection+"/cdnprovided",
+		s.createHandler(cpsCertificate, created))
+	s.add(
+		http.MethodGet,
+		collection,
+		s.listHandler(
+			cpsCertificate,
+			hyperionCollection(collection, "CdnProvidedCertificateWithoutOrg")))
+	s.add(http.MethodGet, item, s.getHandler(cpsCertificate, "id"))
+	s.add(
+		http.MethodPatch,
+		item,
+		s.updateHandler(cpsCertificate, "id", true, nil))
+	s.add(
+		http.MethodDelete,
+		item,
+		s.deleteHandler(cpsCertificate, "id", noContent))
+	s.add(http.MethodGet, item+"/status", s.getCertificateStatus)
+}
+
+// getCertificateStatus reports that every certificate is being processed
+func (s *Server) getCertificateStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	if _, ok := s.get(cpsCertificate, params, params["id"]); !ok {
+		writeNotFound(w, cpsCertificate, params["id"])
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"@type":  "CertificateStatus",
+		"status": CertificateStatus,
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Package ectest provides Server, an in-process fake of the Edgecast APIs for
+testing code built on the SDK's services without network access.
+
+The fake keeps state, so resources that are created can be retrieved, listed,
+updated and deleted, and each is assigned an ID. It serves:
+
+  - the legacy API under /v2/mcc/customers/{account_number}: WAF rules, bot
+    managers and scopes, Route DNS zones, groups, TSIG keys, master server
+    groups and secondary zone groups, origins and edge CNAMEs
+  - the Real-Time Log Delivery API under /rtld, which returns masked values
+    in place of the passwords, tokens and keys of delivery destinations
+  - the Certificate Provisioning System API under /sec/cps
+  - the Origin V3 API under /cdn/origins/v0.5
+  - the Rules Engine API's policies under /rules-engine/v1.1, kept per
+    customer as identified by the Portals_CustomerId header
+  - the IDS token endpoint, /connect/token
+
+Create services with the configuration returned by Server.SDKConfig, or point
+an existing configuration at the fake with Server.Configure:
+
+	server := ectest.NewServer()
+	defer server.Close()
+
+	wafService, err := waf.New(server.SDKConfig())
+
+Faults such as rate limiting, bursts of server errors and the WAF API's
+"rule not processed" error can be injected with Server.InjectFault. Routes for
+endpoints the fake does not serve can be added with Server.Handle.
+*/
+package ectest
@@ -0,0 +1,146 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains faults, which make the fake return errors instead of
+	serving requests
+*/
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Fault makes the server respond to matching requests with an error instead
+// of serving them
+type Fault struct {
+	// Method matches requests with the given HTTP method. Matches all methods
+	// if empty.
+	Method string
+
+	// Path matches requests whose URL path contains the given text. Matches
+	// all paths if empty.
+	Path string
+
+	// Times is the number of matching requests that fail. Zero fails every
+	// matching request until ClearFaults is called.
+	Times int
+
+	// Status is the HTTP status code of the response
+	Status int
+
+	// Header is added to the response
+	Header http.Header
+
+	// Body is the response body, which is sent as JSON if it is valid JSON
+	Body string
+
+	hits int
+}
+
+// RateLimited creates a Fault that fails the given number of requests to
+// paths that contain path with 429 Too Many Requests. The response asks for
+// an immediate retry, so that tests of retries are fast.
+func RateLimited(path string, times int) Fault {
+	return Fault{
+		Path:   path,
+		Times:  times,
+		Status: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"0"}},
+		Body:   `{"title":"Too Many Requests","status":429}`,
+	}
+}
+
+// ServerErrors creates a Fault that fails the given number of requests to
+// paths that contain path with 503 Service Unavailable, as in a burst of
+// server errors. The response asks for an immediate retry.
+func ServerErrors(path string, times int) Fault {
+	return Fault{
+		Path:   path,
+		Times:  times,
+		Status: http.StatusServiceUnavailable,
+		Header: http.Header{"Retry-After": {"0"}},
+		Body:   `{"title":"Service Unavailable","status":503}`,
+	}
+}
+
+// WAFRuleNotProcessed creates a Fault that fails the given number of updates
+// to WAF scopes with the 400 Bad Request returned when a referenced rule has
+// not been processed by the CDN yet
+func WAFRuleNotProcessed(times int) Fault {
+	body, _ := json.Marshal(map[string]interface{}{
+		"success": false,
+		"status":  "error",
+		"errors": []map[string]string{
+			{
+				"code": "400",
+				"message": "One or more rules referenced by the " +
+					"configuration have not been processed yet",
+			},
+		},
+	})
+
+	return Fault{
+		Method: http.MethodPost,
+		Path:   "/waf/v1.0/scopes",
+		Times:  times,
+		Status: http.StatusBadRequest,
+		Body:   string(body),
+	}
+}
+
+// InjectFault makes the server apply f to matching requests. Faults are
+// checked in the order they were injected; the first that matches applies.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// takeFault returns the fault that applies to r, if any, counting the hit.
+// Faults that have been applied Times times are removed.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+
+		f.hits++
+		if f.Times > 0 && f.hits >= f.Times {
+			s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+		}
+		return f
+	}
+	return nil
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (len(f.Method) == 0 || strings.EqualFold(f.Method, r.Method)) &&
+		strings.Contains(r.URL.Path, f.Path)
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	for k, vals := range f.Header {
+		for _, v := range vals {
+			w.Header().Add(k, v)
+		}
+	}
+
+	if json.Valid([]byte(f.Body)) {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(f.Status)
+	w.Write([]byte(f.Body))
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the IDS token endpoint
+*/
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+// TokenExpiresIn is the lifetime in seconds of the tokens issued by the fake
+const TokenExpiresIn = 3600
+
+// AllScopes returns the IDS scopes required by every service that uses IDS
+// credentials
+func AllScopes() []string {
+	var scopes []string
+	for _, required := range [][]string{
+		cps.RequiredScopes(),
+		originv3.RequiredScopes(),
+		rtld.RequiredScopes(),
+		waf_bot_manager.RequiredScopes(),
+	} {
+		scopes = append(scopes, required...)
+	}
+	return scopes
+}
+
+func (s *Server) addIDSRoutes() {
+	r := newRoute(http.MethodPost, "/connect/token", s.issueToken)
+	r.anonymous = true
+	s.routes = append(s.routes, r)
+}
+
+// issueToken grants the requested scopes to any client that presents
+// credentials
+func (s *Server) issueToken(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{
+			"error":             "invalid_request",
+			"error_description": err.Error(),
+		})
+		return
+	}
+
+	if len(r.PostForm.Get("client_id")) == 0 ||
+		len(r.PostForm.Get("client_secret")) == 0 {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{
+			"error": "invalid_client",
+		})
+		return
+	}
+
+	s.mu.Lock()
+	s.nextID++
+	token := "ectest-token-" + strconv.Itoa(s.nextID)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"expires_in":   TokenExpiresIn,
+		"token_type":   "Bearer",
+		"scope":        r.PostForm.Get("scope"),
+	})
+}
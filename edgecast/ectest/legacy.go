@@ -0,0 +1,642 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the legacy API, which serves WAF rules, bot
+	managers and scopes, Route DNS zones, groups, TSIG keys, master server
+	groups and secondary zone groups, origins and edge CNAMEs
+*/
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+)
+
+// Kinds of resources kept by the fake of the legacy API, for use with
+// Server.Items
+const (
+	WAFAccessRules              = "waf-access-rules"
+	WAFManagedRules             = "waf-managed-rules"
+	WAFCustomRules              = "waf-custom-rules"
+	WAFRateRules                = "waf-rate-rules"
+	WAFBotRules                 = "waf-bot-rules"
+	WAFBotManagers              = "waf-bot-managers"
+	WAFScopes                   = "waf-scopes"
+	RouteDNSZones               = "routedns-zones"
+	RouteDNSGroups              = "routedns-groups"
+	RouteDNSTSIGs               = "routedns-tsigs"
+	RouteDNSMasterServerGroups  = "routedns-master-server-groups"
+	RouteDNSSecondaryZoneGroups = "routedns-secondary-zone-groups"
+	Origins                     = "origins"
+	EdgeCnames                  = "edge-cnames"
+)
+
+const legacyPrefix = "/v2/mcc/customers/{account_number}"
+
+// wafScopesID is the ID under which the scopes of an account are stored, as
+// each account has a single scopes configuration
+const wafScopesID = "scopes"
+
+var (
+	routeDNSZone = resource{
+		kind:      RouteDNSZones,
+		idField:   "FixedZoneId",
+		numericID: true,
+		scope:     []string{"account_number"},
+	}
+	routeDNSGroup = resource{
+		kind:      RouteDNSGroups,
+		idField:   "GroupId",
+		numericID: true,
+		scope:     []string{"account_number"},
+	}
+	routeDNSTSIG = resource{
+		kind:      RouteDNSTSIGs,
+		idField:   "Id",
+		numericID: true,
+		scope:     []string{"account_number"},
+	}
+	routeDNSMasterServerGroup = resource{
+		kind:      RouteDNSMasterServerGroups,
+		idField:   "MasterGroupId",
+		numericID: true,
+		scope:     []string{"account_number"},
+	}
+	routeDNSSecondaryZoneGroup = resource{
+		kind:      RouteDNSSecondaryZoneGroups,
+		idField:   "Id",
+		numericID: true,
+		scope:     []string{"account_number"},
+	}
+	legacyOrigin = resource{
+		kind:      Origins,
+		idField:   "Id",
+		numericID: true,
+		scope:     []string{"account_number"},
+		fields:    map[string]string{"platform_id": "MediaTypeId"},
+	}
+	edgeCname = resource{
+		kind:      EdgeCnames,
+		idField:   "Id",
+		numericID: true,
+		scope:     []string{"account_number"},
+		fields:    map[string]string{"platform_id": "MediaTypeId"},
+	}
+	wafScopes = resource{
+		kind:    WAFScopes,
+		idField: "id",
+		scope:   []string{"account_number"},
+	}
+)
+
+func (s *Server) addLegacyRoutes() {
+	s.addWAFRoutes()
+	s.addRouteDNSRoutes()
+	s.addOriginRoutes()
+	s.addEdgeCnameRoutes()
+}
+
+func (s *Server) addWAFRoutes() {
+	for path, kind := range map[string]string{
+		"acl":         WAFAccessRules,
+		"profile":     WAFManagedRules,
+		"rules":       WAFCustomRules,
+		"limit":       WAFRateRules,
+		"bots":        WAFBotRules,
+		"bot-manager": WAFBotManagers,
+	} {
+		res := resource{
+			kind:    kind,
+			idField: "id",
+			scope:   []string{"account_number"},
+		}
+		collection := legacyPrefix + "/waf/v1.0/" + path
+		item := collection + "/{rule_id}"
+
+		s.add(http.MethodPost, collection, s.createHandler(res, wafSuccess))
+		s.add(http.MethodGet, collection, s.listHandler(res, nil))
+		s.add(http.MethodGet, item, s.getHandler(res, "rule_id"))
+		s.add(
+			http.MethodPut,
+			item,
+			s.updateHandler(res, "rule_id", false, wafSuccess))
+		s.add(
+			http.MethodDelete,
+			item,
+			s.deleteHandler(res, "rule_id", wafSuccess))
+	}
+
+	s.add(http.MethodGet, legacyPrefix+"/waf/v1.0/scopes", s.getWAFScopes)
+	s.add(http.MethodPost, legacyPrefix+"/waf/v1.0/scopes", s.putWAFScopes)
+}
+
+// wafSuccess is the response of the WAF API to a successful change
+func wafSuccess(item map[string]interface{}) (int, interface{}) {
+	return http.StatusOK, map[string]interface{}{
+		"id":      item["id"],
+		"success": true,
+		"status":  "success",
+		"errors":  []interface{}{},
+	}
+}
+
+// getWAFScopes returns the scopes of an account, which has no scopes until
+// they are first set
+func (s *Server) getWAFScopes(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	item, ok := s.get(wafScopes, params, wafScopesID)
+	if !ok {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"customer_id": params["account_number"],
+			"scopes":      []interface{}{},
+		})
+		return
+	}
+	s.respond(w, item, nil)
+}
+
+// putWAFScopes replaces the scopes of an account, assigning IDs to the
+// configuration and to any scopes that have none
+func (s *Server) putWAFScopes(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	c := s.store.collection(wafScopes, params)
+	existing, ok := c.items[wafScopesID]
+	if ok {
+		body["id"] = existing["id"]
+	} else {
+		_, body["id"] = s.newID(wafScopes)
+	}
+	body["customer_id"] = params["account_number"]
+
+	scopes, _ := body["scopes"].([]interface{})
+	for _, sc := range scopes {
+		if scope, ok := sc.(map[string]interface{}); ok {
+			if id, _ := scope["id"].(string); len(id) == 0 {
+				_, scope["id"] = s.newID(wafScopes)
+			}
+		}
+	}
+	c.put(wafScopesID, body)
+	s.mu.Unlock()
+
+	s.respond(w, body, wafSuccess)
+}
+
+func (s *Server) addRouteDNSRoutes() {
+	s.add(http.MethodPost, legacyPrefix+"/dns/zone", s.postZone)
+	s.add(
+		http.MethodGet,
+		legacyPrefix+"/dns/zone/{id}",
+		s.getHandler(routeDNSZone, "id"))
+	s.add(
+		http.MethodDelete,
+		legacyPrefix+"/dns/routezone/{id}",
+		s.deleteHandler(routeDNSZone, "id", emptyText))
+
+	s.add(http.MethodPost, legacyPrefix+"/dns/group", s.postGroup)
+	s.add(http.MethodGet, legacyPrefix+"/dns/group", s.getGroup)
+	s.add(http.MethodDelete, legacyPrefix+"/dns/group", s.deleteGroup)
+
+	s.add(
+		http.MethodPost,
+		legacyPrefix+"/dns/tsig",
+		s.createHandler(routeDNSTSIG, idText("Id")))
+	s.add(
+		http.MethodGet,
+		legacyPrefix+"/dns/tsigs/{id}",
+		s.getHandler(routeDNSTSIG, "id"))
+	s.add(
+		http.MethodPut,
+		legacyPrefix+"/dns/tsigs/{id}",
+		s.updateHandler(routeDNSTSIG, "id", false, emptyText))
+	s.add(
+		http.MethodDelete,
+		legacyPrefix+"/dns/tsigs/{id}",
+		s.deleteHandler(routeDNSTSIG, "id", emptyText))
+
+	s.add(
+		http.MethodPost,
+		legacyPrefix+"/dns/mastergroup",
+		s.postMasterServerGroup)
+	s.add(
+		http.MethodGet,
+		legacyPrefix+"/dns/mastergroups",
+		s.getMasterServerGroups)
+	s.add(
+		http.MethodPut,
+		legacyPrefix+"/dns/mastergroup",
+		s.putMasterServerGroup)
+	s.add(
+		http.MethodDelete,
+		legacyPrefix+"/dns/mastergroup/{id}",
+		s.deleteHandler(routeDNSMasterServerGroup, "id", emptyText))
+
+	secondaryGroup := legacyPrefix + "/dns/secondarygroup"
+	s.add(
+		http.MethodPost,
+		secondaryGroup,
+		s.createHandler(routeDNSSecondaryZoneGroup, nil))
+	s.add(
+		http.MethodGet,
+		secondaryGroup,
+		s.getSecondaryZoneGroup)
+	s.add(
+		http.MethodPut,
+		secondaryGroup,
+		s.putSecondaryZoneGroup)
+	s.add(
+		http.MethodDelete,
+		secondaryGroup,
+		withQueryID(
+			s.deleteHandler(routeDNSSecondaryZoneGroup, "id", emptyText)))
+}
+
+// postZone creates a zone, or updates it if the body has a FixedZoneId, as
+// the Route DNS API does
+func (s *Server) postZone(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := fmt.Sprint(body["FixedZoneId"])
+	if n, err := strconv.Atoi(id); err != nil || n == 0 {
+		item := s.create(routeDNSZone, params, body)
+		s.mu.Lock()
+		item["ZoneId"] = item["FixedZoneId"]
+		s.mu.Unlock()
+		s.respond(w, item, idText("FixedZoneId"))
+		return
+	}
+
+	item, ok := s.update(routeDNSZone, params, id, body, false)
+	if !ok {
+		writeNotFound(w, routeDNSZone, id)
+		return
+	}
+	s.mu.Lock()
+	item["ZoneId"] = item["FixedZoneId"]
+	s.mu.Unlock()
+	writeText(w, http.StatusOK, "")
+}
+
+// groupProductTypes maps the groupType query parameter of the group
+// endpoints to the GroupProductTypeId of groups
+var groupProductTypes = map[string]string{"lb": "1", "fo": "2"}
+
+// postGroup creates a group, or replaces it if the body has a GroupId. As in
+// the Route DNS API, a replaced group is assigned a new ID.
+func (s *Server) postGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := fmt.Sprint(body["GroupId"])
+	if n, err := strconv.Atoi(id); err == nil && n != 0 {
+		if !s.remove(routeDNSGroup, params, id) {
+			writeNotFound(w, routeDNSGroup, id)
+			return
+		}
+	}
+
+	item := s.create(routeDNSGroup, params, body)
+	s.mu.Lock()
+	item["FixedGroupId"] = item["GroupId"]
+	s.mu.Unlock()
+	s.respond(w, item, idText("GroupId"))
+}
+
+// findGroup returns the group identified by the id and groupType query
+// parameters, writing a not found error if there is none
+func (s *Server) findGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) (string, map[string]interface{}, bool) {
+	query := r.URL.Query()
+	id := query.Get("id")
+	item, ok := s.get(routeDNSGroup, params, id)
+	if ok {
+		s.mu.Lock()
+		productType := fmt.Sprint(item["GroupProductTypeId"])
+		s.mu.Unlock()
+		ok = productType == groupProductTypes[query.Get("groupType")]
+	}
+	if !ok {
+		writeNotFound(w, routeDNSGroup, id)
+		return "", nil, false
+	}
+	return id, item, true
+}
+
+// getGroup returns the group identified by the query parameters
+func (s *Server) getGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	if _, item, ok := s.findGroup(w, r, params); ok {
+		s.respond(w, item, nil)
+	}
+}
+
+// deleteGroup deletes the group identified by the query parameters
+func (s *Server) deleteGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	id, _, ok := s.findGroup(w, r, params)
+	if !ok {
+		return
+	}
+	s.remove(routeDNSGroup, params, id)
+	writeText(w, http.StatusOK, "")
+}
+
+// postMasterServerGroup creates a master server group. As in the Route DNS
+// API, its master servers are sent as MasterServers but returned as Masters,
+// each with an ID, and the group is returned in an array.
+func (s *Server) postMasterServerGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	body["Masters"] = body["MasterServers"]
+	delete(body, "MasterServers")
+	s.assignMasterServerIDs(body)
+
+	item := s.create(routeDNSMasterServerGroup, params, body)
+	s.respond(w, item, inArray)
+}
+
+// getMasterServerGroups serves both GetMasterServerGroup and
+// GetAllMasterServerGroups, which share a path. A single group, identified
+// by the id query parameter, is returned in an array.
+func (s *Server) getMasterServerGroups(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	id := r.URL.Query().Get("id")
+	if len(id) == 0 {
+		s.listHandler(routeDNSMasterServerGroup, nil)(w, r, params)
+		return
+	}
+
+	item, ok := s.get(routeDNSMasterServerGroup, params, id)
+	if !ok {
+		writeNotFound(w, routeDNSMasterServerGroup, id)
+		return
+	}
+	s.respond(w, item, inArray)
+}
+
+// putMasterServerGroup replaces the master server group identified by the Id
+// field of the body
+func (s *Server) putMasterServerGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := fmt.Sprint(body["Id"])
+	delete(body, "Id")
+	s.assignMasterServerIDs(body)
+
+	if _, ok := s.update(
+		routeDNSMasterServerGroup,
+		params,
+		id,
+		body,
+		false,
+	); !ok {
+		writeNotFound(w, routeDNSMasterServerGroup, id)
+		return
+	}
+	writeText(w, http.StatusOK, "")
+}
+
+// assignMasterServerIDs assigns IDs to the master servers of a group that
+// have none
+func (s *Server) assignMasterServerIDs(group map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	masters, _ := group["Masters"].([]interface{})
+	for _, m := range masters {
+		if master, ok := m.(map[string]interface{}); ok {
+			if id := fmt.Sprint(master["Id"]); id == "<nil>" || id == "0" {
+				_, master["Id"] = s.newID(routeDNSMasterServerGroup)
+			}
+		}
+	}
+}
+
+// putSecondaryZoneGroup replaces the secondary zone group identified by the
+// Id field of the body
+func (s *Server) putSecondaryZoneGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id := fmt.Sprint(body["Id"])
+	if _, ok := s.update(
+		routeDNSSecondaryZoneGroup,
+		params,
+		id,
+		body,
+		false,
+	); !ok {
+		writeNotFound(w, routeDNSSecondaryZoneGroup, id)
+		return
+	}
+	writeText(w, http.StatusOK, "")
+}
+
+// getSecondaryZoneGroup returns the secondary zone group identified by the
+// id query parameter, in an array as the Route DNS API does
+func (s *Server) getSecondaryZoneGroup(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	id := r.URL.Query().Get("id")
+	item, ok := s.get(routeDNSSecondaryZoneGroup, params, id)
+	if !ok {
+		writeNotFound(w, routeDNSSecondaryZoneGroup, id)
+		return
+	}
+	s.respond(w, item, inArray)
+}
+
+// withQueryID sets the path parameter id from the id query parameter
+func withQueryID(handler handlerFunc) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		params["id"] = r.URL.Query().Get("id")
+		handler(w, r, params)
+	}
+}
+
+func (s *Server) addOriginRoutes() {
+	collection := legacyPrefix + "/origins/{platform_id}"
+	item := collection + "/{origin_id}"
+
+	s.add(
+		http.MethodPost,
+		collection,
+		withPlatformID(
+			s.createHandler(legacyOrigin, idObject("CustomerOriginId"))))
+	s.add(
+		http.MethodGet,
+		collection,
+		withPlatformID(s.listHandler(legacyOrigin, nil)))
+	s.add(http.MethodGet, item, s.getHandler(legacyOrigin, "origin_id"))
+	s.add(
+		http.MethodPut,
+		item,
+		s.updateHandler(
+			legacyOrigin,
+			"origin_id",
+			false,
+			idObject("CustomerOriginId")))
+	s.add(
+		http.MethodDelete,
+		legacyPrefix+"/origins/{origin_id}",
+		s.deleteHandler(legacyOrigin, "origin_id", emptyText))
+}
+
+func (s *Server) addEdgeCnameRoutes() {
+	item := legacyPrefix + "/cnames/{edge_cname_id}"
+
+	s.add(
+		http.MethodPost,
+		legacyPrefix+"/cnames",
+		s.createHandler(edgeCname, idObject("CnameId")))
+	s.add(http.MethodGet, item, s.getEdgeCnames)
+	s.add(
+		http.MethodPut,
+		item,
+		s.updateHandler(
+			edgeCname,
+			"edge_cname_id",
+			false,
+			idObject("CnameId")))
+	s.add(
+		http.MethodDelete,
+		item,
+		s.deleteHandler(edgeCname, "edge_cname_id", emptyText))
+}
+
+// getEdgeCnames serves both GetEdgeCname and GetAllEdgeCnames, which share a
+// path. The segment is taken to be an edge CNAME ID if one exists with that
+// ID, and a platform otherwise.
+func (s *Server) getEdgeCnames(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	if _, ok := s.get(edgeCname, params, params["edge_cname_id"]); ok {
+		s.getHandler(edgeCname, "edge_cname_id")(w, r, params)
+		return
+	}
+
+	params["platform_id"] = params["edge_cname_id"]
+	withPlatformID(s.listHandler(edgeCname, nil))(w, r, params)
+}
+
+// withPlatformID replaces the platform name in the path parameter
+// platform_id, e.g. "httplarge", with the numeric ID stored in items, e.g. 3
+func withPlatformID(handler handlerFunc) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		for _, p := range []enums.Platform{
+			enums.HttpLarge,
+			enums.HttpSmall,
+			enums.ADN,
+		} {
+			if params["platform_id"] == p.StringWithoutHyphen() {
+				params["platform_id"] = strconv.Itoa(int(p))
+			}
+		}
+		handler(w, r, params)
+	}
+}
+
+// idText responds with the value of idField as plain text
+func idText(idField string) responder {
+	return func(item map[string]interface{}) (int, interface{}) {
+		return http.StatusOK, fmt.Sprint(item[idField])
+	}
+}
+
+// idObject responds with an object that holds the ID of the item in the
+// field name
+func idObject(name string) responder {
+	return func(item map[string]interface{}) (int, interface{}) {
+		return http.StatusOK, map[string]interface{}{name: item["Id"]}
+	}
+}
+
+// inArray responds with an array holding the item
+func inArray(item map[string]interface{}) (int, interface{}) {
+	return http.StatusOK, []interface{}{item}
+}
+
+// emptyText responds with an empty body
+func emptyText(item map[string]interface{}) (int, interface{}) {
+	return http.StatusOK, ""
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the Origin V3 API
+*/
+
+import "net/http"
+
+// Kinds of resources kept by the fake of the Origin V3 API, for use with
+// Server.Items
+const (
+	OriginV3Groups  = "originv3-groups"
+	OriginV3Origins = "originv3-origins"
+)
+
+const originV3Prefix = "/cdn/origins/v0.5"
+
+var (
+	originV3Group = resource{
+		kind:      OriginV3Groups,
+		idField:   "id",
+		numericID: true,
+	}
+	originV3Origin = resource{
+		kind:      OriginV3Origins,
+		idField:   "id",
+		numericID: true,
+		scope:     []string{"mediaType"},
+		fields:    map[string]string{"groupId": "group_id"},
+	}
+)
+
+func (s *Server) addOriginV3Routes() {
+	// Groups are registered first, as their paths would otherwise match the
+	// routes for origins
+	groups := originV3Prefix + "/http-large/groups"
+	s.add(http.MethodPost, groups, s.createHandler(originV3Group, nil))
+	s.add(http.MethodGet, groups, s.listHandler(originV3Group, nil))
+	s.add(
+		http.MethodGet,
+		groups+"/{groupId}",
+		s.getHandler(originV3Group, "groupId"))
+	s.add(
+		http.MethodPut,
+		groups+"/{groupId}",
+		s.updateHandler(originV3Group, "groupId", false, nil))
+	s.add(
+		http.MethodDelete,
+		originV3Prefix+"/{mediaType}/groups/{groupId}",
+		s.deleteHandler(originV3Group, "groupId", noContent))
+	s.add(
+		http.MethodGet,
+		originV3Prefix+"/{mediaType}/groups/{groupId}/origins",
+		s.listHandler(originV3Origin, nil))
+
+	origins := originV3Prefix + "/{mediaType}"
+	s.add(http.MethodPost, origins, s.createHandler(originV3Origin, nil))
+	s.add(http.MethodGet, origins, s.listHandler(originV3Origin, nil))
+	s.add(
+		http.MethodGet,
+		origins+"/{id}",
+		s.getHandler(originV3Origin, "id"))
+	s.add(
+		http.MethodPut,
+		origins+"/{id}",
+		s.updateHandler(originV3Origin, "id", false, nil))
+	s.add(
+		http.MethodDelete,
+		origins+"/{id}",
+		s.deleteHandler(originV3Origin, "id", noContent))
+}
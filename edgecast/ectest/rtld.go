@@ -0,0 +1,124 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the Real-Time Log Delivery API
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// Kinds of resources kept by the fake of the Real-Time Log Delivery API, for
+// use with Server.Items
+const (
+	RTLDCDNProfiles          = "rtld-cdn-profiles"
+	RTLDWAFProfiles          = "rtld-waf-profiles"
+	RTLDRateLimitingProfiles = "rtld-rl-profiles"
+)
+
+// rtldSecrets lists the fields of delivery destinations that are never
+// returned. A masked value is returned in their place, e.g. masked_token for
+// token.
+var rtldSecrets = []string{"password", "token", "api_key", "access_key"}
+
+func (s *Server) addRTLDRoutes() {
+	for _, p := range []struct {
+		path     string
+		kind     string
+		itemType string
+	}{
+		{"cdn", RTLDCDNProfiles, "CdnProfileDto"},
+		{"waf", RTLDWAFProfiles, "WafProfileDto"},
+		{"rl", RTLDRateLimitingProfiles, "RateLimitingProfileDto"},
+	} {
+		res := resource{kind: p.kind, idField: "id", numericID: true}
+		collection := "/rtld/v1.0/" + p.path + "/profiles"
+		item := collection + "/{id}"
+
+		s.add(
+			http.MethodPost,
+			collection,
+			maskSecrets(s.createHandler(res, created)))
+		s.add(
+			http.MethodGet,
+			collection,
+			s.listHandler(res, hyperionCollection(collection, p.itemType)))
+		s.add(http.MethodGet, item, s.getHandler(res, "id"))
+		s.add(
+			http.MethodPut,
+			item,
+			maskSecrets(s.updateHandler(res, "id", false, nil)))
+		s.add(http.MethodDelete, item, s.deleteHandler(res, "id", noContent))
+	}
+}
+
+// maskSecrets replaces the secrets of the delivery destinations in the
+// request body with masked values before it is handled, so that they are
+// never returned
+func maskSecrets(next handlerFunc) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		body, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		for _, v := range body {
+			destination, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, field := range rtldSecrets {
+				secret, _ := destination[field].(string)
+				if len(secret) > 0 {
+					destination["masked_"+field] = "********"
+				}
+				delete(destination, field)
+			}
+		}
+
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(encoded))
+		next(w, r, params)
+	}
+}
+
+// hyperionCollection wraps items in the collection format shared by the
+// Real-Time Log Delivery and Certificate Provisioning System APIs
+func hyperionCollection(
+	path string,
+	itemType string,
+) func(items []map[string]interface{}) interface{} {
+	return func(items []map[string]interface{}) interface{} {
+		return map[string]interface{}{
+			"@id":         path,
+			"@type":       "Collection<" + itemType + ">",
+			"items":       items,
+			"total_items": len(items),
+		}
+	}
+}
+
+// created responds with the item and 201 Created
+func created(item map[string]interface{}) (int, interface{}) {
+	return http.StatusCreated, item
+}
+
+// noContent responds with 204 No Content
+func noContent(item map[string]interface{}) (int, interface{}) {
+	return http.StatusNoContent, nil
+}
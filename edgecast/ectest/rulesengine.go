@@ -0,0 +1,79 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the fake of the Rules Engine API
+*/
+
+import "net/http"
+
+// RulesEnginePolicies is the kind of the resources kept by the fake of the
+// Rules Engine API, for use with Server.Items
+const RulesEnginePolicies = "rules-engine-policies"
+
+const rulesEnginePrefix = "/rules-engine/v1.1"
+
+// rulesEnginePolicy is scoped by customer_id, which is set from the
+// Portals_CustomerId header
+var rulesEnginePolicy = resource{
+	kind:    RulesEnginePolicies,
+	idField: "id",
+	scope:   []string{"customer_id"},
+}
+
+func (s *Server) addRulesEngineRoutes() {
+	s.add(
+		http.MethodPost,
+		rulesEnginePrefix+"/policies",
+		withCustomerID(s.postPolicy))
+	s.add(
+		http.MethodGet,
+		rulesEnginePrefix+"/policies/{id}",
+		withCustomerID(s.getHandler(rulesEnginePolicy, "id")))
+}
+
+// postPolicy creates a policy. As in the Rules Engine API, policies and their
+// rules are assigned IDs, and created policies are of type Policy.
+func (s *Server) postPolicy(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	item := s.create(rulesEnginePolicy, params, body)
+
+	s.mu.Lock()
+	item["@id"] = rulesEnginePrefix + "/policies/" + item["id"].(string)
+	item["@type"] = "Policy"
+	item["policy_type"] = "customer"
+	rules, _ := item["rules"].([]interface{})
+	for i, r := range rules {
+		if rule, ok := r.(map[string]interface{}); ok {
+			_, rule["id"] = s.newID(rulesEnginePolicy)
+			rule["ordinal"] = i + 1
+		}
+	}
+	s.mu.Unlock()
+
+	s.respond(w, item, created)
+}
+
+// withCustomerID sets the path parameter customer_id from the
+// Portals_CustomerId header
+func withCustomerID(handler handlerFunc) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		params["customer_id"] = r.Header.Get("Portals_CustomerId")
+		handler(w, r, params)
+	}
+}
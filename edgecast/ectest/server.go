@@ -0,0 +1,297 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains Server, which routes requests to the fake APIs after
+	applying injected faults
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+)
+
+const (
+	// APIToken is the API token set by Server.SDKConfig. The fake accepts
+	// any token.
+	APIToken = "ectest-api-token"
+
+	// ClientID is the IDS client ID set by Server.SDKConfig
+	ClientID = "ectest-client"
+
+	// ClientSecret is the IDS client secret set by Server.SDKConfig
+	ClientSecret = "ectest-secret"
+)
+
+// Request is a request received by a Server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a stateful fake of the Edgecast APIs running on a local HTTP
+// server. It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the fake, e.g. http://127.0.0.1:50000
+	URL *url.URL
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	routes   []route
+	store    store
+	nextID   int
+	faults   []*Fault
+	requests []Request
+}
+
+// NewServer starts a Server. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		store:  store{},
+		nextID: 100000,
+	}
+
+	s.addIDSRoutes()
+	s.addLegacyRoutes()
+	s.addRTLDRoutes()
+	s.addCPSRoutes()
+	s.addOriginV3Routes()
+	s.addRulesEngineRoutes()
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL, _ = url.Parse(s.server.URL)
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Configure points every base URL in config at the server
+func (s *Server) Configure(config *edgecast.SDKConfig) {
+	config.BaseAPIURL = *s.URL
+	config.BaseAPIURLLegacy = *s.URL
+	config.BaseIDSURL = *s.URL
+}
+
+// SDKConfig returns a configuration for services that use the server, with
+// an API token and IDS credentials that are granted every scope
+func (s *Server) SDKConfig() edgecast.SDKConfig {
+	config := edgecast.NewSDKConfig()
+	s.Configure(&config)
+
+	config.APIToken = APIToken
+	config.IDSCredentials = edgecast.IDSCredentials{
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		Scope:        ecauth.JoinScopes(AllScopes()),
+	}
+
+	return config
+}
+
+// Handle adds a route for requests with the given method whose path matches
+// pattern, e.g. "/v2/pcc/customers/{account_number}". Segments in braces
+// match any value, which handler can retrieve with PathParams. Routes added
+// with Handle take precedence over the built-in routes.
+func (s *Server) Handle(method string, pattern string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := newRoute(method, pattern, func(
+		w http.ResponseWriter,
+		req *http.Request,
+		params map[string]string,
+	) {
+		handler.ServeHTTP(
+			w,
+			req.WithContext(
+				context.WithValue(req.Context(), pathParamsKey{}, params)))
+	})
+	r.anonymous = true
+	s.routes = append([]route{r}, s.routes...)
+}
+
+// PathParams returns the values of the segments in braces of the pattern
+// that matched a request passed to a handler added with Server.Handle
+func PathParams(r *http.Request) map[string]string {
+	params, _ := r.Context().Value(pathParamsKey{}).(map[string]string)
+	return params
+}
+
+// Requests returns every request received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// Items returns a copy of every stored item of a kind of resource, in the
+// order they were created, e.g. Items(WAFAccessRules). Use it to inspect the
+// state of the fake or to assert on the effects of code under test.
+func (s *Server) Items(kind string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyItems(s.store.all(kind))
+}
+
+type pathParamsKey struct{}
+
+type handlerFunc func(
+	w http.ResponseWriter,
+	r *http.Request,
+	params map[string]string,
+)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+
+	// anonymous is true if requests need no Authorization header
+	anonymous bool
+}
+
+func newRoute(method string, pattern string, handler handlerFunc) route {
+	return route{
+		method:   method,
+		segments: splitPath(pattern),
+		handler:  handler,
+	}
+}
+
+// match reports whether the route handles method and path, returning the
+// values of its parameters
+func (r route) match(
+	method string,
+	segments []string,
+) (map[string]string, bool) {
+	if r.method != method || len(r.segments) != len(segments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, s := range r.segments {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			params[s[1:len(s)-1]] = segments[i]
+		} else if s != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// add registers a built-in route for authorized requests
+func (s *Server) add(method string, pattern string, handler handlerFunc) {
+	s.routes = append(s.routes, newRoute(method, pattern, handler))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	if f := s.takeFault(r); f != nil {
+		s.mu.Unlock()
+		f.write(w)
+		return
+	}
+
+	segments := splitPath(r.URL.Path)
+	var matched *route
+	var params map[string]string
+	for i := range s.routes {
+		if p, ok := s.routes[i].match(r.Method, segments); ok {
+			matched = &s.routes[i]
+			params = p
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if matched == nil {
+		writeError(
+			w,
+			http.StatusNotFound,
+			"ectest: no route for "+r.Method+" "+r.URL.Path)
+		return
+	}
+
+	if !matched.anonymous && len(r.Header.Get("Authorization")) == 0 {
+		writeError(w, http.StatusUnauthorized, "Authorization header required")
+		return
+	}
+
+	r.Body = io.NopCloser(strings.NewReader(string(body)))
+	matched.handler(w, r, params)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// writeJSON writes v as the JSON body of a response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeText writes s as the plain text body of a response
+func writeText(w http.ResponseWriter, status int, s string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+	io.WriteString(w, s)
+}
+
+// writeError writes an error response in the format shared by most APIs
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"title":   http.StatusText(status),
+		"status":  status,
+		"message": message,
+	})
+}
+
+// readObject decodes the JSON object in the body of r. Numbers are kept as
+// json.Number so that they are written back unchanged.
+func readObject(r *http.Request) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return obj, nil
+}
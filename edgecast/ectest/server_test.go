@@ -0,0 +1,722 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/certificate"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/models"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/edgecname"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/origin"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/routedns"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_cdn"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtldmodels"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rulesengine"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/enums"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/scopes"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+const accountNumber = "ABCD"
+
+func TestWAFAccessRuleRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := waf.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("waf.New: %v", err)
+	}
+
+	id, err := svc.Access.AddAccessRule(access.AddAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRule:    access.AccessRule{Name: "rule"},
+	})
+	if err != nil || len(id) == 0 {
+		t.Fatalf("AddAccessRule: Expected an ID but got %q, %v", id, err)
+	}
+
+	err = svc.Access.UpdateAccessRule(access.UpdateAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRuleID:  id,
+		AccessRule:    access.AccessRule{Name: "renamed"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateAccessRule: %v", err)
+	}
+
+	rule, err := svc.Access.GetAccessRule(access.GetAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRuleID:  id,
+	})
+	if err != nil {
+		t.Fatalf("GetAccessRule: %v", err)
+	}
+	if rule.ID != id || rule.Name != "renamed" {
+		t.Fatalf("Expected rule %s named renamed but got %+v", id, rule)
+	}
+
+	all, err := svc.Access.GetAllAccessRules(access.GetAllAccessRulesParams{
+		AccountNumber: accountNumber,
+	})
+	if err != nil || len(*all) != 1 {
+		t.Fatalf("GetAllAccessRules: Expected 1 rule but got %+v, %v", all, err)
+	}
+
+	err = svc.Access.DeleteAccessRule(access.DeleteAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRuleID:  id,
+	})
+	if err != nil {
+		t.Fatalf("DeleteAccessRule: %v", err)
+	}
+
+	_, err = svc.Access.GetAccessRule(access.GetAccessRuleParams{
+		AccountNumber: accountNumber,
+		AccessRuleID:  id,
+	})
+	if err == nil {
+		t.Fatalf("GetAccessRule: Expected an error for a deleted rule")
+	}
+
+	if items := server.Items(WAFAccessRules); len(items) != 0 {
+		t.Fatalf("Expected no stored rules but got %+v", items)
+	}
+}
+
+func TestWAFScopesRetriesRuleNotProcessed(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := waf.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("waf.New: %v", err)
+	}
+
+	server.InjectFault(WAFRuleNotProcessed(1))
+
+	resp, err := svc.Scopes.ModifyAllScopes(scopes.Scopes{
+		CustomerID: accountNumber,
+		Scopes:     []scopes.Scope{{Name: "scope"}},
+	})
+	if err != nil || len(resp.ID) == 0 {
+		t.Fatalf("ModifyAllScopes: Expected an ID but got %+v, %v", resp, err)
+	}
+
+	got, err := svc.Scopes.GetAllScopes(scopes.GetAllScopesParams{
+		AccountNumber: accountNumber,
+	})
+	if err != nil {
+		t.Fatalf("GetAllScopes: %v", err)
+	}
+	if got.ID != resp.ID ||
+		len(got.Scopes) != 1 ||
+		len(got.Scopes[0].ID) == 0 {
+		t.Fatalf("Expected scopes %s with 1 scope but got %+v", resp.ID, got)
+	}
+
+	posts := 0
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodPost {
+			posts++
+		}
+	}
+	if posts != 2 {
+		t.Fatalf("Expected 2 POSTs but got %d", posts)
+	}
+}
+
+func TestRouteDNSRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := routedns.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("routedns.New: %v", err)
+	}
+
+	zoneID, err := svc.AddZone(routedns.AddZoneParams{
+		AccountNumber: accountNumber,
+		Zone:          routedns.Zone{DomainName: "example.com."},
+	})
+	if err != nil {
+		t.Fatalf("AddZone: %v", err)
+	}
+
+	zone, err := svc.GetZone(routedns.GetZoneParams{
+		AccountNumber: accountNumber,
+		ZoneID:        *zoneID,
+	})
+	if err != nil {
+		t.Fatalf("GetZone: %v", err)
+	}
+	if zone.FixedZoneID != *zoneID || zone.DomainName != "example.com." {
+		t.Fatalf("Expected zone %d but got %+v", *zoneID, zone)
+	}
+
+	zone.Comment = "updated"
+	err = svc.UpdateZone(routedns.UpdateZoneParams{
+		AccountNumber: accountNumber,
+		Zone:          *zone,
+	})
+	if err != nil {
+		t.Fatalf("UpdateZone: %v", err)
+	}
+	if items := server.Items(RouteDNSZones); len(items) != 1 ||
+		items[0]["Comment"] != "updated" {
+		t.Fatalf("Expected 1 updated zone but got %+v", items)
+	}
+
+	groupID, err := svc.AddGroup(routedns.AddGroupParams{
+		AccountNumber: accountNumber,
+		Group: routedns.DnsRouteGroup{
+			Name:             "lb",
+			GroupProductType: routedns.LoadBalancing,
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddGroup: %v", err)
+	}
+
+	group, err := svc.GetGroup(routedns.GetGroupParams{
+		AccountNumber:    accountNumber,
+		GroupID:          *groupID,
+		GroupProductType: routedns.LoadBalancing,
+	})
+	if err != nil || group.GroupID != *groupID || group.Name != "lb" {
+		t.Fatalf(
+			"GetGroup: Expected group %d but got %+v, %v",
+			*groupID,
+			group,
+			err)
+	}
+
+	_, err = svc.GetGroup(routedns.GetGroupParams{
+		AccountNumber:    accountNumber,
+		GroupID:          *groupID,
+		GroupProductType: routedns.Failover,
+	})
+	if !edgecast.IsNotFound(err) {
+		t.Fatalf("GetGroup: Expected not found for failover but got %v", err)
+	}
+
+	updateParams := &routedns.UpdateGroupParams{
+		AccountNumber: accountNumber,
+		Group:         group,
+	}
+	if err := svc.UpdateGroup(updateParams); err != nil {
+		t.Fatalf("UpdateGroup: %v", err)
+	}
+	if updateParams.Group.GroupID == *groupID {
+		t.Fatalf("UpdateGroup: Expected a new ID but got %d", *groupID)
+	}
+
+	err = svc.DeleteGroup(routedns.DeleteGroupParams{
+		AccountNumber: accountNumber,
+		Group:         *updateParams.Group,
+	})
+	if err != nil {
+		t.Fatalf("DeleteGroup: %v", err)
+	}
+	if items := server.Items(RouteDNSGroups); len(items) != 0 {
+		t.Fatalf("Expected no stored groups but got %+v", items)
+	}
+
+	tsigID, err := svc.AddTSIG(routedns.AddTSIGParams{
+		AccountNumber: accountNumber,
+		TSIG:          routedns.TSIG{Alias: "key"},
+	})
+	if err != nil {
+		t.Fatalf("AddTSIG: %v", err)
+	}
+
+	tsig, err := svc.GetTSIG(routedns.GetTSIGParams{
+		AccountNumber: accountNumber,
+		TSIGID:        *tsigID,
+	})
+	if err != nil || tsig.ID != *tsigID || tsig.Alias != "key" {
+		t.Fatalf("GetTSIG: Expected TSIG %d but got %+v, %v", *tsigID, tsig, err)
+	}
+
+	err = svc.DeleteTSIG(routedns.DeleteTSIGParams{
+		AccountNumber: accountNumber,
+		TSIG:          *tsig,
+	})
+	if err != nil {
+		t.Fatalf("DeleteTSIG: %v", err)
+	}
+	if items := server.Items(RouteDNSTSIGs); len(items) != 0 {
+		t.Fatalf("Expected no stored TSIGs but got %+v", items)
+	}
+}
+
+func TestRouteDNSSecondaryZonesRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := routedns.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("routedns.New: %v", err)
+	}
+
+	added, err := svc.AddMasterServerGroup(routedns.AddMasterServerGroupParams{
+		AccountNumber: accountNumber,
+		MasterServerGroup: routedns.MasterServerGroupAddRequest{
+			Name: "masters",
+			Masters: []routedns.MasterServer{
+				{Name: "ns1", IPAddress: "192.0.2.1"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddMasterServerGroup: %v", err)
+	}
+	if len(added.Masters) != 1 || added.Masters[0].ID == 0 {
+		t.Fatalf("Expected a master server with an ID but got %+v", added)
+	}
+
+	groups, err := svc.GetAllMasterServerGroups(
+		routedns.GetAllMasterServerGroupsParams{AccountNumber: accountNumber})
+	if err != nil || len(*groups) != 1 ||
+		(*groups)[0].MasterGroupID != added.MasterGroupID {
+		t.Fatalf(
+			"GetAllMasterServerGroups: Expected group %d but got %+v, %v",
+			added.MasterGroupID,
+			groups,
+			err)
+	}
+
+	err = svc.UpdateMasterServerGroup(routedns.UpdateMasterServerGroupParams{
+		AccountNumber: accountNumber,
+		MasterServerGroup: routedns.MasterServerGroupUpdateRequest{
+			MasterServerGroup: routedns.MasterServerGroup{
+				Name:    "renamed",
+				Masters: added.Masters,
+			},
+			MasterGroupID: added.MasterGroupID,
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMasterServerGroup: %v", err)
+	}
+
+	group, err := svc.GetMasterServerGroup(routedns.GetMasterServerGroupParams{
+		AccountNumber:       accountNumber,
+		MasterServerGroupID: added.MasterGroupID,
+	})
+	if err != nil || group.Name != "renamed" ||
+		group.MasterGroupID != added.MasterGroupID {
+		t.Fatalf(
+			"GetMasterServerGroup: Expected renamed group %d but got %+v, %v",
+			added.MasterGroupID,
+			group,
+			err)
+	}
+
+	secondary, err := svc.AddSecondaryZoneGroup(
+		routedns.AddSecondaryZoneGroupParams{
+			AccountNumber: accountNumber,
+			SecondaryZoneGroup: routedns.SecondaryZoneGroup{
+				Name: "secondary",
+				ZoneComposition: routedns.ZoneComposition{
+					MasterGroupID: added.MasterGroupID,
+				},
+			},
+		})
+	if err != nil {
+		t.Fatalf("AddSecondaryZoneGroup: %v", err)
+	}
+
+	got, err := svc.GetSecondaryZoneGroup(routedns.GetSecondaryZoneGroupParams{
+		AccountNumber: accountNumber,
+		ID:            secondary.ID,
+	})
+	if err != nil || got.Name != "secondary" ||
+		got.ZoneComposition.MasterGroupID != added.MasterGroupID {
+		t.Fatalf(
+			"GetSecondaryZoneGroup: Expected group %d but got %+v, %v",
+			secondary.ID,
+			got,
+			err)
+	}
+
+	err = svc.DeleteSecondaryZoneGroup(routedns.DeleteSecondaryZoneGroupParams{
+		AccountNumber:      accountNumber,
+		SecondaryZoneGroup: *got,
+	})
+	if err != nil {
+		t.Fatalf("DeleteSecondaryZoneGroup: %v", err)
+	}
+	err = svc.DeleteMasterServerGroup(routedns.DeleteMasterServerGroupParams{
+		AccountNumber:     accountNumber,
+		MasterServerGroup: *group,
+	})
+	if err != nil {
+		t.Fatalf("DeleteMasterServerGroup: %v", err)
+	}
+	for _, kind := range []string{
+		RouteDNSMasterServerGroups,
+		RouteDNSSecondaryZoneGroups,
+	} {
+		if items := server.Items(kind); len(items) != 0 {
+			t.Fatalf("Expected no stored %s but got %+v", kind, items)
+		}
+	}
+}
+
+func TestOriginAndEdgeCnameRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	originService, err := origin.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("origin.New: %v", err)
+	}
+
+	originID, err := originService.AddOrigin(origin.AddOriginParams{
+		AccountNumber: accountNumber,
+		MediaTypeID:   enums.HttpLarge,
+		Origin:        origin.Origin{DirectoryName: "www"},
+	})
+	if err != nil {
+		t.Fatalf("AddOrigin: %v", err)
+	}
+
+	o, err := originService.GetOrigin(origin.GetOriginParams{
+		AccountNumber:    accountNumber,
+		MediaTypeID:      enums.HttpLarge,
+		CustomerOriginID: *originID,
+	})
+	if err != nil ||
+		o.ID != *originID ||
+		o.DirectoryName != "www" ||
+		o.MediaTypeID != enums.HttpLarge {
+		t.Fatalf("GetOrigin: Expected origin %d but got %+v, %v", *originID, o, err)
+	}
+
+	others, err := originService.GetAllOrigins(origin.GetAllOriginsParams{
+		AccountNumber: accountNumber,
+		MediaTypeID:   enums.ADN,
+	})
+	if err != nil || len(*others) != 0 {
+		t.Fatalf(
+			"GetAllOrigins: Expected no ADN origins but got %+v, %v",
+			others,
+			err)
+	}
+
+	cnameService, err := edgecname.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("edgecname.New: %v", err)
+	}
+
+	cnameID, err := cnameService.AddEdgeCname(edgecname.AddEdgeCnameParams{
+		AccountNumber: accountNumber,
+		EdgeCname: edgecname.EdgeCname{
+			Name:        "cdn.example.com",
+			MediaTypeID: int(enums.HttpLarge),
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddEdgeCname: %v", err)
+	}
+
+	cnames, err := cnameService.GetAllEdgeCnames(
+		edgecname.GetAllEdgeCnameParams{
+			AccountNumber: accountNumber,
+			Platform:      enums.HttpLarge,
+		})
+	if err != nil || len(*cnames) != 1 || (*cnames)[0].ID != *cnameID {
+		t.Fatalf(
+			"GetAllEdgeCnames: Expected edge CNAME %d but got %+v, %v",
+			*cnameID,
+			cnames,
+			err)
+	}
+}
+
+func TestWAFBotManagerRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := waf_bot_manager.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("waf_bot_manager.New: %v", err)
+	}
+
+	name := "bots"
+	resp, err := svc.BotManagers.CreateBotManager(
+		waf_bot_manager.CreateBotManagerParams{
+			CustId:         accountNumber,
+			BotManagerInfo: waf_bot_manager.BotManager{Name: &name},
+		})
+	if err != nil || resp.Id == nil {
+		t.Fatalf("CreateBotManager: Expected an ID but got %+v, %v", resp, err)
+	}
+
+	managers, err := svc.BotManagers.GetBotManagers(
+		waf_bot_manager.GetBotManagersParams{CustId: accountNumber})
+	if err != nil || len(managers) != 1 || managers[0].Name != name {
+		t.Fatalf(
+			"GetBotManagers: Expected %s but got %+v, %v",
+			name,
+			managers,
+			err)
+	}
+
+	err = svc.BotManagers.DeleteBotManager(
+		waf_bot_manager.DeleteBotManagerParams{
+			CustId:       accountNumber,
+			BotManagerId: *resp.Id,
+		})
+	if err != nil {
+		t.Fatalf("DeleteBotManager: %v", err)
+	}
+	if items := server.Items(WAFBotManagers); len(items) != 0 {
+		t.Fatalf("Expected no stored bot managers but got %+v", items)
+	}
+}
+
+func TestRulesEnginePolicyRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := rulesengine.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("rulesengine.New: %v", err)
+	}
+
+	resp, err := svc.AddPolicy(rulesengine.AddPolicyParams{
+		AccountNumber: accountNumber,
+		PolicyAsString: `{"@type": "policy-create", "name": "policy",
+			"rules": [{"name": "rule", "matches": []}]}`,
+	})
+	if err != nil {
+		t.Fatalf("AddPolicy: %v", err)
+	}
+
+	policyID, err := strconv.Atoi(resp.ID)
+	if err != nil {
+		t.Fatalf("AddPolicy: Expected a numeric ID but got %q", resp.ID)
+	}
+
+	policy, err := svc.GetPolicy(rulesengine.GetPolicyParams{
+		AccountNumber: accountNumber,
+		PolicyID:      policyID,
+	})
+	if err != nil || policy["name"] != "policy" ||
+		policy["@type"] != "Policy" {
+		t.Fatalf("GetPolicy: Expected policy but got %+v, %v", policy, err)
+	}
+
+	// Policies are kept per customer
+	_, err = svc.GetPolicy(rulesengine.GetPolicyParams{
+		AccountNumber: "ABCE",
+		PolicyID:      policyID,
+	})
+	if !edgecast.IsNotFound(err) {
+		t.Fatalf("GetPolicy: Expected not found but got %v", err)
+	}
+}
+
+func TestRTLDRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := rtld.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("rtld.New: %v", err)
+	}
+
+	addParams := profiles_cdn.NewProfilesAddCustomerSettingParams()
+	addParams.SettingDto = &rtldmodels.CdnProfileDto{}
+	addParams.SettingDto.Description = "profile"
+	addParams.SettingDto.HTTPPost = &rtldmodels.RtldHTTPPostSettingDto{
+		Token: "secret",
+	}
+	added, err := svc.ProfilesCdn.ProfilesAddCustomerSetting(addParams)
+	if err != nil || added.ID == 0 {
+		t.Fatalf(
+			"ProfilesAddCustomerSetting: Expected an ID but got %+v, %v",
+			added,
+			err)
+	}
+	if added.HTTPPost == nil ||
+		len(added.HTTPPost.Token) > 0 ||
+		len(added.HTTPPost.MaskedToken) == 0 {
+		t.Fatalf(
+			"ProfilesAddCustomerSetting: Expected a masked token but got %+v",
+			added.HTTPPost)
+	}
+
+	listParams := profiles_cdn.NewProfilesGetCustomerSettingsParams()
+	list, err := svc.ProfilesCdn.ProfilesGetCustomerSettings(listParams)
+	if err != nil || list.TotalItems != 1 || list.Items[0].ID != added.ID {
+		t.Fatalf(
+			"ProfilesGetCustomerSettings: Expected profile %d but got %+v, %v",
+			added.ID,
+			list,
+			err)
+	}
+
+	deleteParams := profiles_cdn.NewProfilesDeleteCustomerSettingsByIDParams()
+	deleteParams.ID = added.ID
+	_, err = svc.ProfilesCdn.ProfilesDeleteCustomerSettingsByID(deleteParams)
+	if err != nil {
+		t.Fatalf("ProfilesDeleteCustomerSettingsByID: %v", err)
+	}
+	if items := server.Items(RTLDCDNProfiles); len(items) != 0 {
+		t.Fatalf("Expected no stored profiles but got %+v", items)
+	}
+}
+
+func TestCPSRoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := cps.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("cps.New: %v", err)
+	}
+
+	postParams := certificate.NewCertificatePostParams()
+	postParams.Certificate = &models.CertificateCreate{
+		CertificateLabel: "label",
+	}
+	created, err := svc.Certificate.CertificatePost(postParams)
+	if err != nil || created.ID == 0 {
+		t.Fatalf("CertificatePost: Expected an ID but got %+v, %v", created, err)
+	}
+
+	getParams := certificate.NewCertificateGetParams()
+	getParams.ID = created.ID
+	got, err := svc.Certificate.CertificateGet(getParams)
+	if err != nil || got.CertificateLabel != "label" {
+		t.Fatalf(
+			"CertificateGet: Expected certificate %d but got %+v, %v",
+			created.ID,
+			got,
+			err)
+	}
+}
+
+func TestOriginV3RoundTrip(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	svc, err := originv3.New(server.SDKConfig())
+	if err != nil {
+		t.Fatalf("originv3.New: %v", err)
+	}
+
+	groupParams := originv3.NewAddHttpLargeGroupParams()
+	groupParams.CustomerOriginGroupHTTPRequest =
+		*originv3.NewCustomerOriginGroupHTTPRequest("group")
+	group, err := svc.HttpLargeOnly.AddHttpLargeGroup(groupParams)
+	if err != nil || group.Id == nil {
+		t.Fatalf("AddHttpLargeGroup: Expected an ID but got %+v, %v", group, err)
+	}
+
+	originParams := originv3.NewAddOriginParams()
+	originParams.MediaType = enums.HttpLarge.String()
+	originParams.CustomerOriginRequest =
+		*originv3.NewCustomerOriginRequest("origin.example.com", true, *group.Id)
+	o, err := svc.Common.AddOrigin(originParams)
+	if err != nil || o.Id == nil {
+		t.Fatalf("AddOrigin: Expected an ID but got %+v, %v", o, err)
+	}
+
+	byGroupParams := originv3.NewGetOriginsByGroupParams()
+	byGroupParams.MediaType = enums.HttpLarge.String()
+	byGroupParams.GroupId = *group.Id
+	origins, err := svc.Common.GetOriginsByGroup(byGroupParams)
+	if err != nil || len(origins) != 1 {
+		t.Fatalf("GetOriginsByGroup: Expected 1 origin but got %+v, %v", origins, err)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	cases := []struct {
+		name  string
+		fault Fault
+	}{
+		{name: "rate limited", fault: RateLimited("/waf/v1.0/acl", 2)},
+		{name: "server errors", fault: ServerErrors("/waf/v1.0/acl", 2)},
+	}
+
+	for _, c := range cases {
+		server := NewServer()
+		svc, err := waf.New(server.SDKConfig())
+		if err != nil {
+			t.Fatalf("%s: waf.New: %v", c.name, err)
+		}
+
+		server.InjectFault(c.fault)
+		_, err = svc.Access.GetAllAccessRules(access.GetAllAccessRulesParams{
+			AccountNumber: accountNumber,
+		})
+		if err != nil {
+			t.Fatalf("%s: Expected a retry to succeed but got %v", c.name, err)
+		}
+
+		if n := len(server.Requests()); n != 3 {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, 3, n)
+		}
+		server.Close()
+	}
+}
+
+func TestRequiresAuthorization(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resp, err := http.Get(
+		server.URL.String() + "/v2/mcc/customers/ABCD/waf/v1.0/acl")
+	if err != nil {
+		t.Fatalf("http.Get: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected %+v but got %+v", http.StatusUnauthorized, resp.StatusCode)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var account string
+	server.Handle(
+		http.MethodGet,
+		"/v2/mcc/customers/{account_number}/waf/v1.0/acl",
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			account = PathParams(r)["account_number"]
+			writeText(w, http.StatusTeapot, "custom")
+		}))
+
+	resp, err := http.Get(
+		server.URL.String() + "/v2/mcc/customers/ABCD/waf/v1.0/acl")
+	if err != nil {
+		t.Fatalf("http.Get: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTeapot || account != "ABCD" {
+		t.Fatalf(
+			"Expected %+v for ABCD but got %+v for %q",
+			http.StatusTeapot,
+			resp.StatusCode,
+			account)
+	}
+}
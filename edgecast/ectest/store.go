@@ -0,0 +1,409 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ectest
+
+/*
+	This file contains the in-memory store of resources and the generic
+	handlers that create, list, get, update and delete them
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// resource describes a kind of resource kept by the fake
+type resource struct {
+	// kind names the resource, e.g. WAFAccessRules
+	kind string
+
+	// idField is the name of the JSON field that holds the ID
+	idField string
+
+	// numericID is true if IDs are JSON numbers rather than strings
+	numericID bool
+
+	// scope lists the path parameters that partition items, e.g.
+	// "account_number", so that each account has its own items
+	scope []string
+
+	// fields maps path parameters to JSON fields that are set from them on
+	// creation and used to filter lists, e.g. "platform_id" to "MediaTypeId"
+	fields map[string]string
+}
+
+// responder creates the status and body of a response from the stored item
+type responder func(item map[string]interface{}) (int, interface{})
+
+// store holds the items of each kind by scope and ID
+type store map[string]map[string]*collection
+
+type collection struct {
+	items map[string]map[string]interface{}
+	order []string
+}
+
+func (st store) collection(
+	res resource,
+	params map[string]string,
+) *collection {
+	parts := make([]string, 0, len(res.scope))
+	for _, p := range res.scope {
+		parts = append(parts, params[p])
+	}
+	key := strings.Join(parts, "/")
+
+	byScope, ok := st[res.kind]
+	if !ok {
+		byScope = map[string]*collection{}
+		st[res.kind] = byScope
+	}
+
+	c, ok := byScope[key]
+	if !ok {
+		c = &collection{items: map[string]map[string]interface{}{}}
+		byScope[key] = c
+	}
+	return c
+}
+
+// all returns the items of kind in every scope, in the order they were
+// created
+func (st store) all(kind string) []map[string]interface{} {
+	var ids []int
+	items := map[int]map[string]interface{}{}
+	for _, c := range st[kind] {
+		for id, item := range c.items {
+			// IDs are assigned in increasing order
+			n, _ := strconv.Atoi(id)
+			ids = append(ids, n)
+			items[n] = item
+		}
+	}
+
+	sort.Ints(ids)
+	sorted := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		sorted = append(sorted, items[id])
+	}
+	return sorted
+}
+
+func (c *collection) list() []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(c.order))
+	for _, id := range c.order {
+		items = append(items, c.items[id])
+	}
+	return items
+}
+
+func (c *collection) put(id string, item map[string]interface{}) {
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	for i, o := range c.order {
+		if o == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// newID returns the next ID as the JSON value stored for res
+func (s *Server) newID(res resource) (string, interface{}) {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	if res.numericID {
+		return id, json.Number(id)
+	}
+	return id, id
+}
+
+// create stores item as a new resource, returning the stored item
+func (s *Server) create(
+	res resource,
+	params map[string]string,
+	item map[string]interface{},
+) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, value := s.newID(res)
+	item[res.idField] = value
+	for param, field := range res.fields {
+		if value, ok := params[param]; ok {
+			item[field] = paramValue(value)
+		}
+	}
+
+	s.store.collection(res, params).put(id, item)
+	return item
+}
+
+// get returns the stored item with the given ID
+func (s *Server) get(
+	res resource,
+	params map[string]string,
+	id string,
+) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.store.collection(res, params).items[id]
+	return item, ok
+}
+
+// update replaces or, if merge is true, merges into the stored item with the
+// given ID, returning the stored item
+func (s *Server) update(
+	res resource,
+	params map[string]string,
+	id string,
+	changes map[string]interface{},
+	merge bool,
+) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.store.collection(res, params)
+	existing, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	item := changes
+	if merge {
+		item = existing
+		for k, v := range changes {
+			item[k] = v
+		}
+	}
+
+	// The ID and the fields set from the path cannot be changed
+	item[res.idField] = existing[res.idField]
+	for _, field := range res.fields {
+		if v, ok := existing[field]; ok {
+			item[field] = v
+		}
+	}
+
+	c.put(id, item)
+	return item, true
+}
+
+// remove deletes the stored item with the given ID
+func (s *Server) remove(
+	res resource,
+	params map[string]string,
+	id string,
+) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.collection(res, params).remove(id)
+}
+
+// list returns the stored items that match the path parameters of the
+// request
+func (s *Server) list(
+	res resource,
+	params map[string]string,
+) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []map[string]interface{}
+	for _, item := range s.store.collection(res, params).list() {
+		if matchesFields(res, params, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func matchesFields(
+	res resource,
+	params map[string]string,
+	item map[string]interface{},
+) bool {
+	for param, field := range res.fields {
+		value, ok := params[param]
+		if ok && fmt.Sprint(item[field]) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// createHandler stores the request body as a new item
+func (s *Server) createHandler(res resource, respond responder) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		body, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		item := s.create(res, params, body)
+		s.respond(w, item, respond)
+	}
+}
+
+// getHandler returns the item whose ID is the path parameter idParam
+func (s *Server) getHandler(res resource, idParam string) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		item, ok := s.get(res, params, params[idParam])
+		if !ok {
+			writeNotFound(w, res, params[idParam])
+			return
+		}
+		s.respond(w, item, nil)
+	}
+}
+
+// listHandler returns all items, wrapped by wrap if it is not nil
+func (s *Server) listHandler(
+	res resource,
+	wrap func(items []map[string]interface{}) interface{},
+) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		items := s.list(res, params)
+		if items == nil {
+			items = []map[string]interface{}{}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if wrap != nil {
+			writeJSON(w, http.StatusOK, wrap(items))
+		} else {
+			writeJSON(w, http.StatusOK, items)
+		}
+	}
+}
+
+// updateHandler replaces or merges into the item whose ID is the path
+// parameter idParam
+func (s *Server) updateHandler(
+	res resource,
+	idParam string,
+	merge bool,
+	respond responder,
+) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		body, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		item, ok := s.update(res, params, params[idParam], body, merge)
+		if !ok {
+			writeNotFound(w, res, params[idParam])
+			return
+		}
+		s.respond(w, item, respond)
+	}
+}
+
+// deleteHandler deletes the item whose ID is the path parameter idParam
+func (s *Server) deleteHandler(
+	res resource,
+	idParam string,
+	respond responder,
+) handlerFunc {
+	return func(
+		w http.ResponseWriter,
+		r *http.Request,
+		params map[string]string,
+	) {
+		id := params[idParam]
+		item, ok := s.get(res, params, id)
+		if !ok || !s.remove(res, params, id) {
+			writeNotFound(w, res, id)
+			return
+		}
+		s.respond(w, item, respond)
+	}
+}
+
+// respond writes the response for item, which is the item itself if respond
+// is nil. Items are encoded while locked, as handlers may modify them.
+func (s *Server) respond(
+	w http.ResponseWriter,
+	item map[string]interface{},
+	respond responder,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if respond == nil {
+		writeJSON(w, http.StatusOK, item)
+		return
+	}
+
+	status, body := respond(item)
+	if text, ok := body.(string); ok {
+		writeText(w, status, text)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func writeNotFound(w http.ResponseWriter, res resource, id string) {
+	writeError(
+		w,
+		http.StatusNotFound,
+		fmt.Sprintf("%s %s not found", res.kind, id))
+}
+
+// paramValue converts a path parameter to the JSON value stored in an item
+func paramValue(param string) interface{} {
+	if _, err := strconv.Atoi(param); err == nil {
+		return json.Number(param)
+	}
+	return param
+}
+
+// copyItems returns deep copies of items
+func copyItems(items []map[string]interface{}) []map[string]interface{} {
+	data, _ := json.Marshal(items)
+
+	var copies []map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.UseNumber()
+	dec.Decode(&copies)
+	return copies
+}
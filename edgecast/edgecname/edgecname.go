@@ -4,6 +4,7 @@
 package edgecname
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,17 +15,28 @@ import (
 // GetAllEdgeCnames retrieves all edge CNAMEs for the provided platform.
 func (svc *EdgeCnameService) GetAllEdgeCnames(
 	params GetAllEdgeCnameParams,
+) (*[]EdgeCnameGetOK, error) {
+	return svc.GetAllEdgeCnamesWithContext(context.Background(), params)
+}
+
+// GetAllEdgeCnamesWithContext is the same as GetAllEdgeCnames with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) GetAllEdgeCnamesWithContext(
+	ctx context.Context,
+	params GetAllEdgeCnameParams,
 ) (*[]EdgeCnameGetOK, error) {
 	parsedResponse := &[]EdgeCnameGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/cnames/{platform_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.Platform.StringWithoutHyphen(),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/cnames/{platform_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.Platform.StringWithoutHyphen(),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetAllEdgeCnames: %w", err)
 	}
@@ -34,19 +46,30 @@ func (svc *EdgeCnameService) GetAllEdgeCnames(
 // AddEdgeCname creates an edge CNAME.
 func (svc *EdgeCnameService) AddEdgeCname(
 	params AddEdgeCnameParams,
+) (*int, error) {
+	return svc.AddEdgeCnameWithContext(context.Background(), params)
+}
+
+// AddEdgeCnameWithContext is the same as AddEdgeCname with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) AddEdgeCnameWithContext(
+	ctx context.Context,
+	params AddEdgeCnameParams,
 ) (*int, error) {
 	parsedResponse := &struct {
 		CnameID int `json:"CnameId"`
 	}{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "v2/mcc/customers/{account_number}/cnames",
-		RawBody: params.EdgeCname,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "v2/mcc/customers/{account_number}/cnames",
+			RawBody: params.EdgeCname,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("AddEdgeCname: %w", err)
 	}
@@ -56,17 +79,28 @@ func (svc *EdgeCnameService) AddEdgeCname(
 // GetEdgeCname retrieves a single edge CNAME configuration.
 func (svc *EdgeCnameService) GetEdgeCname(
 	params GetEdgeCnameParams,
+) (*EdgeCnameGetOK, error) {
+	return svc.GetEdgeCnameWithContext(context.Background(), params)
+}
+
+// GetEdgeCnameWithContext is the same as GetEdgeCname with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) GetEdgeCnameWithContext(
+	ctx context.Context,
+	params GetEdgeCnameParams,
 ) (*EdgeCnameGetOK, error) {
 	parsedResponse := &EdgeCnameGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"edge_cname_id":  strconv.Itoa(params.EdgeCnameID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"edge_cname_id":  strconv.Itoa(params.EdgeCnameID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetEdgeCname: %w", err)
 	}
@@ -76,20 +110,31 @@ func (svc *EdgeCnameService) GetEdgeCname(
 // UpdateEdgeCname updates the configuration for the specified edge CNAME.
 func (svc *EdgeCnameService) UpdateEdgeCname(
 	params UpdateEdgeCnameParams,
+) (*int, error) {
+	return svc.UpdateEdgeCnameWithContext(context.Background(), params)
+}
+
+// UpdateEdgeCnameWithContext is the same as UpdateEdgeCname with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) UpdateEdgeCnameWithContext(
+	ctx context.Context,
+	params UpdateEdgeCnameParams,
 ) (*int, error) {
 	parsedResponse := &struct {
 		CnameID int `json:"CnameId"`
 	}{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"edge_cname_id":  strconv.Itoa(params.EdgeCname.ID),
-		},
-		RawBody:        params.EdgeCname,
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"edge_cname_id":  strconv.Itoa(params.EdgeCname.ID),
+			},
+			RawBody:        params.EdgeCname,
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("UpdateEdgeCname: %w", err)
 	}
@@ -100,14 +145,25 @@ func (svc *EdgeCnameService) UpdateEdgeCname(
 func (svc *EdgeCnameService) DeleteEdgeCname(
 	params DeleteEdgeCnameParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"edge_cname_id":  strconv.Itoa(params.EdgeCname.ID),
-		},
-	})
+	return svc.DeleteEdgeCnameWithContext(context.Background(), params)
+}
+
+// DeleteEdgeCnameWithContext is the same as DeleteEdgeCname with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) DeleteEdgeCnameWithContext(
+	ctx context.Context,
+	params DeleteEdgeCnameParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/cnames/{edge_cname_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"edge_cname_id":  strconv.Itoa(params.EdgeCname.ID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("DeleteEdgeCname: %w", err)
 	}
@@ -118,17 +174,29 @@ func (svc *EdgeCnameService) DeleteEdgeCname(
 // CNAME configuration.
 func (svc *EdgeCnameService) GetEdgeCnamePropagationStatus(
 	params GetEdgeCnamePropagationStatus,
+) (*ecmodels.PropagationStatus, error) {
+	return svc.GetEdgeCnamePropagationStatusWithContext(context.Background(), params)
+}
+
+// GetEdgeCnamePropagationStatusWithContext is the same as
+// GetEdgeCnamePropagationStatus with the addition of a context.Context that
+// governs cancellation and deadlines for the call.
+func (svc *EdgeCnameService) GetEdgeCnamePropagationStatusWithContext(
+	ctx context.Context,
+	params GetEdgeCnamePropagationStatus,
 ) (*ecmodels.PropagationStatus, error) {
 	parsedResponse := &ecmodels.PropagationStatus{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/cnames/{edgecname_id}/status",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"edgecname_id":   strconv.Itoa(params.EdgeCnameID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/cnames/{edgecname_id}/status",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"edgecname_id":   strconv.Itoa(params.EdgeCnameID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetEdgeCnamePropagationStatus: %w", err)
 	}
@@ -4,12 +4,13 @@
 package edgecname
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecmodels"
 )
 
 // Edge Cname service interacts with the EdgeCast API for managing Edge Cnames
@@ -18,20 +19,93 @@ type EdgeCnameService struct {
 	logger eclog.Logger
 }
 
+// ClientService is the interface for EdgeCnameService methods
+type ClientService interface {
+	GetAllEdgeCnames(
+		params GetAllEdgeCnameParams,
+	) (*[]EdgeCnameGetOK, error)
+
+	GetAllEdgeCnamesWithContext(
+		ctx context.Context,
+		params GetAllEdgeCnameParams,
+	) (*[]EdgeCnameGetOK, error)
+
+	AddEdgeCname(
+		params AddEdgeCnameParams,
+	) (*int, error)
+
+	AddEdgeCnameWithContext(
+		ctx context.Context,
+		params AddEdgeCnameParams,
+	) (*int, error)
+
+	GetEdgeCname(
+		params GetEdgeCnameParams,
+	) (*EdgeCnameGetOK, error)
+
+	GetEdgeCnameWithContext(
+		ctx context.Context,
+		params GetEdgeCnameParams,
+	) (*EdgeCnameGetOK, error)
+
+	UpdateEdgeCname(
+		params UpdateEdgeCnameParams,
+	) (*int, error)
+
+	UpdateEdgeCnameWithContext(
+		ctx context.Context,
+		params UpdateEdgeCnameParams,
+	) (*int, error)
+
+	DeleteEdgeCname(
+		params DeleteEdgeCnameParams,
+	) error
+
+	DeleteEdgeCnameWithContext(
+		ctx context.Context,
+		params DeleteEdgeCnameParams,
+	) error
+
+	GetEdgeCnamePropagationStatus(
+		params GetEdgeCnamePropagationStatus,
+	) (*ecmodels.PropagationStatus, error)
+
+	GetEdgeCnamePropagationStatusWithContext(
+		ctx context.Context,
+		params GetEdgeCnamePropagationStatus,
+	) (*ecmodels.PropagationStatus, error)
+}
+
+var _ ClientService = (*EdgeCnameService)(nil)
+
 // New creates a new Edge Cname service
 func New(config edgecast.SDKConfig) (*EdgeCnameService, error) {
 
-	authProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("edgecname.New(): %w", err)
+	}
 
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "edgecname", Token: true})
 	if err != nil {
-		return nil, fmt.Errorf("edgecname.New(): %v", err)
+		return nil, fmt.Errorf("edgecname.New(): %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURLLegacy,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURLLegacy,
+		ServiceName:     "edgecname",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &EdgeCnameService{
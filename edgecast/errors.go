@@ -0,0 +1,57 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+)
+
+// APIError is returned by SDK services when an EdgeCast API responds with an
+// HTTP 4xx or 5xx status code. Use errors.As or AsAPIError to inspect it.
+type APIError = ecclient.APIError
+
+// OAuth2ErrorResponse is stored in APIError.Details when an IDS token request
+// fails
+type OAuth2ErrorResponse = ecauth.OAuth2ErrorResponse
+
+// AsAPIError finds the first APIError in err's chain
+func AsAPIError(err error) (*APIError, bool) {
+	return ecclient.AsAPIError(err)
+}
+
+// IsNotFound determines whether err was caused by an HTTP 404 response
+func IsNotFound(err error) bool {
+	return ecclient.IsNotFound(err)
+}
+
+// IsConflict determines whether err was caused by an HTTP 409 response
+func IsConflict(err error) bool {
+	return ecclient.IsConflict(err)
+}
+
+// IsRateLimited determines whether err was caused by an HTTP 429 response
+func IsRateLimited(err error) bool {
+	return ecclient.IsRateLimited(err)
+}
+
+// IsUnauthorized determines whether err was caused by an HTTP 401 response
+func IsUnauthorized(err error) bool {
+	return ecclient.IsUnauthorized(err)
+}
+
+// IsForbidden determines whether err was caused by an HTTP 403 response
+func IsForbidden(err error) bool {
+	return ecclient.IsForbidden(err)
+}
+
+// IsBadRequest determines whether err was caused by an HTTP 400 response
+func IsBadRequest(err error) bool {
+	return ecclient.IsBadRequest(err)
+}
+
+// IsServerError determines whether err was caused by an HTTP 5xx response
+func IsServerError(err error) bool {
+	return ecclient.IsServerError(err)
+}
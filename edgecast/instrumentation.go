@@ -0,0 +1,54 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+
+// Instrumentation receives traces and metrics emitted by SDK services. Its
+// methods mirror the OpenTelemetry tracing and metrics APIs.
+//
+// Every API call produces a span named after its method and path template,
+// with a child span per HTTP attempt (SpanHTTPAttempt) and, when a new
+// IDS token is needed, a token refresh span. Call counts, durations and
+// retries are reported as metrics labelled by service, path template, method
+// and status code.
+type Instrumentation = ecinstrument.Instrumentation
+
+// Span is a single timed operation
+type Span = ecinstrument.Span
+
+// Attribute is a key/value pair attached to spans and metrics
+type Attribute = ecinstrument.Attribute
+
+// InMemoryInstrumentation records all spans and metrics in memory, for use in
+// tests
+type InMemoryInstrumentation = ecinstrument.InMemoryInstrumentation
+
+// RecordedSpan is a span captured by InMemoryInstrumentation
+type RecordedSpan = ecinstrument.RecordedSpan
+
+// RecordedMetric is a metric value captured by InMemoryInstrumentation
+type RecordedMetric = ecinstrument.RecordedMetric
+
+// Span, metric and attribute names used by the SDK
+const (
+	SpanHTTPAttempt       = ecinstrument.SpanHTTPAttempt
+	SpanTokenRefresh      = ecinstrument.SpanTokenRefresh
+	MetricRequests        = ecinstrument.MetricRequests
+	MetricRequestDuration = ecinstrument.MetricRequestDuration
+	MetricRetries         = ecinstrument.MetricRetries
+	MetricTokenRefreshes  = ecinstrument.MetricTokenRefreshes
+	AttrService           = ecinstrument.AttrService
+	AttrRoute             = ecinstrument.AttrRoute
+	AttrMethod            = ecinstrument.AttrMethod
+	AttrStatusCode        = ecinstrument.AttrStatusCode
+	AttrAttempt           = ecinstrument.AttrAttempt
+	AttrAttempts          = ecinstrument.AttrAttempts
+	AttrOutcome           = ecinstrument.AttrOutcome
+)
+
+// NewInMemoryInstrumentation creates an empty InMemoryInstrumentation
+func NewInMemoryInstrumentation() *InMemoryInstrumentation {
+	return ecinstrument.NewInMemoryInstrumentation()
+}
@@ -0,0 +1,21 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+
+// Request contains the properties of an outgoing API request. Interceptors may
+// modify it before it is sent.
+type Request = ecclient.Request
+
+// Response contains the raw result of an API request
+type Response = ecclient.Response
+
+// RequestHandler sends a Request and returns its Response
+type RequestHandler = ecclient.RequestHandler
+
+// Interceptor is invoked for every request sent by an SDK service. It may
+// modify the request before passing it to next, inspect or replace the result,
+// or short-circuit the call by not calling next at all.
+type Interceptor = ecclient.Interceptor
@@ -3,7 +3,18 @@
 
 package ecauth
 
+import "context"
+
 // AuthorizationProvider defines structs that can provide Authorization headers
 type AuthorizationProvider interface {
 	GetAuthorizationHeader() (string, error)
 }
+
+// ContextAuthorizationProvider is implemented by AuthorizationProviders that
+// can use the context of the API call that needs the header, e.g. to trace
+// token retrieval as part of the call
+type ContextAuthorizationProvider interface {
+	AuthorizationProvider
+
+	GetAuthorizationHeaderWithContext(ctx context.Context) (string, error)
+}
@@ -4,25 +4,39 @@
 package ecauth
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
+	"sync"
 	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
 )
 
 const (
 	bearerAuthHeaderFormat string = "Bearer %s"
 )
 
+// DefaultTokenRefreshSkew is how long before expiry a token is refreshed if
+// IDSAuthorizationProvider.RefreshSkew is not set
+const DefaultTokenRefreshSkew = 30 * time.Second
+
 // IDSToken holds an authorization token and its expiration time
 type IDSToken struct {
 	AccessToken    string
 	ExpirationTime time.Time
+
+	// refreshAt is when the token should be replaced. If zero, it is derived
+	// from ExpirationTime and the provider's RefreshSkew.
+	refreshAt time.Time
 }
 
-// Generates IDS Authorization Header values
+// Generates IDS Authorization Header values. It is safe for concurrent use.
 type IDSAuthorizationProvider struct {
-	// The latest token. May be expired.
+	// The latest token. May be expired. Must not be modified once the
+	// provider is in use.
 	CurrentToken *IDSToken
 
 	// TokenClient retrievees new tokens
@@ -30,13 +44,44 @@ type IDSAuthorizationProvider struct {
 
 	// The Credentials used to retrieve new tokens
 	Credentials OAuth2Credentials
+
+	// Instrumentation receives a span for every token retrieval. Optional.
+	Instrumentation ecinstrument.Instrumentation
+
+	// RefreshSkew is how long before expiry a token is refreshed, so that
+	// requests in flight never carry an expired token. For tokens with a
+	// short lifetime, at most half the lifetime is used. Defaults to
+	// DefaultTokenRefreshSkew.
+	RefreshSkew time.Duration
+
+	// TokenCache, if set, is checked for a usable token before a new one is
+	// retrieved from IDS, and stores every new token. Optional.
+	TokenCache TokenCache
+
+	// baseIDSURL identifies the IDS server in TokenCache keys
+	baseIDSURL string
+
+	mu      sync.Mutex
+	refresh *tokenRefresh
+}
+
+// tokenRefresh is a token retrieval in progress. Callers that need a token
+// while it is in progress wait for it instead of starting their own.
+type tokenRefresh struct {
+	done  chan struct{}
+	token *IDSToken
+	err   error
 }
 
 // Creates a new IDSAuthorizationProvider with the given credentials
-// that retrieves tokens from the specified URL
+// that retrieves tokens from the specified URL using httpClient. If
+// httpClient is nil, http.DefaultClient is used. Token retrievals are reported
+// to instrumentation, which may be nil.
 func NewIDSAuthorizationProvider(
 	baseIDSURL url.URL,
 	credentials OAuth2Credentials,
+	httpClient *http.Client,
+	instrumentation ecinstrument.Instrumentation,
 ) (*IDSAuthorizationProvider, error) {
 	if len(credentials.ClientID) == 0 ||
 		len(credentials.ClientSecret) == 0 ||
@@ -45,8 +90,10 @@ func NewIDSAuthorizationProvider(
 	}
 
 	return &IDSAuthorizationProvider{
-		Credentials: credentials,
-		TokenClient: NewIDSClient(baseIDSURL),
+		Credentials:     credentials,
+		TokenClient:     NewIDSClient(baseIDSURL, httpClient),
+		Instrumentation: instrumentation,
+		baseIDSURL:      baseIDSURL.String(),
 	}, nil
 }
 
@@ -54,29 +101,196 @@ func NewIDSAuthorizationProvider(
 // token, refreshing it if it has expired. Used for EdgeCast APIs that use IDS
 // OAuth 2.0 tokens.
 func (ip *IDSAuthorizationProvider) GetAuthorizationHeader() (string, error) {
+	return ip.GetAuthorizationHeaderWithContext(context.Background())
+}
+
+// GetAuthorizationHeaderWithContext is the same as GetAuthorizationHeader,
+// except that a token refresh is traced as a child of the span in ctx and
+// abandoned once ctx is done. If another call is already refreshing the
+// token, it waits for that refresh until ctx is done, and refreshes the token
+// itself if the other call abandons the refresh.
+func (ip *IDSAuthorizationProvider) GetAuthorizationHeaderWithContext(
+	ctx context.Context,
+) (string, error) {
+	token, err := ip.token(ctx)
+	if err != nil {
+		return "", err
+	}
 
-	// If there is no cached token or it's expired, get a new one
-	if ip.CurrentToken == nil ||
-		ip.CurrentToken.ExpirationTime.Before(time.Now()) {
+	return fmt.Sprintf(bearerAuthHeaderFormat, token.AccessToken), nil
+}
 
-		model, err := ip.TokenClient.GetToken(ip.Credentials)
+// token returns the current token, refreshing it if it is due. Concurrent
+// refreshes are collapsed into a single token retrieval. If the refresh fails
+// but the current token has not yet expired, the current token is returned.
+func (ip *IDSAuthorizationProvider) token(
+	ctx context.Context,
+) (*IDSToken, error) {
+	for {
+		ip.mu.Lock()
+		current := ip.CurrentToken
+		if current != nil && time.Now().Before(ip.refreshTime(current)) {
+			ip.mu.Unlock()
+			return current, nil
+		}
 
-		if err != nil {
-			return "", err
+		refresh := ip.refresh
+		leader := refresh == nil
+		if leader {
+			refresh = &tokenRefresh{done: make(chan struct{})}
+			ip.refresh = refresh
 		}
+		ip.mu.Unlock()
 
-		if model == nil {
-			return "", errors.New(
-				"no access token retrieved, please check your IDS credentials")
+		// The token is retrieved using the context of the call that started
+		// the refresh, so the retrieval is abandoned once that call is done
+		if leader {
+			ip.runRefresh(ctx, refresh)
 		}
 
-		expiresIn := time.Second * time.Duration(model.ExpiresIn)
+		select {
+		case <-refresh.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 
-		ip.CurrentToken = &IDSToken{
-			AccessToken:    model.AccessToken,
-			ExpirationTime: time.Now().Add(expiresIn),
+		// A refresh abandoned by another call is retried by this one
+		if !leader && ctx.Err() == nil && isContextError(refresh.err) {
+			continue
 		}
+
+		if refresh.err != nil {
+			if current != nil && time.Now().Before(current.ExpirationTime) {
+				return current, nil
+			}
+			return nil, refresh.err
+		}
+
+		return refresh.token, nil
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// refreshTime returns the time at which token should be replaced
+func (ip *IDSAuthorizationProvider) refreshTime(token *IDSToken) time.Time {
+	if !token.refreshAt.IsZero() {
+		return token.refreshAt
+	}
+	return token.ExpirationTime.Add(-ip.refreshSkew())
+}
+
+func (ip *IDSAuthorizationProvider) refreshSkew() time.Duration {
+	if ip.RefreshSkew > 0 {
+		return ip.RefreshSkew
+	}
+	return DefaultTokenRefreshSkew
+}
+
+// runRefresh retrieves a new token, stores it as the current token if
+// successful, and reports the result to everyone waiting on refresh
+func (ip *IDSAuthorizationProvider) runRefresh(
+	ctx context.Context,
+	refresh *tokenRefresh,
+) {
+	token, err := ip.refreshToken(ctx)
+
+	ip.mu.Lock()
+	if err == nil {
+		ip.CurrentToken = token
 	}
+	ip.refresh = nil
+	ip.mu.Unlock()
 
-	return fmt.Sprintf(bearerAuthHeaderFormat, ip.CurrentToken.AccessToken), nil
+	refresh.token = token
+	refresh.err = err
+	close(refresh.done)
+}
+
+// refreshToken returns a token from TokenCache that is not yet due for
+// refresh, or else retrieves a new token
+func (ip *IDSAuthorizationProvider) refreshToken(
+	ctx context.Context,
+) (*IDSToken, error) {
+	if token := ip.cachedToken(); token != nil {
+		return token, nil
+	}
+
+	instrumentation := ecinstrument.OrNoop(ip.Instrumentation)
+	ctx, span := instrumentation.StartSpan(ctx, ecinstrument.SpanTokenRefresh)
+	defer span.End()
+
+	model, err := ip.TokenClient.GetToken(ctx, ip.Credentials)
+
+	if err == nil && model == nil {
+		err = errors.New(
+			"no access token retrieved, please check your IDS credentials")
+	}
+
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+		span.RecordError(err)
+	}
+	instrumentation.AddCounter(
+		ctx,
+		ecinstrument.MetricTokenRefreshes,
+		1,
+		ecinstrument.String(ecinstrument.AttrOutcome, outcome))
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresIn := time.Second * time.Duration(model.ExpiresIn)
+
+	skew := ip.refreshSkew()
+	if skew > expiresIn/2 {
+		skew = expiresIn / 2
+	}
+
+	token := &IDSToken{
+		AccessToken:    model.AccessToken,
+		ExpirationTime: now.Add(expiresIn),
+		refreshAt:      now.Add(expiresIn - skew),
+	}
+
+	// The cache only saves token retrievals, so failing to store a token is
+	// not an error
+	if ip.TokenCache != nil {
+		_ = ip.TokenCache.Put(ip.tokenCacheKey(), *token)
+	}
+
+	return token, nil
+}
+
+// cachedToken returns the token in TokenCache if it is not yet due for
+// refresh. Cache errors are treated as a cache miss.
+func (ip *IDSAuthorizationProvider) cachedToken() *IDSToken {
+	if ip.TokenCache == nil {
+		return nil
+	}
+
+	token, err := ip.TokenCache.Get(ip.tokenCacheKey())
+	if err != nil || token == nil {
+		return nil
+	}
+
+	if !time.Now().Before(ip.refreshTime(token)) {
+		return nil
+	}
+
+	return token
+}
+
+func (ip *IDSAuthorizationProvider) tokenCacheKey() TokenCacheKey {
+	return TokenCacheKey{
+		BaseIDSURL: ip.baseIDSURL,
+		ClientID:   ip.Credentials.ClientID,
+		Scope:      JoinScopes(ParseScope(ip.Credentials.Scope)),
+	}
 }
@@ -4,8 +4,17 @@
 package ecauth
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
 )
 
 func TestGetAuthorizationHeader_IDS(t *testing.T) {
@@ -80,6 +89,46 @@ func TestGetAuthorizationHeader_IDS(t *testing.T) {
 	}
 }
 
+func TestGetAuthorizationHeaderInstrumentation(t *testing.T) {
+	instrumentation := ecinstrument.NewInMemoryInstrumentation()
+	provider := IDSAuthorizationProvider{
+		TokenClient: TestIDSClient{
+			StaticToken: &OAuth2TokenResponse{
+				AccessToken: "abcd",
+				ExpiresIn:   100000,
+			},
+		},
+		Instrumentation: instrumentation,
+	}
+
+	ctx, parent := instrumentation.StartSpan(context.Background(), "parent")
+
+	// The second call uses the cached token and must not refresh
+	for i := 0; i < 2; i++ {
+		_, err := provider.GetAuthorizationHeaderWithContext(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	parent.End()
+
+	spans := instrumentation.SpansNamed(ecinstrument.SpanTokenRefresh)
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 token refresh span but got %d", len(spans))
+	}
+	if spans[0].ParentID != 1 || !spans[0].Ended {
+		t.Fatalf("Expected an ended child of span 1 but got %+v", spans[0])
+	}
+
+	counters := instrumentation.Counters(ecinstrument.MetricTokenRefreshes)
+	if len(counters) != 1 {
+		t.Fatalf("Expected 1 counter increment but got %d", len(counters))
+	}
+	if outcome, _ := counters[0].Attribute(ecinstrument.AttrOutcome); outcome != "success" {
+		t.Fatalf("Expected %s but got %v", "success", outcome)
+	}
+}
+
 // A test client that implements OAuth2Client
 type TestIDSClient struct {
 	StaticToken *OAuth2TokenResponse
@@ -87,7 +136,281 @@ type TestIDSClient struct {
 
 // GetToken is a test implementation that returns the same token every time
 func (c TestIDSClient) GetToken(
+	ctx context.Context,
 	credentials OAuth2Credentials,
 ) (*OAuth2TokenResponse, error) {
 	return c.StaticToken, nil
 }
+
+func TestGetAuthorizationHeaderConcurrentRefresh(t *testing.T) {
+	client := &testCountingIDSClient{
+		token: &OAuth2TokenResponse{AccessToken: "abcd", ExpiresIn: 3600},
+		delay: 50 * time.Millisecond,
+	}
+	provider := &IDSAuthorizationProvider{TokenClient: client}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			header, err := provider.GetAuthorizationHeader()
+			if err == nil && header != "Bearer abcd" {
+				err = errors.New("unexpected header: " + header)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls := client.Calls(); calls != 1 {
+		t.Fatalf("Expected 1 token request but got %d", calls)
+	}
+}
+
+func TestGetAuthorizationHeaderCancelledRefresh(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			// The first token request is slow enough to be cancelled
+			if atomic.AddInt32(&calls, 1) == 1 {
+				select {
+				case <-release:
+				case <-r.Context().Done():
+				}
+				return
+			}
+			w.Write([]byte(`{"access_token":"abcd","expires_in":3600}`))
+		}))
+	defer server.Close()
+	defer close(release)
+
+	baseURL, _ := url.Parse(server.URL)
+	provider, err := NewIDSAuthorizationProvider(
+		*baseURL,
+		OAuth2Credentials{
+			ClientID:     "id",
+			ClientSecret: "secret",
+			Scope:        "scope",
+		},
+		nil,
+		nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		100*time.Millisecond)
+	defer cancel()
+
+	// A call waiting on the cancelled refresh retries it with its own context
+	waiting := make(chan error, 1)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		header, err := provider.GetAuthorizationHeader()
+		if err == nil && header != "Bearer abcd" {
+			err = errors.New("unexpected header: " + header)
+		}
+		waiting <- err
+	}()
+
+	start := time.Now()
+	_, err = provider.GetAuthorizationHeaderWithContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v but got %v", context.DeadlineExceeded, err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("Expected the refresh to be abandoned but took %s", elapsed)
+	}
+
+	if err := <-waiting; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Fatalf("Expected 2 token requests but got %d", got)
+	}
+}
+
+func TestGetAuthorizationHeaderRefreshSkew(t *testing.T) {
+	cases := []struct {
+		name            string
+		skew            time.Duration
+		expiresIn       time.Duration
+		expectedRefresh bool
+	}{
+		{
+			name:            "outside skew",
+			skew:            time.Minute,
+			expiresIn:       time.Hour,
+			expectedRefresh: false,
+		},
+		{
+			name:            "inside skew",
+			skew:            time.Minute,
+			expiresIn:       30 * time.Second,
+			expectedRefresh: true,
+		},
+		{
+			name:            "inside default skew",
+			expiresIn:       DefaultTokenRefreshSkew / 2,
+			expectedRefresh: true,
+		},
+	}
+
+	for _, c := range cases {
+		client := &testCountingIDSClient{
+			token: &OAuth2TokenResponse{AccessToken: "new", ExpiresIn: 3600},
+		}
+		provider := &IDSAuthorizationProvider{
+			CurrentToken: &IDSToken{
+				AccessToken:    "old",
+				ExpirationTime: time.Now().Add(c.expiresIn),
+			},
+			TokenClient: client,
+			RefreshSkew: c.skew,
+		}
+
+		actual, err := provider.GetAuthorizationHeader()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+
+		expected := "Bearer old"
+		if c.expectedRefresh {
+			expected = "Bearer new"
+		}
+		if actual != expected {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, expected, actual)
+		}
+	}
+}
+
+func TestGetAuthorizationHeaderShortLivedToken(t *testing.T) {
+	// A token that lives for less than the skew must still be reused
+	client := &testCountingIDSClient{
+		token: &OAuth2TokenResponse{AccessToken: "abcd", ExpiresIn: 10},
+	}
+	provider := &IDSAuthorizationProvider{
+		TokenClient: client,
+		RefreshSkew: time.Minute,
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := provider.GetAuthorizationHeader(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls := client.Calls(); calls != 1 {
+		t.Fatalf("Expected 1 token request but got %d", calls)
+	}
+}
+
+func TestGetAuthorizationHeaderFailedRefresh(t *testing.T) {
+	refreshErr := errors.New("IDS unavailable")
+
+	cases := []struct {
+		name          string
+		expiresIn     time.Duration
+		expected      string
+		expectedError error
+	}{
+		{
+			name:      "current token still valid",
+			expiresIn: time.Second * 10,
+			expected:  "Bearer old",
+		},
+		{
+			name:          "current token expired",
+			expiresIn:     -time.Second,
+			expectedError: refreshErr,
+		},
+	}
+
+	for _, c := range cases {
+		provider := &IDSAuthorizationProvider{
+			CurrentToken: &IDSToken{
+				AccessToken:    "old",
+				ExpirationTime: time.Now().Add(c.expiresIn),
+			},
+			TokenClient: &testCountingIDSClient{err: refreshErr},
+		}
+
+		actual, err := provider.GetAuthorizationHeader()
+		if !errors.Is(err, c.expectedError) {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expectedError, err)
+		}
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestGetAuthorizationHeaderWaitCancelled(t *testing.T) {
+	release := make(chan struct{})
+	client := &testCountingIDSClient{
+		token:   &OAuth2TokenResponse{AccessToken: "abcd", ExpiresIn: 3600},
+		release: release,
+	}
+	provider := &IDSAuthorizationProvider{TokenClient: client}
+
+	leaderDone := make(chan error)
+	go func() {
+		_, err := provider.GetAuthorizationHeader()
+		leaderDone <- err
+	}()
+
+	// Wait until the leader's refresh is in flight
+	for client.Calls() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := provider.GetAuthorizationHeaderWithContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v but got %v", context.Canceled, err)
+	}
+
+	close(release)
+	if err := <-leaderDone; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls := client.Calls(); calls != 1 {
+		t.Fatalf("Expected 1 token request but got %d", calls)
+	}
+}
+
+// A test client that counts token requests and may delay or fail them
+type testCountingIDSClient struct {
+	token   *OAuth2TokenResponse
+	err     error
+	delay   time.Duration
+	release chan struct{}
+	calls   int32
+}
+
+func (c *testCountingIDSClient) GetToken(
+	ctx context.Context,
+	credentials OAuth2Credentials,
+) (*OAuth2TokenResponse, error) {
+	atomic.AddInt32(&c.calls, 1)
+	time.Sleep(c.delay)
+	if c.release != nil {
+		<-c.release
+	}
+	return c.token, c.err
+}
+
+func (c *testCountingIDSClient) Calls() int32 {
+	return atomic.LoadInt32(&c.calls)
+}
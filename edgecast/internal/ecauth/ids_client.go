@@ -5,6 +5,7 @@ package ecauth
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -18,15 +19,20 @@ const errorPrefix string = "authentication error:"
 // Calls the IDS token endpoint
 type IDSClient struct {
 	IDSBaseUrl *url.URL
+
+	// HTTPClient sends token requests. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
 }
 
 // NewIDSClientWithURL -
-func NewIDSClient(baseURL url.URL) IDSClient {
-	return IDSClient{IDSBaseUrl: &baseURL}
+func NewIDSClient(baseURL url.URL, httpClient *http.Client) IDSClient {
+	return IDSClient{IDSBaseUrl: &baseURL, HTTPClient: httpClient}
 }
 
-// Gets a new token from the IDS Token Endpoint
+// Gets a new token from the IDS Token Endpoint. The request is cancelled
+// when ctx is done.
 func (c IDSClient) GetToken(
+	ctx context.Context,
 	credentials OAuth2Credentials,
 ) (*OAuth2TokenResponse, error) {
 	data := url.Values{}
@@ -36,7 +42,8 @@ func (c IDSClient) GetToken(
 	data.Add("client_secret", credentials.ClientSecret)
 	idsTokenEndpoint := fmt.Sprintf("%s/connect/token", c.IDSBaseUrl.String())
 	dataString := data.Encode()
-	newTokenRequest, err := http.NewRequest(
+	newTokenRequest, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
 		idsTokenEndpoint,
 		bytes.NewBufferString(dataString))
@@ -51,15 +58,19 @@ func (c IDSClient) GetToken(
 		"application/x-www-form-urlencoded")
 	newTokenRequest.Header.Add("Cache-Control", "no-cache")
 	newTokenRequest.Header.Add("Content-Length", strconv.Itoa(len(dataString)))
-	httpClient := &http.Client{}
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
 	resp, err := httpClient.Do(newTokenRequest)
 
 	if err != nil {
 		return nil, fmt.Errorf("%s HTTP request failed: %w", errorPrefix, err)
 	}
 
-	if resp.StatusCode == http.StatusBadRequest {
-		oAuth2Error := &OAuth2ErrorResponse{}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
 		bodyBytes, err := ioutil.ReadAll(resp.Body)
 
 		if err != nil {
@@ -67,21 +78,20 @@ func (c IDSClient) GetToken(
 				errorPrefix, err)
 		}
 
-		err = json.Unmarshal(bodyBytes, oAuth2Error)
-		if err != nil {
-			// Cannot decode to oAuth2Error so return complete response body
-			return nil, fmt.Errorf("%s error parsing oAuth2Error response: %s",
-				errorPrefix, bodyBytes)
+		oAuth2Error := &OAuth2Error{
+			StatusCode: resp.StatusCode,
+			URL:        idsTokenEndpoint,
+			Header:     resp.Header,
+			Body:       bodyBytes,
 		}
 
-		return nil, fmt.Errorf("%s bad request: %s",
-			errorPrefix, oAuth2Error.Error)
-	}
+		errorResponse := &OAuth2ErrorResponse{}
+		err = json.Unmarshal(bodyBytes, errorResponse)
+		if err == nil && len(errorResponse.Error) > 0 {
+			oAuth2Error.Response = errorResponse
+		}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf(
-			"%s expected 200 OK, received status code %d", errorPrefix,
-			resp.StatusCode)
+		return nil, oAuth2Error
 	}
 
 	tokenResponse := &OAuth2TokenResponse{}
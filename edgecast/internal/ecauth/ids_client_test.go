@@ -0,0 +1,144 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIDSClientGetToken(t *testing.T) {
+	cases := []struct {
+		name             string
+		statusCode       int
+		body             string
+		expected         *OAuth2TokenResponse
+		expectedResponse *OAuth2ErrorResponse
+		expectedError    bool
+	}{
+		{
+			name:       "Happy Path",
+			statusCode: http.StatusOK,
+			body:       `{"access_token":"abcd","expires_in":3600}`,
+			expected: &OAuth2TokenResponse{
+				AccessToken: "abcd",
+				ExpiresIn:   3600,
+			},
+		},
+		{
+			name:             "Error Path - bad request",
+			statusCode:       http.StatusBadRequest,
+			body:             `{"error":"invalid_scope"}`,
+			expectedResponse: &OAuth2ErrorResponse{Error: "invalid_scope"},
+			expectedError:    true,
+		},
+		{
+			name:          "Error Path - undecodable body",
+			statusCode:    http.StatusInternalServerError,
+			body:          "internal error",
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(c.statusCode)
+				w.Write([]byte(c.body))
+			}))
+
+		baseURL, _ := url.Parse(server.URL)
+		actual, err := NewIDSClient(*baseURL, nil).
+			GetToken(context.Background(), OAuth2Credentials{})
+		server.Close()
+
+		if c.expectedError {
+			var oauthErr *OAuth2Error
+			if !errors.As(err, &oauthErr) {
+				t.Fatalf("%s: Expected an OAuth2Error but got %v", c.name, err)
+			}
+			if oauthErr.StatusCode != c.statusCode {
+				t.Fatalf("%s: Expected status %d but got %d", c.name, c.statusCode, oauthErr.StatusCode)
+			}
+			if string(oauthErr.Body) != c.body {
+				t.Fatalf("%s: Expected body '%s' but got '%s'", c.name, c.body, oauthErr.Body)
+			}
+			if !reflect.DeepEqual(c.expectedResponse, oauthErr.Response) {
+				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expectedResponse, oauthErr.Response)
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", c.name, err)
+			}
+			if !reflect.DeepEqual(c.expected, actual) {
+				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+			}
+		}
+	}
+}
+
+func TestIDSClientGetTokenWithHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"access_token":"abcd","expires_in":3600}`))
+		}))
+	defer server.Close()
+
+	baseURL, _ := url.Parse(server.URL)
+
+	// The default client does not trust the test server's certificate
+	_, err := NewIDSClient(*baseURL, nil).
+		GetToken(context.Background(), OAuth2Credentials{})
+	if err == nil {
+		t.Fatal("Expected a certificate error but got nil")
+	}
+
+	actual, err := NewIDSClient(*baseURL, server.Client()).
+		GetToken(context.Background(), OAuth2Credentials{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual.AccessToken != "abcd" {
+		t.Fatalf("Expected %s but got %s", "abcd", actual.AccessToken)
+	}
+}
+
+func TestIDSClientGetTokenCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}))
+	defer server.Close()
+	defer close(release)
+
+	baseURL, _ := url.Parse(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := NewIDSClient(*baseURL, nil).
+			GetToken(ctx, OAuth2Credentials{})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected %v but got %v", context.Canceled, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected GetToken to return once ctx was cancelled")
+	}
+}
@@ -3,6 +3,12 @@
 
 package ecauth
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
 // Holds a customer's OAuth 2.0 Credentials
 type OAuth2Credentials struct {
 	ClientID     string
@@ -24,7 +30,49 @@ type OAuth2ErrorResponse struct {
 	Error string `json:"error"`
 }
 
-// Defines structs that can retrieve OAuth 2.0 Tokens
+// OAuth2Error is returned when an identity server responds to a token request
+// with a status code other than 200 OK
+type OAuth2Error struct {
+	// StatusCode is the HTTP status code returned by the identity server
+	StatusCode int
+
+	// URL is the token endpoint that was called
+	URL string
+
+	// Header contains the response headers
+	Header http.Header
+
+	// Body is the raw response body
+	Body []byte
+
+	// Response is the decoded error response, if the body could be decoded
+	Response *OAuth2ErrorResponse
+}
+
+// Error implements the error interface
+func (e *OAuth2Error) Error() string {
+	if e.Response != nil && e.StatusCode == http.StatusBadRequest {
+		return fmt.Sprintf("%s bad request: %s", errorPrefix, e.Response.Error)
+	}
+	if e.Response != nil {
+		return fmt.Sprintf(
+			"%s expected 200 OK, received status code %d: %s",
+			errorPrefix,
+			e.StatusCode,
+			e.Response.Error)
+	}
+	return fmt.Sprintf(
+		"%s expected 200 OK, received status code %d: %s",
+		errorPrefix,
+		e.StatusCode,
+		e.Body)
+}
+
+// Defines structs that can retrieve OAuth 2.0 Tokens. The request for a
+// token is abandoned once ctx is done.
 type OAuth2Client interface {
-	GetToken(credentials OAuth2Credentials) (*OAuth2TokenResponse, error)
+	GetToken(
+		ctx context.Context,
+		credentials OAuth2Credentials,
+	) (*OAuth2TokenResponse, error)
 }
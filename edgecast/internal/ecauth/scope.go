@@ -0,0 +1,179 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth
+
+/*
+	This file contains the logic that requests IDS tokens limited to the scopes
+	each service needs, from a single set of credentials.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+)
+
+// ErrMissingScope matches any MissingScopeError when used with errors.Is
+var ErrMissingScope = errors.New("required scope not granted")
+
+// MissingScopeError is returned when a token is requested for scopes that
+// are not among the scopes granted to the IDS credentials
+type MissingScopeError struct {
+	// Missing are the required scopes that were not granted
+	Missing []string
+
+	// Granted are the scopes configured in the IDS credentials
+	Granted []string
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf(
+		"%s: the IDS credentials are missing scope %q, granted scopes are %q",
+		ErrMissingScope,
+		JoinScopes(e.Missing),
+		JoinScopes(e.Granted))
+}
+
+// Is allows MissingScopeError to be matched with ErrMissingScope by errors.Is
+func (e *MissingScopeError) Is(target error) bool {
+	return target == ErrMissingScope
+}
+
+// ParseScope splits a space-separated OAuth 2.0 scope value into its scopes.
+// The result is sorted and contains no duplicates.
+func ParseScope(scope string) []string {
+	return normalizeScopes(strings.Fields(scope))
+}
+
+// JoinScopes returns the space-separated OAuth 2.0 scope value for scopes.
+// The same set of scopes always produces the same value.
+func JoinScopes(scopes []string) string {
+	return strings.Join(normalizeScopes(scopes), " ")
+}
+
+// MissingScopes returns the scopes in required that are not in granted
+func MissingScopes(granted []string, required []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, s := range granted {
+		grantedSet[s] = true
+	}
+
+	var missing []string
+	for _, s := range normalizeScopes(required) {
+		if !grantedSet[s] {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+func normalizeScopes(scopes []string) []string {
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		s = strings.TrimSpace(s)
+		if len(s) == 0 || seen[s] {
+			continue
+		}
+		seen[s] = true
+		normalized = append(normalized, s)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// IDSScopeCache creates IDSAuthorizationProviders for subsets of the scopes
+// granted to a single set of credentials. There is one provider, and
+// therefore one cached token, per set of scopes. It is safe for concurrent
+// use.
+type IDSScopeCache struct {
+	baseIDSURL      url.URL
+	credentials     OAuth2Credentials
+	granted         []string
+	httpClient      *http.Client
+	instrumentation ecinstrument.Instrumentation
+	tokenCache      TokenCache
+
+	mu        sync.Mutex
+	providers map[string]*IDSAuthorizationProvider
+}
+
+// Creates a new IDSScopeCache for credentials, whose Scope is the
+// space-separated list of scopes granted to the client. Providers retrieve
+// tokens from the specified URL using httpClient, and report token retrievals
+// to instrumentation. Tokens are shared with other processes through
+// tokenCache. Both instrumentation and tokenCache may be nil.
+func NewIDSScopeCache(
+	baseIDSURL url.URL,
+	credentials OAuth2Credentials,
+	httpClient *http.Client,
+	instrumentation ecinstrument.Instrumentation,
+	tokenCache TokenCache,
+) (*IDSScopeCache, error) {
+	granted := ParseScope(credentials.Scope)
+	if len(credentials.ClientID) == 0 ||
+		len(credentials.ClientSecret) == 0 ||
+		len(granted) == 0 {
+		return nil, errors.New("client ID, secret, and scope required")
+	}
+
+	return &IDSScopeCache{
+		baseIDSURL:      baseIDSURL,
+		credentials:     credentials,
+		granted:         granted,
+		httpClient:      httpClient,
+		instrumentation: instrumentation,
+		tokenCache:      tokenCache,
+		providers:       make(map[string]*IDSAuthorizationProvider),
+	}, nil
+}
+
+// Provider returns the provider whose tokens are limited to scopes. If no
+// scopes are given, tokens carry every granted scope. A MissingScopeError is
+// returned if any of scopes was not granted.
+func (sc *IDSScopeCache) Provider(
+	scopes ...string,
+) (*IDSAuthorizationProvider, error) {
+	if len(normalizeScopes(scopes)) == 0 {
+		scopes = sc.granted
+	}
+
+	if missing := MissingScopes(sc.granted, scopes); len(missing) > 0 {
+		return nil, &MissingScopeError{
+			Missing: missing,
+			Granted: append([]string{}, sc.granted...),
+		}
+	}
+
+	scope := JoinScopes(scopes)
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if p, ok := sc.providers[scope]; ok {
+		return p, nil
+	}
+
+	credentials := sc.credentials
+	credentials.Scope = scope
+
+	p, err := NewIDSAuthorizationProvider(
+		sc.baseIDSURL,
+		credentials,
+		sc.httpClient,
+		sc.instrumentation)
+	if err != nil {
+		return nil, err
+	}
+	p.TokenCache = sc.tokenCache
+
+	sc.providers[scope] = p
+	return p, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseScope(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Scope    string
+		Expected []string
+	}{
+		{
+			Name:     "Empty",
+			Scope:    "",
+			Expected: []string{},
+		},
+		{
+			Name:     "Single scope",
+			Scope:    "cdn.origins",
+			Expected: []string{"cdn.origins"},
+		},
+		{
+			Name:     "Sorted and deduplicated",
+			Scope:    " ec.rules  cdn.rtld ec.rules\tcdn.origins ",
+			Expected: []string{"cdn.origins", "cdn.rtld", "ec.rules"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := ParseScope(c.Scope)
+		if !reflect.DeepEqual(c.Expected, actual) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.Name, c.Expected, actual)
+		}
+	}
+}
+
+func TestJoinScopes(t *testing.T) {
+	a := JoinScopes([]string{"ec.rules", "cdn.rtld"})
+	b := JoinScopes([]string{"cdn.rtld", "ec.rules", "cdn.rtld"})
+
+	expected := "cdn.rtld ec.rules"
+	if a != expected || b != expected {
+		t.Fatalf("Expected %q but got %q and %q", expected, a, b)
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Granted  []string
+		Required []string
+		Expected []string
+	}{
+		{
+			Name:     "All granted",
+			Granted:  []string{"cdn.origins", "cdn.rtld"},
+			Required: []string{"cdn.rtld"},
+			Expected: nil,
+		},
+		{
+			Name:     "Nothing required",
+			Granted:  []string{"cdn.origins"},
+			Required: nil,
+			Expected: nil,
+		},
+		{
+			Name:     "Some missing",
+			Granted:  []string{"cdn.origins"},
+			Required: []string{"ec.rules", "cdn.origins", "cdn.rtld"},
+			Expected: []string{"cdn.rtld", "ec.rules"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := MissingScopes(c.Granted, c.Required)
+		if !reflect.DeepEqual(c.Expected, actual) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.Name, c.Expected, actual)
+		}
+	}
+}
+
+func TestNewIDSScopeCacheRequiresCredentials(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Credentials OAuth2Credentials
+	}{
+		{
+			Name: "Missing client ID",
+			Credentials: OAuth2Credentials{
+				ClientSecret: "secret",
+				Scope:        "cdn.rtld",
+			},
+		},
+		{
+			Name: "Missing client secret",
+			Credentials: OAuth2Credentials{
+				ClientID: "id",
+				Scope:    "cdn.rtld",
+			},
+		},
+		{
+			Name: "Blank scope",
+			Credentials: OAuth2Credentials{
+				ClientID:     "id",
+				ClientSecret: "secret",
+				Scope:        "   ",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		_, err := NewIDSScopeCache(url.URL{}, c.Credentials, nil, nil, nil)
+		if err == nil {
+			t.Fatalf("%s: Expected an error but got nil", c.Name)
+		}
+	}
+}
+
+func TestIDSScopeCacheProvider(t *testing.T) {
+	cache, err := NewIDSScopeCache(
+		url.URL{},
+		OAuth2Credentials{
+			ClientID:     "id",
+			ClientSecret: "secret",
+			Scope:        "ec.rules cdn.rtld cdn.origins",
+		},
+		nil,
+		nil,
+		nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rtld, err := cache.Provider("cdn.rtld")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rtld.Credentials.Scope != "cdn.rtld" {
+		t.Fatalf(
+			"Expected token scope %q but got %q",
+			"cdn.rtld",
+			rtld.Credentials.Scope)
+	}
+
+	again, err := cache.Provider("cdn.rtld", "cdn.rtld")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if again != rtld {
+		t.Fatal("Expected the provider to be reused for the same scope set")
+	}
+
+	both, err := cache.Provider("ec.rules", "cdn.rtld")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if both == rtld {
+		t.Fatal("Expected a separate provider for a different scope set")
+	}
+	if both.Credentials.Scope != "cdn.rtld ec.rules" {
+		t.Fatalf(
+			"Expected token scope %q but got %q",
+			"cdn.rtld ec.rules",
+			both.Credentials.Scope)
+	}
+
+	all, err := cache.Provider()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if all.Credentials.Scope != "cdn.origins cdn.rtld ec.rules" {
+		t.Fatalf(
+			"Expected token scope %q but got %q",
+			"cdn.origins cdn.rtld ec.rules",
+			all.Credentials.Scope)
+	}
+}
+
+func TestIDSScopeCacheProviderMissingScope(t *testing.T) {
+	cache, err := NewIDSScopeCache(
+		url.URL{},
+		OAuth2Credentials{
+			ClientID:     "id",
+			ClientSecret: "secret",
+			Scope:        "cdn.origins",
+		},
+		nil,
+		nil,
+		nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	_, err = cache.Provider("cdn.origins", "cdn.rtld")
+	if !errors.Is(err, ErrMissingScope) {
+		t.Fatalf("Expected ErrMissingScope but got %v", err)
+	}
+
+	var missingErr *MissingScopeError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("Expected a MissingScopeError but got %T", err)
+	}
+
+	expected := []string{"cdn.rtld"}
+	if !reflect.DeepEqual(expected, missingErr.Missing) {
+		t.Fatalf(
+			"Expected missing scopes %+v but got %+v",
+			expected,
+			missingErr.Missing)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth_test
+
+import (
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/ectest"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+func TestAllScopesIncludesEveryService(t *testing.T) {
+	granted := ecauth.ParseScope(ecauth.JoinScopes(ectest.AllScopes()))
+	cases := []struct {
+		service  string
+		required []string
+	}{
+		{service: "cps", required: cps.RequiredScopes()},
+		{service: "originv3", required: originv3.RequiredScopes()},
+		{service: "rtld", required: rtld.RequiredScopes()},
+		{
+			service:  "waf_bot_manager",
+			required: waf_bot_manager.RequiredScopes(),
+		},
+	}
+
+	for _, c := range cases {
+		if len(c.required) == 0 {
+			t.Fatalf("%s: Expected required scopes", c.service)
+		}
+		missing := ecauth.MissingScopes(granted, c.required)
+		if len(missing) > 0 {
+			t.Fatalf(
+				"%s: Expected %+v to be granted but got %+v",
+				c.service,
+				missing,
+				granted)
+		}
+	}
+}
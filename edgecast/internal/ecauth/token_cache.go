@@ -0,0 +1,150 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth
+
+/*
+	This file contains the TokenCache, which lets IDS tokens outlive the
+	process that retrieved them, and its file-backed implementation.
+*/
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TokenCacheKey identifies the token of a client for a set of scopes
+type TokenCacheKey struct {
+	BaseIDSURL string
+	ClientID   string
+
+	// Scope is the space-separated list of scopes the token carries
+	Scope string
+}
+
+// TokenCache stores IDS tokens so that they can be reused by later processes.
+// Implementations must be safe for concurrent use.
+type TokenCache interface {
+	// Get returns the token stored for key, or nil if there is none or it has
+	// expired
+	Get(key TokenCacheKey) (*IDSToken, error)
+
+	// Put stores token for key, replacing any previous token
+	Put(key TokenCacheKey, token IDSToken) error
+}
+
+// FileTokenCache is a TokenCache that stores each token in its own file. Files
+// are readable only by their owner. Tokens are written to a temporary file
+// that is then renamed, so processes sharing the directory never read a
+// partially written token.
+type FileTokenCache struct {
+	// Dir is the directory that holds the token files
+	Dir string
+}
+
+type tokenFile struct {
+	AccessToken    string    `json:"access_token"`
+	ExpirationTime time.Time `json:"expiration_time"`
+}
+
+// DefaultTokenCacheDir returns the default FileTokenCache directory, an
+// edgecast/tokens directory under the user's cache directory
+func DefaultTokenCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "edgecast", "tokens"), nil
+}
+
+// NewFileTokenCache creates a FileTokenCache that stores tokens in dir. If dir
+// is empty, DefaultTokenCacheDir is used. The directory is created if it does
+// not exist.
+func NewFileTokenCache(dir string) (*FileTokenCache, error) {
+	if len(dir) == 0 {
+		defaultDir, err := DefaultTokenCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("NewFileTokenCache: %w", err)
+		}
+		dir = defaultDir
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("NewFileTokenCache: %w", err)
+	}
+
+	return &FileTokenCache{Dir: dir}, nil
+}
+
+// Get returns the token stored for key, or nil if there is none or it has
+// expired
+func (fc *FileTokenCache) Get(key TokenCacheKey) (*IDSToken, error) {
+	data, err := os.ReadFile(fc.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("FileTokenCache.Get: %w", err)
+	}
+
+	var file tokenFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("FileTokenCache.Get: %w", err)
+	}
+
+	if len(file.AccessToken) == 0 || !time.Now().Before(file.ExpirationTime) {
+		return nil, nil
+	}
+
+	return &IDSToken{
+		AccessToken:    file.AccessToken,
+		ExpirationTime: file.ExpirationTime,
+	}, nil
+}
+
+// Put stores token for key, replacing any previous token
+func (fc *FileTokenCache) Put(key TokenCacheKey, token IDSToken) error {
+	data, err := json.Marshal(tokenFile{
+		AccessToken:    token.AccessToken,
+		ExpirationTime: token.ExpirationTime,
+	})
+	if err != nil {
+		return fmt.Errorf("FileTokenCache.Put: %w", err)
+	}
+
+	// os.CreateTemp creates the file with 0600 permissions
+	tmp, err := os.CreateTemp(fc.Dir, ".token-*")
+	if err != nil {
+		return fmt.Errorf("FileTokenCache.Put: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("FileTokenCache.Put: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fc.path(key)); err != nil {
+		return fmt.Errorf("FileTokenCache.Put: %w", err)
+	}
+
+	return nil
+}
+
+// path returns the file that holds the token for key. The key is hashed so
+// that it is a valid file name and does not reveal the client ID.
+func (fc *FileTokenCache) path(key TokenCacheKey) string {
+	sum := sha256.Sum256([]byte(
+		key.BaseIDSURL + "\n" + key.ClientID + "\n" + key.Scope))
+	return filepath.Join(fc.Dir, hex.EncodeToString(sum[:])+".json")
+}
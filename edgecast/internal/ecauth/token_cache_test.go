@@ -0,0 +1,255 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecauth
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileTokenCachePutGet(t *testing.T) {
+	cache, err := NewFileTokenCache(filepath.Join(t.TempDir(), "tokens"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	key := TokenCacheKey{
+		BaseIDSURL: "https://id.example.com",
+		ClientID:   "id",
+		Scope:      "cdn.rtld",
+	}
+
+	token, err := cache.Get(key)
+	if err != nil || token != nil {
+		t.Fatalf("Expected no token but got %+v, %v", token, err)
+	}
+
+	expiration := time.Now().Add(time.Hour).Round(0)
+	err = cache.Put(key, IDSToken{
+		AccessToken:    "abcd",
+		ExpirationTime: expiration,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	token, err = cache.Get(key)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token == nil ||
+		token.AccessToken != "abcd" ||
+		!token.ExpirationTime.Equal(expiration) {
+		t.Fatalf("Expected the stored token but got %+v", token)
+	}
+
+	otherKeys := []TokenCacheKey{
+		{BaseIDSURL: "https://id.example.com", ClientID: "id", Scope: "ec.rules"},
+		{BaseIDSURL: "https://id.example.com", ClientID: "id2", Scope: "cdn.rtld"},
+		{BaseIDSURL: "https://id2.example.com", ClientID: "id", Scope: "cdn.rtld"},
+	}
+	for _, other := range otherKeys {
+		token, err := cache.Get(other)
+		if err != nil || token != nil {
+			t.Fatalf("%+v: Expected no token but got %+v, %v", other, token, err)
+		}
+	}
+}
+
+func TestFileTokenCacheExpired(t *testing.T) {
+	cache, err := NewFileTokenCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	key := TokenCacheKey{ClientID: "id", Scope: "cdn.rtld"}
+	err = cache.Put(key, IDSToken{
+		AccessToken:    "abcd",
+		ExpirationTime: time.Now().Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	token, err := cache.Get(key)
+	if err != nil || token != nil {
+		t.Fatalf("Expected no token but got %+v, %v", token, err)
+	}
+}
+
+func TestFileTokenCachePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not supported on Windows")
+	}
+
+	dir := filepath.Join(t.TempDir(), "tokens")
+	cache, err := NewFileTokenCache(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	key := TokenCacheKey{ClientID: "id", Scope: "cdn.rtld"}
+	err = cache.Put(key, IDSToken{
+		AccessToken:    "abcd",
+		ExpirationTime: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dirInfo, err := os.Stat(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if perm := dirInfo.Mode().Perm(); perm != 0700 {
+		t.Fatalf("Expected directory permissions 0700 but got %o", perm)
+	}
+
+	fileInfo, err := os.Stat(cache.path(key))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if perm := fileInfo.Mode().Perm(); perm != 0600 {
+		t.Fatalf("Expected file permissions 0600 but got %o", perm)
+	}
+}
+
+func TestFileTokenCacheConcurrentPut(t *testing.T) {
+	dir := t.TempDir()
+	key := TokenCacheKey{ClientID: "id", Scope: "cdn.rtld"}
+
+	// Separate instances stand in for separate processes
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cache := &FileTokenCache{Dir: dir}
+			errs <- cache.Put(key, IDSToken{
+				AccessToken:    fmt.Sprintf("token-%d", i),
+				ExpirationTime: time.Now().Add(time.Hour),
+			})
+			if _, err := cache.Get(key); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	token, err := (&FileTokenCache{Dir: dir}).Get(key)
+	if err != nil || token == nil {
+		t.Fatalf("Expected a token but got %+v, %v", token, err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 file but got %d", len(entries))
+	}
+}
+
+func TestGetAuthorizationHeaderTokenCache(t *testing.T) {
+	cache, err := NewFileTokenCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	credentials := OAuth2Credentials{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scope:        "cdn.rtld",
+	}
+
+	// The first process retrieves a token and stores it
+	first := &testCountingIDSClient{
+		token: &OAuth2TokenResponse{AccessToken: "abcd", ExpiresIn: 3600},
+	}
+	provider := &IDSAuthorizationProvider{
+		TokenClient: first,
+		Credentials: credentials,
+		TokenCache:  cache,
+	}
+
+	header, err := provider.GetAuthorizationHeader()
+	if err != nil || header != "Bearer abcd" {
+		t.Fatalf("Expected %q but got %q, %v", "Bearer abcd", header, err)
+	}
+	if calls := first.Calls(); calls != 1 {
+		t.Fatalf("Expected 1 token request but got %d", calls)
+	}
+
+	// A later process reuses it without a token request
+	second := &testCountingIDSClient{
+		token: &OAuth2TokenResponse{AccessToken: "efgh", ExpiresIn: 3600},
+	}
+	provider = &IDSAuthorizationProvider{
+		TokenClient: second,
+		Credentials: credentials,
+		TokenCache:  cache,
+	}
+
+	header, err = provider.GetAuthorizationHeader()
+	if err != nil || header != "Bearer abcd" {
+		t.Fatalf("Expected %q but got %q, %v", "Bearer abcd", header, err)
+	}
+	if calls := second.Calls(); calls != 0 {
+		t.Fatalf("Expected no token requests but got %d", calls)
+	}
+}
+
+func TestGetAuthorizationHeaderTokenCacheDue(t *testing.T) {
+	cache, err := NewFileTokenCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	credentials := OAuth2Credentials{
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scope:        "cdn.rtld",
+	}
+
+	// Cached, but within the refresh skew of its expiry
+	err = cache.Put(
+		TokenCacheKey{ClientID: "id", Scope: "cdn.rtld"},
+		IDSToken{
+			AccessToken:    "abcd",
+			ExpirationTime: time.Now().Add(time.Second),
+		})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	client := &testCountingIDSClient{
+		token: &OAuth2TokenResponse{AccessToken: "efgh", ExpiresIn: 3600},
+	}
+	provider := &IDSAuthorizationProvider{
+		TokenClient: client,
+		Credentials: credentials,
+		TokenCache:  cache,
+	}
+
+	header, err := provider.GetAuthorizationHeader()
+	if err != nil || header != "Bearer efgh" {
+		t.Fatalf("Expected %q but got %q, %v", "Bearer efgh", header, err)
+	}
+	if calls := client.Calls(); calls != 1 {
+		t.Fatalf("Expected 1 token request but got %d", calls)
+	}
+}
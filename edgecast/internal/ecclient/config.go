@@ -11,6 +11,10 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecratelimit"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecretryablehttp"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
 )
 
 // ClientConfig provides configuration for the core SDK client code
@@ -21,6 +25,9 @@ type ClientConfig struct {
 	// APIURL contains the base URL for the target API
 	BaseAPIURL url.URL
 
+	// ServiceName identifies the SDK service in traces and metrics, e.g. "waf"
+	ServiceName string
+
 	// The User Agent for outgoing HTTP requests
 	UserAgent string
 
@@ -39,6 +46,37 @@ type ClientConfig struct {
 	// CheckRetry is a handler that allows users to define custom logic
 	// to determine whether the API Client should retry a failed API call
 	CheckRetry CheckRetry
+
+	// ErrorDecoder decodes API-specific error payloads into APIError.Details
+	ErrorDecoder ErrorDecoder
+
+	// HTTPClient sends each HTTP request attempt. A pooled default client is
+	// used if nil.
+	HTTPClient *http.Client
+
+	// Interceptors wrap every request sent by the client. They run in order,
+	// so the first interceptor sees the request first and the response last.
+	Interceptors []Interceptor
+
+	// Instrumentation receives a span and metrics for every call, and a child
+	// span for every HTTP attempt. Defaults to a no-op implementation.
+	Instrumentation ecinstrument.Instrumentation
+
+	// RateLimit throttles requests to the host of BaseAPIURL. The limiter is
+	// shared by all clients that call the same host with the same settings.
+	RateLimit ecratelimit.Config
+
+	// CircuitBreaker rejects calls to failing hosts or endpoints without
+	// sending them. May be shared by multiple clients. Optional.
+	CircuitBreaker *ecretryablehttp.CircuitBreaker
+
+	// DryRun, if enabled, records mutating requests instead of sending them
+	DryRun DryRunConfig
+
+	// Redaction configures the secrets that are masked in logged headers and
+	// bodies, in addition to ecredact.DefaultFields and
+	// ecredact.DefaultHeaders
+	Redaction ecredact.Config
 }
 
 type CheckRetry func(
@@ -9,12 +9,15 @@ package ecclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/collectionhelper"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
@@ -26,16 +29,37 @@ const (
 	defaultHeaderContentType string = "application/json"
 )
 
-var (
-	// Store headers as a hash set for easy lookup.
-	sensitiveHeaders map[string]bool = map[string]bool{
-		"Authorization": true,
-	}
-)
-
 // SubmitRequest invokes an HTTP request with the given parameters
 func (c ECClient) SubmitRequest(params SubmitRequestParams) (*Response, error) {
-	req, err := c.reqBuilder.buildRequest(buildRequestParams{
+	return c.SubmitRequestWithContext(context.Background(), params)
+}
+
+// SubmitRequestWithContext invokes an HTTP request with the given parameters.
+// The provided context governs the entire call, including any retries and the
+// backoff periods between them.
+func (c ECClient) SubmitRequestWithContext(
+	ctx context.Context,
+	params SubmitRequestParams,
+) (*Response, error) {
+	if ctx == nil {
+		return nil, errors.New("SubmitRequestWithContext: nil context")
+	}
+
+	start := time.Now()
+	ctx, call, span := c.startCall(ctx, params)
+
+	resp, err := c.submitRequest(ctx, params)
+	c.endCall(ctx, call, span, start, resp, err)
+
+	return resp, err
+}
+
+// submitRequest builds a request and sends it
+func (c ECClient) submitRequest(
+	ctx context.Context,
+	params SubmitRequestParams,
+) (*Response, error) {
+	req, err := c.reqBuilder.buildRequest(ctx, buildRequestParams{
 		method:      params.Method,
 		path:        params.Path,
 		rawBody:     params.RawBody,
@@ -52,45 +76,19 @@ func (c ECClient) SubmitRequest(params SubmitRequestParams) (*Response, error) {
 	// Provides an object to be filled in when unmarshaling the API response
 	req.parsedResponse = params.ParsedResponse
 
-	c.Config.Logger.Debug(
-		"[REQUEST-URI]:[%s] %s\n",
-		req.method,
-		req.url.String())
-	c.Config.Logger.Debug("[REQUEST-BODY]:%v\n", req.rawBody)
-	c.Config.Logger.Debug(
-		"[REQUEST-HEADERS]:%s\n",
-		scrubSensitiveHeaders(req.headers))
-
-	resp, err := c.reqSender.sendRequest(*req)
+	resp, err := c.reqSender.sendRequest(ctx, *req)
 	if err != nil {
 		return nil, fmt.Errorf("SubmitRequest: %w", err)
 	}
-	bodyAsString, _ := jsonhelper.ConvertToJSONString(resp.Data, true)
-	c.Config.Logger.Debug("[RESPONSE-BODY]:%s\n", bodyAsString)
 	return resp, nil
 }
 
-func scrubSensitiveHeaders(m map[string]string) map[string]string {
-	scrubbed := make(map[string]string, len(m))
-
-	for k, v := range m {
-		// check the set of sensitive headers.
-		if _, ok := sensitiveHeaders[k]; ok {
-			scrubbed[k] = "*****"
-		} else {
-			scrubbed[k] = v
-		}
-	}
-
-	return scrubbed
-}
-
 // buildRequest creates a new Request for the Edgecast API with query params,
 // adding appropriate headers
 func (eb ecRequestBuilder) buildRequest(
+	ctx context.Context,
 	params buildRequestParams,
-) (*request, error) {
-	eb.logger.Debug("Building Request: %+v", params)
+) (*Request, error) {
 	relativeURL, err := url.Parse(params.path)
 	if err != nil {
 		return nil,
@@ -104,10 +102,10 @@ func (eb ecRequestBuilder) buildRequest(
 				params.method)
 	}
 
-	req := request{
-		method:  params.method.String(),
-		url:     eb.baseAPIURL.ResolveReference(relativeURL),
-		headers: make(map[string]string),
+	req := Request{
+		Method:  params.method.String(),
+		URL:     eb.baseAPIURL.ResolveReference(relativeURL),
+		Headers: make(map[string]string),
 	}
 
 	err = req.setPathParams(params.pathParams)
@@ -117,8 +115,8 @@ func (eb ecRequestBuilder) buildRequest(
 	}
 	req.setQueryParams(params.queryParams)
 
-	req.headers["User-Agent"] = params.userAgent
-	req.headers["Accept"] = defaultHeaderAccept
+	req.Headers["User-Agent"] = params.userAgent
+	req.Headers["Accept"] = defaultHeaderAccept
 
 	if params.rawBody != nil {
 		err := req.setBody(params.rawBody)
@@ -129,7 +127,7 @@ func (eb ecRequestBuilder) buildRequest(
 	}
 
 	if eb.authProvider != nil {
-		err := req.setAuthorization(eb.authProvider)
+		err := req.setAuthorization(ctx, eb.authProvider)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"ecRequestBuilder.buildRequest: %w", err)
@@ -138,14 +136,14 @@ func (eb ecRequestBuilder) buildRequest(
 
 	if len(params.headers) > 0 {
 		for k, v := range params.headers {
-			req.headers[k] = v
+			req.Headers[k] = v
 		}
 	}
 
 	return &req, nil
 }
 
-func (req *request) setPathParams(params map[string]string) error {
+func (req *Request) setPathParams(params map[string]string) error {
 	// Apply path parameters
 	// e.g.
 	// path = "/customers/{customer_id}/policies/{policy_id}""
@@ -154,14 +152,14 @@ func (req *request) setPathParams(params map[string]string) error {
 	for k, v := range params {
 		searchKey := fmt.Sprintf("{%s}", k)
 
-		if !strings.Contains(req.url.Path, searchKey) {
+		if !strings.Contains(req.URL.Path, searchKey) {
 			return fmt.Errorf(
 				"Request.setPathParams: param not found in path: %s",
 				k)
 		}
 
-		req.url.Path = strings.Replace(
-			req.url.Path,
+		req.URL.Path = strings.Replace(
+			req.URL.Path,
 			searchKey,
 			fmt.Sprintf("%v", v),
 			-1)
@@ -169,51 +167,64 @@ func (req *request) setPathParams(params map[string]string) error {
 	return nil
 }
 
-func (req *request) setQueryParams(queryParams map[string]string) {
+func (req *Request) setQueryParams(queryParams map[string]string) {
 	// Adding Query Params
-	query := req.url.Query()
+	query := req.URL.Query()
 	for k, v := range queryParams {
 		query.Add(k, v)
 	}
 	// Encode the parameters and set the URL
-	req.url.RawQuery = query.Encode()
+	req.URL.RawQuery = query.Encode()
 }
 
-func (req *request) setBody(rawBody interface{}) error {
-	if req.headers == nil {
-		req.headers = make(map[string]string)
+func (req *Request) setBody(rawBody interface{}) error {
+	if req.Headers == nil {
+		req.Headers = make(map[string]string)
 	}
 	switch b := rawBody.(type) {
 	case string:
-		req.rawBody = []byte(b)
-		req.headers["Content-Type"] = "text/plain; charset=utf-8"
-		req.headers["Accept"] = "application/json, text/html"
+		req.RawBody = []byte(b)
+		req.Headers["Content-Type"] = "text/plain; charset=utf-8"
+		req.Headers["Accept"] = "application/json, text/html"
 	default:
 		buf := new(bytes.Buffer)
 		err := json.NewEncoder(buf).Encode(rawBody)
 		if err != nil {
 			return err
 		}
-		req.rawBody = buf
-		req.headers["Accept"] = defaultHeaderAccept
-		req.headers["Content-Type"] = defaultHeaderContentType
+		req.RawBody = buf
+		req.Headers["Accept"] = defaultHeaderAccept
+		req.Headers["Content-Type"] = defaultHeaderContentType
 	}
 	return nil
 }
 
-func (req *request) setAuthorization(
+func (req *Request) setAuthorization(
+	ctx context.Context,
 	auth ecauth.AuthorizationProvider,
 ) error {
-	authHeader, err := auth.GetAuthorizationHeader()
+	var authHeader string
+	var err error
+
+	if ctxAuth, ok := auth.(ecauth.ContextAuthorizationProvider); ok {
+		authHeader, err = ctxAuth.GetAuthorizationHeaderWithContext(ctx)
+	} else {
+		authHeader, err = auth.GetAuthorizationHeader()
+	}
+
 	if err != nil {
+		var oauthErr *ecauth.OAuth2Error
+		if errors.As(err, &oauthErr) {
+			err = newAPIErrorFromOAuth2Error(oauthErr)
+		}
 		return fmt.Errorf(
-			"request.setAuthorization: failed to get authorization: %v",
+			"request.setAuthorization: failed to get authorization: %w",
 			err)
 	}
-	if req.headers == nil {
-		req.headers = make(map[string]string)
+	if req.Headers == nil {
+		req.Headers = make(map[string]string)
 	}
-	req.headers["Authorization"] = authHeader
+	req.Headers["Authorization"] = authHeader
 	return nil
 }
 
@@ -262,16 +273,29 @@ func (bp jsonBodyParser) parseBody(
 	return nil
 }
 
-// sendRequest sends a Request and returns the Response.
+// sendRequest passes a Request through the configured interceptors and sends
+// it, returning the Response.
 // If Request.ParsedResponse is non-nil, then the response body will be
 // unmarshaled to it.
 // Response.Data will always have the unmarshaled response body as a string.
-func (es ecRequestSender) sendRequest(req request) (*Response, error) {
+func (es ecRequestSender) sendRequest(
+	ctx context.Context,
+	req Request,
+) (*Response, error) {
+	return chainInterceptors(es.interceptors, es.send)(ctx, &req)
+}
+
+// send performs the HTTP call for a Request once all interceptors have run
+func (es ecRequestSender) send(
+	ctx context.Context,
+	req *Request,
+) (*Response, error) {
 	httpResp, err := es.clientAdapter.Do(
-		req.method,
-		req.url,
-		req.headers,
-		req.rawBody)
+		ctx,
+		req.Method,
+		req.URL,
+		req.Headers,
+		req.RawBody)
 	if err != nil {
 		return nil, fmt.Errorf("sendRequest: %w", err)
 	}
@@ -280,20 +304,18 @@ func (es ecRequestSender) sendRequest(req request) (*Response, error) {
 	body, err := ioutil.ReadAll(httpResp.Body)
 	bodyAsString := string(body)
 
-	logMsg := jsonhelper.CreateJSONLogMessage("Reading Response", bodyAsString)
-	es.logger.Debug(logMsg)
-
 	if err != nil {
 		return nil, fmt.Errorf(
-			"sendRequest: ioutil.ReadAll: %v",
+			"sendRequest: ioutil.ReadAll: %w",
 			err)
 	}
 
 	if httpResp.StatusCode >= 400 && httpResp.StatusCode <= 599 {
-		return nil, fmt.Errorf(
-			"sendRequest failed (HTTP StatusCode:%d): %s",
-			httpResp.StatusCode,
-			bodyAsString)
+		apiErr := newAPIError(*req, httpResp, body)
+		if es.errorDecoder != nil {
+			es.errorDecoder(apiErr)
+		}
+		return nil, fmt.Errorf("sendRequest: %w", apiErr)
 	}
 
 	// If a schema was provided, use the parser.
@@ -0,0 +1,221 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains the dry-run interceptor, which records mutating
+	requests in a plan instead of sending them
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrDryRun matches any DryRunError when used with errors.Is
+var ErrDryRun = errors.New("request not sent: dry run")
+
+// DryRunConfig controls dry-run mode, in which GET requests are sent as usual
+// but POST, PUT, PATCH and DELETE requests are only recorded
+type DryRunConfig struct {
+	// Enabled turns on dry-run mode
+	Enabled bool
+
+	// Plan receives every request that is not sent. Optional.
+	Plan *DryRunPlan
+
+	// ReturnError makes calls that would have been sent fail with a
+	// *DryRunError. By default they succeed with a synthetic HTTP 200
+	// Response whose DryRun field is set. The response model, if any, is
+	// left zero-valued, so service methods return zero values, e.g. an empty
+	// ID, for the resources they would have created. The body of the
+	// response to a POST, PUT or PATCH request without a response model is
+	// DryRunPlaceholderID, since some APIs, such as Route DNS, respond to
+	// those requests with the bare ID of the resource. Code that validates
+	// or uses a response must check Response.DryRun first.
+	ReturnError bool
+}
+
+// DryRunPlaceholderID is the ID returned in dry-run mode for the resources
+// created by APIs that respond with a bare ID
+const DryRunPlaceholderID = "0"
+
+// PlannedRequest describes a request that was not sent because of dry-run
+// mode
+type PlannedRequest struct {
+	// Service is the name of the service that made the request
+	Service string `json:"service,omitempty"`
+
+	Method string `json:"method"`
+
+	// URL is the fully resolved URL, including path and query parameters
+	URL string `json:"url"`
+
+	// Body is the JSON request body. Bodies that are not JSON are stored as a
+	// JSON string.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRunPlan collects the requests intercepted in dry-run mode. The zero
+// value is an empty plan ready to use, and a plan may be shared by multiple
+// services.
+type DryRunPlan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns a copy of the requests recorded so far, in the order they
+// were made
+func (p *DryRunPlan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	requests := make([]PlannedRequest, len(p.requests))
+	copy(requests, p.requests)
+	return requests
+}
+
+// Reset removes all recorded requests
+func (p *DryRunPlan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = nil
+}
+
+func (p *DryRunPlan) add(req PlannedRequest) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = append(p.requests, req)
+}
+
+// DryRunError is returned for each request that was not sent because of
+// dry-run mode, if DryRunConfig.ReturnError is set
+type DryRunError struct {
+	Request PlannedRequest
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Request.Method, e.Request.URL, ErrDryRun)
+}
+
+// Is allows DryRunError to be matched with ErrDryRun by errors.Is
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// newDryRunInterceptor creates an Interceptor that sends GET, HEAD and
+// OPTIONS requests as usual and records all other requests in the plan
+// without sending them
+func newDryRunInterceptor(service string, config DryRunConfig) Interceptor {
+	return func(
+		ctx context.Context,
+		req *Request,
+		next RequestHandler,
+	) (*Response, error) {
+		if !isMutating(req.Method) {
+			return next(ctx, req)
+		}
+
+		planned := PlannedRequest{
+			Service: service,
+			Method:  req.Method,
+			URL:     req.URL.String(),
+		}
+
+		body, err := plannedBody(req.RawBody)
+		if err != nil {
+			return nil, fmt.Errorf("dry run: %w", err)
+		}
+		planned.Body = body
+
+		if config.Plan != nil {
+			config.Plan.add(planned)
+		}
+
+		if config.ReturnError {
+			return nil, &DryRunError{Request: planned}
+		}
+
+		data := dryRunResponseBody(req)
+		return &Response{
+			Data:   data,
+			DryRun: true,
+			HTTPResponse: &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(data)),
+			},
+		}, nil
+	}
+}
+
+// dryRunResponseBody returns the body of the synthetic response to a request
+// that was not sent. Requests that are not decoded into a response model may
+// be read as a bare ID, so they are given a placeholder one. A response model
+// is never populated.
+func dryRunResponseBody(req *Request) string {
+	if req.parsedResponse != nil {
+		return ""
+	}
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return DryRunPlaceholderID
+	}
+	return ""
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// plannedBody returns a request body as JSON without consuming it
+func plannedBody(rawBody interface{}) (json.RawMessage, error) {
+	var body []byte
+	switch b := rawBody.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		body = b
+	case string:
+		body = []byte(b)
+	case *bytes.Buffer:
+		body = b.Bytes()
+	case *bytes.Reader:
+		data, err := io.ReadAll(io.NewSectionReader(b, 0, b.Size()))
+		if err != nil {
+			return nil, err
+		}
+		body = data
+	default:
+		return json.Marshal(rawBody)
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	if json.Valid(body) {
+		return append(json.RawMessage{}, body...), nil
+	}
+
+	return json.Marshal(string(body))
+}
@@ -0,0 +1,165 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+type testDryRunModel struct {
+	Name string
+	Port int
+}
+
+func TestSubmitRequestDryRun(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			methods = append(methods, r.Method)
+			w.Write([]byte(`{"Name":"origin","Port":80}`))
+		}))
+	defer server.Close()
+
+	plan := &DryRunPlan{}
+	client := New(ClientConfig{
+		AuthProvider: testAuthProvider{Auth: "token"},
+		BaseAPIURL:   *testhelper.URLParse(server.URL + "/v2/"),
+		ServiceName:  "origin",
+		Logger:       testLog,
+		DryRun:       DryRunConfig{Enabled: true, Plan: plan},
+	})
+
+	parsed := testDryRunModel{}
+	_, err := client.SubmitRequest(SubmitRequestParams{
+		Method:         Get,
+		Path:           "origins/{id}",
+		PathParams:     map[string]string{"id": "1"},
+		ParsedResponse: &parsed,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed.Name != "origin" {
+		t.Fatalf("Expected GET requests to be sent but got %+v", parsed)
+	}
+
+	resp, err := client.SubmitRequest(SubmitRequestParams{
+		Method:      Put,
+		Path:        "origins/{id}",
+		PathParams:  map[string]string{"id": "1"},
+		QueryParams: map[string]string{"force": "true"},
+		RawBody:     testDryRunModel{Name: "origin", Port: 443},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.HTTPResponse.StatusCode != http.StatusOK {
+		t.Fatalf(
+			"Expected a synthetic success but got %d",
+			resp.HTTPResponse.StatusCode)
+	}
+	if resp.Data != DryRunPlaceholderID {
+		t.Fatalf(
+			"Expected the placeholder ID %q but got %q",
+			DryRunPlaceholderID,
+			resp.Data)
+	}
+
+	// A response model is left zero-valued
+	created := testDryRunModel{}
+	resp, err = client.SubmitRequest(SubmitRequestParams{
+		Method:         Post,
+		Path:           "origins",
+		RawBody:        testDryRunModel{Name: "new"},
+		ParsedResponse: &created,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !resp.DryRun || resp.Data != "" || created != (testDryRunModel{}) {
+		t.Fatalf(
+			"Expected a dry-run response and an empty model but got %+v, %+v",
+			resp,
+			created)
+	}
+
+	_, err = client.SubmitRequest(SubmitRequestParams{
+		Method:     Delete,
+		Path:       "origins/{id}",
+		PathParams: map[string]string{"id": "1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual([]string{http.MethodGet}, methods) {
+		t.Fatalf("Expected only the GET request to be sent but got %+v", methods)
+	}
+
+	expected := []PlannedRequest{
+		{
+			Service: "origin",
+			Method:  http.MethodPut,
+			URL:     server.URL + "/v2/origins/1?force=true",
+			Body:    json.RawMessage(`{"Name":"origin","Port":443}`),
+		},
+		{
+			Service: "origin",
+			Method:  http.MethodPost,
+			URL:     server.URL + "/v2/origins",
+			Body:    json.RawMessage(`{"Name":"new","Port":0}`),
+		},
+		{
+			Service: "origin",
+			Method:  http.MethodDelete,
+			URL:     server.URL + "/v2/origins/1",
+		},
+	}
+	if actual := plan.Requests(); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	plan.Reset()
+	if len(plan.Requests()) != 0 {
+		t.Fatalf("Expected an empty plan after Reset")
+	}
+}
+
+func TestSubmitRequestDryRunReturnError(t *testing.T) {
+	adapter := &testRecordingClientAdapter{}
+	client := New(ClientConfig{
+		BaseAPIURL: *testhelper.URLParse("https://api.vdms.io/v2/"),
+		Logger:     testLog,
+		DryRun:     DryRunConfig{Enabled: true, ReturnError: true},
+	})
+	sender := client.reqSender.(ecRequestSender)
+	sender.clientAdapter = adapter
+	client.reqSender = sender
+
+	_, err := client.SubmitRequest(SubmitRequestParams{
+		Method:  Post,
+		Path:    "origins",
+		RawBody: "plain text",
+	})
+
+	var dryRunErr *DryRunError
+	if !errors.As(err, &dryRunErr) || !errors.Is(err, ErrDryRun) {
+		t.Fatalf("Expected a *DryRunError but got %v", err)
+	}
+	if string(dryRunErr.Request.Body) != `"plain text"` {
+		t.Fatalf(
+			"Expected the body to be stored as a JSON string but got %s",
+			dryRunErr.Request.Body)
+	}
+	if adapter.calls != 0 {
+		t.Fatalf("Expected no requests to be sent but got %d", adapter.calls)
+	}
+}
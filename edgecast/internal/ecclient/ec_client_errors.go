@@ -0,0 +1,168 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains the error types returned by the EC SDK client
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+)
+
+var (
+	// Headers that may carry the ID assigned to a request by the API, in order
+	// of precedence.
+	requestIDHeaders []string = []string{
+		"X-Request-Id",
+		"X-Correlation-Id",
+		"X-Ec-Request-Id",
+	}
+)
+
+// APIError is returned when an EdgeCast API responds with an HTTP 4xx or 5xx
+// status code
+type APIError struct {
+	// StatusCode is the HTTP status code returned by the API
+	StatusCode int
+
+	// Method is the HTTP method of the failed request
+	Method string
+
+	// URL is the full URL of the failed request
+	URL string
+
+	// Header contains the response headers
+	Header http.Header
+
+	// RequestID is the ID the API assigned to the request, if one was returned
+	RequestID string
+
+	// Body is the raw response body
+	Body []byte
+
+	// Details holds the API-specific error payload decoded from Body, if the
+	// service provided an ErrorDecoder that recognized it
+	Details interface{}
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf(
+		"%s %s failed (HTTP StatusCode:%d): %s",
+		e.Method,
+		e.URL,
+		e.StatusCode,
+		string(e.Body))
+}
+
+// ErrorDecoder decodes the API-specific error payload in APIError.Body and
+// stores the result in APIError.Details. Decoders should leave Details
+// untouched if the body is not in the expected format.
+type ErrorDecoder func(apiErr *APIError)
+
+// newAPIError creates an APIError from an HTTP response and its body
+func newAPIError(
+	req Request,
+	httpResp *http.Response,
+	body []byte,
+) *APIError {
+	apiErr := &APIError{
+		StatusCode: httpResp.StatusCode,
+		Method:     req.Method,
+		Header:     httpResp.Header,
+		Body:       body,
+	}
+
+	if req.URL != nil {
+		apiErr.URL = req.URL.String()
+	}
+
+	apiErr.RequestID = requestIDFromHeader(httpResp.Header)
+
+	return apiErr
+}
+
+// requestIDFromHeader returns the ID the API assigned to a request, or an
+// empty string if the response headers do not contain one
+func requestIDFromHeader(header http.Header) string {
+	for _, h := range requestIDHeaders {
+		if id := header.Get(h); len(id) > 0 {
+			return id
+		}
+	}
+	return ""
+}
+
+// newAPIErrorFromOAuth2Error converts a failed IDS token request into an
+// APIError so that callers only need to inspect a single error type
+func newAPIErrorFromOAuth2Error(oauthErr *ecauth.OAuth2Error) *APIError {
+	apiErr := &APIError{
+		StatusCode: oauthErr.StatusCode,
+		Method:     http.MethodPost,
+		URL:        oauthErr.URL,
+		Header:     oauthErr.Header,
+		Body:       oauthErr.Body,
+	}
+
+	if oauthErr.Response != nil {
+		apiErr.Details = *oauthErr.Response
+	}
+
+	return apiErr
+}
+
+// AsAPIError finds the first APIError in err's chain
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound determines whether err was caused by an HTTP 404 response
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict determines whether err was caused by an HTTP 409 response
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsRateLimited determines whether err was caused by an HTTP 429 response
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized determines whether err was caused by an HTTP 401 response
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden determines whether err was caused by an HTTP 403 response
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsBadRequest determines whether err was caused by an HTTP 400 response
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsServerError determines whether err was caused by an HTTP 5xx response
+func IsServerError(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode >= 500 && apiErr.StatusCode <= 599
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == statusCode
+}
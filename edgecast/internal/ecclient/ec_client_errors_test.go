@@ -0,0 +1,161 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestSendRequestAPIError(t *testing.T) {
+	body := `{"errors":[{"code":"404","message":"not found"}]}`
+	decoderCalled := false
+
+	sender := ecRequestSender{
+		clientAdapter: testClientAdapter{
+			response: http.Response{
+				StatusCode: http.StatusNotFound,
+				Header: http.Header{
+					"X-Request-Id": []string{"req-123"},
+				},
+				Body: testhelper.ToIOReadCloser(body),
+			},
+		},
+		logger: testLog,
+		parser: newJSONBodyParser(),
+		errorDecoder: func(apiErr *APIError) {
+			decoderCalled = true
+			apiErr.Details = "decoded"
+		},
+	}
+
+	_, err := sender.sendRequest(
+		context.Background(),
+		Request{
+			Method: "GET",
+			URL:    testhelper.URLParse("https://edgecast.com/test/1"),
+		})
+
+	apiErr, ok := AsAPIError(fmt.Errorf("wrapped: %w", err))
+	if !ok {
+		t.Fatalf("Expected an APIError but got %T: %v", err, err)
+	}
+
+	expected := &APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     "GET",
+		URL:        "https://edgecast.com/test/1",
+		Header: http.Header{
+			"X-Request-Id": []string{"req-123"},
+		},
+		RequestID: "req-123",
+		Body:      []byte(body),
+		Details:   "decoded",
+	}
+	if !reflect.DeepEqual(expected, apiErr) {
+		t.Fatalf("Expected %+v but got %+v", expected, apiErr)
+	}
+	if !decoderCalled {
+		t.Fatalf("Expected the error decoder to be called")
+	}
+}
+
+func TestAPIErrorStatusHelpers(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		check    func(error) bool
+		expected bool
+	}{
+		{
+			name:     "Not Found",
+			err:      &APIError{StatusCode: http.StatusNotFound},
+			check:    IsNotFound,
+			expected: true,
+		},
+		{
+			name:     "Wrapped Conflict",
+			err:      fmt.Errorf("outer: %w", &APIError{StatusCode: 409}),
+			check:    IsConflict,
+			expected: true,
+		},
+		{
+			name:     "Rate Limited",
+			err:      &APIError{StatusCode: http.StatusTooManyRequests},
+			check:    IsRateLimited,
+			expected: true,
+		},
+		{
+			name:     "Server Error",
+			err:      &APIError{StatusCode: http.StatusBadGateway},
+			check:    IsServerError,
+			expected: true,
+		},
+		{
+			name:     "Mismatched status code",
+			err:      &APIError{StatusCode: http.StatusBadRequest},
+			check:    IsNotFound,
+			expected: false,
+		},
+		{
+			name:     "Not an APIError",
+			err:      errors.New("404 not found"),
+			check:    IsNotFound,
+			expected: false,
+		},
+		{
+			name:     "Nil error",
+			err:      nil,
+			check:    IsNotFound,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.check(c.err)
+		if c.expected != actual {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestSetAuthorizationOAuth2Error(t *testing.T) {
+	req := Request{}
+	err := req.setAuthorization(context.Background(), testOAuth2ErrorProvider{
+		err: &ecauth.OAuth2Error{
+			StatusCode: http.StatusBadRequest,
+			URL:        "https://id.vdms.io/connect/token",
+			Body:       []byte(`{"error":"invalid_scope"}`),
+			Response:   &ecauth.OAuth2ErrorResponse{Error: "invalid_scope"},
+		},
+	})
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("Expected an APIError but got %T: %v", err, err)
+	}
+
+	expected := ecauth.OAuth2ErrorResponse{Error: "invalid_scope"}
+	if !reflect.DeepEqual(expected, apiErr.Details) {
+		t.Fatalf("Expected %+v but got %+v", expected, apiErr.Details)
+	}
+	if !IsBadRequest(err) {
+		t.Fatalf("Expected IsBadRequest to be true")
+	}
+}
+
+type testOAuth2ErrorProvider struct {
+	err error
+}
+
+func (p testOAuth2ErrorProvider) GetAuthorizationHeader() (string, error) {
+	return "", fmt.Errorf("GetToken: %w", p.err)
+}
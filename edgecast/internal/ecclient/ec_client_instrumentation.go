@@ -0,0 +1,99 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains the tracing and metrics emitted for each API call
+*/
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+)
+
+// startCall starts the span for a logical API call and returns a context that
+// carries both the span and the call, so that token refreshes and HTTP
+// attempts are recorded beneath it
+func (c ECClient) startCall(
+	ctx context.Context,
+	params SubmitRequestParams,
+) (context.Context, *ecinstrument.Call, ecinstrument.Span) {
+	call := &ecinstrument.Call{
+		Service: c.Config.ServiceName,
+		Route:   routeTemplate(c.Config.BaseAPIURL, params.Path),
+		Method:  params.Method.String(),
+	}
+
+	ctx = ecinstrument.ContextWithCall(ctx, call)
+	ctx, span := ecinstrument.OrNoop(c.Config.Instrumentation).StartSpan(
+		ctx,
+		call.Method+" "+call.Route,
+		call.Attributes()...)
+
+	return ctx, call, span
+}
+
+// endCall ends the span for a logical API call and records its metrics
+func (c ECClient) endCall(
+	ctx context.Context,
+	call *ecinstrument.Call,
+	span ecinstrument.Span,
+	start time.Time,
+	resp *Response,
+	err error,
+) {
+	instrumentation := ecinstrument.OrNoop(c.Config.Instrumentation)
+
+	attrs := append(
+		call.Attributes(),
+		ecinstrument.Int(ecinstrument.AttrStatusCode, statusCode(resp, err)))
+
+	span.SetAttributes(
+		ecinstrument.Int(ecinstrument.AttrStatusCode, statusCode(resp, err)),
+		ecinstrument.Int(ecinstrument.AttrAttempts, call.Attempts()))
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+
+	instrumentation.AddCounter(ctx, ecinstrument.MetricRequests, 1, attrs...)
+	instrumentation.RecordHistogram(
+		ctx,
+		ecinstrument.MetricRequestDuration,
+		time.Since(start).Seconds(),
+		attrs...)
+
+	if retries := call.Attempts() - 1; retries > 0 {
+		instrumentation.AddCounter(
+			ctx,
+			ecinstrument.MetricRetries,
+			int64(retries),
+			attrs...)
+	}
+}
+
+// routeTemplate resolves the path template of a request against the base URL
+// before any path parameters are substituted
+func routeTemplate(baseAPIURL url.URL, path string) string {
+	relativeURL, err := url.Parse(path)
+	if err != nil {
+		return path
+	}
+	return baseAPIURL.ResolveReference(relativeURL).Path
+}
+
+// statusCode determines the HTTP status code of a completed call, or 0 if no
+// response was received
+func statusCode(resp *Response, err error) int {
+	if resp != nil && resp.HTTPResponse != nil {
+		return resp.HTTPResponse.StatusCode
+	}
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.StatusCode
+	}
+	return 0
+}
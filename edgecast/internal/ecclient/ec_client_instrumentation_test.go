@@ -0,0 +1,113 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestSubmitRequestInstrumentation(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+	defer server.Close()
+
+	instrumentation := ecinstrument.NewInMemoryInstrumentation()
+	client := New(ClientConfig{
+		AuthProvider: &ecauth.IDSAuthorizationProvider{
+			TokenClient:     testTokenClient{},
+			Instrumentation: instrumentation,
+		},
+		BaseAPIURL:      *testhelper.URLParse(server.URL + "/v2/"),
+		ServiceName:     "test",
+		Logger:          testLog,
+		RetryWaitMin:    testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryWaitMax:    testhelper.WrapDurationInPointer(time.Millisecond),
+		Instrumentation: instrumentation,
+	})
+
+	_, err := client.SubmitRequest(SubmitRequestParams{
+		Method:     Get,
+		Path:       "customers/{id}",
+		PathParams: map[string]string{"id": "1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	spans := instrumentation.Spans()
+	if len(spans) != 4 {
+		t.Fatalf("Expected 4 spans but got %+v", spans)
+	}
+
+	call := spans[0]
+	if call.Name != "GET /v2/customers/{id}" || call.ParentID != 0 {
+		t.Fatalf("Expected a root call span but got %+v", call)
+	}
+	if attempts, _ := call.Attribute(ecinstrument.AttrAttempts); attempts != int64(2) {
+		t.Fatalf("Expected %d but got %v", 2, attempts)
+	}
+
+	expectedChildren := []string{
+		ecinstrument.SpanTokenRefresh,
+		ecinstrument.SpanHTTPAttempt,
+		ecinstrument.SpanHTTPAttempt,
+	}
+	for i, name := range expectedChildren {
+		span := spans[i+1]
+		if span.Name != name || span.ParentID != call.ID || !span.Ended {
+			t.Fatalf("Expected an ended %s child span but got %+v", name, span)
+		}
+	}
+
+	requests := instrumentation.Counters(ecinstrument.MetricRequests)
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 request metric but got %+v", requests)
+	}
+	expectedAttrs := map[string]interface{}{
+		ecinstrument.AttrService:    "test",
+		ecinstrument.AttrRoute:      "/v2/customers/{id}",
+		ecinstrument.AttrMethod:     "GET",
+		ecinstrument.AttrStatusCode: int64(http.StatusOK),
+	}
+	for k, v := range expectedAttrs {
+		if actual, _ := requests[0].Attribute(k); actual != v {
+			t.Fatalf("Expected %s=%v but got %v", k, v, actual)
+		}
+	}
+
+	retries := instrumentation.Counters(ecinstrument.MetricRetries)
+	if len(retries) != 1 || retries[0].Value != 1 {
+		t.Fatalf("Expected 1 retry but got %+v", retries)
+	}
+
+	durations := instrumentation.Histograms(ecinstrument.MetricRequestDuration)
+	if len(durations) != 1 {
+		t.Fatalf("Expected 1 duration but got %+v", durations)
+	}
+}
+
+type testTokenClient struct{}
+
+func (testTokenClient) GetToken(
+	ctx context.Context,
+	credentials ecauth.OAuth2Credentials,
+) (*ecauth.OAuth2TokenResponse, error) {
+	return &ecauth.OAuth2TokenResponse{AccessToken: "abcd", ExpiresIn: 3600}, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains the interceptor chain that wraps every request sent by
+	the EC SDK client
+*/
+
+import (
+	"context"
+)
+
+// RequestHandler sends a Request and returns its Response
+type RequestHandler func(ctx context.Context, req *Request) (*Response, error)
+
+// Interceptor is invoked for every request sent by an APIClient. It may modify
+// req before passing it to next, inspect or replace the Response and error
+// returned by next, or short-circuit the call by not calling next at all.
+//
+// A Response returned without calling next is passed to the caller as-is; the
+// service's response model is not populated from it.
+type Interceptor func(
+	ctx context.Context,
+	req *Request,
+	next RequestHandler,
+) (*Response, error)
+
+// chainInterceptors wraps handler with the given interceptors. The first
+// interceptor is the outermost, i.e. it sees the request first and the
+// response last.
+func chainInterceptors(
+	interceptors []Interceptor,
+	handler RequestHandler,
+) RequestHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := handler
+		handler = func(ctx context.Context, req *Request) (*Response, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+
+	return handler
+}
@@ -0,0 +1,230 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestSendRequestInterceptorOrder(t *testing.T) {
+	var calls []string
+
+	newInterceptor := func(name string) Interceptor {
+		return func(
+			ctx context.Context,
+			req *Request,
+			next RequestHandler,
+		) (*Response, error) {
+			calls = append(calls, name+":before")
+			resp, err := next(ctx, req)
+			calls = append(calls, name+":after")
+			return resp, err
+		}
+	}
+
+	sender := ecRequestSender{
+		clientAdapter: testClientAdapter{
+			response: http.Response{
+				StatusCode: http.StatusOK,
+				Body:       testhelper.ToIOReadCloser("{}"),
+			},
+		},
+		logger: testLog,
+		parser: newJSONBodyParser(),
+		interceptors: []Interceptor{
+			newInterceptor("first"),
+			newInterceptor("second"),
+		},
+	}
+
+	_, err := sender.sendRequest(
+		context.Background(),
+		Request{Method: "GET", URL: testhelper.URLParse("https://a.com")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{
+		"first:before",
+		"second:before",
+		"second:after",
+		"first:after",
+	}
+	if !reflect.DeepEqual(expected, calls) {
+		t.Fatalf("Expected %+v but got %+v", expected, calls)
+	}
+}
+
+func TestSendRequestInterceptorModifiesRequest(t *testing.T) {
+	adapter := &testRecordingClientAdapter{
+		response: http.Response{
+			StatusCode: http.StatusOK,
+			Body:       testhelper.ToIOReadCloser("{}"),
+		},
+	}
+
+	sender := ecRequestSender{
+		clientAdapter: adapter,
+		logger:        testLog,
+		parser:        newJSONBodyParser(),
+		interceptors: []Interceptor{
+			func(
+				ctx context.Context,
+				req *Request,
+				next RequestHandler,
+			) (*Response, error) {
+				req.Headers["X-Owner-Id"] = "owner"
+				return next(ctx, req)
+			},
+		},
+	}
+
+	_, err := sender.sendRequest(
+		context.Background(),
+		Request{
+			Method:  "GET",
+			URL:     testhelper.URLParse("https://a.com"),
+			Headers: map[string]string{},
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if adapter.headers["X-Owner-Id"] != "owner" {
+		t.Fatalf("Expected %s but got %+v", "owner", adapter.headers)
+	}
+}
+
+func TestSendRequestInterceptorShortCircuit(t *testing.T) {
+	adapter := &testRecordingClientAdapter{}
+	expectedErr := errors.New("blocked")
+
+	cases := []struct {
+		name          string
+		resp          *Response
+		err           error
+		expectedError bool
+	}{
+		{
+			name: "Happy Path - synthetic response",
+			resp: &Response{Data: "synthetic"},
+		},
+		{
+			name:          "Error Path - interceptor error",
+			err:           expectedErr,
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		sender := ecRequestSender{
+			clientAdapter: adapter,
+			logger:        testLog,
+			parser:        newJSONBodyParser(),
+			interceptors: []Interceptor{
+				func(
+					ctx context.Context,
+					req *Request,
+					next RequestHandler,
+				) (*Response, error) {
+					return c.resp, c.err
+				},
+			},
+		}
+
+		actual, err := sender.sendRequest(
+			context.Background(),
+			Request{Method: "DELETE", URL: testhelper.URLParse("https://a.com")})
+
+		if c.expectedError {
+			if !errors.Is(err, expectedErr) {
+				t.Fatalf("%s: Expected %v but got %v", c.name, expectedErr, err)
+			}
+		} else if actual != c.resp {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.resp, actual)
+		}
+
+		if adapter.calls != 0 {
+			t.Fatalf("%s: Expected no HTTP calls but got %d", c.name, adapter.calls)
+		}
+	}
+}
+
+func TestDebugLoggingInterceptor(t *testing.T) {
+	logger := &testRecordingLogger{}
+	interceptor := newDebugLoggingInterceptor(
+		logger,
+		ecredact.New(ecredact.Config{}))
+
+	req := Request{
+		Method: "GET",
+		URL:    testhelper.URLParse("https://a.com/path"),
+		Headers: map[string]string{
+			"Authorization": "TOK:secret",
+			"Accept":        "application/json",
+		},
+	}
+
+	_, err := interceptor(
+		context.Background(),
+		&req,
+		func(ctx context.Context, req *Request) (*Response, error) {
+			return &Response{Data: "data"}, nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	logs := strings.Join(logger.debug, "")
+	for _, expected := range []string{
+		"sending request method=GET path=/path",
+		"request_headers=",
+		"response_body=data",
+	} {
+		if !strings.Contains(logs, expected) {
+			t.Fatalf("Expected log to contain '%s' but got '%s'", expected, logs)
+		}
+	}
+	if strings.Contains(logs, "secret") {
+		t.Fatalf("Expected Authorization header to be scrubbed but got '%s'", logs)
+	}
+}
+
+type testRecordingClientAdapter struct {
+	response http.Response
+	headers  map[string]string
+	calls    int
+}
+
+func (c *testRecordingClientAdapter) Do(
+	ctx context.Context,
+	method string,
+	url *url.URL,
+	headers map[string]string,
+	rawBody interface{},
+) (*http.Response, error) {
+	c.calls++
+	c.headers = headers
+	return &c.response, nil
+}
+
+type testRecordingLogger struct {
+	eclog.NullLogger
+	debug []string
+}
+
+func (l *testRecordingLogger) Debug(format string, v ...interface{}) {
+	l.debug = append(l.debug, fmt.Sprintf(format, v...))
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains the structured debug log written for each API call
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
+)
+
+// newDebugLoggingInterceptor creates an Interceptor that writes each request
+// and its outcome to the Debug log, with the service, method, path, status,
+// attempt count, duration and request ID as fields. Secrets in headers and
+// bodies are masked by redactor.
+func newDebugLoggingInterceptor(
+	logger eclog.Logger,
+	redactor *ecredact.Redactor,
+) Interceptor {
+	structured := eclog.Structured(logger)
+
+	return func(
+		ctx context.Context,
+		req *Request,
+		next RequestHandler,
+	) (*Response, error) {
+		if !structured.Enabled(eclog.LevelDebug) {
+			return next(ctx, req)
+		}
+
+		fields := requestLogFields(ctx, req)
+		structured.Log(
+			eclog.LevelDebug,
+			"sending request",
+			withFields(
+				fields,
+				eclog.F(
+					eclog.FieldRequestHeaders,
+					redactor.HeaderMap(req.Headers)),
+				eclog.F(
+					eclog.FieldRequestBody,
+					logBody(redactor, req)))...)
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+
+		fields = append(fields, eclog.F(eclog.FieldDuration, time.Since(start)))
+		if call, ok := ecinstrument.CallFromContext(ctx); ok {
+			fields = append(fields, eclog.F(eclog.FieldAttempt, call.Attempts()))
+		}
+
+		if err != nil {
+			if apiErr, ok := AsAPIError(err); ok {
+				fields = append(
+					fields,
+					eclog.F(eclog.FieldStatus, apiErr.StatusCode),
+					eclog.F(eclog.FieldRequestID, apiErr.RequestID))
+			}
+			structured.Log(
+				eclog.LevelDebug,
+				"request failed",
+				withFields(fields, eclog.F(eclog.FieldError, err))...)
+			return nil, err
+		}
+
+		if resp == nil {
+			structured.Log(eclog.LevelDebug, "received no response", fields...)
+			return nil, nil
+		}
+
+		contentType := ""
+		if resp.HTTPResponse != nil {
+			contentType = resp.HTTPResponse.Header.Get("Content-Type")
+			fields = append(
+				fields,
+				eclog.F(eclog.FieldStatus, resp.HTTPResponse.StatusCode),
+				eclog.F(
+					eclog.FieldRequestID,
+					requestIDFromHeader(resp.HTTPResponse.Header)))
+		}
+		structured.Log(
+			eclog.LevelDebug,
+			"received response",
+			withFields(
+				fields,
+				eclog.F(
+					eclog.FieldResponseBody,
+					redactor.Body([]byte(resp.Data), contentType)))...)
+
+		return resp, nil
+	}
+}
+
+// requestLogFields returns the fields that identify req
+func requestLogFields(ctx context.Context, req *Request) []eclog.Field {
+	fields := make([]eclog.Field, 0, 10)
+	if call, ok := ecinstrument.CallFromContext(ctx); ok {
+		fields = append(fields, eclog.F(eclog.FieldService, call.Service))
+	}
+
+	fields = append(fields, eclog.F(eclog.FieldMethod, req.Method))
+	if req.URL != nil {
+		fields = append(fields, eclog.F(eclog.FieldPath, req.URL.Path))
+	}
+
+	return fields
+}
+
+// withFields returns fields followed by extra without modifying the array
+// behind fields, so that loggers may keep the result
+func withFields(fields []eclog.Field, extra ...eclog.Field) []eclog.Field {
+	return append(fields[:len(fields):len(fields)], extra...)
+}
+
+// logBody returns the body of req as text with secrets masked
+func logBody(redactor *ecredact.Redactor, req *Request) string {
+	body, err := plannedBody(req.RawBody)
+	if err != nil {
+		// The body could not be encoded, so it cannot be redacted field by
+		// field
+		if req.RawBody == nil {
+			return ""
+		}
+		return redactor.Mask()
+	}
+
+	// Bodies that are not JSON, such as forms, are encoded as JSON strings
+	var text string
+	if json.Unmarshal(body, &text) == nil {
+		return redactor.Body([]byte(text), req.Headers["Content-Type"])
+	}
+	return redactor.Body(body, req.Headers["Content-Type"])
+}
@@ -0,0 +1,275 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestSubmitRequestStructuredLogging(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&attempts, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("X-Request-Id", "req-123")
+			w.Write([]byte(`{}`))
+		}))
+	defer server.Close()
+
+	logger := &testStructuredLogger{}
+	client := New(ClientConfig{
+		AuthProvider: &ecauth.IDSAuthorizationProvider{
+			TokenClient: testTokenClient{},
+		},
+		BaseAPIURL:   *testhelper.URLParse(server.URL + "/v2/"),
+		ServiceName:  "test",
+		Logger:       logger,
+		RetryWaitMin: testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryWaitMax: testhelper.WrapDurationInPointer(time.Millisecond),
+	})
+
+	_, err := client.SubmitRequest(SubmitRequestParams{
+		Method:     Get,
+		Path:       "customers/{id}",
+		PathParams: map[string]string{"id": "1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entry, ok := logger.find("received response")
+	if !ok {
+		t.Fatalf("Expected a 'received response' entry but got %+v", logger.entries)
+	}
+
+	expected := map[string]interface{}{
+		eclog.FieldService:   "test",
+		eclog.FieldMethod:    "GET",
+		eclog.FieldPath:      "/v2/customers/1",
+		eclog.FieldStatus:    http.StatusOK,
+		eclog.FieldAttempt:   2,
+		eclog.FieldRequestID: "req-123",
+	}
+	for k, v := range expected {
+		if actual := entry.fields[k]; actual != v {
+			t.Fatalf("Expected %s=%v but got %v", k, v, actual)
+		}
+	}
+	if _, ok := entry.fields[eclog.FieldDuration].(time.Duration); !ok {
+		t.Fatalf("Expected a duration but got %+v", entry.fields)
+	}
+}
+
+func TestSubmitRequestStructuredLoggingDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{}`))
+		}))
+	defer server.Close()
+
+	logger := &testStructuredLogger{min: eclog.LevelInfo}
+	client := New(ClientConfig{
+		AuthProvider: &ecauth.IDSAuthorizationProvider{
+			TokenClient: testTokenClient{},
+		},
+		BaseAPIURL:  *testhelper.URLParse(server.URL + "/v2/"),
+		ServiceName: "test",
+		Logger:      logger,
+	})
+
+	_, err := client.SubmitRequest(SubmitRequestParams{
+		Method: Get,
+		Path:   "customers",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := logger.find("sending request"); ok {
+		t.Fatalf("Expected no debug entries but got %+v", logger.entries)
+	}
+}
+
+func TestSubmitRequestLoggingRedactsSecrets(t *testing.T) {
+	responses := map[string]string{
+		// RTLD profile with an HTTP POST destination
+		"/v2/rtld": `{"delivery_method":{"http_post":{"authentication_type":` +
+			`"http_basic","username":"rtld-user","password":"rtld-pass",` +
+			`"token":"rtld-token"}}}`,
+		// Route DNS TSIG key
+		"/v2/tsig": `{"Alias":"k","KeyName":"n","KeyValue":"tsig-key"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(responses[r.URL.Path]))
+		}))
+	defer server.Close()
+
+	logger := &testStructuredLogger{}
+	client := New(ClientConfig{
+		AuthProvider: &ecauth.IDSAuthorizationProvider{
+			TokenClient: testTokenClient{},
+		},
+		BaseAPIURL: *testhelper.URLParse(server.URL + "/v2/"),
+		Logger:     logger,
+		Redaction: ecredact.Config{
+			Fields:  []string{"custom_secret"},
+			Paths:   []string{"delivery_method.http_post.username"},
+			Headers: []string{"X-Custom-Auth"},
+		},
+	})
+
+	requests := []SubmitRequestParams{
+		{
+			Method: Post,
+			Path:   "rtld",
+			RawBody: map[string]interface{}{
+				"delivery_method": map[string]interface{}{
+					"http_post": map[string]interface{}{
+						"username": "rtld-user",
+						"password": "rtld-pass",
+					},
+					"datadog":      map[string]interface{}{"api_key": "dd-key"},
+					"splunk":       map[string]interface{}{"token": "splunk-token"},
+					"azure_blob":   map[string]interface{}{"access_key": "az-key"},
+					"custom_field": map[string]interface{}{"custom_secret": "c"},
+				},
+			},
+		},
+		{
+			// WAF bot manager scope
+			Method: Put,
+			Path:   "waf",
+			RawBody: map[string]interface{}{
+				"scopes": []interface{}{
+					map[string]interface{}{
+						"recaptcha_action_name": "action",
+						"recaptcha_secret_key":  "waf-recaptcha",
+					},
+				},
+			},
+		},
+		{
+			Method:  Get,
+			Path:    "tsig",
+			Headers: map[string]string{"X-Custom-Auth": "custom-header"},
+		},
+		{
+			Method: Get,
+			Path:   "rtld",
+		},
+	}
+	for _, params := range requests {
+		_, err := client.SubmitRequest(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	logs := logger.format()
+	for _, secret := range []string{
+		"rtld-user",
+		"rtld-pass",
+		"rtld-token",
+		"dd-key",
+		"splunk-token",
+		"az-key",
+		`"c"`,
+		"waf-recaptcha",
+		"tsig-key",
+		"custom-header",
+		"TOK:",
+	} {
+		if strings.Contains(logs, secret) {
+			t.Fatalf("Expected %q to be redacted from %s", secret, logs)
+		}
+	}
+	if !strings.Contains(logs, "recaptcha_action_name") {
+		t.Fatalf("Expected request bodies to be logged but got %s", logs)
+	}
+}
+
+type testStructuredLogEntry struct {
+	level  eclog.Level
+	msg    string
+	fields map[string]interface{}
+}
+
+// A test logger that records structured messages at or above min
+type testStructuredLogger struct {
+	eclog.NullLogger
+	min     eclog.Level
+	mu      sync.Mutex
+	entries []testStructuredLogEntry
+}
+
+func (l *testStructuredLogger) Log(
+	level eclog.Level,
+	msg string,
+	fields ...eclog.Field,
+) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	entry := testStructuredLogEntry{
+		level:  level,
+		msg:    msg,
+		fields: map[string]interface{}{},
+	}
+	for _, f := range fields {
+		entry.fields[f.Key] = f.Value
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func (l *testStructuredLogger) Enabled(level eclog.Level) bool {
+	return level >= l.min
+}
+
+func (l *testStructuredLogger) find(msg string) (testStructuredLogEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, e := range l.entries {
+		if e.msg == msg {
+			return e, true
+		}
+	}
+	return testStructuredLogEntry{}, false
+}
+
+func (l *testStructuredLogger) format() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var sb strings.Builder
+	for _, e := range l.entries {
+		fields := make([]eclog.Field, 0, len(e.fields))
+		for k, v := range e.fields {
+			fields = append(fields, eclog.F(k, v))
+		}
+		sb.WriteString(eclog.FormatFields(e.msg, fields))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
@@ -4,6 +4,7 @@
 package ecclient
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -11,13 +12,20 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecratelimit"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecretryablehttp"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
 )
 
 // APIClient describes structs that can send HTTP requests to an API given the
 // request's method, path relative to the API base path.
 type APIClient interface {
 	SubmitRequest(params SubmitRequestParams) (*Response, error)
+
+	SubmitRequestWithContext(
+		ctx context.Context,
+		params SubmitRequestParams,
+	) (*Response, error)
 }
 
 type SubmitRequestParams struct {
@@ -45,14 +53,19 @@ func NewSubmitRequestParams() SubmitRequestParams {
 type Response struct {
 	Data         string
 	HTTPResponse *http.Response
+
+	// DryRun is true if the request was not sent because of dry-run mode,
+	// the response being synthetic
+	DryRun bool
 }
 
-// request contains the properties of an HTTP request
-type request struct {
-	method  string
-	url     *url.URL
-	headers map[string]string
-	rawBody interface{}
+// Request contains the properties of an HTTP request. Interceptors may modify
+// these properties before the request is sent.
+type Request struct {
+	Method  string
+	URL     *url.URL
+	Headers map[string]string
+	RawBody interface{}
 	// parsedResponse will be filled in using the API response
 	parsedResponse interface{}
 }
@@ -71,18 +84,22 @@ type buildRequestParams struct {
 
 // requestBuilder builds a new request using the given parameters
 type requestBuilder interface {
-	buildRequest(params buildRequestParams) (*request, error)
+	buildRequest(
+		ctx context.Context,
+		params buildRequestParams,
+	) (*Request, error)
 }
 
 // requestSender sends a request to an API
 type requestSender interface {
-	sendRequest(req request) (*Response, error)
+	sendRequest(ctx context.Context, req Request) (*Response, error)
 }
 
 // Describes structs that can pass requests to a 3rd party http library, and
 // return the http.Response from the library
 type clientAdapter interface {
 	Do(
+		ctx context.Context,
 		method string,
 		url *url.URL,
 		headers map[string]string,
@@ -100,13 +117,19 @@ type ECClient struct {
 // New creates a default instance of ECClient using the provided
 // configuration
 func New(config ClientConfig) ECClient {
+	rateLimiter := ecratelimit.Shared(config.BaseAPIURL, config.RateLimit)
+
 	clientAdapter := ecretryablehttp.NewRetryableHTTPClientAdapter(
 		ecretryablehttp.RetryConfig{
-			Logger:       config.Logger,
-			RetryWaitMin: config.RetryWaitMin,
-			RetryWaitMax: config.RetryWaitMax,
-			RetryMax:     config.RetryMax,
-			CheckRetry:   ecretryablehttp.CheckRetry(config.CheckRetry),
+			Logger:          config.Logger,
+			RetryWaitMin:    config.RetryWaitMin,
+			RetryWaitMax:    config.RetryWaitMax,
+			RetryMax:        config.RetryMax,
+			CheckRetry:      ecretryablehttp.CheckRetry(config.CheckRetry),
+			HTTPClient:      config.HTTPClient,
+			Instrumentation: config.Instrumentation,
+			RateLimiter:     rateLimiter,
+			CircuitBreaker:  config.CircuitBreaker,
 		})
 	return ECClient{
 		reqBuilder: newECRequestBuilder(config),
@@ -143,6 +166,8 @@ type ecRequestSender struct {
 	clientAdapter clientAdapter
 	logger        eclog.Logger
 	parser        bodyParser
+	errorDecoder  ErrorDecoder
+	interceptors  []Interceptor
 }
 
 type jsonBodyParser struct{}
@@ -157,7 +182,29 @@ func newECRequestSender(config ClientConfig, ca clientAdapter) ecRequestSender {
 		clientAdapter: ca,
 		logger:        config.Logger,
 		parser:        newJSONBodyParser(),
+		errorDecoder:  config.ErrorDecoder,
+		interceptors:  buildInterceptors(config),
+	}
+}
+
+// buildInterceptors returns the caller's interceptors followed by the SDK's
+// built-in interceptors, which run closest to the wire
+func buildInterceptors(config ClientConfig) []Interceptor {
+	interceptors := make([]Interceptor, 0, len(config.Interceptors)+2)
+	interceptors = append(interceptors, config.Interceptors...)
+	interceptors = append(
+		interceptors,
+		newDebugLoggingInterceptor(
+			config.Logger,
+			ecredact.New(config.Redaction)))
+
+	if config.DryRun.Enabled {
+		interceptors = append(
+			interceptors,
+			newDryRunInterceptor(config.ServiceName, config.DryRun))
 	}
+
+	return interceptors
 }
 
 // literalResponse is used for unmarshaling response data
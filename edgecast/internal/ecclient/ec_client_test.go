@@ -5,14 +5,17 @@ package ecclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/jsonhelper"
@@ -47,15 +50,15 @@ func TestSetBody(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		req := request{}
+		req := Request{}
 		req.setBody(c.body)
 
 		var actual string
 		switch c.body.(type) {
 		case string:
-			actual = string(req.rawBody.([]byte))
+			actual = string(req.RawBody.([]byte))
 		default:
-			buf := req.rawBody.(*bytes.Buffer)
+			buf := req.RawBody.(*bytes.Buffer)
 			actual = buf.String()
 			// JSON encoding adds a newline character to the end, so trim it
 			actual = strings.TrimSuffix(actual, "\n")
@@ -86,12 +89,12 @@ func TestSetAuthorization(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		req := request{}
+		req := Request{}
 		authProvider := testAuthProvider{
 			Auth:  c.token,
 			Error: c.throwError,
 		}
-		err := req.setAuthorization(authProvider)
+		err := req.setAuthorization(context.Background(), authProvider)
 		if c.throwError {
 			if err == nil {
 				t.Fatalf("Case '%s': expected an error, but got none", c.name)
@@ -102,7 +105,7 @@ func TestSetAuthorization(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Case '%s': unexpected error: %v", c.name, err)
 			}
-			actual := req.headers["Authorization"]
+			actual := req.Headers["Authorization"]
 			if strings.Compare(c.expected, actual) != 0 {
 				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
 			}
@@ -135,11 +138,11 @@ func TestSetQueryParams(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		req := request{
-			url: testhelper.URLParse(c.baseURL),
+		req := Request{
+			URL: testhelper.URLParse(c.baseURL),
 		}
 		req.setQueryParams(c.params)
-		actual := req.url.String()
+		actual := req.URL.String()
 		if !reflect.DeepEqual(c.expected, actual) {
 			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
 		}
@@ -185,8 +188,8 @@ func TestSetPathParams(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		req := request{
-			url: testhelper.URLParse(c.baseURL),
+		req := Request{
+			URL: testhelper.URLParse(c.baseURL),
 		}
 		err := req.setPathParams(c.params)
 		if c.expectedError {
@@ -194,7 +197,7 @@ func TestSetPathParams(t *testing.T) {
 				t.Fatalf("Case '%s': expected an error, but got none", c.name)
 			}
 		} else {
-			actual := req.url.String()
+			actual := req.URL.String()
 			if !reflect.DeepEqual(c.expected, actual) {
 				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
 			}
@@ -233,7 +236,7 @@ func TestSendRequest(t *testing.T) {
 
 	cases := []struct {
 		name          string
-		request       request
+		request       Request
 		clientAdapter clientAdapter
 		parser        bodyParser
 		expected      *Response
@@ -247,7 +250,7 @@ func TestSendRequest(t *testing.T) {
 					Body:       jsonBody,
 				},
 			},
-			request: request{
+			request: Request{
 				parsedResponse: testOKResponse{},
 			},
 			parser: testBodyParser{
@@ -312,7 +315,7 @@ func TestSendRequest(t *testing.T) {
 					Body:       testhelper.ToIOReadCloser(`{"id":"1"}`),
 				},
 			},
-			request: request{
+			request: Request{
 				parsedResponse: &sampleData{},
 			},
 			parser: testBodyParser{
@@ -327,7 +330,7 @@ func TestSendRequest(t *testing.T) {
 			parser:        c.parser,
 			logger:        testLog,
 		}
-		actual, err := sender.sendRequest(c.request)
+		actual, err := sender.sendRequest(context.Background(), c.request)
 		if c.expectedError {
 			if err == nil {
 				t.Fatalf("Case '%s': expected an error, but got none", c.name)
@@ -484,6 +487,73 @@ func TestECClientSubmitRequest(t *testing.T) {
 	}
 }
 
+func TestECClientSubmitRequestWithContext(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+	defer server.Close()
+
+	client := New(ClientConfig{
+		BaseAPIURL:   *testhelper.URLParse(server.URL),
+		Logger:       testLog,
+		RetryWaitMin: testhelper.WrapDurationInPointer(time.Minute),
+		RetryWaitMax: testhelper.WrapDurationInPointer(time.Minute),
+	})
+
+	cases := []struct {
+		name          string
+		ctx           func() (context.Context, context.CancelFunc)
+		expectedError error
+	}{
+		{
+			name: "Deadline exceeded during backoff",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(
+					context.Background(),
+					100*time.Millisecond)
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			name: "Cancelled before sending",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			expectedError: context.Canceled,
+		},
+	}
+
+	for _, c := range cases {
+		ctx, cancel := c.ctx()
+		start := time.Now()
+		_, err := client.SubmitRequestWithContext(
+			ctx,
+			SubmitRequestParams{Method: Get, Path: "test"})
+		cancel()
+
+		if !errors.Is(err, c.expectedError) {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expectedError, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Fatalf("%s: request was not aborted, took %s", c.name, elapsed)
+		}
+	}
+
+	if attempts > 1 {
+		t.Fatalf("Expected at most 1 attempt but got %d", attempts)
+	}
+
+	_, err := client.SubmitRequestWithContext(nil, SubmitRequestParams{})
+	if err == nil {
+		t.Fatalf("Nil context: expected an error, but got none")
+	}
+}
+
 func TestBuildRequest(t *testing.T) {
 	goodSampleData := sampleData{
 		StringData: "some string",
@@ -496,7 +566,7 @@ func TestBuildRequest(t *testing.T) {
 		baseAPIURL    string
 		authProvider  ecauth.AuthorizationProvider
 		input         buildRequestParams
-		expected      *request
+		expected      *Request
 		expectedError bool
 	}{
 		{
@@ -520,16 +590,16 @@ func TestBuildRequest(t *testing.T) {
 				},
 				userAgent: "test-app",
 			},
-			expected: &request{
-				method: "POST",
-				url:    testhelper.URLParse("https://edgecast.com/customers/HEX/policies/100?q1=val1&q2=val2"),
-				headers: map[string]string{
+			expected: &Request{
+				Method: "POST",
+				URL:    testhelper.URLParse("https://edgecast.com/customers/HEX/policies/100?q1=val1&q2=val2"),
+				Headers: map[string]string{
 					"Authorization": "valid-token",
 					"Accept":        "application/json",
 					"Content-Type":  "application/json",
 					"User-Agent":    "test-app",
 				},
-				rawBody: goodSampleData,
+				RawBody: goodSampleData,
 			},
 		},
 		{
@@ -587,35 +657,35 @@ func TestBuildRequest(t *testing.T) {
 			logger:       testLog,
 		}
 
-		actual, err := builder.buildRequest(c.input)
+		actual, err := builder.buildRequest(context.Background(), c.input)
 		if c.expectedError {
 			if err == nil {
 				t.Fatalf("Case '%s': expected an error, but got none", c.name)
 			}
 		} else {
 			// Need to check each property because of rawBody
-			if !reflect.DeepEqual(c.expected.method, actual.method) {
-				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.method, actual.method)
+			if !reflect.DeepEqual(c.expected.Method, actual.Method) {
+				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.Method, actual.Method)
 			}
-			if !reflect.DeepEqual(c.expected.url, actual.url) {
-				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.url, actual.url)
+			if !reflect.DeepEqual(c.expected.URL, actual.URL) {
+				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.URL, actual.URL)
 			}
-			if !reflect.DeepEqual(c.expected.headers, actual.headers) {
-				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.headers, actual.headers)
+			if !reflect.DeepEqual(c.expected.Headers, actual.Headers) {
+				t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.Headers, actual.Headers)
 			}
-			if bodyBuffer, ok := actual.rawBody.(*bytes.Buffer); ok {
+			if bodyBuffer, ok := actual.RawBody.(*bytes.Buffer); ok {
 				var resultParsed sampleData
 				bufBytes := bodyBuffer.Bytes()
 				err := json.Unmarshal(bufBytes, &resultParsed)
 				if err == nil {
-					if !reflect.DeepEqual(c.expected.rawBody, resultParsed) {
-						t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.rawBody, resultParsed)
+					if !reflect.DeepEqual(c.expected.RawBody, resultParsed) {
+						t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected.RawBody, resultParsed)
 					}
 				} else {
-					t.Fatalf("%s: failed to parse rawBody result as json:%+v", c.name, actual.rawBody)
+					t.Fatalf("%s: failed to parse rawBody result as json:%+v", c.name, actual.RawBody)
 				}
 			} else {
-				t.Fatalf("%s: rawBody expected to be bytes.Buffer, Actual:%T", c.name, actual.rawBody)
+				t.Fatalf("%s: rawBody expected to be bytes.Buffer, Actual:%T", c.name, actual.RawBody)
 			}
 		}
 	}
@@ -654,6 +724,7 @@ type testClientAdapter struct {
 }
 
 func (c testClientAdapter) Do(
+	ctx context.Context,
 	method string,
 	url *url.URL,
 	headers map[string]string,
@@ -672,8 +743,9 @@ type testReqBuilder struct {
 }
 
 func (rb testReqBuilder) buildRequest(
+	ctx context.Context,
 	params buildRequestParams,
-) (*request, error) {
+) (*Request, error) {
 	if rb.errorToReturn != nil {
 		return nil, rb.errorToReturn
 	}
@@ -683,9 +755,9 @@ func (rb testReqBuilder) buildRequest(
 	} else {
 		u, _ = url.Parse("https://edgecast.com")
 	}
-	return &request{
-		method: rb.method,
-		url:    u,
+	return &Request{
+		Method: rb.method,
+		URL:    u,
 	}, nil
 }
 
@@ -695,7 +767,8 @@ type testReqSender struct {
 }
 
 func (rs testReqSender) sendRequest(
-	req request,
+	ctx context.Context,
+	req Request,
 ) (*Response, error) {
 	if rs.errorToReturn != nil {
 		return nil, rs.errorToReturn
@@ -750,6 +823,13 @@ type MockAPIClient struct {
 
 func (m MockAPIClient) SubmitRequest(
 	params SubmitRequestParams,
+) (*Response, error) {
+	return m.SubmitRequestWithContext(context.Background(), params)
+}
+
+func (m MockAPIClient) SubmitRequestWithContext(
+	ctx context.Context,
+	params SubmitRequestParams,
 ) (*Response, error) {
 	// Use reflection to set the parsed response data
 	pv := reflect.ValueOf(params.ParsedResponse)
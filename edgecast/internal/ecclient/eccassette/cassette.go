@@ -0,0 +1,296 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+/*
+	This file contains the Cassette, which holds the HTTP interactions that
+	are recorded to, or replayed from, a cassette file.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode determines whether a Cassette records or replays interactions
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette file and never sends
+	// requests over the network
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests over the network and saves every interaction
+	// to the cassette file as it is recorded, replacing the file's previous
+	// contents
+	ModeRecord
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	}
+	return "unknown"
+}
+
+// Config configures a Cassette
+type Config struct {
+	// Path is the location of the cassette file
+	Path string
+
+	// Mode determines whether interactions are recorded or replayed. Defaults
+	// to ModeReplay.
+	Mode Mode
+
+	// MatchOn selects the request properties that must be equal for a
+	// recorded interaction to be replayed. Defaults to MatchAll.
+	MatchOn MatchOn
+
+	// ScrubHeaders are header names whose values are replaced before being
+	// saved, in addition to DefaultScrubHeaders
+	ScrubHeaders []string
+
+	// ScrubFields are JSON, form and query field names whose values are
+	// replaced before being saved, in addition to DefaultScrubFields
+	ScrubFields []string
+}
+
+// Interaction is a single recorded request and response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the scrubbed form of a request sent to an API
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the scrubbed form of a response received from an API
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// cassetteHeader and cassetteFooter enclose the interactions of a cassette
+// file, which are indented by interactionIndent, as json.MarshalIndent
+// formats a cassetteFile
+const (
+	cassetteHeader    = "{\n  \"interactions\": [\n"
+	cassetteFooter    = "\n  ]\n}\n"
+	interactionIndent = "    "
+)
+
+// Cassette records HTTP interactions to a file or replays them from it. A
+// single Cassette may be shared by all services.
+type Cassette struct {
+	config  Config
+	scrub   scrubber
+	matchOn MatchOn
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+
+	// file is the cassette file being recorded to, which is kept open so
+	// that each interaction is appended to it. end is the offset of the
+	// footer, which the next interaction overwrites.
+	file *os.File
+	end  int64
+}
+
+// New creates a Cassette. In ModeReplay the cassette file is loaded
+// immediately and must exist.
+func New(config Config) (*Cassette, error) {
+	if len(config.Path) == 0 {
+		return nil, errors.New("eccassette.New: Path is required")
+	}
+
+	c := &Cassette{
+		config:  config,
+		scrub:   newScrubber(config.ScrubHeaders, config.ScrubFields),
+		matchOn: config.MatchOn,
+	}
+
+	if c.matchOn == 0 {
+		c.matchOn = MatchAll
+	}
+
+	switch config.Mode {
+	case ModeRecord:
+	case ModeReplay:
+		if err := c.load(); err != nil {
+			return nil, fmt.Errorf("eccassette.New: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf(
+			"eccassette.New: unknown mode %d",
+			config.Mode)
+	}
+
+	return c, nil
+}
+
+// Mode returns the mode the Cassette was created with
+func (c *Cassette) Mode() Mode {
+	return c.config.Mode
+}
+
+// Interactions returns a copy of the interactions recorded or loaded so far
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	interactions := make([]Interaction, len(c.interactions))
+	copy(interactions, c.interactions)
+	return interactions
+}
+
+// Close closes the cassette file. Interactions are saved as they are
+// recorded, so Close only releases the file. An interaction recorded after
+// Close saves every interaction to the file again.
+func (c *Cassette) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// record appends an interaction and saves it to the cassette file. Only the
+// new interaction is written, after the first, so recording is not slowed
+// down by the interactions recorded before.
+func (c *Cassette) record(interaction Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+	if c.file == nil {
+		return c.save()
+	}
+
+	entry, err := formatInteraction(interaction)
+	if err != nil {
+		return err
+	}
+	entry = ",\n" + entry
+	_, err = c.file.WriteAt([]byte(entry+cassetteFooter), c.end)
+	if err != nil {
+		return err
+	}
+	c.end += int64(len(entry))
+	return nil
+}
+
+// find returns the recorded interaction for req. Interactions that have not
+// yet been replayed are preferred, so that a sequence of identical requests
+// receives the recorded sequence of responses. Once all matching
+// interactions have been replayed, the first one is replayed again.
+func (c *Cassette) find(req RecordedRequest) (Interaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	first := -1
+	for i, recorded := range c.interactions {
+		if !c.matchOn.matches(req, recorded.Request) {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+			return recorded, nil
+		}
+		if first == -1 {
+			first = i
+		}
+	}
+
+	if first != -1 {
+		return c.interactions[first], nil
+	}
+
+	return Interaction{}, newMismatchError(req, c.interactions, c.matchOn)
+}
+
+func (c *Cassette) load() error {
+	data, err := os.ReadFile(c.config.Path)
+	if err != nil {
+		return err
+	}
+
+	var file cassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("invalid cassette file %s: %w", c.config.Path, err)
+	}
+
+	c.interactions = file.Interactions
+	c.used = make([]bool, len(file.Interactions))
+	return nil
+}
+
+// save writes all interactions to the cassette file, which is kept open so
+// that later interactions can be appended. The file is readable only by its
+// owner, as scrubbing may miss secrets. The caller must hold c.mu, and the
+// file must not already be open.
+func (c *Cassette) save() error {
+	entries := make([]string, len(c.interactions))
+	for i, interaction := range c.interactions {
+		entry, err := formatInteraction(interaction)
+		if err != nil {
+			return err
+		}
+		entries[i] = entry
+	}
+	body := cassetteHeader + strings.Join(entries, ",\n")
+
+	f, err := os.OpenFile(
+		c.config.Path,
+		os.O_RDWR|os.O_CREATE|os.O_TRUNC,
+		0600)
+	if err != nil {
+		return err
+	}
+	// The file may have been created with wider permissions before
+	if err := f.Chmod(0600); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.WriteString(body + cassetteFooter); err != nil {
+		f.Close()
+		return err
+	}
+
+	c.file = f
+	c.end = int64(len(body))
+	return nil
+}
+
+// formatInteraction formats an interaction as an element of the interactions
+// array of a cassette file
+func formatInteraction(interaction Interaction) (string, error) {
+	data, err := json.MarshalIndent(interaction, interactionIndent, "  ")
+	if err != nil {
+		return "", err
+	}
+	return interactionIndent + string(data), nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCassetteRecordAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	err := os.WriteFile(path, []byte("previous contents"), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c, err := New(Config{Path: path, Mode: ModeRecord})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer c.Close()
+
+	var recorded []Interaction
+	for _, body := range []string{"first", "second", "third"} {
+		i := interaction("GET", "http://example.com/status", "", body)
+		if err := c.record(i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		recorded = append(recorded, i)
+
+		// The file is complete after every interaction, and formatted as if
+		// it had been written at once
+		saved, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected, _ := json.MarshalIndent(
+			cassetteFile{Interactions: recorded},
+			"",
+			"  ")
+		if string(saved) != string(expected)+"\n" {
+			t.Fatalf("Expected %s but got %s", expected, saved)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected mode 0600 but got %v", info.Mode().Perm())
+	}
+
+	// Recording after Close saves every interaction again
+	if err := c.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	last := interaction("GET", "http://example.com/status", "", "fourth")
+	if err := c.record(last); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	replayer, err := New(Config{Path: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	recorded = append(recorded, last)
+	if got := replayer.Interactions(); !reflect.DeepEqual(got, recorded) {
+		t.Fatalf("Expected %+v but got %+v", recorded, got)
+	}
+}
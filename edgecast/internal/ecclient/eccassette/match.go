@@ -0,0 +1,190 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+/*
+	This file contains the logic that matches requests against recorded
+	interactions, and the error returned when nothing matches.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// MatchOn is a set of request properties compared during replay
+type MatchOn int
+
+const (
+	// MatchMethod compares the HTTP method
+	MatchMethod MatchOn = 1 << iota
+
+	// MatchPath compares the URL path. The scheme and host are never
+	// compared, so a cassette may be replayed against any base URL.
+	MatchPath
+
+	// MatchQuery compares query parameters, ignoring their order
+	MatchQuery
+
+	// MatchBody compares request bodies. JSON bodies are compared by value,
+	// ignoring formatting and key order.
+	MatchBody
+
+	// MatchAll compares all of the above
+	MatchAll = MatchMethod | MatchPath | MatchQuery | MatchBody
+)
+
+// ErrNoMatch matches any MismatchError when used with errors.Is
+var ErrNoMatch = errors.New("no recorded interaction matches the request")
+
+// MismatchError is returned during replay when no recorded interaction
+// matches a request
+type MismatchError struct {
+	// Method and URL identify the request that could not be matched
+	Method string
+	URL    string
+
+	// Closest is the recorded interaction that matched the most properties,
+	// or nil if the cassette is empty
+	Closest *Interaction
+
+	// ClosestIndex is the position of Closest in the cassette file
+	ClosestIndex int
+
+	// Differences describes each property in which Closest differs from the
+	// request
+	Differences []string
+}
+
+func (e *MismatchError) Error() string {
+	msg := fmt.Sprintf("cassette: %s %s: %s", e.Method, e.URL, ErrNoMatch)
+	if e.Closest == nil {
+		return msg + "; the cassette is empty"
+	}
+
+	return fmt.Sprintf(
+		"%s; closest is interaction #%d (%s %s): %s",
+		msg,
+		e.ClosestIndex,
+		e.Closest.Request.Method,
+		e.Closest.Request.URL,
+		strings.Join(e.Differences, "; "))
+}
+
+// Is allows MismatchError to be matched with ErrNoMatch by errors.Is
+func (e *MismatchError) Is(target error) bool {
+	return target == ErrNoMatch
+}
+
+// matches determines whether req and recorded are equal in every selected
+// property
+func (m MatchOn) matches(req RecordedRequest, recorded RecordedRequest) bool {
+	return len(m.differences(req, recorded)) == 0
+}
+
+// differences describes each selected property in which req and recorded
+// differ
+func (m MatchOn) differences(
+	req RecordedRequest,
+	recorded RecordedRequest,
+) []string {
+	var diffs []string
+
+	if m&MatchMethod != 0 && req.Method != recorded.Method {
+		diffs = append(diffs, fmt.Sprintf(
+			"method is %s, recorded %s",
+			req.Method,
+			recorded.Method))
+	}
+
+	reqURL, _ := url.Parse(req.URL)
+	recordedURL, _ := url.Parse(recorded.URL)
+	if reqURL == nil || recordedURL == nil {
+		if req.URL != recorded.URL {
+			diffs = append(diffs, fmt.Sprintf(
+				"URL is %s, recorded %s",
+				req.URL,
+				recorded.URL))
+		}
+		return diffs
+	}
+
+	if m&MatchPath != 0 && reqURL.Path != recordedURL.Path {
+		diffs = append(diffs, fmt.Sprintf(
+			"path is %s, recorded %s",
+			reqURL.Path,
+			recordedURL.Path))
+	}
+
+	if m&MatchQuery != 0 &&
+		!reflect.DeepEqual(reqURL.Query(), recordedURL.Query()) {
+		diffs = append(diffs, fmt.Sprintf(
+			"query is %q, recorded %q",
+			reqURL.Query().Encode(),
+			recordedURL.Query().Encode()))
+	}
+
+	if m&MatchBody != 0 && !bodiesEqual(req.Body, recorded.Body) {
+		diffs = append(diffs, fmt.Sprintf(
+			"body is %q, recorded %q",
+			truncate(req.Body),
+			truncate(recorded.Body)))
+	}
+
+	return diffs
+}
+
+// newMismatchError creates a MismatchError that points at the interaction
+// with the fewest differences from req. Ties go to the earliest interaction.
+func newMismatchError(
+	req RecordedRequest,
+	interactions []Interaction,
+	matchOn MatchOn,
+) *MismatchError {
+	e := &MismatchError{
+		Method:       req.Method,
+		URL:          req.URL,
+		ClosestIndex: -1,
+	}
+
+	for i := range interactions {
+		diffs := matchOn.differences(req, interactions[i].Request)
+		if e.Closest == nil || len(diffs) < len(e.Differences) {
+			closest := interactions[i]
+			e.Closest = &closest
+			e.ClosestIndex = i
+			e.Differences = diffs
+		}
+	}
+
+	return e
+}
+
+// bodiesEqual compares two bodies by value if both are JSON, and byte for
+// byte otherwise
+func bodiesEqual(a string, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var aJSON, bJSON interface{}
+	if json.Unmarshal([]byte(a), &aJSON) != nil ||
+		json.Unmarshal([]byte(b), &bJSON) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aJSON, bJSON)
+}
+
+func truncate(s string) string {
+	const max = 100
+	if len(s) <= max {
+		return s
+	}
+	return s[:max] + "..."
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchOnMatches(t *testing.T) {
+	recorded := RecordedRequest{
+		Method: "POST",
+		URL:    "https://api.vdms.io/v2/origins?a=1&b=2",
+		Body:   `{"Name":"origin","Port":80}`,
+	}
+
+	cases := []struct {
+		name     string
+		matchOn  MatchOn
+		req      RecordedRequest
+		expected bool
+	}{
+		{
+			name:    "equal, different host, query order and JSON formatting",
+			matchOn: MatchAll,
+			req: RecordedRequest{
+				Method: "POST",
+				URL:    "http://localhost:8080/v2/origins?b=2&a=1",
+				Body:   `{ "Port": 80, "Name": "origin" }`,
+			},
+			expected: true,
+		},
+		{
+			name:    "different method",
+			matchOn: MatchAll,
+			req: RecordedRequest{
+				Method: "PUT",
+				URL:    "https://api.vdms.io/v2/origins?a=1&b=2",
+				Body:   `{"Name":"origin","Port":80}`,
+			},
+			expected: false,
+		},
+		{
+			name:    "different query",
+			matchOn: MatchAll,
+			req: RecordedRequest{
+				Method: "POST",
+				URL:    "https://api.vdms.io/v2/origins?a=1",
+				Body:   `{"Name":"origin","Port":80}`,
+			},
+			expected: false,
+		},
+		{
+			name:    "different body",
+			matchOn: MatchAll,
+			req: RecordedRequest{
+				Method: "POST",
+				URL:    "https://api.vdms.io/v2/origins?a=1&b=2",
+				Body:   `{"Name":"other","Port":80}`,
+			},
+			expected: false,
+		},
+		{
+			name:    "different query and body, not compared",
+			matchOn: MatchMethod | MatchPath,
+			req: RecordedRequest{
+				Method: "POST",
+				URL:    "https://api.vdms.io/v2/origins",
+				Body:   `{"Name":"other"}`,
+			},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.matchOn.matches(c.req, recorded)
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestMismatchErrorClosest(t *testing.T) {
+	interactions := []Interaction{
+		interaction("GET", "https://api.vdms.io/v2/cnames", "", ""),
+		interaction("POST", "https://api.vdms.io/v2/origins", `{"Port":80}`, ""),
+		interaction("GET", "https://api.vdms.io/v2/origins", "", ""),
+	}
+	req := RecordedRequest{
+		Method: "POST",
+		URL:    "https://api.vdms.io/v2/origins",
+		Body:   `{"Port":443}`,
+	}
+
+	err := newMismatchError(req, interactions, MatchAll)
+
+	if err.ClosestIndex != 1 {
+		t.Fatalf("Expected closest interaction #1 but got #%d", err.ClosestIndex)
+	}
+	if len(err.Differences) != 1 ||
+		!strings.HasPrefix(err.Differences[0], "body is") {
+		t.Fatalf("Expected only the body to differ but got %+v", err.Differences)
+	}
+
+	msg := err.Error()
+	for _, expected := range []string{"interaction #1", `{\"Port\":80}`} {
+		if !strings.Contains(msg, expected) {
+			t.Fatalf("Expected %q to contain %q", msg, expected)
+		}
+	}
+}
+
+func TestMismatchErrorEmptyCassette(t *testing.T) {
+	err := newMismatchError(
+		RecordedRequest{Method: "GET", URL: "https://api.vdms.io/v2/origins"},
+		nil,
+		MatchAll)
+
+	if err.Closest != nil {
+		t.Fatalf("Expected no closest interaction but got %+v", err.Closest)
+	}
+	if !strings.Contains(err.Error(), "empty") {
+		t.Fatalf("Expected the error to mention the empty cassette: %s", err)
+	}
+}
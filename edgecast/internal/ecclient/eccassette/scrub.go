@@ -0,0 +1,75 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+/*
+	This file contains the logic that removes credentials and other secrets
+	from interactions before they are saved.
+*/
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
+)
+
+// Scrubbed replaces the value of every scrubbed header and field
+const Scrubbed = "[SCRUBBED]"
+
+var (
+	// DefaultScrubHeaders are always scrubbed, in addition to
+	// ecredact.DefaultHeaders
+	DefaultScrubHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+	}
+
+	// DefaultScrubFields are always scrubbed from JSON bodies, form bodies
+	// and query strings, in addition to ecredact.DefaultFields. Names are
+	// compared case-insensitively.
+	DefaultScrubFields = []string{
+		"access_token",
+		"refresh_token",
+		"client_secret",
+		"password",
+		"secret",
+	}
+)
+
+type scrubber struct {
+	redactor *ecredact.Redactor
+}
+
+func newScrubber(headers []string, fields []string) scrubber {
+	return scrubber{
+		redactor: ecredact.New(ecredact.Config{
+			Headers: append(
+				append([]string{}, DefaultScrubHeaders...),
+				headers...),
+			Fields: append(
+				append([]string{}, DefaultScrubFields...),
+				fields...),
+			Mask: Scrubbed,
+		}),
+	}
+}
+
+// header returns a copy of h with scrubbed header values replaced
+func (s scrubber) header(h http.Header) http.Header {
+	return s.redactor.Header(h)
+}
+
+// url returns u as a string with scrubbed query parameters replaced
+func (s scrubber) url(u *url.URL) string {
+	return s.redactor.URL(u)
+}
+
+// body returns body with scrubbed fields replaced. JSON bodies and form
+// bodies are supported; any other body is returned unchanged.
+func (s scrubber) body(body []byte, contentType string) string {
+	return s.redactor.Body(body, contentType)
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestScrubberHeader(t *testing.T) {
+	s := newScrubber([]string{"X-Api-Key"}, nil)
+	h := http.Header{
+		"Authorization": {"TOK:abc"},
+		"X-Api-Key":     {"key"},
+		"Content-Type":  {"application/json"},
+	}
+
+	actual := s.header(h)
+
+	expected := http.Header{
+		"Authorization": {Scrubbed},
+		"X-Api-Key":     {Scrubbed},
+		"Content-Type":  {"application/json"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+	if h.Get("Authorization") != "TOK:abc" {
+		t.Fatalf("Expected the original header to be left untouched")
+	}
+}
+
+func TestScrubberBody(t *testing.T) {
+	s := newScrubber(nil, []string{"apiKey"})
+
+	cases := []struct {
+		name        string
+		body        string
+		contentType string
+		expected    string
+	}{
+		{
+			name:        "form",
+			body:        "client_id=id&client_secret=secret",
+			contentType: "application/x-www-form-urlencoded",
+			expected:    "client_id=id&client_secret=%5BSCRUBBED%5D",
+		},
+		{
+			name:        "nested JSON",
+			body:        `{"access_token":"t","Items":[{"ApiKey":"k","Id":1}]}`,
+			contentType: "application/json",
+			expected: `{"Items":[{"ApiKey":"[SCRUBBED]","Id":1}],` +
+				`"access_token":"[SCRUBBED]"}`,
+		},
+		{
+			name:        "JSON without secrets is unchanged",
+			body:        `{ "Id": 1 }`,
+			contentType: "application/json",
+			expected:    `{ "Id": 1 }`,
+		},
+		{
+			name:        "plain text",
+			body:        "password=secret",
+			contentType: "text/plain",
+			expected:    "password=secret",
+		},
+	}
+
+	for _, c := range cases {
+		actual := s.body([]byte(c.body), c.contentType)
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestScrubberURL(t *testing.T) {
+	s := newScrubber(nil, nil)
+
+	actual := s.url(testhelper.URLParse(
+		"https://api.vdms.io/v2/items?password=secret&page=1"))
+
+	expected := "https://api.vdms.io/v2/items?page=1&password=%5BSCRUBBED%5D"
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
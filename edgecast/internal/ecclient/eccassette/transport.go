@@ -0,0 +1,139 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+/*
+	This file contains an http.RoundTripper that records requests to, or
+	replays them from, a Cassette
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type transport struct {
+	base     http.RoundTripper
+	cassette *Cassette
+}
+
+// NewTransport wraps base so that every request is recorded to, or replayed
+// from, cassette. base is never called in ModeReplay. If base is nil,
+// http.DefaultTransport is used.
+func NewTransport(
+	base http.RoundTripper,
+	cassette *Cassette,
+) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{base: base, cassette: cassette}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: reading request body: %w", err)
+	}
+
+	recordedReq := t.recordRequest(req, body)
+
+	if t.cassette.Mode() == ModeReplay {
+		interaction, err := t.cassette.find(recordedReq)
+		if err != nil {
+			return nil, err
+		}
+		return replayResponse(req, interaction.Response), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	err = t.cassette.record(Interaction{
+		Request: recordedReq,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     t.cassette.scrub.header(resp.Header),
+			Body: t.cassette.scrub.body(
+				respBody,
+				resp.Header.Get("Content-Type")),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cassette: saving interaction: %w", err)
+	}
+
+	return resp, nil
+}
+
+// recordRequest creates the scrubbed form of req
+func (t transport) recordRequest(
+	req *http.Request,
+	body []byte,
+) RecordedRequest {
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    t.cassette.scrub.url(req.URL),
+		Header: t.cassette.scrub.header(req.Header),
+		Body: t.cassette.scrub.body(
+			body,
+			req.Header.Get("Content-Type")),
+	}
+}
+
+// readRequestBody reads the body of req and replaces it with an equivalent
+// reader so that it can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// replayResponse creates a response to req from a recorded response
+func replayResponse(
+	req *http.Request,
+	recorded RecordedResponse,
+) *http.Response {
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status: fmt.Sprintf(
+			"%d %s",
+			recorded.StatusCode,
+			http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewBufferString(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
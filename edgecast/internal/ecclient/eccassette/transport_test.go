@@ -0,0 +1,226 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package eccassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTransportRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/connect/token" {
+				w.Write([]byte(`{"access_token":"abc123","expires_in":300}`))
+				return
+			}
+			w.Write([]byte(`{"Id":42}`))
+		}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := New(Config{Path: path, Mode: ModeRecord})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: NewTransport(nil, recorder)}
+
+	resp, err := client.PostForm(server.URL+"/connect/token", url.Values{
+		"client_id":     {"my-client"},
+		"client_secret": {"top-secret"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "abc123") {
+		t.Fatalf("Expected the caller to see the real token but got %s", body)
+	}
+
+	req, _ := http.NewRequest(
+		http.MethodPost,
+		server.URL+"/origins?mediaType=3",
+		strings.NewReader(`{"Name":"origin"}`))
+	req.Header.Set("Authorization", "Bearer abc123")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, secret := range []string{"top-secret", "abc123"} {
+		if strings.Contains(string(saved), secret) {
+			t.Fatalf("Expected %q to be scrubbed from %s", secret, saved)
+		}
+	}
+
+	replayer, err := New(Config{Path: path, Mode: ModeReplay})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client = &http.Client{Transport: NewTransport(nil, replayer)}
+	server.Close()
+
+	resp, err = client.PostForm(server.URL+"/connect/token", url.Values{
+		"client_id":     {"my-client"},
+		"client_secret": {"a-different-secret"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Post(
+		server.URL+"/origins?mediaType=3",
+		"application/json",
+		strings.NewReader(`{ "Name": "origin" }`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || string(body) != `{"Id":42}` {
+		t.Fatalf(
+			"Expected the recorded response but got %d %s",
+			resp.StatusCode,
+			body)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls to the server but got %d", calls)
+	}
+}
+
+func TestTransportReplaySequence(t *testing.T) {
+	path := writeCassette(t, []Interaction{
+		interaction("GET", "http://example.com/status", "", "first"),
+		interaction("GET", "http://example.com/status", "", "second"),
+	})
+
+	c, err := New(Config{Path: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: NewTransport(nil, c)}
+
+	expected := []string{"first", "second", "first"}
+	for i, e := range expected {
+		resp, err := client.Get("http://example.com/status")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if string(body) != e {
+			t.Fatalf("call %d: Expected %+v but got %+v", i, e, string(body))
+		}
+	}
+}
+
+func TestTransportReplayMismatch(t *testing.T) {
+	path := writeCassette(t, []Interaction{
+		interaction("GET", "http://example.com/origins", "", "[]"),
+	})
+
+	c, err := New(Config{Path: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: NewTransport(nil, c)}
+
+	_, err = client.Get("http://example.com/cnames")
+
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Expected a *MismatchError but got %v", err)
+	}
+	if !errors.Is(err, ErrNoMatch) {
+		t.Fatalf("Expected errors.Is(err, ErrNoMatch) to be true")
+	}
+	if mismatch.ClosestIndex != 0 {
+		t.Fatalf("Expected the closest interaction to be #0")
+	}
+}
+
+func TestNewReplayMissingFile(t *testing.T) {
+	_, err := New(Config{Path: filepath.Join(t.TempDir(), "missing.json")})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected os.ErrNotExist but got %v", err)
+	}
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	cases := []struct {
+		name   string
+		config Config
+	}{
+		{
+			name:   "missing path",
+			config: Config{Mode: ModeRecord},
+		},
+		{
+			name:   "unknown mode",
+			config: Config{Path: "cassette.json", Mode: Mode(99)},
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := New(c.config); err == nil {
+			t.Fatalf("%s: Expected an error but got nil", c.name)
+		}
+	}
+}
+
+func interaction(
+	method string,
+	rawURL string,
+	reqBody string,
+	respBody string,
+) Interaction {
+	return Interaction{
+		Request: RecordedRequest{
+			Method: method,
+			URL:    rawURL,
+			Body:   reqBody,
+		},
+		Response: RecordedResponse{
+			StatusCode: http.StatusOK,
+			Body:       respBody,
+		},
+	}
+}
+
+func writeCassette(t *testing.T, interactions []Interaction) string {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	c := &Cassette{
+		config:       Config{Path: path},
+		interactions: interactions,
+	}
+	if err := c.save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return path
+}
@@ -0,0 +1,26 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+// Config controls client-side rate limiting and concurrency for a single API
+// host. The zero value disables both.
+type Config struct {
+	// RequestsPerSecond is the sustained rate at which requests are sent. Zero
+	// means no rate limit. When the API responds with HTTP 429, the rate is
+	// temporarily reduced and then recovers gradually as requests succeed.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that may be sent at once after a period
+	// of inactivity. Defaults to 1.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent requests. Zero means no
+	// limit. Like the rate, it is temporarily reduced on HTTP 429.
+	MaxInFlight int
+}
+
+// IsEnabled determines whether the configuration limits anything
+func (c Config) IsEnabled() bool {
+	return c.RequestsPerSecond > 0 || c.MaxInFlight > 0
+}
@@ -0,0 +1,285 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+/*
+	This file contains a token-bucket rate limiter combined with a semaphore
+	that caps the number of requests in flight. Both adapt to HTTP 429
+	responses: they are halved on every 429, down to a floor, and recover
+	additively as requests succeed.
+*/
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// The adapted rate never drops below this fraction of the configured rate
+	minRateFraction = 0.1
+
+	// The number of consecutive successful responses required before the
+	// adapted rate is increased
+	recoveryThreshold = 10
+
+	// The fraction of the configured rate, and of the configured number of
+	// requests in flight, added on each recovery step
+	recoveryFraction = 0.1
+)
+
+// Limiter throttles requests to a single API host. It is safe for concurrent
+// use.
+type Limiter struct {
+	config Config
+
+	mu           sync.Mutex
+	rate         float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	successes    int
+
+	// maxInFlight is the adapted limit on concurrent requests, and inFlight
+	// the number of requests holding a slot. slotFreed is closed, and then
+	// replaced, whenever a slot may have become available.
+	maxInFlight int
+	inFlight    int
+	slotFreed   chan struct{}
+
+	now func() time.Time
+}
+
+// NewLimiter creates a Limiter from the given configuration
+func NewLimiter(config Config) *Limiter {
+	if config.Burst < 1 {
+		config.Burst = 1
+	}
+
+	return &Limiter{
+		config:      config,
+		rate:        config.RequestsPerSecond,
+		tokens:      float64(config.Burst),
+		maxInFlight: config.MaxInFlight,
+		slotFreed:   make(chan struct{}),
+		now:         time.Now,
+	}
+}
+
+// Acquire blocks until a request may be sent or ctx is done. The returned
+// function must be called once the request has completed.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+
+	if l.config.MaxInFlight > 0 {
+		if err := l.acquireSlot(ctx); err != nil {
+			return nil, err
+		}
+
+		var once sync.Once
+		release = func() {
+			once.Do(l.releaseSlot)
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// Observe adapts the limiter to a response received from the API. On HTTP
+// 429, both the rate and the number of requests allowed in flight are halved.
+// Requests already in flight are not interrupted.
+func (l *Limiter) Observe(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		l.successes = 0
+
+		if l.config.RequestsPerSecond > 0 {
+			l.rate = math.Max(
+				l.rate/2,
+				l.config.RequestsPerSecond*minRateFraction)
+			l.tokens = 0
+		}
+
+		if l.config.MaxInFlight > 0 {
+			l.maxInFlight /= 2
+			if l.maxInFlight < 1 {
+				l.maxInFlight = 1
+			}
+		}
+
+		if retryAfter, ok := parseRetryAfter(resp); ok {
+			until := l.now().Add(retryAfter)
+			if until.After(l.blockedUntil) {
+				l.blockedUntil = until
+			}
+		}
+
+		return
+	}
+
+	if resp.StatusCode < 500 && l.throttled() {
+		l.successes++
+		if l.successes >= recoveryThreshold {
+			l.successes = 0
+			l.recover()
+		}
+	}
+}
+
+// throttled reports whether the limiter is below its configured limits. Must
+// be called with l.mu held.
+func (l *Limiter) throttled() bool {
+	return l.rate < l.config.RequestsPerSecond ||
+		l.maxInFlight < l.config.MaxInFlight
+}
+
+// recover raises the adapted limits by one step toward the configured ones.
+// Must be called with l.mu held.
+func (l *Limiter) recover() {
+	l.rate = math.Min(
+		l.rate+l.config.RequestsPerSecond*recoveryFraction,
+		l.config.RequestsPerSecond)
+
+	if l.maxInFlight < l.config.MaxInFlight {
+		step := int(float64(l.config.MaxInFlight) * recoveryFraction)
+		if step < 1 {
+			step = 1
+		}
+
+		l.maxInFlight += step
+		if l.maxInFlight > l.config.MaxInFlight {
+			l.maxInFlight = l.config.MaxInFlight
+		}
+		l.notifySlotFreed()
+	}
+}
+
+// Rate returns the current, possibly adapted, number of requests per second
+func (l *Limiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// MaxInFlight returns the current, possibly adapted, maximum number of
+// concurrent requests, or zero if there is no limit
+func (l *Limiter) MaxInFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.maxInFlight
+}
+
+// acquireSlot blocks until fewer than maxInFlight requests are in flight, or
+// ctx is done
+func (l *Limiter) acquireSlot(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.inFlight < l.maxInFlight {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		freed := l.slotFreed
+		l.mu.Unlock()
+
+		select {
+		case <-freed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *Limiter) releaseSlot() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	l.notifySlotFreed()
+}
+
+// notifySlotFreed wakes every caller waiting for a slot. Must be called with
+// l.mu held.
+func (l *Limiter) notifySlotFreed() {
+	close(l.slotFreed)
+	l.slotFreed = make(chan struct{})
+}
+
+// wait blocks until a token is available
+func (l *Limiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		delay := l.reserve(l.now())
+		l.mu.Unlock()
+
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token if one is available and otherwise returns how long to
+// wait before trying again. Must be called with l.mu held.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		elapsed := now.Sub(l.last).Seconds()
+		l.tokens = math.Min(
+			l.tokens+elapsed*l.rate,
+			float64(l.config.Burst))
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	s := resp.Header.Get("Retry-After")
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds) * time.Second, true
+}
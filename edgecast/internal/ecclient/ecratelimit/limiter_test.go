@@ -0,0 +1,165 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	l := NewLimiter(Config{RequestsPerSecond: 10, Burst: 2})
+	now := time.Now()
+
+	expected := []time.Duration{0, 0, 100 * time.Millisecond}
+	for i, e := range expected {
+		actual := l.reserve(now)
+		if actual != e {
+			t.Fatalf("reserve %d: Expected %v but got %v", i, e, actual)
+		}
+	}
+
+	// A token accumulates after 1/rate seconds
+	if actual := l.reserve(now.Add(100 * time.Millisecond)); actual != 0 {
+		t.Fatalf("Expected %v but got %v", 0, actual)
+	}
+}
+
+func TestLimiterObserve(t *testing.T) {
+	now := time.Now()
+	l := NewLimiter(Config{RequestsPerSecond: 10})
+	l.now = func() time.Time { return now }
+
+	tooManyRequests := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"2"}},
+	}
+
+	l.Observe(tooManyRequests)
+	if l.Rate() != 5 {
+		t.Fatalf("Expected %v but got %v", 5, l.Rate())
+	}
+	if actual := l.reserve(now); actual != 2*time.Second {
+		t.Fatalf("Expected %v but got %v", 2*time.Second, actual)
+	}
+
+	// The rate never drops below the floor
+	for i := 0; i < 10; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests})
+	}
+	if l.Rate() != 1 {
+		t.Fatalf("Expected %v but got %v", 1, l.Rate())
+	}
+
+	// The rate recovers gradually as requests succeed
+	for i := 0; i < recoveryThreshold; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusOK})
+	}
+	if l.Rate() != 2 {
+		t.Fatalf("Expected %v but got %v", 2, l.Rate())
+	}
+}
+
+func TestLimiterMaxInFlight(t *testing.T) {
+	l := NewLimiter(Config{MaxInFlight: 1})
+
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = l.Acquire(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v but got %v", context.DeadlineExceeded, err)
+	}
+
+	// Releasing more than once must not free additional slots
+	release()
+	release()
+
+	release, err = l.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.inFlightCount() != 1 {
+		t.Fatalf("Expected %d slot in use but got %d", 1, l.inFlightCount())
+	}
+	release()
+}
+
+func TestLimiterObserveMaxInFlight(t *testing.T) {
+	l := NewLimiter(Config{MaxInFlight: 4})
+
+	var releases []func()
+	for i := 0; i < 2; i++ {
+		release, err := l.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		releases = append(releases, release)
+	}
+
+	// Halving the limit to 2 blocks further requests until a slot is freed
+	l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests})
+	if l.MaxInFlight() != 2 {
+		t.Fatalf("Expected %v but got %v", 2, l.MaxInFlight())
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v but got %v", context.DeadlineExceeded, err)
+	}
+
+	// The limit never drops below one request
+	for i := 0; i < 3; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusTooManyRequests})
+	}
+	if l.MaxInFlight() != 1 {
+		t.Fatalf("Expected %v but got %v", 1, l.MaxInFlight())
+	}
+
+	// A waiting request proceeds once a slot is freed, and the limit recovers
+	// as requests succeed
+	acquired := make(chan error, 1)
+	go func() {
+		_, err := l.Acquire(context.Background())
+		acquired <- err
+	}()
+
+	for _, release := range releases {
+		release()
+	}
+	for i := 0; i < recoveryThreshold; i++ {
+		l.Observe(&http.Response{StatusCode: http.StatusOK})
+	}
+	if l.MaxInFlight() != 2 {
+		t.Fatalf("Expected %v but got %v", 2, l.MaxInFlight())
+	}
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a waiting request to acquire a slot")
+	}
+}
+
+// inFlightCount returns the number of requests holding a slot
+func (l *Limiter) inFlightCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.inFlight
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+/*
+	This file contains the registry that shares Limiters between all clients
+	that call the same API host
+*/
+
+import (
+	"net/url"
+	"sync"
+)
+
+// maxRegistrySize bounds the number of shared Limiters. Once it is reached,
+// the least recently shared Limiter is dropped from the registry. Clients
+// that already use it keep doing so; clients created later get a new one.
+const maxRegistrySize = 64
+
+type registryKey struct {
+	scheme string
+	host   string
+	config Config
+}
+
+type registryEntry struct {
+	limiter *Limiter
+
+	// lastShared orders entries by when they were last returned by Shared
+	lastShared uint64
+}
+
+var (
+	registryMu    sync.Mutex
+	registry      = map[registryKey]*registryEntry{}
+	registryClock uint64
+)
+
+// Shared returns the Limiter for the host of baseURL, creating it if needed.
+// All clients with the same scheme, host and configuration share a Limiter,
+// regardless of the path of their base URL. At most maxRegistrySize Limiters
+// are kept for sharing. Returns nil if config is not enabled.
+func Shared(baseURL url.URL, config Config) *Limiter {
+	if !config.IsEnabled() {
+		return nil
+	}
+
+	key := registryKey{
+		scheme: baseURL.Scheme,
+		host:   baseURL.Host,
+		config: config,
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registryClock++
+
+	if e, ok := registry[key]; ok {
+		e.lastShared = registryClock
+		return e.limiter
+	}
+
+	if len(registry) >= maxRegistrySize {
+		evictLeastRecentlyShared()
+	}
+
+	l := NewLimiter(config)
+	registry[key] = &registryEntry{limiter: l, lastShared: registryClock}
+	return l
+}
+
+// evictLeastRecentlyShared removes the entry that was shared least recently.
+// Must be called with registryMu held.
+func evictLeastRecentlyShared() {
+	var oldestKey registryKey
+	var oldest *registryEntry
+	for k, e := range registry {
+		if oldest == nil || e.lastShared < oldest.lastShared {
+			oldestKey = k
+			oldest = e
+		}
+	}
+	delete(registry, oldestKey)
+}
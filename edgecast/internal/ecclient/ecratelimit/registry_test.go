@@ -0,0 +1,69 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestShared(t *testing.T) {
+	config := Config{RequestsPerSecond: 5, MaxInFlight: 2}
+
+	a := Shared(*testhelper.URLParse("https://api.example.com/rtld/v1"), config)
+	b := Shared(*testhelper.URLParse("https://api.example.com/v2/cps"), config)
+	c := Shared(*testhelper.URLParse("https://legacy.example.com"), config)
+
+	if a == nil || a != b {
+		t.Fatalf("Expected clients of the same host to share a limiter")
+	}
+	if a == c {
+		t.Fatalf("Expected clients of different hosts to use different limiters")
+	}
+
+	disabled := Shared(*testhelper.URLParse("https://api.example.com"), Config{})
+	if disabled != nil {
+		t.Fatalf("Expected nil but got %+v", disabled)
+	}
+}
+
+func TestSharedBounded(t *testing.T) {
+	config := Config{MaxInFlight: 3}
+	first := Shared(*testhelper.URLParse("https://first.example.com"), config)
+
+	var host0 *Limiter
+	for i := 0; i < maxRegistrySize; i++ {
+		// Keep the first limiter in use so that it is not evicted
+		Shared(*testhelper.URLParse("https://first.example.com"), config)
+
+		u := fmt.Sprintf("https://host%d.example.com", i)
+		l := Shared(*testhelper.URLParse(u), config)
+		if i == 0 {
+			host0 = l
+		}
+	}
+
+	registryMu.Lock()
+	size := len(registry)
+	registryMu.Unlock()
+
+	if size > maxRegistrySize {
+		t.Fatalf(
+			"Expected at most %d limiters but got %d",
+			maxRegistrySize,
+			size)
+	}
+
+	again := Shared(*testhelper.URLParse("https://first.example.com"), config)
+	if again != first {
+		t.Fatalf("Expected a recently shared limiter to be kept")
+	}
+
+	evicted := Shared(*testhelper.URLParse("https://host0.example.com"), config)
+	if evicted == nil || evicted == host0 {
+		t.Fatalf("Expected a new limiter for the least recently shared host")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+/*
+	This file contains an http.RoundTripper that sends every request through a
+	Limiter
+*/
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+type transport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+}
+
+// NewTransport wraps base so that every request, including each retry,
+// waits for the limiter. A request counts as in flight until its response
+// body is closed. If base is nil, http.DefaultTransport is used.
+func NewTransport(base http.RoundTripper, limiter *Limiter) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{base: base, limiter: limiter}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	t.limiter.Observe(resp)
+
+	if resp.Body == nil {
+		release()
+		return resp, nil
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody releases a Limiter slot when the response body is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
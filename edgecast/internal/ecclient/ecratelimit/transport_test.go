@@ -0,0 +1,37 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecratelimit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransportReleasesOnBodyClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("ok"))
+		}))
+	defer server.Close()
+
+	l := NewLimiter(Config{MaxInFlight: 1})
+	client := &http.Client{Transport: NewTransport(nil, l)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.inFlightCount() != 1 {
+		t.Fatalf("Expected the request to be in flight until its body is closed")
+	}
+
+	io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if l.inFlightCount() != 0 {
+		t.Fatalf("Expected the slot to be released but got %d in use", l.inFlightCount())
+	}
+}
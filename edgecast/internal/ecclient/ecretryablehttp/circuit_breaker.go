@@ -0,0 +1,354 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecretryablehttp
+
+/*
+	This file contains a circuit breaker that stops calls to an API host or
+	endpoint that is failing, instead of letting every caller wait through
+	the full retry and backoff cycle.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultCircuitOpenTimeout         = 30 * time.Second
+	DefaultCircuitHalfOpenMaxRequests = 1
+)
+
+// ErrCircuitOpen matches any CircuitOpenError when used with errors.Is
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a single circuit
+type CircuitState int
+
+const (
+	// CircuitClosed allows all calls
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen rejects all calls until the open timeout has passed
+	CircuitOpen
+
+	// CircuitHalfOpen allows a limited number of trial calls. The circuit
+	// closes if they succeed and opens again if any of them fail.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitOpenError is returned, without any HTTP request being made, when a
+// call is rejected by the circuit breaker
+type CircuitOpenError struct {
+	// Key identifies the circuit, e.g. "api.vdms.io"
+	Key string
+
+	// State is the state of the circuit when the call was rejected
+	State CircuitState
+
+	// RetryAt is the earliest time at which a call may be allowed
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf(
+		"circuit breaker for %s is %s, retry after %s",
+		e.Key,
+		e.State,
+		e.RetryAt.Format(time.RFC3339))
+}
+
+// Is allows errors.Is(err, ErrCircuitOpen) to match any CircuitOpenError
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreakerConfig configures a CircuitBreaker
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed attempts, including
+	// retries, that opens a circuit. Required.
+	FailureThreshold int
+
+	// OpenTimeout is how long a circuit stays open before trial calls are
+	// allowed. Defaults to DefaultCircuitOpenTimeout.
+	OpenTimeout time.Duration
+
+	// HalfOpenMaxRequests is the number of trial calls allowed while a circuit
+	// is half-open, and the number of successful attempts required to close
+	// it. Defaults to DefaultCircuitHalfOpenMaxRequests.
+	HalfOpenMaxRequests int
+
+	// PerEndpoint keys circuits by method, host and path template instead of
+	// by host alone
+	PerEndpoint bool
+
+	// IsFailure determines whether an attempt counts as a failure. By default,
+	// transport errors and HTTP 5xx responses are failures.
+	IsFailure func(resp *http.Response, err error) bool
+
+	// OnStateChange is called whenever a circuit changes state. It is called
+	// synchronously, so it should not block.
+	OnStateChange func(key string, from CircuitState, to CircuitState)
+}
+
+// CircuitBreaker tracks a circuit per API host or endpoint. A single
+// CircuitBreaker may be shared by multiple clients and is safe for concurrent
+// use.
+type CircuitBreaker struct {
+	config CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+
+	now func() time.Time
+}
+
+type circuit struct {
+	state CircuitState
+
+	// generation changes on every state transition
+	generation uint64
+
+	// consecutive failed attempts while closed
+	failures int
+
+	openedAt time.Time
+
+	// trial calls in flight and successful trial attempts while half-open
+	probes    int
+	successes int
+}
+
+type stateChange struct {
+	key  string
+	from CircuitState
+	to   CircuitState
+}
+
+type circuitKeyContextKey struct{}
+
+// NewCircuitBreaker creates a CircuitBreaker from the given configuration
+func NewCircuitBreaker(config CircuitBreakerConfig) (*CircuitBreaker, error) {
+	if config.FailureThreshold < 1 {
+		return nil, errors.New(
+			"NewCircuitBreaker: FailureThreshold must be at least 1")
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = DefaultCircuitOpenTimeout
+	}
+	if config.HalfOpenMaxRequests < 1 {
+		config.HalfOpenMaxRequests = DefaultCircuitHalfOpenMaxRequests
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = isServerFailure
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[string]*circuit),
+		now:      time.Now,
+	}, nil
+}
+
+// State returns the current state of the circuit with the given key
+func (b *CircuitBreaker) State(key string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.circuits[key]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// key determines the circuit that a request belongs to
+func (b *CircuitBreaker) key(
+	ctx context.Context,
+	method string,
+	u *url.URL,
+) string {
+	if !b.config.PerEndpoint {
+		return u.Host
+	}
+
+	path := u.Path
+	if call, ok := ecinstrument.CallFromContext(ctx); ok && len(call.Route) > 0 {
+		path = call.Route
+	}
+	return fmt.Sprintf("%s %s%s", method, u.Host, path)
+}
+
+// allow determines whether a call may proceed. On success, it returns the
+// generation of the circuit, which must be passed to done once the call has
+// completed.
+func (b *CircuitBreaker) allow(key string) (uint64, error) {
+	b.mu.Lock()
+	c := b.circuit(key)
+
+	var changes []stateChange
+	if c.state == CircuitOpen {
+		retryAt := c.openedAt.Add(b.config.OpenTimeout)
+		if b.now().Before(retryAt) {
+			b.mu.Unlock()
+			return 0, &CircuitOpenError{
+				Key:     key,
+				State:   CircuitOpen,
+				RetryAt: retryAt,
+			}
+		}
+		changes = append(changes, b.transition(key, c, CircuitHalfOpen))
+	}
+
+	var err error
+	if c.state == CircuitHalfOpen {
+		if c.probes < b.config.HalfOpenMaxRequests {
+			c.probes++
+		} else {
+			err = &CircuitOpenError{
+				Key:     key,
+				State:   CircuitHalfOpen,
+				RetryAt: b.now(),
+			}
+		}
+	}
+
+	generation := c.generation
+	b.mu.Unlock()
+
+	b.notify(changes)
+	return generation, err
+}
+
+// done marks a call admitted by allow as complete
+func (b *CircuitBreaker) done(key string, generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuit(key)
+	if c.state == CircuitHalfOpen &&
+		c.generation == generation &&
+		c.probes > 0 {
+		c.probes--
+	}
+}
+
+// record updates a circuit with the outcome of a single attempt
+func (b *CircuitBreaker) record(key string, failed bool) {
+	b.mu.Lock()
+	c := b.circuit(key)
+
+	var changes []stateChange
+	switch c.state {
+	case CircuitClosed:
+		if !failed {
+			c.failures = 0
+			break
+		}
+		c.failures++
+		if c.failures >= b.config.FailureThreshold {
+			changes = append(changes, b.transition(key, c, CircuitOpen))
+		}
+	case CircuitHalfOpen:
+		if failed {
+			changes = append(changes, b.transition(key, c, CircuitOpen))
+			break
+		}
+		c.successes++
+		if c.successes >= b.config.HalfOpenMaxRequests {
+			changes = append(changes, b.transition(key, c, CircuitClosed))
+		}
+	}
+	b.mu.Unlock()
+
+	b.notify(changes)
+}
+
+// wrapCheckRetry records the outcome of every attempt and stops retrying once
+// the circuit is no longer closed
+func (b *CircuitBreaker) wrapCheckRetry(
+	checkRetry retryablehttp.CheckRetry,
+) retryablehttp.CheckRetry {
+	return func(
+		ctx context.Context,
+		resp *http.Response,
+		err error,
+	) (bool, error) {
+		shouldRetry, checkErr := checkRetry(ctx, resp, err)
+
+		key, ok := ctx.Value(circuitKeyContextKey{}).(string)
+		if !ok {
+			return shouldRetry, checkErr
+		}
+
+		b.record(key, b.config.IsFailure(resp, err))
+		if shouldRetry && b.State(key) != CircuitClosed {
+			return false, checkErr
+		}
+
+		return shouldRetry, checkErr
+	}
+}
+
+// circuit returns the circuit for key, creating it if needed. Must be called
+// with b.mu held.
+func (b *CircuitBreaker) circuit(key string) *circuit {
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+// transition moves a circuit to a new state. Must be called with b.mu held.
+func (b *CircuitBreaker) transition(
+	key string,
+	c *circuit,
+	to CircuitState,
+) stateChange {
+	change := stateChange{key: key, from: c.state, to: to}
+
+	c.state = to
+	c.generation++
+	c.failures = 0
+	c.probes = 0
+	c.successes = 0
+	if to == CircuitOpen {
+		c.openedAt = b.now()
+	}
+
+	return change
+}
+
+func (b *CircuitBreaker) notify(changes []stateChange) {
+	if b.config.OnStateChange == nil {
+		return
+	}
+	for _, change := range changes {
+		b.config.OnStateChange(change.key, change.from, change.to)
+	}
+}
+
+func isServerFailure(resp *http.Response, err error) bool {
+	return err != nil || (resp != nil && resp.StatusCode >= 500)
+}
@@ -0,0 +1,229 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecretryablehttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestNewCircuitBreaker(t *testing.T) {
+	_, err := NewCircuitBreaker(CircuitBreakerConfig{})
+	if err == nil {
+		t.Fatal("Expected an error for a missing FailureThreshold but got nil")
+	}
+
+	b, err := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.config.OpenTimeout != DefaultCircuitOpenTimeout {
+		t.Fatalf(
+			"Expected %v but got %v",
+			DefaultCircuitOpenTimeout,
+			b.config.OpenTimeout)
+	}
+	if b.config.HalfOpenMaxRequests != DefaultCircuitHalfOpenMaxRequests {
+		t.Fatalf(
+			"Expected %v but got %v",
+			DefaultCircuitHalfOpenMaxRequests,
+			b.config.HalfOpenMaxRequests)
+	}
+}
+
+func TestCircuitBreakerStateTransitions(t *testing.T) {
+	var changes []string
+	b, _ := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+		OnStateChange: func(key string, from, to CircuitState) {
+			changes = append(changes, key+":"+from.String()+"->"+to.String())
+		},
+	})
+
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	key := "api.example.com"
+
+	// A success resets the consecutive failure count
+	b.record(key, true)
+	b.record(key, false)
+	b.record(key, true)
+	if b.State(key) != CircuitClosed {
+		t.Fatalf("Expected %v but got %v", CircuitClosed, b.State(key))
+	}
+
+	b.record(key, true)
+	if b.State(key) != CircuitOpen {
+		t.Fatalf("Expected %v but got %v", CircuitOpen, b.State(key))
+	}
+
+	_, err := b.allow(key)
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected a CircuitOpenError but got %v", err)
+	}
+	if !openErr.RetryAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("Expected %v but got %v", now.Add(time.Minute), openErr.RetryAt)
+	}
+
+	// After the open timeout, a single trial call is allowed
+	now = now.Add(time.Minute)
+	generation, err := b.allow(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := b.allow(key); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected %v but got %v", ErrCircuitOpen, err)
+	}
+
+	// A failed trial re-opens the circuit
+	b.record(key, true)
+	b.done(key, generation)
+	if b.State(key) != CircuitOpen {
+		t.Fatalf("Expected %v but got %v", CircuitOpen, b.State(key))
+	}
+
+	// A successful trial closes it
+	now = now.Add(time.Minute)
+	generation, _ = b.allow(key)
+	b.record(key, false)
+	b.done(key, generation)
+	if b.State(key) != CircuitClosed {
+		t.Fatalf("Expected %v but got %v", CircuitClosed, b.State(key))
+	}
+
+	expected := []string{
+		key + ":closed->open",
+		key + ":open->half-open",
+		key + ":half-open->open",
+		key + ":open->half-open",
+		key + ":half-open->closed",
+	}
+	if !reflect.DeepEqual(expected, changes) {
+		t.Fatalf("Expected %+v but got %+v", expected, changes)
+	}
+}
+
+func TestCircuitBreakerAbandonedTrial(t *testing.T) {
+	b, _ := NewCircuitBreaker(CircuitBreakerConfig{
+		FailureThreshold: 1,
+		OpenTimeout:      time.Minute,
+	})
+
+	now := time.Now()
+	b.now = func() time.Time { return now }
+	key := "api.example.com"
+
+	b.record(key, true)
+	now = now.Add(time.Minute)
+
+	// A trial that ends without an outcome, e.g. because its context was
+	// cancelled, must free its slot
+	generation, _ := b.allow(key)
+	b.done(key, generation)
+
+	if _, err := b.allow(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCircuitBreakerKey(t *testing.T) {
+	u := testhelper.URLParse("https://api.example.com/v2/customers/1")
+	call := &ecinstrument.Call{Route: "/v2/customers/{id}"}
+	ctx := ecinstrument.ContextWithCall(context.Background(), call)
+
+	cases := []struct {
+		name        string
+		perEndpoint bool
+		ctx         context.Context
+		expected    string
+	}{
+		{
+			name:     "Per host",
+			ctx:      ctx,
+			expected: "api.example.com",
+		},
+		{
+			name:        "Per endpoint with route template",
+			perEndpoint: true,
+			ctx:         ctx,
+			expected:    "GET api.example.com/v2/customers/{id}",
+		},
+		{
+			name:        "Per endpoint without route template",
+			perEndpoint: true,
+			ctx:         context.Background(),
+			expected:    "GET api.example.com/v2/customers/1",
+		},
+	}
+
+	for _, c := range cases {
+		b, _ := NewCircuitBreaker(CircuitBreakerConfig{
+			FailureThreshold: 1,
+			PerEndpoint:      c.perEndpoint,
+		})
+
+		actual := b.key(c.ctx, http.MethodGet, u)
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %s but got %s", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestDoWithCircuitBreaker(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+	defer server.Close()
+
+	breaker, _ := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2})
+	adapter := NewRetryableHTTPClientAdapter(RetryConfig{
+		RetryWaitMin:   testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryWaitMax:   testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryMax:       testhelper.WrapIntInPointer(5),
+		CircuitBreaker: breaker,
+	})
+
+	// Retries stop as soon as the circuit opens
+	resp, err := adapter.Do(
+		context.Background(),
+		http.MethodGet,
+		testhelper.URLParse(server.URL),
+		nil,
+		nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if attempts != 2 {
+		t.Fatalf("Expected %d attempts but got %d", 2, attempts)
+	}
+
+	// Subsequent calls fail fast
+	_, err = adapter.Do(
+		context.Background(),
+		http.MethodGet,
+		testhelper.URLParse(server.URL),
+		nil,
+		nil)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Expected %v but got %v", ErrCircuitOpen, err)
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected %d attempts but got %d", 2, attempts)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecratelimit"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
 )
 
 const (
@@ -33,6 +35,19 @@ type RetryConfig struct {
 	// CheckRetry is a handler that allows users to define custom logic
 	// to determine whether the API Client should retry a failed API call
 	CheckRetry CheckRetry
+
+	// HTTPClient sends each HTTP request attempt. A pooled default client is
+	// used if nil.
+	HTTPClient *http.Client
+
+	// Instrumentation receives a span for every HTTP attempt. Optional.
+	Instrumentation ecinstrument.Instrumentation
+
+	// RateLimiter throttles every HTTP attempt, including retries. Optional.
+	RateLimiter *ecratelimit.Limiter
+
+	// CircuitBreaker rejects calls to failing hosts or endpoints. Optional.
+	CircuitBreaker *CircuitBreaker
 }
 
 type CheckRetry func(
@@ -0,0 +1,68 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecretryablehttp
+
+/*
+	This file contains the adapter that passes retryablehttp's log messages to
+	the SDK logger with their key/value pairs as structured fields
+*/
+
+import (
+	"fmt"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// leveledLogger adapts an eclog.Logger to retryablehttp.LeveledLogger
+type leveledLogger struct {
+	logger eclog.StructuredLogger
+}
+
+// newLeveledLogger returns logger as a retryablehttp.LeveledLogger, or nil if
+// logger is nil so that retryablehttp does not log at all
+func newLeveledLogger(logger eclog.Logger) retryablehttp.LeveledLogger {
+	if logger == nil {
+		return nil
+	}
+	return leveledLogger{logger: eclog.Structured(logger)}
+}
+
+func (l leveledLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log(eclog.LevelError, msg, keysAndValues)
+}
+
+func (l leveledLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log(eclog.LevelInfo, msg, keysAndValues)
+}
+
+func (l leveledLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log(eclog.LevelDebug, msg, keysAndValues)
+}
+
+func (l leveledLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log(eclog.LevelWarn, msg, keysAndValues)
+}
+
+func (l leveledLogger) log(
+	level eclog.Level,
+	msg string,
+	keysAndValues []interface{},
+) {
+	if !l.logger.Enabled(level) {
+		return
+	}
+
+	fields := make([]eclog.Field, 0, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		var value interface{}
+		if i+1 < len(keysAndValues) {
+			value = keysAndValues[i+1]
+		}
+		fields = append(fields, eclog.F(key, value))
+	}
+
+	l.logger.Log(level, msg, fields...)
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecretryablehttp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
+)
+
+func TestLeveledLogger(t *testing.T) {
+	cases := []struct {
+		Name           string
+		KeysAndValues  []interface{}
+		ExpectedFields []eclog.Field
+	}{
+		{
+			Name:           "No fields",
+			KeysAndValues:  nil,
+			ExpectedFields: []eclog.Field{},
+		},
+		{
+			Name:          "Key/value pairs",
+			KeysAndValues: []interface{}{"method", "GET", "attempt", 2},
+			ExpectedFields: []eclog.Field{
+				eclog.F("method", "GET"),
+				eclog.F("attempt", 2),
+			},
+		},
+		{
+			Name:          "Missing value",
+			KeysAndValues: []interface{}{"method", "GET", "url"},
+			ExpectedFields: []eclog.Field{
+				eclog.F("method", "GET"),
+				eclog.F("url", nil),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		recorder := &testFieldRecorder{}
+		newLeveledLogger(recorder).Warn("retrying request", c.KeysAndValues...)
+
+		if recorder.level != eclog.LevelWarn || recorder.msg != "retrying request" {
+			t.Fatalf(
+				"%s: Expected a warning but got %v %q",
+				c.Name,
+				recorder.level,
+				recorder.msg)
+		}
+		if !reflect.DeepEqual(c.ExpectedFields, recorder.fields) {
+			t.Fatalf(
+				"%s: Expected %+v but got %+v",
+				c.Name,
+				c.ExpectedFields,
+				recorder.fields)
+		}
+	}
+}
+
+func TestNewLeveledLoggerNil(t *testing.T) {
+	if logger := newLeveledLogger(nil); logger != nil {
+		t.Fatalf("Expected nil but got %+v", logger)
+	}
+}
+
+// A test logger that keeps the last structured message
+type testFieldRecorder struct {
+	eclog.NullLogger
+	level  eclog.Level
+	msg    string
+	fields []eclog.Field
+}
+
+func (r *testFieldRecorder) Log(
+	level eclog.Level,
+	msg string,
+	fields ...eclog.Field,
+) {
+	r.level = level
+	r.msg = msg
+	r.fields = fields
+}
+
+func (r *testFieldRecorder) Enabled(level eclog.Level) bool {
+	return true
+}
@@ -9,11 +9,16 @@ package ecretryablehttp
 */
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/eccassette"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecratelimit"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecinstrument"
 	"github.com/hashicorp/go-retryablehttp"
 )
 
@@ -21,6 +26,7 @@ import (
 type RetryableHTTPClientAdapter struct {
 	RetryableHttpClient *retryablehttp.Client
 	HasCustomRetry      bool
+	CircuitBreaker      *CircuitBreaker
 }
 
 func NewRetryableHTTPClientAdapter(
@@ -28,9 +34,35 @@ func NewRetryableHTTPClientAdapter(
 ) *RetryableHTTPClientAdapter {
 	httpClient := retryablehttp.NewClient()
 	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
-	httpClient.Logger = config.Logger
+	httpClient.Logger = newLeveledLogger(config.Logger)
 	httpClient.Backoff = exponentialJitterBackoff
 
+	if config.HTTPClient != nil {
+		httpClient.HTTPClient = config.HTTPClient
+	}
+
+	if config.Instrumentation != nil {
+		// Copy the client so that a caller-provided client is left untouched
+		instrumented := *httpClient.HTTPClient
+		instrumented.Transport = ecinstrument.NewTransport(
+			instrumented.Transport,
+			config.Instrumentation)
+		httpClient.HTTPClient = &instrumented
+	} else {
+		// Without the instrumented transport, count the attempts of each call
+		// here so that they are still reported in the debug log
+		httpClient.RequestLogHook = countAttempt
+	}
+
+	if config.RateLimiter != nil {
+		// Copy the client so that a caller-provided client is left untouched
+		limited := *httpClient.HTTPClient
+		limited.Transport = ecratelimit.NewTransport(
+			limited.Transport,
+			config.RateLimiter)
+		httpClient.HTTPClient = &limited
+	}
+
 	adapter := &RetryableHTTPClientAdapter{}
 
 	if config.CheckRetry != nil {
@@ -38,6 +70,14 @@ func NewRetryableHTTPClientAdapter(
 		httpClient.CheckRetry = retryablehttp.CheckRetry(config.CheckRetry)
 	}
 
+	if config.CircuitBreaker != nil {
+		adapter.CircuitBreaker = config.CircuitBreaker
+		httpClient.CheckRetry = config.CircuitBreaker.wrapCheckRetry(
+			httpClient.CheckRetry)
+	}
+
+	httpClient.CheckRetry = stopOnContextDone(httpClient.CheckRetry)
+
 	if config.RetryWaitMin != nil {
 		httpClient.RetryWaitMin = *config.RetryWaitMin
 	} else {
@@ -58,12 +98,28 @@ func NewRetryableHTTPClientAdapter(
 	return adapter
 }
 
+// Do sends an HTTP request, retrying according to the adapter's
+// configuration. Cancelling ctx aborts any in-flight attempt and any pending
+// backoff sleep. If the circuit for the request is open, a *CircuitOpenError
+// is returned without sending anything.
 func (c *RetryableHTTPClientAdapter) Do(
+	ctx context.Context,
 	method string,
 	url *url.URL,
 	headers map[string]string,
 	rawBody interface{},
 ) (*http.Response, error) {
+	if c.CircuitBreaker != nil {
+		key := c.CircuitBreaker.key(ctx, method, url)
+		generation, err := c.CircuitBreaker.allow(key)
+		if err != nil {
+			return nil, fmt.Errorf("RetryableHTTPClientAdapter.Do: %w", err)
+		}
+		defer c.CircuitBreaker.done(key, generation)
+
+		ctx = context.WithValue(ctx, circuitKeyContextKey{}, key)
+	}
+
 	retryablehttpReq, err := retryablehttp.NewRequest(
 		method,
 		url.String(),
@@ -73,11 +129,43 @@ func (c *RetryableHTTPClientAdapter) Do(
 		return nil, fmt.Errorf("RetryableHTTPClientAdapter.Do:%w", err)
 	}
 
+	retryablehttpReq = retryablehttpReq.WithContext(ctx)
 	setHeaders(retryablehttpReq, headers)
 
 	return c.RetryableHttpClient.Do(retryablehttpReq)
 }
 
+// stopOnContextDone wraps a retry policy so that no further attempts are made
+// once the request context has been cancelled or its deadline has passed,
+// regardless of what the wrapped policy decides. Requests that could not be
+// matched against a replayed cassette are never retried either, as every
+// attempt would fail the same way.
+func stopOnContextDone(
+	checkRetry retryablehttp.CheckRetry,
+) retryablehttp.CheckRetry {
+	return func(
+		ctx context.Context,
+		resp *http.Response,
+		err error,
+	) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if errors.Is(err, eccassette.ErrNoMatch) {
+			return false, err
+		}
+		return checkRetry(ctx, resp, err)
+	}
+}
+
+// countAttempt is a retryablehttp.RequestLogHook that counts each attempt of
+// the call in the request context
+func countAttempt(_ retryablehttp.Logger, req *http.Request, _ int) {
+	if call, ok := ecinstrument.CallFromContext(req.Context()); ok {
+		call.AddAttempt()
+	}
+}
+
 func setHeaders(req *retryablehttp.Request, headers map[string]string) {
 	for k, v := range headers {
 		req.Header.Set(k, v)
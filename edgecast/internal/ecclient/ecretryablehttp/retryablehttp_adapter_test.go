@@ -5,12 +5,18 @@ package ecretryablehttp
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/eccassette"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
 	"github.com/hashicorp/go-retryablehttp"
 )
@@ -53,6 +59,7 @@ func TestNewRetryableHTTPClientAdapterDefaults(t *testing.T) {
 func TestNewRetryableHTTPClientAdapter(t *testing.T) {
 
 	config := RetryConfig{
+		HTTPClient:   &http.Client{Timeout: 42 * time.Second},
 		RetryWaitMin: testhelper.WrapDurationInPointer(99 * time.Second),
 		RetryWaitMax: testhelper.WrapDurationInPointer(899 * time.Second),
 		RetryMax:     testhelper.WrapIntInPointer(20),
@@ -96,6 +103,12 @@ func TestNewRetryableHTTPClientAdapter(t *testing.T) {
 			true,
 			actual.HasCustomRetry)
 	}
+	if config.HTTPClient != actual.RetryableHttpClient.HTTPClient {
+		t.Fatalf(
+			"Expected %+v but got %+v",
+			config.HTTPClient,
+			actual.RetryableHttpClient.HTTPClient)
+	}
 }
 
 func TestSetHeaders(t *testing.T) {
@@ -130,3 +143,87 @@ func TestSetHeaders(t *testing.T) {
 		}
 	}
 }
+
+func TestDoWithCancelledContext(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+	defer server.Close()
+
+	// A custom policy that always retries must still be overridden by
+	// a cancelled context.
+	adapter := NewRetryableHTTPClientAdapter(RetryConfig{
+		RetryWaitMin: testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryWaitMax: testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryMax:     testhelper.WrapIntInPointer(100),
+		CheckRetry: func(
+			ctx context.Context,
+			resp *http.Response,
+			err error,
+		) (bool, error) {
+			return true, nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		50*time.Millisecond)
+	defer cancel()
+
+	_, err := adapter.Do(
+		ctx,
+		http.MethodGet,
+		testhelper.URLParse(server.URL),
+		nil,
+		nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v but got %v", context.DeadlineExceeded, err)
+	}
+	if n := atomic.LoadInt32(&attempts); n > 100 {
+		t.Fatalf("Expected retries to stop early but got %d attempts", n)
+	}
+}
+
+func TestDoWithCassetteMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	os.WriteFile(path, []byte(`{"interactions":[]}`), 0644)
+
+	cassette, err := eccassette.New(eccassette.Config{Path: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attempts := 0
+	adapter := NewRetryableHTTPClientAdapter(RetryConfig{
+		HTTPClient: &http.Client{
+			Transport: eccassette.NewTransport(nil, cassette),
+		},
+		RetryWaitMin: testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryWaitMax: testhelper.WrapDurationInPointer(time.Millisecond),
+		RetryMax:     testhelper.WrapIntInPointer(3),
+		CheckRetry: func(
+			ctx context.Context,
+			resp *http.Response,
+			err error,
+		) (bool, error) {
+			attempts++
+			return true, nil
+		},
+	})
+
+	_, err = adapter.Do(
+		context.Background(),
+		http.MethodGet,
+		testhelper.URLParse("http://example.com/origins"),
+		nil,
+		nil)
+	if !errors.Is(err, eccassette.ErrNoMatch) {
+		t.Fatalf("Expected %v but got %v", eccassette.ErrNoMatch, err)
+	}
+	if attempts != 0 {
+		t.Fatalf("Expected no retries but got %d", attempts)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+/*
+	This file contains code that builds the HTTP client used for all outgoing
+	requests, including IDS token requests
+*/
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/eccassette"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// HTTPClientConfig controls how the SDK's HTTP client connects to the APIs
+type HTTPClientConfig struct {
+	// HTTPClient, if set, is used as-is and all other fields except Cassette
+	// are ignored
+	HTTPClient *http.Client
+
+	// Transport is the base RoundTripper. Defaults to a pooled transport.
+	Transport http.RoundTripper
+
+	// ProxyURL, if set, routes all requests through the given proxy instead
+	// of the proxy specified by the environment
+	ProxyURL *url.URL
+
+	// RootCAs is the set of root certificate authorities used to verify
+	// server certificates. The host's root CA set is used if nil.
+	RootCAs *x509.CertPool
+
+	// ClientCertificates are presented to servers that request mutual TLS
+	ClientCertificates []tls.Certificate
+
+	// MinTLSVersion is the minimum TLS version accepted, e.g. tls.VersionTLS12
+	MinTLSVersion uint16
+
+	// Timeout limits the time taken by a single HTTP request attempt. Zero
+	// means no timeout.
+	Timeout time.Duration
+
+	// Cassette, if set, records or replays every request
+	Cassette *eccassette.Cassette
+}
+
+// NewHTTPClient creates an *http.Client from the given configuration
+func NewHTTPClient(config HTTPClientConfig) (*http.Client, error) {
+	client, _, err := BuildHTTPClient(config)
+	return client, err
+}
+
+// BuildHTTPClient is the same as NewHTTPClient, except that it also reports
+// whether the transport of the client was created or cloned by it rather than
+// provided in config. Only such a transport may be closed by the caller.
+func BuildHTTPClient(config HTTPClientConfig) (*http.Client, bool, error) {
+	if config.HTTPClient != nil {
+		if config.Cassette == nil {
+			return config.HTTPClient, false, nil
+		}
+
+		// Copy the client so that a caller-provided client is left untouched
+		recording := *config.HTTPClient
+		recording.Transport = eccassette.NewTransport(
+			recording.Transport,
+			config.Cassette)
+		return &recording, false, nil
+	}
+
+	var transport http.RoundTripper = cleanhttp.DefaultPooledTransport()
+	ownsTransport := true
+	if config.Transport != nil {
+		transport = config.Transport
+		ownsTransport = false
+	}
+
+	if config.ProxyURL != nil ||
+		config.RootCAs != nil ||
+		len(config.ClientCertificates) > 0 ||
+		config.MinTLSVersion != 0 {
+
+		t, ok := transport.(*http.Transport)
+		if !ok {
+			return nil, false, errors.New(
+				"NewHTTPClient: ProxyURL and TLS settings require Transport " +
+					"to be an *http.Transport")
+		}
+
+		// Never modify a transport the caller may be using elsewhere
+		t = t.Clone()
+
+		if config.ProxyURL != nil {
+			t.Proxy = http.ProxyURL(config.ProxyURL)
+		}
+
+		if config.RootCAs != nil ||
+			len(config.ClientCertificates) > 0 ||
+			config.MinTLSVersion != 0 {
+
+			if t.TLSClientConfig == nil {
+				t.TLSClientConfig = &tls.Config{}
+			}
+			if config.RootCAs != nil {
+				t.TLSClientConfig.RootCAs = config.RootCAs
+			}
+			if len(config.ClientCertificates) > 0 {
+				t.TLSClientConfig.Certificates = config.ClientCertificates
+			}
+			if config.MinTLSVersion != 0 {
+				t.TLSClientConfig.MinVersion = config.MinTLSVersion
+			}
+		}
+
+		transport = t
+		ownsTransport = true
+	}
+
+	if config.Cassette != nil {
+		transport = eccassette.NewTransport(transport, config.Cassette)
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.Timeout,
+	}, ownsTransport, nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/eccassette"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+type testRoundTripper struct{}
+
+func (testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, nil
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	customClient := &http.Client{}
+	callerTransport := &http.Transport{}
+	proxyURL := testhelper.URLParse("http://proxy.example.com:3128")
+	rootCAs := x509.NewCertPool()
+
+	cases := []struct {
+		name          string
+		input         HTTPClientConfig
+		check         func(c *http.Client) bool
+		expectedError bool
+	}{
+		{
+			name:  "Happy Path - custom client used as-is",
+			input: HTTPClientConfig{HTTPClient: customClient},
+			check: func(c *http.Client) bool { return c == customClient },
+		},
+		{
+			name:  "Happy Path - default pooled transport",
+			input: HTTPClientConfig{},
+			check: func(c *http.Client) bool {
+				t, ok := c.Transport.(*http.Transport)
+				return ok && t.Proxy != nil && c.Timeout == 0
+			},
+		},
+		{
+			name: "Happy Path - custom round tripper",
+			input: HTTPClientConfig{
+				Transport: testRoundTripper{},
+				Timeout:   5 * time.Second,
+			},
+			check: func(c *http.Client) bool {
+				_, ok := c.Transport.(testRoundTripper)
+				return ok && c.Timeout == 5*time.Second
+			},
+		},
+		{
+			name: "Happy Path - proxy and TLS settings",
+			input: HTTPClientConfig{
+				Transport:     callerTransport,
+				ProxyURL:      proxyURL,
+				RootCAs:       rootCAs,
+				MinTLSVersion: tls.VersionTLS12,
+			},
+			check: func(c *http.Client) bool {
+				t := c.Transport.(*http.Transport)
+				req, _ := http.NewRequest(http.MethodGet, "https://a.com", nil)
+				p, _ := t.Proxy(req)
+
+				// The caller's transport must not be modified
+				untouched := callerTransport.Proxy == nil &&
+					(callerTransport.TLSClientConfig == nil ||
+						callerTransport.TLSClientConfig.RootCAs == nil)
+
+				return t != callerTransport && untouched &&
+					p.String() == proxyURL.String() &&
+					t.TLSClientConfig.RootCAs == rootCAs &&
+					t.TLSClientConfig.MinVersion == tls.VersionTLS12
+			},
+		},
+		{
+			name: "Error Path - proxy with custom round tripper",
+			input: HTTPClientConfig{
+				Transport: testRoundTripper{},
+				ProxyURL:  proxyURL,
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := NewHTTPClient(c.input)
+
+		if c.expectedError {
+			if err == nil {
+				t.Fatalf("%s: Expected error but got nil", c.name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !c.check(actual) {
+			t.Fatalf("%s: unexpected client %+v", c.name, actual)
+		}
+	}
+}
+
+func TestNewHTTPClientRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	defer server.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	client, err := NewHTTPClient(HTTPClientConfig{RootCAs: rootCAs})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected %d but got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestNewHTTPClientCassette(t *testing.T) {
+	cassette, err := eccassette.New(eccassette.Config{
+		Path: filepath.Join(t.TempDir(), "cassette.json"),
+		Mode: eccassette.ModeRecord,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	customClient := &http.Client{Transport: testRoundTripper{}}
+
+	actual, err := NewHTTPClient(HTTPClientConfig{
+		HTTPClient: customClient,
+		Cassette:   cassette,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if actual == customClient {
+		t.Fatalf("Expected a copy of the caller's client")
+	}
+	if _, ok := customClient.Transport.(testRoundTripper); !ok {
+		t.Fatalf("Expected the caller's client to be left untouched")
+	}
+	if _, ok := actual.Transport.(testRoundTripper); ok {
+		t.Fatalf("Expected the transport to be wrapped by the cassette")
+	}
+}
+
+func TestBuildHTTPClientOwnsTransport(t *testing.T) {
+	roundTripperFunc := testRoundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			return nil, nil
+		})
+
+	cases := []struct {
+		name     string
+		input    HTTPClientConfig
+		expected bool
+	}{
+		{
+			name:     "default transport",
+			input:    HTTPClientConfig{},
+			expected: true,
+		},
+		{
+			name:     "caller's client",
+			input:    HTTPClientConfig{HTTPClient: &http.Client{}},
+			expected: false,
+		},
+		{
+			name:     "caller's transport",
+			input:    HTTPClientConfig{Transport: roundTripperFunc},
+			expected: false,
+		},
+		{
+			name: "caller's transport cloned for TLS settings",
+			input: HTTPClientConfig{
+				Transport:     &http.Transport{},
+				MinTLSVersion: tls.VersionTLS12,
+			},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		_, actual, err := BuildHTTPClient(c.input)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+// testRoundTripperFunc is a RoundTripper of a type that cannot be compared
+type testRoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f testRoundTripperFunc) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	return f(req)
+}
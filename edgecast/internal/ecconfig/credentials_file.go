@@ -0,0 +1,157 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecconfig
+
+/*
+	This file contains the parser for credentials files, which hold named
+	profiles in INI or TOML format, e.g.
+
+		[default]
+		api_token = "abc"
+
+		[staging]
+		ids_client_id = "id"
+		ids_client_secret = "secret"
+		base_api_url = "https://api.staging.example.com"
+*/
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultCredentialsFile returns the location of the credentials file in the
+// user's home directory
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".edgecast", "credentials"), nil
+}
+
+// ParseCredentials reads all profiles from a credentials file. Both INI
+// (key = value) and TOML (key = "value") syntax are accepted, and comments
+// start with # or ;.
+func ParseCredentials(r io.Reader) (map[string]Values, error) {
+	profiles := map[string]Values{}
+	var current Values
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if len(line) == 0 || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid section", lineNumber)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = unquote(strings.TrimPrefix(name, "profile "))
+			if len(name) == 0 {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = Values{}
+			}
+			current = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf(
+				"line %d: key outside of a profile section",
+				lineNumber)
+		}
+
+		current[strings.ToLower(strings.TrimSpace(key))] =
+			unquote(stripComment(strings.TrimSpace(value)))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// LoadProfile reads the values of a single profile from the credentials file
+// at path. If required is false, a missing file or profile yields no values
+// instead of an error.
+func LoadProfile(path string, profile string, required bool) (Values, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if !required && errors.Is(err, os.ErrNotExist) {
+			return Values{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := ParseCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	values, ok := profiles[profile]
+	if !ok {
+		if !required {
+			return Values{}, nil
+		}
+		return nil, fmt.Errorf("%s: profile %q not found", path, profile)
+	}
+
+	return values, nil
+}
+
+// stripComment removes a trailing comment from an unquoted value
+func stripComment(value string) string {
+	if strings.HasPrefix(value, `"`) || strings.HasPrefix(value, "'") {
+		return value
+	}
+	for _, marker := range []string{" #", " ;"} {
+		if i := strings.Index(value, marker); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+	}
+	return value
+}
+
+// unquote removes TOML-style quotes from a value, if present
+func unquote(value string) string {
+	if len(value) < 2 {
+		return value
+	}
+
+	switch {
+	case value[0] == '"':
+		if end := strings.LastIndex(value, `"`); end > 0 {
+			if s, err := strconv.Unquote(value[:end+1]); err == nil {
+				return s
+			}
+		}
+	case value[0] == '\'':
+		if end := strings.LastIndex(value, "'"); end > 0 {
+			return value[1:end]
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecconfig
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCredentials(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected map[string]Values
+	}{
+		{
+			name: "INI",
+			input: `
+; partner account
+[default]
+api_token = abc123 ; trailing comment
+ids_client_id=id
+
+[staging]
+base_api_url = https://api.staging.example.com
+`,
+			expected: map[string]Values{
+				"default": {
+					KeyAPIToken:    "abc123",
+					KeyIDSClientID: "id",
+				},
+				"staging": {
+					KeyBaseAPIURL: "https://api.staging.example.com",
+				},
+			},
+		},
+		{
+			name: "TOML",
+			input: `
+# customer account
+[customer]
+ids_client_secret = "s3cr#t" # trailing comment
+ids_scope = 'ec.rtld ec.cps'
+
+["profile with spaces"]
+API_TOKEN = "quoted \"token\""
+`,
+			expected: map[string]Values{
+				"customer": {
+					KeyIDSClientSecret: "s3cr#t",
+					KeyIDSScope:        "ec.rtld ec.cps",
+				},
+				"profile with spaces": {
+					KeyAPIToken: `quoted "token"`,
+				},
+			},
+		},
+		{
+			name:  "profile prefix",
+			input: "[profile prod]\napi_token = x\n",
+			expected: map[string]Values{
+				"prod": {KeyAPIToken: "x"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseCredentials(strings.NewReader(c.input))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestParseCredentialsInvalid(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "unterminated section",
+			input: "[default\napi_token = x",
+		},
+		{
+			name:  "empty section",
+			input: "[]\napi_token = x",
+		},
+		{
+			name:  "missing equals",
+			input: "[default]\napi_token",
+		},
+		{
+			name:  "key outside section",
+			input: "api_token = x",
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := ParseCredentials(strings.NewReader(c.input)); err == nil {
+			t.Fatalf("%s: Expected an error but got nil", c.name)
+		}
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	os.WriteFile(path, []byte("[default]\napi_token = abc\n"), 0600)
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	cases := []struct {
+		name          string
+		path          string
+		profile       string
+		required      bool
+		expected      Values
+		expectedError bool
+	}{
+		{
+			name:     "found",
+			path:     path,
+			profile:  "default",
+			expected: Values{KeyAPIToken: "abc"},
+		},
+		{
+			name:     "missing optional profile",
+			path:     path,
+			profile:  "staging",
+			expected: Values{},
+		},
+		{
+			name:          "missing required profile",
+			path:          path,
+			profile:       "staging",
+			required:      true,
+			expectedError: true,
+		},
+		{
+			name:     "missing optional file",
+			path:     missing,
+			profile:  "default",
+			expected: Values{},
+		},
+		{
+			name:          "missing required file",
+			path:          missing,
+			profile:       "default",
+			required:      true,
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := LoadProfile(c.path, c.profile, c.required)
+		if c.expectedError {
+			if err == nil {
+				t.Fatalf("%s: Expected an error but got nil", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
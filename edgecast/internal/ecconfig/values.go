@@ -0,0 +1,124 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecconfig
+
+/*
+	This file contains the configuration keys and the logic that combines
+	values from several sources
+*/
+
+import "strings"
+
+// Keys used in credentials files. Each has a corresponding environment
+// variable, e.g. KeyAPIToken is read from EC_API_TOKEN.
+const (
+	KeyAPIToken         = "api_token"
+	KeyIDSClientID      = "ids_client_id"
+	KeyIDSClientSecret  = "ids_client_secret"
+	KeyIDSScope         = "ids_scope"
+	KeyBaseAPIURL       = "base_api_url"
+	KeyBaseAPIURLLegacy = "base_api_url_legacy"
+	KeyBaseIDSURL       = "base_ids_url"
+)
+
+// Keys lists every configuration key
+var Keys = []string{
+	KeyAPIToken,
+	KeyIDSClientID,
+	KeyIDSClientSecret,
+	KeyIDSScope,
+	KeyBaseAPIURL,
+	KeyBaseAPIURLLegacy,
+	KeyBaseIDSURL,
+}
+
+const (
+	// EnvProfile selects the credentials file profile
+	EnvProfile = "EC_PROFILE"
+
+	// EnvCredentialsFile overrides the location of the credentials file
+	EnvCredentialsFile = "EC_CREDENTIALS_FILE"
+
+	// DefaultProfile is used when no profile is selected
+	DefaultProfile = "default"
+
+	envPrefix = "EC_"
+)
+
+// Values holds configuration values by key. Empty values are treated as
+// unset.
+type Values map[string]string
+
+// EnvVar returns the name of the environment variable for key
+func EnvVar(key string) string {
+	return envPrefix + strings.ToUpper(key)
+}
+
+// FromEnv reads every key from the environment using getenv, e.g. os.Getenv
+func FromEnv(getenv func(string) string) Values {
+	values := Values{}
+	for _, key := range Keys {
+		if v := getenv(EnvVar(key)); len(v) > 0 {
+			values[key] = v
+		}
+	}
+	return values
+}
+
+// credentialKeys are the keys that make up a set of credentials. They are
+// taken together from a single layer, so that e.g. a client ID from the
+// environment is never paired with a secret or API token from a file.
+var credentialKeys = []string{
+	KeyAPIToken,
+	KeyIDSClientID,
+	KeyIDSClientSecret,
+	KeyIDSScope,
+}
+
+// Merge combines layers of values. For each key, the first layer with a
+// non-empty value wins, except that all credentials are taken from the first
+// layer that sets any of them.
+func Merge(layers ...Values) Values {
+	merged := Values{}
+	credentialsSet := false
+	for _, layer := range layers {
+		if !credentialsSet && layer.hasAny(credentialKeys) {
+			credentialsSet = true
+			for _, key := range credentialKeys {
+				if v := layer[key]; len(v) > 0 {
+					merged[key] = v
+				}
+			}
+		}
+
+		for key, v := range layer {
+			if len(v) == 0 || isCredentialKey(key) {
+				continue
+			}
+			if _, ok := merged[key]; !ok {
+				merged[key] = v
+			}
+		}
+	}
+	return merged
+}
+
+// hasAny reports whether any of keys has a non-empty value
+func (v Values) hasAny(keys []string) bool {
+	for _, key := range keys {
+		if len(v[key]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isCredentialKey(key string) bool {
+	for _, k := range credentialKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
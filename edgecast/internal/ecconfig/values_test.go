@@ -0,0 +1,97 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromEnv(t *testing.T) {
+	env := map[string]string{
+		"EC_API_TOKEN":     "abc",
+		"EC_IDS_CLIENT_ID": "id",
+		"EC_IDS_SCOPE":     "",
+		"EC_UNRELATED":     "x",
+	}
+
+	actual := FromEnv(func(key string) string { return env[key] })
+
+	expected := Values{KeyAPIToken: "abc", KeyIDSClientID: "id"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	explicit := Values{KeyAPIToken: "explicit", KeyBaseIDSURL: ""}
+	env := Values{KeyAPIToken: "env", KeyBaseIDSURL: "env-ids-url"}
+	file := Values{KeyBaseIDSURL: "file-ids-url", KeyBaseAPIURL: "file-url"}
+
+	actual := Merge(explicit, env, file)
+
+	expected := Values{
+		KeyAPIToken:   "explicit",
+		KeyBaseIDSURL: "env-ids-url",
+		KeyBaseAPIURL: "file-url",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestMergeCredentials(t *testing.T) {
+	file := Values{
+		KeyAPIToken:        "file-token",
+		KeyIDSClientID:     "file-id",
+		KeyIDSClientSecret: "file-secret",
+		KeyIDSScope:        "file-scope",
+		KeyBaseAPIURL:      "file-url",
+	}
+
+	cases := []struct {
+		name     string
+		env      Values
+		expected Values
+	}{
+		{
+			name: "client ID only",
+			env:  Values{KeyIDSClientID: "env-id"},
+			expected: Values{
+				KeyIDSClientID: "env-id",
+				KeyBaseAPIURL:  "file-url",
+			},
+		},
+		{
+			name: "API token only",
+			env:  Values{KeyAPIToken: "env-token", KeyIDSScope: ""},
+			expected: Values{
+				KeyAPIToken:   "env-token",
+				KeyBaseAPIURL: "file-url",
+			},
+		},
+		{
+			name: "no credentials",
+			env:  Values{KeyBaseAPIURL: "env-url"},
+			expected: Values{
+				KeyAPIToken:        "file-token",
+				KeyIDSClientID:     "file-id",
+				KeyIDSClientSecret: "file-secret",
+				KeyIDSScope:        "file-scope",
+				KeyBaseAPIURL:      "env-url",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := Merge(Values{}, c.env, file)
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Fatalf(
+				"%s: Expected %+v but got %+v",
+				c.name,
+				c.expected,
+				actual)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+/*
+	This file contains the per-call state shared between the logical call span
+	and the HTTP attempt spans beneath it
+*/
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type callContextKey struct{}
+
+// Call describes a logical API call. It is carried in the request context so
+// that each HTTP attempt can be labelled and counted.
+type Call struct {
+	// Service is the name of the SDK service making the call, e.g. "waf"
+	Service string
+
+	// Route is the path template of the call, e.g.
+	// /v2/mcc/customers/{account_number}/waf/v1.0/scopes
+	Route string
+
+	// Method is the HTTP method of the call
+	Method string
+
+	attempts int32
+}
+
+// Attributes returns the attributes that identify the call
+func (c *Call) Attributes() []Attribute {
+	return []Attribute{
+		String(AttrService, c.Service),
+		String(AttrRoute, c.Route),
+		String(AttrMethod, c.Method),
+	}
+}
+
+// Attempts returns the number of HTTP attempts made so far
+func (c *Call) Attempts() int {
+	return int(atomic.LoadInt32(&c.attempts))
+}
+
+// AddAttempt counts a new HTTP attempt and returns its number, starting at 1
+func (c *Call) AddAttempt() int {
+	return int(atomic.AddInt32(&c.attempts, 1))
+}
+
+// ContextWithCall returns a copy of ctx that carries call
+func ContextWithCall(ctx context.Context, call *Call) context.Context {
+	return context.WithValue(ctx, callContextKey{}, call)
+}
+
+// CallFromContext returns the Call carried by ctx, if any
+func CallFromContext(ctx context.Context) (*Call, bool) {
+	call, ok := ctx.Value(callContextKey{}).(*Call)
+	return call, ok
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+/*
+	This file contains an Instrumentation that keeps everything in memory, for
+	use in tests
+*/
+
+import (
+	"context"
+	"sync"
+)
+
+type spanContextKey struct{}
+
+// RecordedSpan is a span captured by InMemoryInstrumentation
+type RecordedSpan struct {
+	// ID uniquely identifies the span. IDs start at 1.
+	ID int
+
+	// ParentID is the ID of the parent span, or 0 for root spans
+	ParentID int
+
+	Name       string
+	Attributes []Attribute
+	Err        error
+	Ended      bool
+}
+
+// Attribute returns the value of the attribute with the given key
+func (s RecordedSpan) Attribute(key string) (interface{}, bool) {
+	return findAttribute(s.Attributes, key)
+}
+
+// RecordedMetric is a counter increment or histogram value captured by
+// InMemoryInstrumentation
+type RecordedMetric struct {
+	Name       string
+	Value      float64
+	Attributes []Attribute
+}
+
+// Attribute returns the value of the attribute with the given key
+func (m RecordedMetric) Attribute(key string) (interface{}, bool) {
+	return findAttribute(m.Attributes, key)
+}
+
+// InMemoryInstrumentation records all spans and metrics in memory. It is safe
+// for concurrent use.
+type InMemoryInstrumentation struct {
+	mu         sync.Mutex
+	spans      []*RecordedSpan
+	counters   []RecordedMetric
+	histograms []RecordedMetric
+}
+
+// NewInMemoryInstrumentation creates an empty InMemoryInstrumentation
+func NewInMemoryInstrumentation() *InMemoryInstrumentation {
+	return &InMemoryInstrumentation{}
+}
+
+func (m *InMemoryInstrumentation) StartSpan(
+	ctx context.Context,
+	name string,
+	attrs ...Attribute,
+) (context.Context, Span) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	span := &RecordedSpan{
+		ID:         len(m.spans) + 1,
+		Name:       name,
+		Attributes: append([]Attribute{}, attrs...),
+	}
+	if parent, ok := ctx.Value(spanContextKey{}).(int); ok {
+		span.ParentID = parent
+	}
+	m.spans = append(m.spans, span)
+
+	return context.WithValue(ctx, spanContextKey{}, span.ID),
+		inMemorySpan{owner: m, span: span}
+}
+
+func (m *InMemoryInstrumentation) AddCounter(
+	ctx context.Context,
+	name string,
+	value int64,
+	attrs ...Attribute,
+) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counters = append(m.counters, RecordedMetric{
+		Name:       name,
+		Value:      float64(value),
+		Attributes: append([]Attribute{}, attrs...),
+	})
+}
+
+func (m *InMemoryInstrumentation) RecordHistogram(
+	ctx context.Context,
+	name string,
+	value float64,
+	attrs ...Attribute,
+) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.histograms = append(m.histograms, RecordedMetric{
+		Name:       name,
+		Value:      value,
+		Attributes: append([]Attribute{}, attrs...),
+	})
+}
+
+// Spans returns a snapshot of all spans started so far, in start order
+func (m *InMemoryInstrumentation) Spans() []RecordedSpan {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spans := make([]RecordedSpan, 0, len(m.spans))
+	for _, s := range m.spans {
+		span := *s
+		span.Attributes = append([]Attribute{}, s.Attributes...)
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+// SpansNamed returns a snapshot of all spans with the given name
+func (m *InMemoryInstrumentation) SpansNamed(name string) []RecordedSpan {
+	var spans []RecordedSpan
+	for _, s := range m.Spans() {
+		if s.Name == name {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+// Counters returns a snapshot of all counter increments with the given name
+func (m *InMemoryInstrumentation) Counters(name string) []RecordedMetric {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return filterMetrics(m.counters, name)
+}
+
+// Histograms returns a snapshot of all histogram values with the given name
+func (m *InMemoryInstrumentation) Histograms(name string) []RecordedMetric {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return filterMetrics(m.histograms, name)
+}
+
+// Reset discards everything recorded so far
+func (m *InMemoryInstrumentation) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.spans = nil
+	m.counters = nil
+	m.histograms = nil
+}
+
+type inMemorySpan struct {
+	owner *InMemoryInstrumentation
+	span  *RecordedSpan
+}
+
+func (s inMemorySpan) SetAttributes(attrs ...Attribute) {
+	s.owner.mu.Lock()
+	defer s.owner.mu.Unlock()
+
+	s.span.Attributes = append(s.span.Attributes, attrs...)
+}
+
+func (s inMemorySpan) RecordError(err error) {
+	s.owner.mu.Lock()
+	defer s.owner.mu.Unlock()
+
+	s.span.Err = err
+}
+
+func (s inMemorySpan) End() {
+	s.owner.mu.Lock()
+	defer s.owner.mu.Unlock()
+
+	s.span.Ended = true
+}
+
+func filterMetrics(metrics []RecordedMetric, name string) []RecordedMetric {
+	var filtered []RecordedMetric
+	for _, m := range metrics {
+		if m.Name == name {
+			m.Attributes = append([]Attribute{}, m.Attributes...)
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
+
+func findAttribute(attrs []Attribute, key string) (interface{}, bool) {
+	// Later attributes override earlier ones with the same key
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if attrs[i].Key == key {
+			return attrs[i].Value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestInMemoryInstrumentationSpans(t *testing.T) {
+	m := NewInMemoryInstrumentation()
+
+	ctx, root := m.StartSpan(context.Background(), "root", String("a", "1"))
+	_, child := m.StartSpan(ctx, "child")
+	child.SetAttributes(Int("b", 2))
+	child.RecordError(errors.New("failed"))
+	child.End()
+	root.End()
+
+	expected := []RecordedSpan{
+		{
+			ID:         1,
+			Name:       "root",
+			Attributes: []Attribute{{Key: "a", Value: "1"}},
+			Ended:      true,
+		},
+		{
+			ID:         2,
+			ParentID:   1,
+			Name:       "child",
+			Attributes: []Attribute{{Key: "b", Value: int64(2)}},
+			Err:        errors.New("failed"),
+			Ended:      true,
+		},
+	}
+
+	actual := m.Spans()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	m.Reset()
+	if len(m.Spans()) != 0 {
+		t.Fatalf("Expected no spans after Reset but got %+v", m.Spans())
+	}
+}
+
+func TestInMemoryInstrumentationMetrics(t *testing.T) {
+	m := NewInMemoryInstrumentation()
+	ctx := context.Background()
+
+	m.AddCounter(ctx, "requests", 1, String(AttrService, "waf"))
+	m.AddCounter(ctx, "other", 5)
+	m.RecordHistogram(ctx, "duration", 0.5, String(AttrService, "waf"))
+
+	counters := m.Counters("requests")
+	if len(counters) != 1 || counters[0].Value != 1 {
+		t.Fatalf("Expected a single increment of 1 but got %+v", counters)
+	}
+	if service, _ := counters[0].Attribute(AttrService); service != "waf" {
+		t.Fatalf("Expected %s but got %v", "waf", service)
+	}
+
+	histograms := m.Histograms("duration")
+	if len(histograms) != 1 || histograms[0].Value != 0.5 {
+		t.Fatalf("Expected a single value of 0.5 but got %+v", histograms)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+/*
+	This file contains the instrumentation interface used to emit traces and
+	metrics for SDK calls. Its shape mirrors the OpenTelemetry tracing and
+	metrics APIs so that an adapter is a thin wrapper.
+*/
+
+import "context"
+
+// Span names
+const (
+	// SpanHTTPAttempt is the name of the child span emitted for each HTTP
+	// attempt, including retries
+	SpanHTTPAttempt = "HTTP attempt"
+
+	// SpanTokenRefresh is the name of the span emitted when an IDS token is
+	// retrieved
+	SpanTokenRefresh = "ecauth.RefreshToken"
+)
+
+// Metric names
+const (
+	// MetricRequests counts logical API calls
+	MetricRequests = "ec.client.requests"
+
+	// MetricRequestDuration records the duration of logical API calls in
+	// seconds, including retries
+	MetricRequestDuration = "ec.client.request.duration"
+
+	// MetricRetries counts HTTP attempts beyond the first
+	MetricRetries = "ec.client.retries"
+
+	// MetricTokenRefreshes counts IDS token retrievals
+	MetricTokenRefreshes = "ec.auth.token.refreshes"
+)
+
+// Attribute keys
+const (
+	AttrService    = "ec.service"
+	AttrRoute      = "http.route"
+	AttrMethod     = "http.method"
+	AttrStatusCode = "http.status_code"
+	AttrAttempt    = "ec.attempt"
+	AttrAttempts   = "ec.attempts"
+	AttrOutcome    = "ec.outcome"
+)
+
+// Attribute is a key/value pair attached to spans and metrics. Value is a
+// string, int64, float64 or bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String creates a string Attribute
+func String(key string, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int creates an integer Attribute
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: int64(value)}
+}
+
+// Instrumentation receives traces and metrics emitted by the SDK
+type Instrumentation interface {
+	// StartSpan starts a span that is a child of the span in ctx, if any, and
+	// returns a context that carries the new span
+	StartSpan(
+		ctx context.Context,
+		name string,
+		attrs ...Attribute,
+	) (context.Context, Span)
+
+	// AddCounter adds value to the named counter
+	AddCounter(
+		ctx context.Context,
+		name string,
+		value int64,
+		attrs ...Attribute,
+	)
+
+	// RecordHistogram records value in the named histogram
+	RecordHistogram(
+		ctx context.Context,
+		name string,
+		value float64,
+		attrs ...Attribute,
+	)
+}
+
+// Span is a single timed operation
+type Span interface {
+	// SetAttributes adds attributes to the span
+	SetAttributes(attrs ...Attribute)
+
+	// RecordError marks the span as failed with err
+	RecordError(err error)
+
+	// End completes the span
+	End()
+}
+
+// NoopInstrumentation discards all traces and metrics
+type NoopInstrumentation struct{}
+
+// NewNoopInstrumentation creates an Instrumentation that does nothing
+func NewNoopInstrumentation() Instrumentation {
+	return NoopInstrumentation{}
+}
+
+func (NoopInstrumentation) StartSpan(
+	ctx context.Context,
+	name string,
+	attrs ...Attribute,
+) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+func (NoopInstrumentation) AddCounter(
+	ctx context.Context,
+	name string,
+	value int64,
+	attrs ...Attribute,
+) {
+}
+
+func (NoopInstrumentation) RecordHistogram(
+	ctx context.Context,
+	name string,
+	value float64,
+	attrs ...Attribute,
+) {
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+
+func (noopSpan) RecordError(err error) {}
+
+func (noopSpan) End() {}
+
+// OrNoop returns instrumentation, or a NoopInstrumentation if it is nil
+func OrNoop(instrumentation Instrumentation) Instrumentation {
+	if instrumentation == nil {
+		return NoopInstrumentation{}
+	}
+	return instrumentation
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+/*
+	This file contains an http.RoundTripper that emits a span for every HTTP
+	attempt
+*/
+
+import (
+	"net/http"
+)
+
+type transport struct {
+	base            http.RoundTripper
+	instrumentation Instrumentation
+}
+
+// NewTransport wraps base so that every request sent through it is recorded
+// as a SpanHTTPAttempt span. If base is nil, http.DefaultTransport is used.
+func NewTransport(
+	base http.RoundTripper,
+	instrumentation Instrumentation,
+) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{
+		base:            base,
+		instrumentation: OrNoop(instrumentation),
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var attrs []Attribute
+	attempt := 1
+	if call, ok := CallFromContext(ctx); ok {
+		attempt = call.AddAttempt()
+		attrs = call.Attributes()
+	} else {
+		attrs = []Attribute{String(AttrMethod, req.Method)}
+	}
+	attrs = append(attrs, Int(AttrAttempt, attempt))
+
+	ctx, span := t.instrumentation.StartSpan(ctx, SpanHTTPAttempt, attrs...)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	span.SetAttributes(Int(AttrStatusCode, resp.StatusCode))
+	return resp, nil
+}
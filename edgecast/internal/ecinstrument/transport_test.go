@@ -0,0 +1,83 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecinstrument
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type testRoundTripper struct {
+	statusCode int
+	err        error
+}
+
+func (rt testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.err != nil {
+		return nil, rt.err
+	}
+	return &http.Response{StatusCode: rt.statusCode}, nil
+}
+
+func TestTransportRoundTrip(t *testing.T) {
+	cases := []struct {
+		name           string
+		base           testRoundTripper
+		expectedStatus interface{}
+		expectedError  bool
+	}{
+		{
+			name:           "Happy Path",
+			base:           testRoundTripper{statusCode: http.StatusOK},
+			expectedStatus: int64(http.StatusOK),
+		},
+		{
+			name:          "Error Path - transport error",
+			base:          testRoundTripper{err: errors.New("refused")},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		m := NewInMemoryInstrumentation()
+		call := &Call{Service: "waf", Route: "/v1/{id}", Method: "GET"}
+		ctx := ContextWithCall(context.Background(), call)
+
+		req, _ := http.NewRequestWithContext(
+			ctx,
+			http.MethodGet,
+			"https://example.com/v1/1",
+			nil)
+
+		rt := NewTransport(c.base, m)
+		for i := 0; i < 2; i++ {
+			rt.RoundTrip(req)
+		}
+
+		spans := m.SpansNamed(SpanHTTPAttempt)
+		if len(spans) != 2 || call.Attempts() != 2 {
+			t.Fatalf("%s: Expected 2 attempts but got %+v", c.name, spans)
+		}
+
+		for i, span := range spans {
+			attempt, _ := span.Attribute(AttrAttempt)
+			if attempt != int64(i+1) {
+				t.Fatalf("%s: Expected attempt %d but got %v", c.name, i+1, attempt)
+			}
+			route, _ := span.Attribute(AttrRoute)
+			if route != call.Route {
+				t.Fatalf("%s: Expected %s but got %v", c.name, call.Route, route)
+			}
+			status, _ := span.Attribute(AttrStatusCode)
+			if status != c.expectedStatus {
+				t.Fatalf("%s: Expected %v but got %v", c.name, c.expectedStatus, status)
+			}
+			if c.expectedError != (span.Err != nil) {
+				t.Fatalf("%s: unexpected span error: %v", c.name, span.Err)
+			}
+		}
+	}
+}
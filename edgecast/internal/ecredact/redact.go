@@ -0,0 +1,287 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecredact
+
+/*
+	This file contains the Redactor, which masks secrets in headers, query
+	strings and request and response bodies before they are logged or saved.
+*/
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultMask replaces redacted values if Config.Mask is not set
+const DefaultMask = "*****"
+
+var (
+	// DefaultHeaders are the headers that are always redacted
+	DefaultHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Api-Key",
+	}
+
+	// DefaultFields are the JSON, form and query field names that are always
+	// redacted. They cover every secret sent or received by SDK services,
+	// e.g. the credentials of RTLD delivery destinations, WAF reCAPTCHA
+	// secret keys, Route DNS TSIG key values and IDS client secrets.
+	DefaultFields = []string{
+		// IDS and OAuth 2.0
+		"client_secret",
+		"access_token",
+		"refresh_token",
+		"id_token",
+
+		// RTLD HTTP POST, Splunk, Datadog and Azure destinations
+		"password",
+		"token",
+		"api_key",
+		"access_key",
+
+		// WAF scopes
+		"recaptcha_secret_key",
+
+		// Route DNS TSIG keys
+		"KeyValue",
+
+		// Common names for secrets
+		"secret",
+		"secret_key",
+		"private_key",
+		"passphrase",
+	}
+)
+
+// Config configures a Redactor. The defaults are always redacted in addition
+// to the values given here.
+type Config struct {
+	// Fields are JSON, form and query field names whose values are redacted
+	// wherever they appear. Names are compared case-insensitively and
+	// ignoring '_' and '-', so "api_key" also matches "APIKey".
+	Fields []string
+
+	// Paths are dot-separated paths of JSON fields whose values are redacted,
+	// e.g. "delivery_method.http_post.username". A '*' segment matches any
+	// field name or array index. Segments are compared case-insensitively.
+	Paths []string
+
+	// Headers are the names of headers whose values are redacted
+	Headers []string
+
+	// Mask replaces each redacted value. Defaults to DefaultMask.
+	Mask string
+}
+
+// Redactor masks secrets. It is safe for concurrent use.
+type Redactor struct {
+	fields  map[string]bool
+	paths   [][]string
+	headers []string
+	mask    string
+}
+
+// New creates a Redactor that redacts the defaults and everything in config
+func New(config Config) *Redactor {
+	r := &Redactor{
+		fields: map[string]bool{},
+		mask:   config.Mask,
+	}
+
+	if len(r.mask) == 0 {
+		r.mask = DefaultMask
+	}
+
+	for _, f := range DefaultFields {
+		r.fields[normalizeField(f)] = true
+	}
+	for _, f := range config.Fields {
+		r.fields[normalizeField(f)] = true
+	}
+
+	for _, p := range config.Paths {
+		if len(p) > 0 {
+			r.paths = append(r.paths, strings.Split(p, "."))
+		}
+	}
+
+	r.headers = append(r.headers, DefaultHeaders...)
+	r.headers = append(r.headers, config.Headers...)
+
+	return r
+}
+
+// Mask returns the value that replaces each redacted value
+func (r *Redactor) Mask() string {
+	return r.mask
+}
+
+// Header returns a copy of h with redacted header values replaced, or nil if
+// h is empty
+func (r *Redactor) Header(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+
+	redacted := h.Clone()
+	for _, name := range r.headers {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted.Set(name, r.mask)
+		}
+	}
+	return redacted
+}
+
+// HeaderMap returns a copy of m with redacted header values replaced
+func (r *Redactor) HeaderMap(m map[string]string) map[string]string {
+	redacted := make(map[string]string, len(m))
+	for k, v := range m {
+		if r.isHeader(k) {
+			redacted[k] = r.mask
+		} else {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
+// URL returns u as a string with redacted query parameters replaced
+func (r *Redactor) URL(u *url.URL) string {
+	if len(u.RawQuery) == 0 {
+		return u.String()
+	}
+
+	query := u.Query()
+	if !r.Values(query) {
+		return u.String()
+	}
+
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// Body returns body with redacted fields replaced. Form bodies, identified by
+// contentType, and JSON bodies are supported; any other body is returned
+// unchanged.
+func (r *Redactor) Body(body []byte, contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		form, err := url.ParseQuery(string(body))
+		if err == nil && r.Values(form) {
+			return form.Encode()
+		}
+		return string(body)
+	}
+
+	var parsed interface{}
+	if json.Unmarshal(body, &parsed) != nil {
+		return string(body)
+	}
+
+	if !r.JSON(parsed) {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(parsed)
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+// Values replaces redacted fields in v, reporting whether any were found
+func (r *Redactor) Values(v url.Values) bool {
+	found := false
+	for k, vals := range v {
+		if r.isField(k) {
+			for i := range vals {
+				vals[i] = r.mask
+			}
+			found = true
+		}
+	}
+	return found
+}
+
+// JSON replaces redacted fields anywhere in a decoded JSON value, reporting
+// whether any were found
+func (r *Redactor) JSON(v interface{}) bool {
+	return r.json(v, nil)
+}
+
+func (r *Redactor) json(v interface{}, path []string) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			childPath := append(path[:len(path):len(path)], k)
+			if r.isField(k) || r.isPath(childPath) {
+				v[k] = r.mask
+				found = true
+			} else if r.json(child, childPath) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			childPath := append(path[:len(path):len(path)], strconv.Itoa(i))
+			if r.isPath(childPath) {
+				v[i] = r.mask
+				found = true
+			} else if r.json(child, childPath) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+func (r *Redactor) isField(name string) bool {
+	return r.fields[normalizeField(name)]
+}
+
+func (r *Redactor) isHeader(name string) bool {
+	for _, h := range r.headers {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Redactor) isPath(path []string) bool {
+	for _, p := range r.paths {
+		if pathMatches(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func pathMatches(pattern []string, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && !strings.EqualFold(pattern[i], path[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeField lowercases name and removes '_' and '-' so that the
+// snake_case, camelCase and PascalCase forms of a name are equal
+func normalizeField(name string) string {
+	name = strings.ToLower(name)
+	return strings.NewReplacer("_", "", "-", "").Replace(name)
+}
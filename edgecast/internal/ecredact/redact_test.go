@@ -0,0 +1,150 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecredact
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/testhelper"
+)
+
+func TestRedactorBody(t *testing.T) {
+	r := New(Config{
+		Fields: []string{"customSecret"},
+		Paths: []string{
+			"delivery_method.http_post.username",
+			"items.*.name",
+			"list.1",
+		},
+	})
+
+	cases := []struct {
+		name        string
+		body        string
+		contentType string
+		expected    string
+	}{
+		{
+			name:        "IDS form",
+			body:        "client_id=id&client_secret=secret&scope=cdn.rtld",
+			contentType: "application/x-www-form-urlencoded",
+			expected: "client_id=id&client_secret=%2A%2A%2A%2A%2A" +
+				"&scope=cdn.rtld",
+		},
+		{
+			name: "RTLD destinations",
+			body: `{"http_post":{"password":"p","token":"t"},` +
+				`"datadog":{"api_key":"k"},"azure_blob":{"access_key":"a"}}`,
+			contentType: "application/json",
+			expected: `{"azure_blob":{"access_key":"*****"},` +
+				`"datadog":{"api_key":"*****"},` +
+				`"http_post":{"password":"*****","token":"*****"}}`,
+		},
+		{
+			name:        "WAF scopes",
+			body:        `[{"recaptcha_secret_key":"s","id":"1"}]`,
+			contentType: "application/json",
+			expected:    `[{"id":"1","recaptcha_secret_key":"*****"}]`,
+		},
+		{
+			name:        "TSIG key",
+			body:        `{"KeyName":"n","KeyValue":"v"}`,
+			contentType: "application/json",
+			expected:    `{"KeyName":"n","KeyValue":"*****"}`,
+		},
+		{
+			name:        "field names ignore case and separators",
+			body:        `{"APIKey":"k","custom-secret":"c"}`,
+			contentType: "application/json",
+			expected:    `{"APIKey":"*****","custom-secret":"*****"}`,
+		},
+		{
+			name: "paths",
+			body: `{"delivery_method":{"http_post":{"username":"u"}},` +
+				`"username":"public","items":[{"name":"a"}],"list":[1,2]}`,
+			contentType: "application/json",
+			expected: `{"delivery_method":{"http_post":{"username":"*****"}},` +
+				`"items":[{"name":"*****"}],"list":[1,"*****"],` +
+				`"username":"public"}`,
+		},
+		{
+			name:        "JSON without secrets is unchanged",
+			body:        `{ "Id": 1 }`,
+			contentType: "application/json",
+			expected:    `{ "Id": 1 }`,
+		},
+		{
+			name:        "plain text",
+			body:        "password=secret",
+			contentType: "text/plain",
+			expected:    "password=secret",
+		},
+	}
+
+	for _, c := range cases {
+		actual := r.Body([]byte(c.body), c.contentType)
+		if actual != c.expected {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestRedactorHeader(t *testing.T) {
+	r := New(Config{Headers: []string{"X-Custom-Auth"}, Mask: "[REDACTED]"})
+	h := http.Header{
+		"Authorization": {"TOK:abc"},
+		"X-Custom-Auth": {"key"},
+		"Content-Type":  {"application/json"},
+	}
+
+	actual := r.Header(h)
+
+	expected := http.Header{
+		"Authorization": {"[REDACTED]"},
+		"X-Custom-Auth": {"[REDACTED]"},
+		"Content-Type":  {"application/json"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+	if h.Get("Authorization") != "TOK:abc" {
+		t.Fatalf("Expected the original header to be left untouched")
+	}
+}
+
+func TestRedactorHeaderMap(t *testing.T) {
+	r := New(Config{})
+	m := map[string]string{
+		"authorization": "Bearer abc",
+		"Accept":        "application/json",
+	}
+
+	actual := r.HeaderMap(m)
+
+	expected := map[string]string{
+		"authorization": DefaultMask,
+		"Accept":        "application/json",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+	if m["authorization"] != "Bearer abc" {
+		t.Fatalf("Expected the original map to be left untouched")
+	}
+}
+
+func TestRedactorURL(t *testing.T) {
+	r := New(Config{})
+
+	actual := r.URL(testhelper.URLParse(
+		"https://api.vdms.io/v2/items?access_token=secret&page=1"))
+
+	expected := "https://api.vdms.io/v2/items?access_token=%2A%2A%2A%2A%2A" +
+		"&page=1"
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
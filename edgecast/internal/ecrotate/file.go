@@ -0,0 +1,362 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecrotate
+
+/*
+	This file contains File, a log file that is rotated once it reaches a
+	maximum size or age. Rotated files are renamed with a timestamp, e.g.
+	sdk.log becomes sdk-2022-06-01T15-04-05.000.log, optionally compressed
+	with gzip and removed once there are more than a given number of them.
+*/
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMode is used for new files if Options.Mode is not set
+const DefaultMode os.FileMode = 0600
+
+// backupTimeFormat is the timestamp added to the names of rotated files. It
+// sorts chronologically and contains no characters that are invalid in file
+// names.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// Options controls when a File is rotated and what happens to rotated files
+type Options struct {
+	// MaxSize is the size in bytes after which the file is rotated. A write
+	// that would exceed it is written to a new file. Zero disables size-based
+	// rotation.
+	MaxSize int64
+
+	// MaxAge is how long the file is written to before it is rotated. Zero
+	// disables age-based rotation.
+	MaxAge time.Duration
+
+	// MaxBackups is the number of rotated files to keep; the oldest are
+	// removed first. Zero keeps all rotated files.
+	MaxBackups int
+
+	// Compress, if true, compresses rotated files with gzip
+	Compress bool
+
+	// Mode is the permissions of new files. Defaults to DefaultMode.
+	Mode os.FileMode
+}
+
+// File is an io.Writer that appends to a file and rotates it according to its
+// Options. It is safe for concurrent use.
+type File struct {
+	path    string
+	options Options
+
+	// now returns the current time; replaced in tests
+	now func() time.Time
+
+	// rename renames a file; replaced in tests
+	rename func(oldPath, newPath string) error
+
+	mu       sync.Mutex
+	file     *os.File
+	closed   bool
+	size     int64
+	openedAt time.Time
+
+	// rotateFailed is set once a rotation has failed, so that the failure is
+	// reported once rather than on every write until a rotation succeeds
+	rotateFailed bool
+}
+
+// Open opens the file at path for appending, creating it if it does not exist
+func Open(path string, options Options) (*File, error) {
+	if options.Mode == 0 {
+		options.Mode = DefaultMode
+	}
+
+	f := &File{
+		path:    path,
+		options: options,
+		now:     time.Now,
+		rename:  os.Rename,
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write appends p to the file, first rotating it if required. If the file
+// cannot be rotated, p is appended to the current file and the error is
+// returned along with the number of bytes written; later failures are not
+// reported until a rotation has succeeded.
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+
+	var rotateErr error
+	if f.file != nil && f.shouldRotate(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			if !f.rotateFailed {
+				rotateErr = err
+			}
+			f.rotateFailed = true
+		} else {
+			f.rotateFailed = false
+		}
+	}
+
+	// The file is reopened if it could not be after a failed rotation
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			if rotateErr != nil {
+				return 0, rotateErr
+			}
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	if err != nil {
+		return n, err
+	}
+	return n, rotateErr
+}
+
+// Sync commits the file's contents to stable storage
+func (f *File) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return os.ErrClosed
+	}
+	if f.file == nil {
+		return nil
+	}
+	return f.file.Sync()
+}
+
+// Close closes the file. Subsequent writes return os.ErrClosed.
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(
+		f.path,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		f.options.Mode)
+	if err != nil {
+		return fmt.Errorf("ecrotate.Open: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("ecrotate.Open: %w", err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+	f.openedAt = f.now()
+	return nil
+}
+
+func (f *File) shouldRotate(writeSize int64) bool {
+	// An empty file is never rotated, so that a write larger than MaxSize is
+	// written instead of rotating forever
+	if f.size == 0 {
+		return false
+	}
+
+	if f.options.MaxSize > 0 && f.size+writeSize > f.options.MaxSize {
+		return true
+	}
+
+	return f.options.MaxAge > 0 &&
+		f.now().Sub(f.openedAt) >= f.options.MaxAge
+}
+
+// rotate renames the current file, opens a new one and then compresses and
+// removes rotated files as configured. If the file cannot be renamed, it is
+// reopened so that writes can continue.
+func (f *File) rotate() error {
+	err := f.file.Close()
+	f.file = nil
+	if err != nil {
+		return fmt.Errorf("ecrotate.rotate: %w", err)
+	}
+
+	backup := f.backupName()
+	if err := f.rename(f.path, backup); err != nil {
+		// The age of the file is reset so that a rotation by age is not
+		// retried on every write
+		f.open()
+		return fmt.Errorf("ecrotate.rotate: %w", err)
+	}
+
+	if err := f.open(); err != nil {
+		return fmt.Errorf("ecrotate.rotate: %w", err)
+	}
+
+	if f.options.Compress {
+		if err := compress(backup, f.options.Mode); err != nil {
+			return fmt.Errorf("ecrotate.rotate: %w", err)
+		}
+	}
+
+	if err := f.removeOldBackups(); err != nil {
+		return fmt.Errorf("ecrotate.rotate: %w", err)
+	}
+
+	return nil
+}
+
+// backupName returns an unused name for the current file once rotated. If
+// the name for the current time is taken, later milliseconds are tried so
+// that names still sort chronologically.
+func (f *File) backupName() string {
+	dir, prefix, ext := f.nameParts()
+
+	stamp := f.now().UTC()
+	for {
+		name := filepath.Join(dir, prefix+stamp.Format(backupTimeFormat)+ext)
+		if !exists(name) && !exists(name+".gz") {
+			return name
+		}
+		stamp = stamp.Add(time.Millisecond)
+	}
+}
+
+// Backups returns the paths of the rotated files, oldest first
+func (f *File) Backups() ([]string, error) {
+	dir, prefix, ext := f.nameParts()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []string
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), ".gz")
+		if e.IsDir() ||
+			!strings.HasPrefix(name, prefix) ||
+			!strings.HasSuffix(name, ext) ||
+			len(name) < len(prefix)+len(backupTimeFormat)+len(ext) {
+			continue
+		}
+
+		stamp := name[len(prefix) : len(prefix)+len(backupTimeFormat)]
+		if _, err := time.Parse(backupTimeFormat, stamp); err != nil {
+			continue
+		}
+
+		backups = append(backups, filepath.Join(dir, e.Name()))
+	}
+
+	// The timestamp format sorts chronologically
+	sort.Strings(backups)
+	return backups, nil
+}
+
+func (f *File) removeOldBackups() error {
+	if f.options.MaxBackups <= 0 {
+		return nil
+	}
+
+	backups, err := f.Backups()
+	if err != nil {
+		return err
+	}
+
+	for len(backups) > f.options.MaxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// nameParts splits the file's path into its directory, the prefix of rotated
+// file names and the extension, e.g. "logs", "sdk-" and ".log"
+func (f *File) nameParts() (string, string, string) {
+	dir := filepath.Dir(f.path)
+	base := filepath.Base(f.path)
+	ext := filepath.Ext(base)
+	return dir, strings.TrimSuffix(base, ext) + "-", ext
+}
+
+// compress replaces the file at path with a gzip-compressed copy named
+// path.gz
+func compress(path string, mode os.FileMode) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(
+		path+".gz",
+		os.O_CREATE|os.O_TRUNC|os.O_WRONLY,
+		mode)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		zw.Close()
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+
+	src.Close()
+	return os.Remove(path)
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
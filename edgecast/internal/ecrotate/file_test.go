@@ -0,0 +1,281 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecrotate
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileRotatesBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, clock := openTestFile(t, path, Options{MaxSize: 10})
+
+	write(t, f, "12345")
+	write(t, f, "67890")
+	clock.advance(time.Second)
+	write(t, f, "abc")
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("Expected 1 backup but got %+v", backups)
+	}
+
+	expected := filepath.Join(
+		filepath.Dir(path),
+		"sdk-2022-06-01T12-00-01.000.log")
+	if backups[0] != expected {
+		t.Fatalf("Expected %s but got %s", expected, backups[0])
+	}
+	assertContents(t, backups[0], "1234567890")
+	assertContents(t, path, "abc")
+}
+
+func TestFileRotatesByAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, clock := openTestFile(t, path, Options{MaxAge: time.Hour})
+
+	write(t, f, "first")
+	clock.advance(59 * time.Minute)
+	write(t, f, "second")
+	clock.advance(time.Minute)
+	write(t, f, "third")
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("Expected 1 backup but got %+v", backups)
+	}
+	assertContents(t, backups[0], "firstsecond")
+	assertContents(t, path, "third")
+}
+
+func TestFileRemovesOldBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, clock := openTestFile(t, path, Options{MaxSize: 1, MaxBackups: 2})
+
+	for _, s := range []string{"a", "b", "c", "d"} {
+		write(t, f, s)
+		clock.advance(time.Second)
+	}
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups but got %+v", backups)
+	}
+	assertContents(t, backups[0], "b")
+	assertContents(t, backups[1], "c")
+	assertContents(t, path, "d")
+}
+
+func TestFileCompressesBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, _ := openTestFile(t, path, Options{MaxSize: 5, Compress: true})
+
+	write(t, f, "hello")
+	write(t, f, "world")
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 1 || !strings.HasSuffix(backups[0], ".log.gz") {
+		t.Fatalf("Expected 1 compressed backup but got %+v", backups)
+	}
+
+	file, err := os.Open(backups[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("Expected hello but got %s", data)
+	}
+}
+
+func TestFileBackupNamesDoNotCollide(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, _ := openTestFile(t, path, Options{MaxSize: 1})
+
+	for _, s := range []string{"a", "b", "c"} {
+		write(t, f, s)
+	}
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups but got %+v", backups)
+	}
+}
+
+func TestFileMode(t *testing.T) {
+	cases := []struct {
+		name     string
+		mode     os.FileMode
+		expected os.FileMode
+	}{
+		{
+			name:     "default",
+			expected: DefaultMode,
+		},
+		{
+			name:     "configured",
+			mode:     0640,
+			expected: 0640,
+		},
+	}
+
+	for _, c := range cases {
+		path := filepath.Join(t.TempDir(), "sdk.log")
+		f, err := Open(path, Options{Mode: c.mode})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		f.Close()
+
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		// The umask may remove permissions but never adds them
+		if actual := info.Mode().Perm(); actual&^c.expected != 0 {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestFileRotateRenameError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, clock := openTestFile(t, path, Options{MaxSize: 10})
+
+	renameErr := errors.New("rename failed")
+	f.rename = func(oldPath, newPath string) error {
+		return renameErr
+	}
+
+	write(t, f, "12345")
+	write(t, f, "67890")
+
+	// The failure is reported once, and messages are still written
+	n, err := f.Write([]byte("abc"))
+	if !errors.Is(err, renameErr) || n != 3 {
+		t.Fatalf("Expected 3 bytes and %v but got %d, %v", renameErr, n, err)
+	}
+	write(t, f, "def")
+	assertContents(t, path, "1234567890abcdef")
+
+	// Rotation resumes once the file can be renamed
+	f.rename = os.Rename
+	clock.advance(time.Second)
+	write(t, f, "ghi")
+
+	backups, err := f.Backups()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("Expected 1 backup but got %+v", backups)
+	}
+	assertContents(t, backups[0], "1234567890abcdef")
+	assertContents(t, path, "ghi")
+}
+
+func TestFileOpenError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "sdk.log")
+
+	_, err := Open(path, Options{})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected %v but got %v", os.ErrNotExist, err)
+	}
+}
+
+func TestFileClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sdk.log")
+	f, _ := openTestFile(t, path, Options{})
+
+	if err := f.Sync(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := f.Write([]byte("a")); !errors.Is(err, os.ErrClosed) {
+		t.Fatalf("Expected %v but got %v", os.ErrClosed, err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("Expected closing twice to succeed but got %v", err)
+	}
+}
+
+type testClock struct {
+	t time.Time
+}
+
+func (c *testClock) now() time.Time {
+	return c.t
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func openTestFile(
+	t *testing.T,
+	path string,
+	options Options,
+) (*File, *testClock) {
+	clock := &testClock{t: time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)}
+
+	f, err := Open(path, options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.now = clock.now
+	f.openedAt = clock.now()
+	t.Cleanup(func() { f.Close() })
+
+	return f, clock
+}
+
+func write(t *testing.T, f *File, s string) {
+	if _, err := f.Write([]byte(s)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func assertContents(t *testing.T, path string, expected string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != expected {
+		t.Fatalf("Expected %s in %s but got %s", expected, path, data)
+	}
+}
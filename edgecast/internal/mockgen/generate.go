@@ -0,0 +1,451 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+/*
+	This file contains the interfaces that are mocked and the code that
+	parses them and renders their mocks from the template
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// modulePath is the import path of the edgecast directory
+const modulePath = "github.com/EdgeCast/ec-sdk-go/edgecast"
+
+// target is an interface to mock
+type target struct {
+	// Dir is the directory of the package that declares the interface,
+	// relative to the edgecast directory
+	Dir string
+
+	// Interface is the name of the interface
+	Interface string
+
+	// Mock is the name of the mock type
+	Mock string
+}
+
+// targets lists every interface that is mocked. Add an entry when adding a
+// service; TestEveryClientServiceIsMocked fails until you do.
+var targets = []target{
+	{"cps/appendix", "ClientService", "AppendixClientService"},
+	{"cps/certificate", "ClientService", "CertificateClientService"},
+	{"cps/customer", "ClientService", "CPSCustomerClientService"},
+	{"cps/dcv", "ClientService", "DCVClientService"},
+	{"cps/organization", "ClientService", "OrganizationClientService"},
+	{"cps/task", "ClientService", "TaskClientService"},
+	{"customer", "ClientService", "CustomerClientService"},
+	{"edgecname", "ClientService", "EdgeCnameClientService"},
+	{"origin", "ClientService", "OriginClientService"},
+	{"originv3", "AdnOnlyClientService", "AdnOnlyClientService"},
+	{"originv3", "CommonClientService", "CommonClientService"},
+	{"originv3", "HttpLargeOnlyClientService", "HttpLargeOnlyClientService"},
+	{"originv3", "Phase3ClientService", "Phase3ClientService"},
+	{"routedns", "ClientService", "RouteDNSClientService"},
+	{"rtld/lookups", "ClientService", "LookupsClientService"},
+	{"rtld/profiles_cdn", "ClientService", "ProfilesCdnClientService"},
+	{"rtld/profiles_rl", "ClientService", "ProfilesRlClientService"},
+	{"rtld/profiles_waf", "ClientService", "ProfilesWafClientService"},
+	{
+		"rtld/settings_internal",
+		"ClientService",
+		"SettingsInternalClientService",
+	},
+	{"rulesengine", "ClientService", "RulesEngineClientService"},
+	{"waf/rules/access", "ClientService", "AccessClientService"},
+	{"waf/rules/bot", "ClientService", "BotClientService"},
+	{"waf/rules/custom", "ClientService", "CustomClientService"},
+	{"waf/rules/managed", "ClientService", "ManagedClientService"},
+	{"waf/rules/rate", "ClientService", "RateClientService"},
+	{"waf/scopes", "ClientService", "ScopesClientService"},
+	{
+		"waf_bot_manager",
+		"BotManagersClientService",
+		"BotManagersClientService",
+	},
+}
+
+// mockData is passed to the template to render the mock of an interface
+type mockData struct {
+	Mock      string
+	Interface string
+	Imports   []string
+	Methods   []method
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+}
+
+type param struct {
+	Name    string
+	Type    string
+	Context bool
+}
+
+// parseTemplate parses the mock template at path
+func parseTemplate(path string) (*template.Template, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("parseTemplate: %w", err)
+	}
+
+	tmpl, err := template.New("mock").Funcs(funcs).Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("parseTemplate: %w", err)
+	}
+	return tmpl, nil
+}
+
+// generate renders the mock of every target in the edgecast directory dir,
+// returning the formatted source of each by file name
+func generate(
+	dir string,
+	tmpl *template.Template,
+) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, t := range targets {
+		data, err := parseTarget(dir, t)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"generate: %s.%s: %w",
+				t.Dir,
+				t.Interface,
+				err)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("generate: %s: %w", t.Mock, err)
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("generate: %s: %w", t.Mock, err)
+		}
+		files[fileName(t.Mock)] = src
+	}
+	return files, nil
+}
+
+// parseTarget finds the interface of t and converts it to template data
+func parseTarget(dir string, t target) (*mockData, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(
+		fset,
+		filepath.Join(dir, filepath.FromSlash(t.Dir)),
+		func(fi os.FileInfo) bool {
+			return !strings.HasSuffix(fi.Name(), "_test.go")
+		},
+		0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			iface := findInterface(file, t.Interface)
+			if iface == nil {
+				continue
+			}
+
+			c := converter{
+				pkgName: pkg.Name,
+				pkgPath: path.Join(modulePath, t.Dir),
+				imports: fileImports(file),
+				used:    map[string]bool{},
+			}
+			return c.convert(t, iface)
+		}
+	}
+	return nil, fmt.Errorf("interface not found")
+}
+
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if iface, ok := ts.Type.(*ast.InterfaceType); ok &&
+				ts.Name.Name == name {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// fileImports maps the names of the packages imported by file to their paths
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		p := strings.Trim(spec.Path.Value, `"`)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+// converter writes the types of an interface's methods as they appear in
+// package mocks, recording the packages they use
+type converter struct {
+	pkgName string
+	pkgPath string
+	imports map[string]string
+	used    map[string]bool
+}
+
+func (c converter) convert(
+	t target,
+	iface *ast.InterfaceType,
+) (*mockData, error) {
+	data := &mockData{
+		Mock:      t.Mock,
+		Interface: c.pkgName + "." + t.Interface,
+	}
+	c.used[c.pkgPath] = true
+
+	for _, field := range iface.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) != 1 {
+			return nil, fmt.Errorf("embedded interfaces are not supported")
+		}
+
+		m := method{Name: field.Names[0].Name}
+		for _, p := range ft.Params.List {
+			typ, err := c.typeString(p.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", m.Name, err)
+			}
+
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent("")}
+			}
+			for _, name := range names {
+				n := name.Name
+				if len(n) == 0 || n == "_" {
+					n = fmt.Sprintf("p%d", len(m.Params))
+				}
+				m.Params = append(m.Params, param{
+					Name:    n,
+					Type:    typ,
+					Context: typ == "context.Context",
+				})
+			}
+		}
+
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				typ, err := c.typeString(r.Type)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", m.Name, err)
+				}
+				for i := 0; i < len(r.Names) || i == 0; i++ {
+					m.Results = append(m.Results, typ)
+				}
+			}
+		}
+
+		data.Methods = append(data.Methods, m)
+	}
+
+	for p := range c.used {
+		data.Imports = append(data.Imports, p)
+	}
+	sort.Strings(data.Imports)
+
+	// Parameters must not shadow the packages used in method bodies, e.g. a
+	// parameter named scopes of type scopes.Scopes
+	packages := map[string]bool{c.pkgName: true}
+	for name, p := range c.imports {
+		packages[name] = c.used[p]
+	}
+	for _, m := range data.Methods {
+		for i := range m.Params {
+			if packages[m.Params[i].Name] {
+				m.Params[i].Name += "Param"
+			}
+		}
+	}
+	return data, nil
+}
+
+// typeString writes expr, qualifying the types declared in the package of
+// the interface with its name
+func (c converter) typeString(expr ast.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if !e.IsExported() {
+			return e.Name, nil
+		}
+		return c.pkgName + "." + e.Name, nil
+	case *ast.SelectorExpr:
+		x, ok := e.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported type %T", e.X)
+		}
+		p, ok := c.imports[x.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", x.Name)
+		}
+		c.used[p] = true
+		return x.Name + "." + e.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := c.typeString(e.X)
+		return "*" + s, err
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return "", fmt.Errorf("arrays are not supported")
+		}
+		s, err := c.typeString(e.Elt)
+		return "[]" + s, err
+	case *ast.MapType:
+		k, err := c.typeString(e.Key)
+		if err != nil {
+			return "", err
+		}
+		v, err := c.typeString(e.Value)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(e.Methods.List) > 0 {
+			return "", fmt.Errorf("interface literals are not supported")
+		}
+		return "interface{}", nil
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+// fileName returns the name of the file for a mock, e.g.
+// access_client_service.go for AccessClientService
+func fileName(mock string) string {
+	var b strings.Builder
+	runes := []rune(mock)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) &&
+			(!unicode.IsUpper(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String() + ".go"
+}
+
+// funcs are the functions available to the template
+var funcs = template.FuncMap{
+	// signature writes params as a parameter list
+	"signature": func(params []param) string {
+		list := make([]string, 0, len(params))
+		for _, p := range params {
+			list = append(list, p.Name+" "+p.Type)
+		}
+		return strings.Join(list, ", ")
+	},
+
+	// resultList writes results as the result list of a signature
+	"resultList": func(results []string) string {
+		switch len(results) {
+		case 0:
+			return ""
+		case 1:
+			return " " + results[0]
+		}
+		return " (" + strings.Join(results, ", ") + ")"
+	},
+
+	// namedResults writes results as a parameter list
+	"namedResults": func(results []string) string {
+		list := make([]string, 0, len(results))
+		for i, r := range results {
+			list = append(list, fmt.Sprintf("r%d %s", i, r))
+		}
+		return strings.Join(list, ", ")
+	},
+
+	// resultNames writes the names of the variables that hold results
+	"resultNames": func(results []string) string {
+		names := make([]string, 0, len(results))
+		for i := range results {
+			names = append(names, fmt.Sprintf("r%d", i))
+		}
+		return strings.Join(names, ", ")
+	},
+
+	// argNames writes the names of params
+	"argNames": func(params []param) string {
+		names := make([]string, 0, len(params))
+		for _, p := range params {
+			names = append(names, p.Name)
+		}
+		return strings.Join(names, ", ")
+	},
+
+	// localNames writes the names of the variables that hold the arguments
+	// passed to Do
+	"localNames": func(params []param) string {
+		names := make([]string, 0, len(params))
+		for i := range params {
+			names = append(names, fmt.Sprintf("a%d", i))
+		}
+		return strings.Join(names, ", ")
+	},
+
+	// matchArgs writes the expected arguments of a call, matching any
+	// context
+	"matchArgs": func(params []param) string {
+		args := make([]string, 0, len(params))
+		for _, p := range params {
+			if p.Context {
+				args = append(args, "anything{}")
+			} else {
+				args = append(args, p.Name)
+			}
+		}
+		return strings.Join(args, ", ")
+	},
+
+	// nonContext returns the params that are not contexts
+	"nonContext": func(params []param) []param {
+		var filtered []param
+		for _, p := range params {
+			if !p.Context {
+				filtered = append(filtered, p)
+			}
+		}
+		return filtered
+	},
+
+	// hasContext reports whether any of params is a context
+	"hasContext": func(params []param) bool {
+		for _, p := range params {
+			if p.Context {
+				return true
+			}
+		}
+		return false
+	},
+}
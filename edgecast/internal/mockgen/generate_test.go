@@ -0,0 +1,114 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestMocksAreUpToDate(t *testing.T) {
+	tmpl, err := parseTemplate("../../../template/client/mock.gotmpl")
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	files, err := generate("../..", tmpl)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+
+	existing, err := generatedFiles("../../mocks")
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	if len(existing) != len(files) {
+		t.Fatalf(
+			"Expected %d generated files but got %d; run go generate "+
+				"./edgecast/mocks",
+			len(files),
+			len(existing))
+	}
+
+	for name, expected := range files {
+		actual, err := os.ReadFile(filepath.Join("../../mocks", name))
+		if err != nil {
+			t.Fatalf("%s: Expected no error but got %v", name, err)
+		}
+		if !bytes.Equal(expected, actual) {
+			t.Fatalf(
+				"%s: Expected the generated mock; run go generate "+
+					"./edgecast/mocks",
+				name)
+		}
+	}
+}
+
+func TestEveryClientServiceIsMocked(t *testing.T) {
+	declaration := regexp.MustCompile(
+		`(?m)^type (\w*ClientService) interface`)
+
+	mocked := map[string]bool{}
+	for _, target := range targets {
+		mocked[target.Dir+"."+target.Interface] = true
+	}
+
+	err := filepath.WalkDir(
+		"../..",
+		func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if d.Name() == "internal" || d.Name() == "mocks" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(p, ".go") ||
+				strings.HasSuffix(p, "_test.go") {
+				return nil
+			}
+
+			src, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel("../..", filepath.Dir(p))
+			if err != nil {
+				return err
+			}
+			for _, m := range declaration.FindAllSubmatch(src, -1) {
+				key := filepath.ToSlash(rel) + "." + string(m[1])
+				if !mocked[key] {
+					t.Errorf("%s: Expected a target in targets", key)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	cases := map[string]string{
+		"AccessClientService":      "access_client_service.go",
+		"DCVClientService":         "dcv_client_service.go",
+		"CPSCustomerClientService": "cps_customer_client_service.go",
+	}
+
+	for mock, expected := range cases {
+		actual := fileName(mock)
+		if actual != expected {
+			t.Fatalf("%s: Expected %s but got %s", mock, expected, actual)
+		}
+	}
+}
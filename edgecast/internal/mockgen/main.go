@@ -0,0 +1,100 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Mockgen generates package mocks from template/client/mock.gotmpl. It is run by
+go generate in the mocks directory:
+
+	go generate ./edgecast/mocks
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// generatedHeader identifies the files written by mockgen
+const generatedHeader = "// This file was generated by mockgen"
+
+func main() {
+	dir := flag.String(
+		"dir",
+		"edgecast",
+		"the edgecast directory, which contains the mocked packages")
+	out := flag.String(
+		"out",
+		"edgecast/mocks",
+		"the directory to write the mocks to")
+	tmplPath := flag.String(
+		"template",
+		"template/client/mock.gotmpl",
+		"the template that mocks are rendered from")
+	flag.Parse()
+
+	if err := run(*dir, *out, *tmplPath); err != nil {
+		fmt.Fprintln(os.Stderr, "mockgen:", err)
+		os.Exit(1)
+	}
+}
+
+// run writes the mocks to out, removing generated files that are no longer
+// needed
+func run(dir string, out string, tmplPath string) error {
+	tmpl, err := parseTemplate(tmplPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := generate(dir, tmpl)
+	if err != nil {
+		return err
+	}
+
+	existing, err := generatedFiles(out)
+	if err != nil {
+		return err
+	}
+	for _, name := range existing {
+		if _, ok := files[name]; !ok {
+			if err := os.Remove(filepath.Join(out, name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(out, name), src, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatedFiles returns the names of the files in dir written by mockgen
+func generatedFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Contains(src, []byte(generatedHeader)) {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecconfig"
+)
+
+// LoadConfigOptions controls how LoadConfig resolves the configuration.
+// Values set here take precedence over all other sources.
+type LoadConfigOptions struct {
+	// Profile is the credentials file profile to use. Defaults to the
+	// EC_PROFILE environment variable, then "default".
+	Profile string
+
+	// CredentialsFile is the location of the credentials file. Defaults to
+	// the EC_CREDENTIALS_FILE environment variable, then
+	// ~/.edgecast/credentials.
+	CredentialsFile string
+
+	APIToken       string
+	IDSCredentials IDSCredentials
+
+	// Base URLs, e.g. to target a staging environment
+	BaseAPIURL       string
+	BaseAPIURLLegacy string
+	BaseIDSURL       string
+}
+
+// LoadConfig creates an SDKConfig whose credentials and base URLs are
+// resolved from the following sources, in order of precedence:
+//
+//  1. The values set in options
+//  2. Environment variables: EC_API_TOKEN, EC_IDS_CLIENT_ID,
+//     EC_IDS_CLIENT_SECRET, EC_IDS_SCOPE, EC_BASE_API_URL,
+//     EC_BASE_API_URL_LEGACY and EC_BASE_IDS_URL
+//  3. A profile in the credentials file, which uses INI or TOML syntax with
+//     the keys api_token, ids_client_id, ids_client_secret, ids_scope,
+//     base_api_url, base_api_url_legacy and base_ids_url
+//  4. The defaults of NewSDKConfig
+//
+// Credentials, i.e. the API token and IDS credentials, are all taken from the
+// first source that sets any of them, so that e.g. a client ID set in the
+// environment is never combined with a secret from the credentials file.
+//
+// A missing credentials file, or a missing "default" profile, is not an
+// error unless the file or profile was selected explicitly.
+func LoadConfig(options LoadConfigOptions) (SDKConfig, error) {
+	explicit := ecconfig.Values{
+		ecconfig.KeyAPIToken:         options.APIToken,
+		ecconfig.KeyIDSClientID:      options.IDSCredentials.ClientID,
+		ecconfig.KeyIDSClientSecret:  options.IDSCredentials.ClientSecret,
+		ecconfig.KeyIDSScope:         options.IDSCredentials.Scope,
+		ecconfig.KeyBaseAPIURL:       options.BaseAPIURL,
+		ecconfig.KeyBaseAPIURLLegacy: options.BaseAPIURLLegacy,
+		ecconfig.KeyBaseIDSURL:       options.BaseIDSURL,
+	}
+
+	profile, profileRequired := firstNonEmpty(
+		options.Profile,
+		os.Getenv(ecconfig.EnvProfile))
+	if len(profile) == 0 {
+		profile = ecconfig.DefaultProfile
+	}
+
+	path, fileRequired := firstNonEmpty(
+		options.CredentialsFile,
+		os.Getenv(ecconfig.EnvCredentialsFile))
+	if len(path) == 0 {
+		// Without a home directory there is no default file to read
+		path, _ = ecconfig.DefaultCredentialsFile()
+	}
+
+	file := ecconfig.Values{}
+	if len(path) > 0 {
+		var err error
+		file, err = ecconfig.LoadProfile(
+			path,
+			profile,
+			fileRequired || profileRequired)
+		if err != nil {
+			return SDKConfig{}, fmt.Errorf("LoadConfig: %w", err)
+		}
+	}
+
+	values := ecconfig.Merge(explicit, ecconfig.FromEnv(os.Getenv), file)
+
+	config := NewSDKConfig()
+	config.APIToken = values[ecconfig.KeyAPIToken]
+	config.IDSCredentials = IDSCredentials{
+		ClientID:     values[ecconfig.KeyIDSClientID],
+		ClientSecret: values[ecconfig.KeyIDSClientSecret],
+		Scope:        values[ecconfig.KeyIDSScope],
+	}
+
+	urls := []struct {
+		key    string
+		target *url.URL
+	}{
+		{ecconfig.KeyBaseAPIURL, &config.BaseAPIURL},
+		{ecconfig.KeyBaseAPIURLLegacy, &config.BaseAPIURLLegacy},
+		{ecconfig.KeyBaseIDSURL, &config.BaseIDSURL},
+	}
+	for _, u := range urls {
+		raw, ok := values[u.key]
+		if !ok {
+			continue
+		}
+
+		parsed, err := url.Parse(raw)
+		if err != nil {
+			return SDKConfig{}, fmt.Errorf("LoadConfig: %s: %w", u.key, err)
+		}
+		*u.target = *parsed
+	}
+
+	return config, nil
+}
+
+// firstNonEmpty returns the first non-empty value and whether one was found
+func firstNonEmpty(values ...string) (string, bool) {
+	for _, v := range values {
+		if len(v) > 0 {
+			return v, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecconfig"
+)
+
+const testCredentials = `
+[default]
+api_token = file-token
+ids_client_secret = file-secret
+ids_scope = file-scope
+
+[profile staging]
+ids_client_id = staging-id
+ids_client_secret = staging-secret
+base_api_url = https://staging.example.com/api
+`
+
+// isolateConfig clears the environment variables read by LoadConfig and
+// points the home directory at an empty temporary directory, which it returns
+func isolateConfig(t *testing.T) string {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(ecconfig.EnvProfile, "")
+	t.Setenv(ecconfig.EnvCredentialsFile, "")
+	for _, key := range ecconfig.Keys {
+		t.Setenv(ecconfig.EnvVar(key), "")
+	}
+	return home
+}
+
+func TestLoadConfig(t *testing.T) {
+	defaults := NewSDKConfig()
+
+	cases := []struct {
+		name    string
+		options LoadConfigOptions
+		env     map[string]string
+
+		// file is written to the default credentials file if it is set
+		file string
+
+		expectedToken  string
+		expectedIDS    IDSCredentials
+		expectedAPIURL string
+		expectedIDSURL string
+		err            string
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name:          "default profile",
+			file:          testCredentials,
+			expectedToken: "file-token",
+			expectedIDS: IDSCredentials{
+				ClientSecret: "file-secret",
+				Scope:        "file-scope",
+			},
+		},
+		{
+			name:          "environment over file",
+			env:           map[string]string{"EC_API_TOKEN": "env-token"},
+			file:          testCredentials,
+			expectedToken: "env-token",
+		},
+		{
+			name:        "credentials from a single source",
+			env:         map[string]string{"EC_IDS_CLIENT_ID": "env-id"},
+			file:        testCredentials,
+			expectedIDS: IDSCredentials{ClientID: "env-id"},
+		},
+		{
+			name:          "options over environment",
+			options:       LoadConfigOptions{APIToken: "option-token"},
+			env:           map[string]string{"EC_IDS_CLIENT_ID": "env-id"},
+			expectedToken: "option-token",
+		},
+		{
+			name:          "other values from every source",
+			env:           map[string]string{"EC_BASE_IDS_URL": "https://ids"},
+			file:          testCredentials,
+			expectedToken: "file-token",
+			expectedIDS: IDSCredentials{
+				ClientSecret: "file-secret",
+				Scope:        "file-scope",
+			},
+			expectedIDSURL: "https://ids",
+		},
+		{
+			name: "profile from environment",
+			env:  map[string]string{"EC_PROFILE": "staging"},
+			file: testCredentials,
+			expectedIDS: IDSCredentials{
+				ClientID:     "staging-id",
+				ClientSecret: "staging-secret",
+			},
+			expectedAPIURL: "https://staging.example.com/api",
+		},
+		{
+			name: "missing default profile",
+			file: "[other]\napi_token = other",
+		},
+		{
+			name:    "missing selected profile",
+			options: LoadConfigOptions{Profile: "production"},
+			file:    testCredentials,
+			err:     `profile "production" not found`,
+		},
+		{
+			name:    "selected profile without a file",
+			options: LoadConfigOptions{Profile: "staging"},
+			err:     "no such file",
+		},
+		{
+			name: "missing file from environment",
+			env: map[string]string{
+				"EC_CREDENTIALS_FILE": filepath.Join(t.TempDir(), "missing"),
+			},
+			err: "no such file",
+		},
+		{
+			name: "invalid base URL",
+			env:  map[string]string{"EC_BASE_API_URL": "%zz"},
+			err:  "LoadConfig: base_api_url: parse",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			home := isolateConfig(t)
+			for k, v := range c.env {
+				t.Setenv(k, v)
+			}
+
+			if len(c.file) > 0 {
+				dir := filepath.Join(home, ".edgecast")
+				if err := os.MkdirAll(dir, 0700); err != nil {
+					t.Fatalf("MkdirAll: %v", err)
+				}
+				path := filepath.Join(dir, "credentials")
+				err := os.WriteFile(path, []byte(c.file), 0600)
+				if err != nil {
+					t.Fatalf("WriteFile: %v", err)
+				}
+			}
+
+			config, err := LoadConfig(c.options)
+			if len(c.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("Expected %q but got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+
+			if len(c.expectedAPIURL) == 0 {
+				c.expectedAPIURL = defaults.BaseAPIURL.String()
+			}
+			if len(c.expectedIDSURL) == 0 {
+				c.expectedIDSURL = defaults.BaseIDSURL.String()
+			}
+			if config.APIToken != c.expectedToken ||
+				config.IDSCredentials != c.expectedIDS ||
+				config.BaseAPIURL.String() != c.expectedAPIURL ||
+				config.BaseIDSURL.String() != c.expectedIDSURL {
+				t.Fatalf(
+					"Expected %q, %+v, %s, %s but got %q, %+v, %s, %s",
+					c.expectedToken,
+					c.expectedIDS,
+					c.expectedAPIURL,
+					c.expectedIDSURL,
+					config.APIToken,
+					config.IDSCredentials,
+					config.BaseAPIURL.String(),
+					config.BaseIDSURL.String())
+			}
+		})
+	}
+}
+
+func TestLoadConfigFileOption(t *testing.T) {
+	isolateConfig(t)
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	_, err := LoadConfig(LoadConfigOptions{CredentialsFile: path})
+	if err == nil || !strings.Contains(err.Error(), "no such file") {
+		t.Fatalf("Expected a missing file error but got %v", err)
+	}
+
+	err = os.WriteFile(path, []byte(testCredentials), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	config, err := LoadConfig(LoadConfigOptions{
+		CredentialsFile: path,
+		Profile:         "staging",
+	})
+	if err != nil || config.IDSCredentials.ClientID != "staging-id" {
+		t.Fatalf(
+			"Expected the staging profile but got %+v, %v",
+			config.IDSCredentials,
+			err)
+	}
+
+	// A file selected explicitly must hold the profile, even the default one
+	err = os.WriteFile(path, []byte("[other]\napi_token = other"), 0600)
+	if err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	_, err = LoadConfig(LoadConfigOptions{CredentialsFile: path})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("Expected a missing profile error but got %v", err)
+	}
+}
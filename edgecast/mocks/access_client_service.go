@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+)
+
+// AccessClientService is a mock of access.ClientService
+type AccessClientService struct {
+	controller *controller
+}
+
+var _ access.ClientService = (*AccessClientService)(nil)
+
+// NewAccessClientService creates a mock of access.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewAccessClientService(t TestingT) *AccessClientService {
+	return &AccessClientService{controller: newController(t, "AccessClientService")}
+}
+
+// AddAccessRule returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) AddAccessRule(params access.AddAccessRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddAccessRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceAddAccessRuleCall is an expected call to AddAccessRule
+type AccessClientServiceAddAccessRuleCall struct {
+	e *expectation
+}
+
+// ExpectAddAccessRule expects a single call to AddAccessRule with any arguments
+func (m *AccessClientService) ExpectAddAccessRule() *AccessClientServiceAddAccessRuleCall {
+	return &AccessClientServiceAddAccessRuleCall{m.controller.expect("AddAccessRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AccessClientServiceAddAccessRuleCall) With(params access.AddAccessRuleParams) *AccessClientServiceAddAccessRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceAddAccessRuleCall) Return(r0 string, r1 error) *AccessClientServiceAddAccessRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceAddAccessRuleCall) Do(
+	fn func(params access.AddAccessRuleParams) (string, error),
+) *AccessClientServiceAddAccessRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(access.AddAccessRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceAddAccessRuleCall) Times(n int) *AccessClientServiceAddAccessRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceAddAccessRuleCall) AnyTimes() *AccessClientServiceAddAccessRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddAccessRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) AddAccessRuleWithContext(ctx context.Context, params access.AddAccessRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddAccessRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceAddAccessRuleWithContextCall is an expected call to AddAccessRuleWithContext
+type AccessClientServiceAddAccessRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddAccessRuleWithContext expects a single call to AddAccessRuleWithContext with any arguments
+func (m *AccessClientService) ExpectAddAccessRuleWithContext() *AccessClientServiceAddAccessRuleWithContextCall {
+	return &AccessClientServiceAddAccessRuleWithContextCall{m.controller.expect("AddAccessRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AccessClientServiceAddAccessRuleWithContextCall) With(params access.AddAccessRuleParams) *AccessClientServiceAddAccessRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceAddAccessRuleWithContextCall) Return(r0 string, r1 error) *AccessClientServiceAddAccessRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceAddAccessRuleWithContextCall) Do(
+	fn func(ctx context.Context, params access.AddAccessRuleParams) (string, error),
+) *AccessClientServiceAddAccessRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(access.AddAccessRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceAddAccessRuleWithContextCall) Times(n int) *AccessClientServiceAddAccessRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceAddAccessRuleWithContextCall) AnyTimes() *AccessClientServiceAddAccessRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllAccessRules returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) GetAllAccessRules(params access.GetAllAccessRulesParams) (*[]access.AccessRuleGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllAccessRules",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]access.AccessRuleGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceGetAllAccessRulesCall is an expected call to GetAllAccessRules
+type AccessClientServiceGetAllAccessRulesCall struct {
+	e *expectation
+}
+
+// ExpectGetAllAccessRules expects a single call to GetAllAccessRules with any arguments
+func (m *AccessClientService) ExpectGetAllAccessRules() *AccessClientServiceGetAllAccessRulesCall {
+	return &AccessClientServiceGetAllAccessRulesCall{m.controller.expect("GetAllAccessRules")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AccessClientServiceGetAllAccessRulesCall) With(params access.GetAllAccessRulesParams) *AccessClientServiceGetAllAccessRulesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceGetAllAccessRulesCall) Return(r0 *[]access.AccessRuleGetAllOK, r1 error) *AccessClientServiceGetAllAccessRulesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceGetAllAccessRulesCall) Do(
+	fn func(params access.GetAllAccessRulesParams) (*[]access.AccessRuleGetAllOK, error),
+) *AccessClientServiceGetAllAccessRulesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(access.GetAllAccessRulesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceGetAllAccessRulesCall) Times(n int) *AccessClientServiceGetAllAccessRulesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceGetAllAccessRulesCall) AnyTimes() *AccessClientServiceGetAllAccessRulesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllAccessRulesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) GetAllAccessRulesWithContext(ctx context.Context, params access.GetAllAccessRulesParams) (*[]access.AccessRuleGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllAccessRulesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]access.AccessRuleGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceGetAllAccessRulesWithContextCall is an expected call to GetAllAccessRulesWithContext
+type AccessClientServiceGetAllAccessRulesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllAccessRulesWithContext expects a single call to GetAllAccessRulesWithContext with any arguments
+func (m *AccessClientService) ExpectGetAllAccessRulesWithContext() *AccessClientServiceGetAllAccessRulesWithContextCall {
+	return &AccessClientServiceGetAllAccessRulesWithContextCall{m.controller.expect("GetAllAccessRulesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AccessClientServiceGetAllAccessRulesWithContextCall) With(params access.GetAllAccessRulesParams) *AccessClientServiceGetAllAccessRulesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceGetAllAccessRulesWithContextCall) Return(r0 *[]access.AccessRuleGetAllOK, r1 error) *AccessClientServiceGetAllAccessRulesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceGetAllAccessRulesWithContextCall) Do(
+	fn func(ctx context.Context, params access.GetAllAccessRulesParams) (*[]access.AccessRuleGetAllOK, error),
+) *AccessClientServiceGetAllAccessRulesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(access.GetAllAccessRulesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceGetAllAccessRulesWithContextCall) Times(n int) *AccessClientServiceGetAllAccessRulesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceGetAllAccessRulesWithContextCall) AnyTimes() *AccessClientServiceGetAllAccessRulesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAccessRule returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) GetAccessRule(params access.GetAccessRuleParams) (*access.AccessRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAccessRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*access.AccessRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceGetAccessRuleCall is an expected call to GetAccessRule
+type AccessClientServiceGetAccessRuleCall struct {
+	e *expectation
+}
+
+// ExpectGetAccessRule expects a single call to GetAccessRule with any arguments
+func (m *AccessClientService) ExpectGetAccessRule() *AccessClientServiceGetAccessRuleCall {
+	return &AccessClientServiceGetAccessRuleCall{m.controller.expect("GetAccessRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AccessClientServiceGetAccessRuleCall) With(params access.GetAccessRuleParams) *AccessClientServiceGetAccessRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceGetAccessRuleCall) Return(r0 *access.AccessRuleGetOK, r1 error) *AccessClientServiceGetAccessRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceGetAccessRuleCall) Do(
+	fn func(params access.GetAccessRuleParams) (*access.AccessRuleGetOK, error),
+) *AccessClientServiceGetAccessRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(access.GetAccessRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceGetAccessRuleCall) Times(n int) *AccessClientServiceGetAccessRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceGetAccessRuleCall) AnyTimes() *AccessClientServiceGetAccessRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAccessRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) GetAccessRuleWithContext(ctx context.Context, params access.GetAccessRuleParams) (*access.AccessRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAccessRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*access.AccessRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AccessClientServiceGetAccessRuleWithContextCall is an expected call to GetAccessRuleWithContext
+type AccessClientServiceGetAccessRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAccessRuleWithContext expects a single call to GetAccessRuleWithContext with any arguments
+func (m *AccessClientService) ExpectGetAccessRuleWithContext() *AccessClientServiceGetAccessRuleWithContextCall {
+	return &AccessClientServiceGetAccessRuleWithContextCall{m.controller.expect("GetAccessRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AccessClientServiceGetAccessRuleWithContextCall) With(params access.GetAccessRuleParams) *AccessClientServiceGetAccessRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceGetAccessRuleWithContextCall) Return(r0 *access.AccessRuleGetOK, r1 error) *AccessClientServiceGetAccessRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceGetAccessRuleWithContextCall) Do(
+	fn func(ctx context.Context, params access.GetAccessRuleParams) (*access.AccessRuleGetOK, error),
+) *AccessClientServiceGetAccessRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(access.GetAccessRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceGetAccessRuleWithContextCall) Times(n int) *AccessClientServiceGetAccessRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceGetAccessRuleWithContextCall) AnyTimes() *AccessClientServiceGetAccessRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateAccessRule returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) UpdateAccessRule(params access.UpdateAccessRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateAccessRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// AccessClientServiceUpdateAccessRuleCall is an expected call to UpdateAccessRule
+type AccessClientServiceUpdateAccessRuleCall struct {
+	e *expectation
+}
+
+// ExpectUpdateAccessRule expects a single call to UpdateAccessRule with any arguments
+func (m *AccessClientService) ExpectUpdateAccessRule() *AccessClientServiceUpdateAccessRuleCall {
+	return &AccessClientServiceUpdateAccessRuleCall{m.controller.expect("UpdateAccessRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AccessClientServiceUpdateAccessRuleCall) With(params access.UpdateAccessRuleParams) *AccessClientServiceUpdateAccessRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceUpdateAccessRuleCall) Return(r0 error) *AccessClientServiceUpdateAccessRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceUpdateAccessRuleCall) Do(
+	fn func(params access.UpdateAccessRuleParams) error,
+) *AccessClientServiceUpdateAccessRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(access.UpdateAccessRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceUpdateAccessRuleCall) Times(n int) *AccessClientServiceUpdateAccessRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceUpdateAccessRuleCall) AnyTimes() *AccessClientServiceUpdateAccessRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateAccessRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) UpdateAccessRuleWithContext(ctx context.Context, params access.UpdateAccessRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateAccessRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// AccessClientServiceUpdateAccessRuleWithContextCall is an expected call to UpdateAccessRuleWithContext
+type AccessClientServiceUpdateAccessRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateAccessRuleWithContext expects a single call to UpdateAccessRuleWithContext with any arguments
+func (m *AccessClientService) ExpectUpdateAccessRuleWithContext() *AccessClientServiceUpdateAccessRuleWithContextCall {
+	return &AccessClientServiceUpdateAccessRuleWithContextCall{m.controller.expect("UpdateAccessRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AccessClientServiceUpdateAccessRuleWithContextCall) With(params access.UpdateAccessRuleParams) *AccessClientServiceUpdateAccessRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceUpdateAccessRuleWithContextCall) Return(r0 error) *AccessClientServiceUpdateAccessRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceUpdateAccessRuleWithContextCall) Do(
+	fn func(ctx context.Context, params access.UpdateAccessRuleParams) error,
+) *AccessClientServiceUpdateAccessRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(access.UpdateAccessRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceUpdateAccessRuleWithContextCall) Times(n int) *AccessClientServiceUpdateAccessRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceUpdateAccessRuleWithContextCall) AnyTimes() *AccessClientServiceUpdateAccessRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteAccessRule returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) DeleteAccessRule(params access.DeleteAccessRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteAccessRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// AccessClientServiceDeleteAccessRuleCall is an expected call to DeleteAccessRule
+type AccessClientServiceDeleteAccessRuleCall struct {
+	e *expectation
+}
+
+// ExpectDeleteAccessRule expects a single call to DeleteAccessRule with any arguments
+func (m *AccessClientService) ExpectDeleteAccessRule() *AccessClientServiceDeleteAccessRuleCall {
+	return &AccessClientServiceDeleteAccessRuleCall{m.controller.expect("DeleteAccessRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AccessClientServiceDeleteAccessRuleCall) With(params access.DeleteAccessRuleParams) *AccessClientServiceDeleteAccessRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceDeleteAccessRuleCall) Return(r0 error) *AccessClientServiceDeleteAccessRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceDeleteAccessRuleCall) Do(
+	fn func(params access.DeleteAccessRuleParams) error,
+) *AccessClientServiceDeleteAccessRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(access.DeleteAccessRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceDeleteAccessRuleCall) Times(n int) *AccessClientServiceDeleteAccessRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceDeleteAccessRuleCall) AnyTimes() *AccessClientServiceDeleteAccessRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteAccessRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AccessClientService) DeleteAccessRuleWithContext(ctx context.Context, params access.DeleteAccessRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteAccessRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// AccessClientServiceDeleteAccessRuleWithContextCall is an expected call to DeleteAccessRuleWithContext
+type AccessClientServiceDeleteAccessRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteAccessRuleWithContext expects a single call to DeleteAccessRuleWithContext with any arguments
+func (m *AccessClientService) ExpectDeleteAccessRuleWithContext() *AccessClientServiceDeleteAccessRuleWithContextCall {
+	return &AccessClientServiceDeleteAccessRuleWithContextCall{m.controller.expect("DeleteAccessRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AccessClientServiceDeleteAccessRuleWithContextCall) With(params access.DeleteAccessRuleParams) *AccessClientServiceDeleteAccessRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AccessClientServiceDeleteAccessRuleWithContextCall) Return(r0 error) *AccessClientServiceDeleteAccessRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AccessClientServiceDeleteAccessRuleWithContextCall) Do(
+	fn func(ctx context.Context, params access.DeleteAccessRuleParams) error,
+) *AccessClientServiceDeleteAccessRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(access.DeleteAccessRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AccessClientServiceDeleteAccessRuleWithContextCall) Times(n int) *AccessClientServiceDeleteAccessRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AccessClientServiceDeleteAccessRuleWithContextCall) AnyTimes() *AccessClientServiceDeleteAccessRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,500 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+)
+
+// AdnOnlyClientService is a mock of originv3.AdnOnlyClientService
+type AdnOnlyClientService struct {
+	controller *controller
+}
+
+var _ originv3.AdnOnlyClientService = (*AdnOnlyClientService)(nil)
+
+// NewAdnOnlyClientService creates a mock of originv3.AdnOnlyClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewAdnOnlyClientService(t TestingT) *AdnOnlyClientService {
+	return &AdnOnlyClientService{controller: newController(t, "AdnOnlyClientService")}
+}
+
+// AddAdnGroup returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) AddAdnGroup(params originv3.AddAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddAdnGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceAddAdnGroupCall is an expected call to AddAdnGroup
+type AdnOnlyClientServiceAddAdnGroupCall struct {
+	e *expectation
+}
+
+// ExpectAddAdnGroup expects a single call to AddAdnGroup with any arguments
+func (m *AdnOnlyClientService) ExpectAddAdnGroup() *AdnOnlyClientServiceAddAdnGroupCall {
+	return &AdnOnlyClientServiceAddAdnGroupCall{m.controller.expect("AddAdnGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AdnOnlyClientServiceAddAdnGroupCall) With(params originv3.AddAdnGroupParams) *AdnOnlyClientServiceAddAdnGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceAddAdnGroupCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceAddAdnGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceAddAdnGroupCall) Do(
+	fn func(params originv3.AddAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceAddAdnGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.AddAdnGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceAddAdnGroupCall) Times(n int) *AdnOnlyClientServiceAddAdnGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceAddAdnGroupCall) AnyTimes() *AdnOnlyClientServiceAddAdnGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddAdnGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) AddAdnGroupWithContext(ctx context.Context, params originv3.AddAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddAdnGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceAddAdnGroupWithContextCall is an expected call to AddAdnGroupWithContext
+type AdnOnlyClientServiceAddAdnGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddAdnGroupWithContext expects a single call to AddAdnGroupWithContext with any arguments
+func (m *AdnOnlyClientService) ExpectAddAdnGroupWithContext() *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	return &AdnOnlyClientServiceAddAdnGroupWithContextCall{m.controller.expect("AddAdnGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AdnOnlyClientServiceAddAdnGroupWithContextCall) With(params originv3.AddAdnGroupParams) *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceAddAdnGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceAddAdnGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.AddAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.AddAdnGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceAddAdnGroupWithContextCall) Times(n int) *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceAddAdnGroupWithContextCall) AnyTimes() *AdnOnlyClientServiceAddAdnGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAdnGroup returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) GetAdnGroup(params originv3.GetAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAdnGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceGetAdnGroupCall is an expected call to GetAdnGroup
+type AdnOnlyClientServiceGetAdnGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetAdnGroup expects a single call to GetAdnGroup with any arguments
+func (m *AdnOnlyClientService) ExpectGetAdnGroup() *AdnOnlyClientServiceGetAdnGroupCall {
+	return &AdnOnlyClientServiceGetAdnGroupCall{m.controller.expect("GetAdnGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AdnOnlyClientServiceGetAdnGroupCall) With(params originv3.GetAdnGroupParams) *AdnOnlyClientServiceGetAdnGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceGetAdnGroupCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceGetAdnGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceGetAdnGroupCall) Do(
+	fn func(params originv3.GetAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceGetAdnGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetAdnGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceGetAdnGroupCall) Times(n int) *AdnOnlyClientServiceGetAdnGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceGetAdnGroupCall) AnyTimes() *AdnOnlyClientServiceGetAdnGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAdnGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) GetAdnGroupWithContext(ctx context.Context, params originv3.GetAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAdnGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceGetAdnGroupWithContextCall is an expected call to GetAdnGroupWithContext
+type AdnOnlyClientServiceGetAdnGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAdnGroupWithContext expects a single call to GetAdnGroupWithContext with any arguments
+func (m *AdnOnlyClientService) ExpectGetAdnGroupWithContext() *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	return &AdnOnlyClientServiceGetAdnGroupWithContextCall{m.controller.expect("GetAdnGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AdnOnlyClientServiceGetAdnGroupWithContextCall) With(params originv3.GetAdnGroupParams) *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceGetAdnGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceGetAdnGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetAdnGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceGetAdnGroupWithContextCall) Times(n int) *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceGetAdnGroupWithContextCall) AnyTimes() *AdnOnlyClientServiceGetAdnGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllAdnGroups returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) GetAllAdnGroups() ([]originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllAdnGroups",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceGetAllAdnGroupsCall is an expected call to GetAllAdnGroups
+type AdnOnlyClientServiceGetAllAdnGroupsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllAdnGroups expects a single call to GetAllAdnGroups with any arguments
+func (m *AdnOnlyClientService) ExpectGetAllAdnGroups() *AdnOnlyClientServiceGetAllAdnGroupsCall {
+	return &AdnOnlyClientServiceGetAllAdnGroupsCall{m.controller.expect("GetAllAdnGroups")}
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceGetAllAdnGroupsCall) Return(r0 []originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceGetAllAdnGroupsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceGetAllAdnGroupsCall) Do(
+	fn func() ([]originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceGetAllAdnGroupsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceGetAllAdnGroupsCall) Times(n int) *AdnOnlyClientServiceGetAllAdnGroupsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceGetAllAdnGroupsCall) AnyTimes() *AdnOnlyClientServiceGetAllAdnGroupsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllAdnGroupsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) GetAllAdnGroupsWithContext(ctx context.Context) ([]originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllAdnGroupsWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceGetAllAdnGroupsWithContextCall is an expected call to GetAllAdnGroupsWithContext
+type AdnOnlyClientServiceGetAllAdnGroupsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllAdnGroupsWithContext expects a single call to GetAllAdnGroupsWithContext with any arguments
+func (m *AdnOnlyClientService) ExpectGetAllAdnGroupsWithContext() *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall {
+	return &AdnOnlyClientServiceGetAllAdnGroupsWithContextCall{m.controller.expect("GetAllAdnGroupsWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall) Return(r0 []originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall) Do(
+	fn func(ctx context.Context) ([]originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall) Times(n int) *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall) AnyTimes() *AdnOnlyClientServiceGetAllAdnGroupsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateAdnGroup returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) UpdateAdnGroup(params originv3.UpdateAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateAdnGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceUpdateAdnGroupCall is an expected call to UpdateAdnGroup
+type AdnOnlyClientServiceUpdateAdnGroupCall struct {
+	e *expectation
+}
+
+// ExpectUpdateAdnGroup expects a single call to UpdateAdnGroup with any arguments
+func (m *AdnOnlyClientService) ExpectUpdateAdnGroup() *AdnOnlyClientServiceUpdateAdnGroupCall {
+	return &AdnOnlyClientServiceUpdateAdnGroupCall{m.controller.expect("UpdateAdnGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AdnOnlyClientServiceUpdateAdnGroupCall) With(params originv3.UpdateAdnGroupParams) *AdnOnlyClientServiceUpdateAdnGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceUpdateAdnGroupCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceUpdateAdnGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceUpdateAdnGroupCall) Do(
+	fn func(params originv3.UpdateAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceUpdateAdnGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.UpdateAdnGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceUpdateAdnGroupCall) Times(n int) *AdnOnlyClientServiceUpdateAdnGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceUpdateAdnGroupCall) AnyTimes() *AdnOnlyClientServiceUpdateAdnGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateAdnGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AdnOnlyClientService) UpdateAdnGroupWithContext(ctx context.Context, params originv3.UpdateAdnGroupParams) (*originv3.CustomerOriginGroupADN, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateAdnGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupADN)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AdnOnlyClientServiceUpdateAdnGroupWithContextCall is an expected call to UpdateAdnGroupWithContext
+type AdnOnlyClientServiceUpdateAdnGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateAdnGroupWithContext expects a single call to UpdateAdnGroupWithContext with any arguments
+func (m *AdnOnlyClientService) ExpectUpdateAdnGroupWithContext() *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	return &AdnOnlyClientServiceUpdateAdnGroupWithContextCall{m.controller.expect("UpdateAdnGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AdnOnlyClientServiceUpdateAdnGroupWithContextCall) With(params originv3.UpdateAdnGroupParams) *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AdnOnlyClientServiceUpdateAdnGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupADN, r1 error) *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AdnOnlyClientServiceUpdateAdnGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.UpdateAdnGroupParams) (*originv3.CustomerOriginGroupADN, error),
+) *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.UpdateAdnGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AdnOnlyClientServiceUpdateAdnGroupWithContextCall) Times(n int) *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AdnOnlyClientServiceUpdateAdnGroupWithContextCall) AnyTimes() *AdnOnlyClientServiceUpdateAdnGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,1369 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/appendix"
+)
+
+// AppendixClientService is a mock of appendix.ClientService
+type AppendixClientService struct {
+	controller *controller
+}
+
+var _ appendix.ClientService = (*AppendixClientService)(nil)
+
+// NewAppendixClientService creates a mock of appendix.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewAppendixClientService(t TestingT) *AppendixClientService {
+	return &AppendixClientService{controller: newController(t, "AppendixClientService")}
+}
+
+// AppendixGet returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGet(params appendix.AppendixGetParams) (*appendix.AppendixGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCall is an expected call to AppendixGet
+type AppendixClientServiceAppendixGetCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGet expects a single call to AppendixGet with any arguments
+func (m *AppendixClientService) ExpectAppendixGet() *AppendixClientServiceAppendixGetCall {
+	return &AppendixClientServiceAppendixGetCall{m.controller.expect("AppendixGet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetCall) With(params appendix.AppendixGetParams) *AppendixClientServiceAppendixGetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCall) Return(r0 *appendix.AppendixGetOK, r1 error) *AppendixClientServiceAppendixGetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCall) Do(
+	fn func(params appendix.AppendixGetParams) (*appendix.AppendixGetOK, error),
+) *AppendixClientServiceAppendixGetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCall) Times(n int) *AppendixClientServiceAppendixGetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCall) AnyTimes() *AppendixClientServiceAppendixGetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetWithContext(ctx context.Context, params appendix.AppendixGetParams) (*appendix.AppendixGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetWithContextCall is an expected call to AppendixGetWithContext
+type AppendixClientServiceAppendixGetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetWithContext expects a single call to AppendixGetWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetWithContext() *AppendixClientServiceAppendixGetWithContextCall {
+	return &AppendixClientServiceAppendixGetWithContextCall{m.controller.expect("AppendixGetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetWithContextCall) With(params appendix.AppendixGetParams) *AppendixClientServiceAppendixGetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetWithContextCall) Return(r0 *appendix.AppendixGetOK, r1 error) *AppendixClientServiceAppendixGetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetParams) (*appendix.AppendixGetOK, error),
+) *AppendixClientServiceAppendixGetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetWithContextCall) Times(n int) *AppendixClientServiceAppendixGetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCancelActions returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCancelActions(params appendix.AppendixGetCancelActionsParams) (*appendix.AppendixGetCancelActionsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCancelActions",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCancelActionsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCancelActionsCall is an expected call to AppendixGetCancelActions
+type AppendixClientServiceAppendixGetCancelActionsCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCancelActions expects a single call to AppendixGetCancelActions with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCancelActions() *AppendixClientServiceAppendixGetCancelActionsCall {
+	return &AppendixClientServiceAppendixGetCancelActionsCall{m.controller.expect("AppendixGetCancelActions")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetCancelActionsCall) With(params appendix.AppendixGetCancelActionsParams) *AppendixClientServiceAppendixGetCancelActionsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCancelActionsCall) Return(r0 *appendix.AppendixGetCancelActionsOK, r1 error) *AppendixClientServiceAppendixGetCancelActionsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCancelActionsCall) Do(
+	fn func(params appendix.AppendixGetCancelActionsParams) (*appendix.AppendixGetCancelActionsOK, error),
+) *AppendixClientServiceAppendixGetCancelActionsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetCancelActionsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCancelActionsCall) Times(n int) *AppendixClientServiceAppendixGetCancelActionsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCancelActionsCall) AnyTimes() *AppendixClientServiceAppendixGetCancelActionsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCancelActionsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCancelActionsWithContext(ctx context.Context, params appendix.AppendixGetCancelActionsParams) (*appendix.AppendixGetCancelActionsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCancelActionsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCancelActionsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCancelActionsWithContextCall is an expected call to AppendixGetCancelActionsWithContext
+type AppendixClientServiceAppendixGetCancelActionsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCancelActionsWithContext expects a single call to AppendixGetCancelActionsWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCancelActionsWithContext() *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	return &AppendixClientServiceAppendixGetCancelActionsWithContextCall{m.controller.expect("AppendixGetCancelActionsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetCancelActionsWithContextCall) With(params appendix.AppendixGetCancelActionsParams) *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCancelActionsWithContextCall) Return(r0 *appendix.AppendixGetCancelActionsOK, r1 error) *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCancelActionsWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetCancelActionsParams) (*appendix.AppendixGetCancelActionsOK, error),
+) *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetCancelActionsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCancelActionsWithContextCall) Times(n int) *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCancelActionsWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetCancelActionsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCertificateAuthorities returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCertificateAuthorities(params appendix.AppendixGetCertificateAuthoritiesParams) (*appendix.AppendixGetCertificateAuthoritiesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCertificateAuthorities",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCertificateAuthoritiesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCertificateAuthoritiesCall is an expected call to AppendixGetCertificateAuthorities
+type AppendixClientServiceAppendixGetCertificateAuthoritiesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCertificateAuthorities expects a single call to AppendixGetCertificateAuthorities with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCertificateAuthorities() *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	return &AppendixClientServiceAppendixGetCertificateAuthoritiesCall{m.controller.expect("AppendixGetCertificateAuthorities")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesCall) With(params appendix.AppendixGetCertificateAuthoritiesParams) *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesCall) Return(r0 *appendix.AppendixGetCertificateAuthoritiesOK, r1 error) *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesCall) Do(
+	fn func(params appendix.AppendixGetCertificateAuthoritiesParams) (*appendix.AppendixGetCertificateAuthoritiesOK, error),
+) *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetCertificateAuthoritiesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesCall) Times(n int) *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesCall) AnyTimes() *AppendixClientServiceAppendixGetCertificateAuthoritiesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCertificateAuthoritiesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCertificateAuthoritiesWithContext(ctx context.Context, params appendix.AppendixGetCertificateAuthoritiesParams) (*appendix.AppendixGetCertificateAuthoritiesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCertificateAuthoritiesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCertificateAuthoritiesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall is an expected call to AppendixGetCertificateAuthoritiesWithContext
+type AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCertificateAuthoritiesWithContext expects a single call to AppendixGetCertificateAuthoritiesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCertificateAuthoritiesWithContext() *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	return &AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall{m.controller.expect("AppendixGetCertificateAuthoritiesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall) With(params appendix.AppendixGetCertificateAuthoritiesParams) *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall) Return(r0 *appendix.AppendixGetCertificateAuthoritiesOK, r1 error) *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetCertificateAuthoritiesParams) (*appendix.AppendixGetCertificateAuthoritiesOK, error),
+) *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetCertificateAuthoritiesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetCertificateAuthoritiesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCertificateStatuses returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCertificateStatuses(params appendix.AppendixGetCertificateStatusesParams) (*appendix.AppendixGetCertificateStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCertificateStatuses",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCertificateStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCertificateStatusesCall is an expected call to AppendixGetCertificateStatuses
+type AppendixClientServiceAppendixGetCertificateStatusesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCertificateStatuses expects a single call to AppendixGetCertificateStatuses with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCertificateStatuses() *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	return &AppendixClientServiceAppendixGetCertificateStatusesCall{m.controller.expect("AppendixGetCertificateStatuses")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetCertificateStatusesCall) With(params appendix.AppendixGetCertificateStatusesParams) *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCertificateStatusesCall) Return(r0 *appendix.AppendixGetCertificateStatusesOK, r1 error) *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCertificateStatusesCall) Do(
+	fn func(params appendix.AppendixGetCertificateStatusesParams) (*appendix.AppendixGetCertificateStatusesOK, error),
+) *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetCertificateStatusesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCertificateStatusesCall) Times(n int) *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCertificateStatusesCall) AnyTimes() *AppendixClientServiceAppendixGetCertificateStatusesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetCertificateStatusesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetCertificateStatusesWithContext(ctx context.Context, params appendix.AppendixGetCertificateStatusesParams) (*appendix.AppendixGetCertificateStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetCertificateStatusesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetCertificateStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetCertificateStatusesWithContextCall is an expected call to AppendixGetCertificateStatusesWithContext
+type AppendixClientServiceAppendixGetCertificateStatusesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetCertificateStatusesWithContext expects a single call to AppendixGetCertificateStatusesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetCertificateStatusesWithContext() *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	return &AppendixClientServiceAppendixGetCertificateStatusesWithContextCall{m.controller.expect("AppendixGetCertificateStatusesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall) With(params appendix.AppendixGetCertificateStatusesParams) *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall) Return(r0 *appendix.AppendixGetCertificateStatusesOK, r1 error) *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetCertificateStatusesParams) (*appendix.AppendixGetCertificateStatusesOK, error),
+) *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetCertificateStatusesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetCertificateStatusesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetDcvTypes returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetDcvTypes(params appendix.AppendixGetDcvTypesParams) (*appendix.AppendixGetDcvTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetDcvTypes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetDcvTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetDcvTypesCall is an expected call to AppendixGetDcvTypes
+type AppendixClientServiceAppendixGetDcvTypesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetDcvTypes expects a single call to AppendixGetDcvTypes with any arguments
+func (m *AppendixClientService) ExpectAppendixGetDcvTypes() *AppendixClientServiceAppendixGetDcvTypesCall {
+	return &AppendixClientServiceAppendixGetDcvTypesCall{m.controller.expect("AppendixGetDcvTypes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetDcvTypesCall) With(params appendix.AppendixGetDcvTypesParams) *AppendixClientServiceAppendixGetDcvTypesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetDcvTypesCall) Return(r0 *appendix.AppendixGetDcvTypesOK, r1 error) *AppendixClientServiceAppendixGetDcvTypesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetDcvTypesCall) Do(
+	fn func(params appendix.AppendixGetDcvTypesParams) (*appendix.AppendixGetDcvTypesOK, error),
+) *AppendixClientServiceAppendixGetDcvTypesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetDcvTypesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetDcvTypesCall) Times(n int) *AppendixClientServiceAppendixGetDcvTypesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetDcvTypesCall) AnyTimes() *AppendixClientServiceAppendixGetDcvTypesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetDcvTypesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetDcvTypesWithContext(ctx context.Context, params appendix.AppendixGetDcvTypesParams) (*appendix.AppendixGetDcvTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetDcvTypesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetDcvTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetDcvTypesWithContextCall is an expected call to AppendixGetDcvTypesWithContext
+type AppendixClientServiceAppendixGetDcvTypesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetDcvTypesWithContext expects a single call to AppendixGetDcvTypesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetDcvTypesWithContext() *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	return &AppendixClientServiceAppendixGetDcvTypesWithContextCall{m.controller.expect("AppendixGetDcvTypesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetDcvTypesWithContextCall) With(params appendix.AppendixGetDcvTypesParams) *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetDcvTypesWithContextCall) Return(r0 *appendix.AppendixGetDcvTypesOK, r1 error) *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetDcvTypesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetDcvTypesParams) (*appendix.AppendixGetDcvTypesOK, error),
+) *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetDcvTypesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetDcvTypesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetDcvTypesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetDcvTypesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetDomainStatuses returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetDomainStatuses(params appendix.AppendixGetDomainStatusesParams) (*appendix.AppendixGetDomainStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetDomainStatuses",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetDomainStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetDomainStatusesCall is an expected call to AppendixGetDomainStatuses
+type AppendixClientServiceAppendixGetDomainStatusesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetDomainStatuses expects a single call to AppendixGetDomainStatuses with any arguments
+func (m *AppendixClientService) ExpectAppendixGetDomainStatuses() *AppendixClientServiceAppendixGetDomainStatusesCall {
+	return &AppendixClientServiceAppendixGetDomainStatusesCall{m.controller.expect("AppendixGetDomainStatuses")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetDomainStatusesCall) With(params appendix.AppendixGetDomainStatusesParams) *AppendixClientServiceAppendixGetDomainStatusesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetDomainStatusesCall) Return(r0 *appendix.AppendixGetDomainStatusesOK, r1 error) *AppendixClientServiceAppendixGetDomainStatusesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetDomainStatusesCall) Do(
+	fn func(params appendix.AppendixGetDomainStatusesParams) (*appendix.AppendixGetDomainStatusesOK, error),
+) *AppendixClientServiceAppendixGetDomainStatusesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetDomainStatusesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetDomainStatusesCall) Times(n int) *AppendixClientServiceAppendixGetDomainStatusesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetDomainStatusesCall) AnyTimes() *AppendixClientServiceAppendixGetDomainStatusesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetDomainStatusesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetDomainStatusesWithContext(ctx context.Context, params appendix.AppendixGetDomainStatusesParams) (*appendix.AppendixGetDomainStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetDomainStatusesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetDomainStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetDomainStatusesWithContextCall is an expected call to AppendixGetDomainStatusesWithContext
+type AppendixClientServiceAppendixGetDomainStatusesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetDomainStatusesWithContext expects a single call to AppendixGetDomainStatusesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetDomainStatusesWithContext() *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	return &AppendixClientServiceAppendixGetDomainStatusesWithContextCall{m.controller.expect("AppendixGetDomainStatusesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetDomainStatusesWithContextCall) With(params appendix.AppendixGetDomainStatusesParams) *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetDomainStatusesWithContextCall) Return(r0 *appendix.AppendixGetDomainStatusesOK, r1 error) *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetDomainStatusesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetDomainStatusesParams) (*appendix.AppendixGetDomainStatusesOK, error),
+) *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetDomainStatusesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetDomainStatusesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetDomainStatusesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetDomainStatusesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetOrderStatuses returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetOrderStatuses(params appendix.AppendixGetOrderStatusesParams) (*appendix.AppendixGetOrderStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetOrderStatuses",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetOrderStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetOrderStatusesCall is an expected call to AppendixGetOrderStatuses
+type AppendixClientServiceAppendixGetOrderStatusesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetOrderStatuses expects a single call to AppendixGetOrderStatuses with any arguments
+func (m *AppendixClientService) ExpectAppendixGetOrderStatuses() *AppendixClientServiceAppendixGetOrderStatusesCall {
+	return &AppendixClientServiceAppendixGetOrderStatusesCall{m.controller.expect("AppendixGetOrderStatuses")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetOrderStatusesCall) With(params appendix.AppendixGetOrderStatusesParams) *AppendixClientServiceAppendixGetOrderStatusesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetOrderStatusesCall) Return(r0 *appendix.AppendixGetOrderStatusesOK, r1 error) *AppendixClientServiceAppendixGetOrderStatusesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetOrderStatusesCall) Do(
+	fn func(params appendix.AppendixGetOrderStatusesParams) (*appendix.AppendixGetOrderStatusesOK, error),
+) *AppendixClientServiceAppendixGetOrderStatusesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetOrderStatusesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetOrderStatusesCall) Times(n int) *AppendixClientServiceAppendixGetOrderStatusesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetOrderStatusesCall) AnyTimes() *AppendixClientServiceAppendixGetOrderStatusesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetOrderStatusesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetOrderStatusesWithContext(ctx context.Context, params appendix.AppendixGetOrderStatusesParams) (*appendix.AppendixGetOrderStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetOrderStatusesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetOrderStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetOrderStatusesWithContextCall is an expected call to AppendixGetOrderStatusesWithContext
+type AppendixClientServiceAppendixGetOrderStatusesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetOrderStatusesWithContext expects a single call to AppendixGetOrderStatusesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetOrderStatusesWithContext() *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	return &AppendixClientServiceAppendixGetOrderStatusesWithContextCall{m.controller.expect("AppendixGetOrderStatusesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetOrderStatusesWithContextCall) With(params appendix.AppendixGetOrderStatusesParams) *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetOrderStatusesWithContextCall) Return(r0 *appendix.AppendixGetOrderStatusesOK, r1 error) *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetOrderStatusesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetOrderStatusesParams) (*appendix.AppendixGetOrderStatusesOK, error),
+) *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetOrderStatusesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetOrderStatusesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetOrderStatusesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetOrderStatusesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetProductTypes returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetProductTypes(params appendix.AppendixGetProductTypesParams) (*appendix.AppendixGetProductTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetProductTypes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetProductTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetProductTypesCall is an expected call to AppendixGetProductTypes
+type AppendixClientServiceAppendixGetProductTypesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetProductTypes expects a single call to AppendixGetProductTypes with any arguments
+func (m *AppendixClientService) ExpectAppendixGetProductTypes() *AppendixClientServiceAppendixGetProductTypesCall {
+	return &AppendixClientServiceAppendixGetProductTypesCall{m.controller.expect("AppendixGetProductTypes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetProductTypesCall) With(params appendix.AppendixGetProductTypesParams) *AppendixClientServiceAppendixGetProductTypesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetProductTypesCall) Return(r0 *appendix.AppendixGetProductTypesOK, r1 error) *AppendixClientServiceAppendixGetProductTypesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetProductTypesCall) Do(
+	fn func(params appendix.AppendixGetProductTypesParams) (*appendix.AppendixGetProductTypesOK, error),
+) *AppendixClientServiceAppendixGetProductTypesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetProductTypesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetProductTypesCall) Times(n int) *AppendixClientServiceAppendixGetProductTypesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetProductTypesCall) AnyTimes() *AppendixClientServiceAppendixGetProductTypesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetProductTypesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetProductTypesWithContext(ctx context.Context, params appendix.AppendixGetProductTypesParams) (*appendix.AppendixGetProductTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetProductTypesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetProductTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetProductTypesWithContextCall is an expected call to AppendixGetProductTypesWithContext
+type AppendixClientServiceAppendixGetProductTypesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetProductTypesWithContext expects a single call to AppendixGetProductTypesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetProductTypesWithContext() *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	return &AppendixClientServiceAppendixGetProductTypesWithContextCall{m.controller.expect("AppendixGetProductTypesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetProductTypesWithContextCall) With(params appendix.AppendixGetProductTypesParams) *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetProductTypesWithContextCall) Return(r0 *appendix.AppendixGetProductTypesOK, r1 error) *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetProductTypesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetProductTypesParams) (*appendix.AppendixGetProductTypesOK, error),
+) *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetProductTypesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetProductTypesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetProductTypesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetProductTypesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetRequestType returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetRequestType(params appendix.AppendixGetRequestTypeParams) (*appendix.AppendixGetRequestTypeOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetRequestType",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetRequestTypeOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetRequestTypeCall is an expected call to AppendixGetRequestType
+type AppendixClientServiceAppendixGetRequestTypeCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetRequestType expects a single call to AppendixGetRequestType with any arguments
+func (m *AppendixClientService) ExpectAppendixGetRequestType() *AppendixClientServiceAppendixGetRequestTypeCall {
+	return &AppendixClientServiceAppendixGetRequestTypeCall{m.controller.expect("AppendixGetRequestType")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetRequestTypeCall) With(params appendix.AppendixGetRequestTypeParams) *AppendixClientServiceAppendixGetRequestTypeCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetRequestTypeCall) Return(r0 *appendix.AppendixGetRequestTypeOK, r1 error) *AppendixClientServiceAppendixGetRequestTypeCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetRequestTypeCall) Do(
+	fn func(params appendix.AppendixGetRequestTypeParams) (*appendix.AppendixGetRequestTypeOK, error),
+) *AppendixClientServiceAppendixGetRequestTypeCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetRequestTypeParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetRequestTypeCall) Times(n int) *AppendixClientServiceAppendixGetRequestTypeCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetRequestTypeCall) AnyTimes() *AppendixClientServiceAppendixGetRequestTypeCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetRequestTypeWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetRequestTypeWithContext(ctx context.Context, params appendix.AppendixGetRequestTypeParams) (*appendix.AppendixGetRequestTypeOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetRequestTypeWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetRequestTypeOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetRequestTypeWithContextCall is an expected call to AppendixGetRequestTypeWithContext
+type AppendixClientServiceAppendixGetRequestTypeWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetRequestTypeWithContext expects a single call to AppendixGetRequestTypeWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetRequestTypeWithContext() *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	return &AppendixClientServiceAppendixGetRequestTypeWithContextCall{m.controller.expect("AppendixGetRequestTypeWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetRequestTypeWithContextCall) With(params appendix.AppendixGetRequestTypeParams) *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetRequestTypeWithContextCall) Return(r0 *appendix.AppendixGetRequestTypeOK, r1 error) *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetRequestTypeWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetRequestTypeParams) (*appendix.AppendixGetRequestTypeOK, error),
+) *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetRequestTypeParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetRequestTypeWithContextCall) Times(n int) *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetRequestTypeWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetRequestTypeWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetValidationStatuses returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetValidationStatuses(params appendix.AppendixGetValidationStatusesParams) (*appendix.AppendixGetValidationStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetValidationStatuses",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetValidationStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetValidationStatusesCall is an expected call to AppendixGetValidationStatuses
+type AppendixClientServiceAppendixGetValidationStatusesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetValidationStatuses expects a single call to AppendixGetValidationStatuses with any arguments
+func (m *AppendixClientService) ExpectAppendixGetValidationStatuses() *AppendixClientServiceAppendixGetValidationStatusesCall {
+	return &AppendixClientServiceAppendixGetValidationStatusesCall{m.controller.expect("AppendixGetValidationStatuses")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetValidationStatusesCall) With(params appendix.AppendixGetValidationStatusesParams) *AppendixClientServiceAppendixGetValidationStatusesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetValidationStatusesCall) Return(r0 *appendix.AppendixGetValidationStatusesOK, r1 error) *AppendixClientServiceAppendixGetValidationStatusesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetValidationStatusesCall) Do(
+	fn func(params appendix.AppendixGetValidationStatusesParams) (*appendix.AppendixGetValidationStatusesOK, error),
+) *AppendixClientServiceAppendixGetValidationStatusesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetValidationStatusesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetValidationStatusesCall) Times(n int) *AppendixClientServiceAppendixGetValidationStatusesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetValidationStatusesCall) AnyTimes() *AppendixClientServiceAppendixGetValidationStatusesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetValidationStatusesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetValidationStatusesWithContext(ctx context.Context, params appendix.AppendixGetValidationStatusesParams) (*appendix.AppendixGetValidationStatusesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetValidationStatusesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetValidationStatusesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetValidationStatusesWithContextCall is an expected call to AppendixGetValidationStatusesWithContext
+type AppendixClientServiceAppendixGetValidationStatusesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetValidationStatusesWithContext expects a single call to AppendixGetValidationStatusesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetValidationStatusesWithContext() *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	return &AppendixClientServiceAppendixGetValidationStatusesWithContextCall{m.controller.expect("AppendixGetValidationStatusesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetValidationStatusesWithContextCall) With(params appendix.AppendixGetValidationStatusesParams) *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetValidationStatusesWithContextCall) Return(r0 *appendix.AppendixGetValidationStatusesOK, r1 error) *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetValidationStatusesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetValidationStatusesParams) (*appendix.AppendixGetValidationStatusesOK, error),
+) *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetValidationStatusesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetValidationStatusesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetValidationStatusesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetValidationStatusesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetValidationTypes returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetValidationTypes(params appendix.AppendixGetValidationTypesParams) (*appendix.AppendixGetValidationTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetValidationTypes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetValidationTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetValidationTypesCall is an expected call to AppendixGetValidationTypes
+type AppendixClientServiceAppendixGetValidationTypesCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetValidationTypes expects a single call to AppendixGetValidationTypes with any arguments
+func (m *AppendixClientService) ExpectAppendixGetValidationTypes() *AppendixClientServiceAppendixGetValidationTypesCall {
+	return &AppendixClientServiceAppendixGetValidationTypesCall{m.controller.expect("AppendixGetValidationTypes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *AppendixClientServiceAppendixGetValidationTypesCall) With(params appendix.AppendixGetValidationTypesParams) *AppendixClientServiceAppendixGetValidationTypesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetValidationTypesCall) Return(r0 *appendix.AppendixGetValidationTypesOK, r1 error) *AppendixClientServiceAppendixGetValidationTypesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetValidationTypesCall) Do(
+	fn func(params appendix.AppendixGetValidationTypesParams) (*appendix.AppendixGetValidationTypesOK, error),
+) *AppendixClientServiceAppendixGetValidationTypesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(appendix.AppendixGetValidationTypesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetValidationTypesCall) Times(n int) *AppendixClientServiceAppendixGetValidationTypesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetValidationTypesCall) AnyTimes() *AppendixClientServiceAppendixGetValidationTypesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AppendixGetValidationTypesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *AppendixClientService) AppendixGetValidationTypesWithContext(ctx context.Context, params appendix.AppendixGetValidationTypesParams) (*appendix.AppendixGetValidationTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AppendixGetValidationTypesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*appendix.AppendixGetValidationTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// AppendixClientServiceAppendixGetValidationTypesWithContextCall is an expected call to AppendixGetValidationTypesWithContext
+type AppendixClientServiceAppendixGetValidationTypesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAppendixGetValidationTypesWithContext expects a single call to AppendixGetValidationTypesWithContext with any arguments
+func (m *AppendixClientService) ExpectAppendixGetValidationTypesWithContext() *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	return &AppendixClientServiceAppendixGetValidationTypesWithContextCall{m.controller.expect("AppendixGetValidationTypesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *AppendixClientServiceAppendixGetValidationTypesWithContextCall) With(params appendix.AppendixGetValidationTypesParams) *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *AppendixClientServiceAppendixGetValidationTypesWithContextCall) Return(r0 *appendix.AppendixGetValidationTypesOK, r1 error) *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *AppendixClientServiceAppendixGetValidationTypesWithContextCall) Do(
+	fn func(ctx context.Context, params appendix.AppendixGetValidationTypesParams) (*appendix.AppendixGetValidationTypesOK, error),
+) *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(appendix.AppendixGetValidationTypesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *AppendixClientServiceAppendixGetValidationTypesWithContextCall) Times(n int) *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *AppendixClientServiceAppendixGetValidationTypesWithContextCall) AnyTimes() *AppendixClientServiceAppendixGetValidationTypesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/bot"
+)
+
+// BotClientService is a mock of bot.ClientService
+type BotClientService struct {
+	controller *controller
+}
+
+var _ bot.ClientService = (*BotClientService)(nil)
+
+// NewBotClientService creates a mock of bot.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewBotClientService(t TestingT) *BotClientService {
+	return &BotClientService{controller: newController(t, "BotClientService")}
+}
+
+// AddBotRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) AddBotRuleSet(params bot.AddBotRuleSetParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddBotRuleSet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceAddBotRuleSetCall is an expected call to AddBotRuleSet
+type BotClientServiceAddBotRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectAddBotRuleSet expects a single call to AddBotRuleSet with any arguments
+func (m *BotClientService) ExpectAddBotRuleSet() *BotClientServiceAddBotRuleSetCall {
+	return &BotClientServiceAddBotRuleSetCall{m.controller.expect("AddBotRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotClientServiceAddBotRuleSetCall) With(params bot.AddBotRuleSetParams) *BotClientServiceAddBotRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceAddBotRuleSetCall) Return(r0 string, r1 error) *BotClientServiceAddBotRuleSetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceAddBotRuleSetCall) Do(
+	fn func(params bot.AddBotRuleSetParams) (string, error),
+) *BotClientServiceAddBotRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(bot.AddBotRuleSetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceAddBotRuleSetCall) Times(n int) *BotClientServiceAddBotRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceAddBotRuleSetCall) AnyTimes() *BotClientServiceAddBotRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddBotRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) AddBotRuleSetWithContext(ctx context.Context, params bot.AddBotRuleSetParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddBotRuleSetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceAddBotRuleSetWithContextCall is an expected call to AddBotRuleSetWithContext
+type BotClientServiceAddBotRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddBotRuleSetWithContext expects a single call to AddBotRuleSetWithContext with any arguments
+func (m *BotClientService) ExpectAddBotRuleSetWithContext() *BotClientServiceAddBotRuleSetWithContextCall {
+	return &BotClientServiceAddBotRuleSetWithContextCall{m.controller.expect("AddBotRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotClientServiceAddBotRuleSetWithContextCall) With(params bot.AddBotRuleSetParams) *BotClientServiceAddBotRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceAddBotRuleSetWithContextCall) Return(r0 string, r1 error) *BotClientServiceAddBotRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceAddBotRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params bot.AddBotRuleSetParams) (string, error),
+) *BotClientServiceAddBotRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(bot.AddBotRuleSetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceAddBotRuleSetWithContextCall) Times(n int) *BotClientServiceAddBotRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceAddBotRuleSetWithContextCall) AnyTimes() *BotClientServiceAddBotRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllBotRuleSets returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) GetAllBotRuleSets(params bot.GetAllBotRuleSetsParams) (*[]bot.BotRuleSetGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllBotRuleSets",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]bot.BotRuleSetGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceGetAllBotRuleSetsCall is an expected call to GetAllBotRuleSets
+type BotClientServiceGetAllBotRuleSetsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllBotRuleSets expects a single call to GetAllBotRuleSets with any arguments
+func (m *BotClientService) ExpectGetAllBotRuleSets() *BotClientServiceGetAllBotRuleSetsCall {
+	return &BotClientServiceGetAllBotRuleSetsCall{m.controller.expect("GetAllBotRuleSets")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotClientServiceGetAllBotRuleSetsCall) With(params bot.GetAllBotRuleSetsParams) *BotClientServiceGetAllBotRuleSetsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceGetAllBotRuleSetsCall) Return(r0 *[]bot.BotRuleSetGetAllOK, r1 error) *BotClientServiceGetAllBotRuleSetsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceGetAllBotRuleSetsCall) Do(
+	fn func(params bot.GetAllBotRuleSetsParams) (*[]bot.BotRuleSetGetAllOK, error),
+) *BotClientServiceGetAllBotRuleSetsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(bot.GetAllBotRuleSetsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceGetAllBotRuleSetsCall) Times(n int) *BotClientServiceGetAllBotRuleSetsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceGetAllBotRuleSetsCall) AnyTimes() *BotClientServiceGetAllBotRuleSetsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllBotRuleSetsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) GetAllBotRuleSetsWithContext(ctx context.Context, params bot.GetAllBotRuleSetsParams) (*[]bot.BotRuleSetGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllBotRuleSetsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]bot.BotRuleSetGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceGetAllBotRuleSetsWithContextCall is an expected call to GetAllBotRuleSetsWithContext
+type BotClientServiceGetAllBotRuleSetsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllBotRuleSetsWithContext expects a single call to GetAllBotRuleSetsWithContext with any arguments
+func (m *BotClientService) ExpectGetAllBotRuleSetsWithContext() *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	return &BotClientServiceGetAllBotRuleSetsWithContextCall{m.controller.expect("GetAllBotRuleSetsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotClientServiceGetAllBotRuleSetsWithContextCall) With(params bot.GetAllBotRuleSetsParams) *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceGetAllBotRuleSetsWithContextCall) Return(r0 *[]bot.BotRuleSetGetAllOK, r1 error) *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceGetAllBotRuleSetsWithContextCall) Do(
+	fn func(ctx context.Context, params bot.GetAllBotRuleSetsParams) (*[]bot.BotRuleSetGetAllOK, error),
+) *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(bot.GetAllBotRuleSetsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceGetAllBotRuleSetsWithContextCall) Times(n int) *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceGetAllBotRuleSetsWithContextCall) AnyTimes() *BotClientServiceGetAllBotRuleSetsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteBotRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) DeleteBotRuleSet(params bot.DeleteBotRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteBotRuleSet",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotClientServiceDeleteBotRuleSetCall is an expected call to DeleteBotRuleSet
+type BotClientServiceDeleteBotRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectDeleteBotRuleSet expects a single call to DeleteBotRuleSet with any arguments
+func (m *BotClientService) ExpectDeleteBotRuleSet() *BotClientServiceDeleteBotRuleSetCall {
+	return &BotClientServiceDeleteBotRuleSetCall{m.controller.expect("DeleteBotRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotClientServiceDeleteBotRuleSetCall) With(params bot.DeleteBotRuleSetParams) *BotClientServiceDeleteBotRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceDeleteBotRuleSetCall) Return(r0 error) *BotClientServiceDeleteBotRuleSetCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceDeleteBotRuleSetCall) Do(
+	fn func(params bot.DeleteBotRuleSetParams) error,
+) *BotClientServiceDeleteBotRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(bot.DeleteBotRuleSetParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceDeleteBotRuleSetCall) Times(n int) *BotClientServiceDeleteBotRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceDeleteBotRuleSetCall) AnyTimes() *BotClientServiceDeleteBotRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteBotRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) DeleteBotRuleSetWithContext(ctx context.Context, params bot.DeleteBotRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteBotRuleSetWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotClientServiceDeleteBotRuleSetWithContextCall is an expected call to DeleteBotRuleSetWithContext
+type BotClientServiceDeleteBotRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteBotRuleSetWithContext expects a single call to DeleteBotRuleSetWithContext with any arguments
+func (m *BotClientService) ExpectDeleteBotRuleSetWithContext() *BotClientServiceDeleteBotRuleSetWithContextCall {
+	return &BotClientServiceDeleteBotRuleSetWithContextCall{m.controller.expect("DeleteBotRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotClientServiceDeleteBotRuleSetWithContextCall) With(params bot.DeleteBotRuleSetParams) *BotClientServiceDeleteBotRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceDeleteBotRuleSetWithContextCall) Return(r0 error) *BotClientServiceDeleteBotRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceDeleteBotRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params bot.DeleteBotRuleSetParams) error,
+) *BotClientServiceDeleteBotRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(bot.DeleteBotRuleSetParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceDeleteBotRuleSetWithContextCall) Times(n int) *BotClientServiceDeleteBotRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceDeleteBotRuleSetWithContextCall) AnyTimes() *BotClientServiceDeleteBotRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) GetBotRuleSet(params bot.GetBotRuleSetParams) (*bot.BotRuleSetGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotRuleSet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*bot.BotRuleSetGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceGetBotRuleSetCall is an expected call to GetBotRuleSet
+type BotClientServiceGetBotRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectGetBotRuleSet expects a single call to GetBotRuleSet with any arguments
+func (m *BotClientService) ExpectGetBotRuleSet() *BotClientServiceGetBotRuleSetCall {
+	return &BotClientServiceGetBotRuleSetCall{m.controller.expect("GetBotRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotClientServiceGetBotRuleSetCall) With(params bot.GetBotRuleSetParams) *BotClientServiceGetBotRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceGetBotRuleSetCall) Return(r0 *bot.BotRuleSetGetOK, r1 error) *BotClientServiceGetBotRuleSetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceGetBotRuleSetCall) Do(
+	fn func(params bot.GetBotRuleSetParams) (*bot.BotRuleSetGetOK, error),
+) *BotClientServiceGetBotRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(bot.GetBotRuleSetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceGetBotRuleSetCall) Times(n int) *BotClientServiceGetBotRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceGetBotRuleSetCall) AnyTimes() *BotClientServiceGetBotRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) GetBotRuleSetWithContext(ctx context.Context, params bot.GetBotRuleSetParams) (*bot.BotRuleSetGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotRuleSetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*bot.BotRuleSetGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotClientServiceGetBotRuleSetWithContextCall is an expected call to GetBotRuleSetWithContext
+type BotClientServiceGetBotRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetBotRuleSetWithContext expects a single call to GetBotRuleSetWithContext with any arguments
+func (m *BotClientService) ExpectGetBotRuleSetWithContext() *BotClientServiceGetBotRuleSetWithContextCall {
+	return &BotClientServiceGetBotRuleSetWithContextCall{m.controller.expect("GetBotRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotClientServiceGetBotRuleSetWithContextCall) With(params bot.GetBotRuleSetParams) *BotClientServiceGetBotRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceGetBotRuleSetWithContextCall) Return(r0 *bot.BotRuleSetGetOK, r1 error) *BotClientServiceGetBotRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceGetBotRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params bot.GetBotRuleSetParams) (*bot.BotRuleSetGetOK, error),
+) *BotClientServiceGetBotRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(bot.GetBotRuleSetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceGetBotRuleSetWithContextCall) Times(n int) *BotClientServiceGetBotRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceGetBotRuleSetWithContextCall) AnyTimes() *BotClientServiceGetBotRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateBotRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) UpdateBotRuleSet(params bot.UpdateBotRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateBotRuleSet",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotClientServiceUpdateBotRuleSetCall is an expected call to UpdateBotRuleSet
+type BotClientServiceUpdateBotRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectUpdateBotRuleSet expects a single call to UpdateBotRuleSet with any arguments
+func (m *BotClientService) ExpectUpdateBotRuleSet() *BotClientServiceUpdateBotRuleSetCall {
+	return &BotClientServiceUpdateBotRuleSetCall{m.controller.expect("UpdateBotRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotClientServiceUpdateBotRuleSetCall) With(params bot.UpdateBotRuleSetParams) *BotClientServiceUpdateBotRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceUpdateBotRuleSetCall) Return(r0 error) *BotClientServiceUpdateBotRuleSetCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceUpdateBotRuleSetCall) Do(
+	fn func(params bot.UpdateBotRuleSetParams) error,
+) *BotClientServiceUpdateBotRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(bot.UpdateBotRuleSetParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceUpdateBotRuleSetCall) Times(n int) *BotClientServiceUpdateBotRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceUpdateBotRuleSetCall) AnyTimes() *BotClientServiceUpdateBotRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateBotRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotClientService) UpdateBotRuleSetWithContext(ctx context.Context, params bot.UpdateBotRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateBotRuleSetWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotClientServiceUpdateBotRuleSetWithContextCall is an expected call to UpdateBotRuleSetWithContext
+type BotClientServiceUpdateBotRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateBotRuleSetWithContext expects a single call to UpdateBotRuleSetWithContext with any arguments
+func (m *BotClientService) ExpectUpdateBotRuleSetWithContext() *BotClientServiceUpdateBotRuleSetWithContextCall {
+	return &BotClientServiceUpdateBotRuleSetWithContextCall{m.controller.expect("UpdateBotRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotClientServiceUpdateBotRuleSetWithContextCall) With(params bot.UpdateBotRuleSetParams) *BotClientServiceUpdateBotRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotClientServiceUpdateBotRuleSetWithContextCall) Return(r0 error) *BotClientServiceUpdateBotRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotClientServiceUpdateBotRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params bot.UpdateBotRuleSetParams) error,
+) *BotClientServiceUpdateBotRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(bot.UpdateBotRuleSetParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotClientServiceUpdateBotRuleSetWithContextCall) Times(n int) *BotClientServiceUpdateBotRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotClientServiceUpdateBotRuleSetWithContextCall) AnyTimes() *BotClientServiceUpdateBotRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf_bot_manager"
+)
+
+// BotManagersClientService is a mock of waf_bot_manager.BotManagersClientService
+type BotManagersClientService struct {
+	controller *controller
+}
+
+var _ waf_bot_manager.BotManagersClientService = (*BotManagersClientService)(nil)
+
+// NewBotManagersClientService creates a mock of waf_bot_manager.BotManagersClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewBotManagersClientService(t TestingT) *BotManagersClientService {
+	return &BotManagersClientService{controller: newController(t, "BotManagersClientService")}
+}
+
+// CreateBotManager returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) CreateBotManager(params waf_bot_manager.CreateBotManagerParams) (*waf_bot_manager.ResponseObj, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CreateBotManager",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*waf_bot_manager.ResponseObj)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceCreateBotManagerCall is an expected call to CreateBotManager
+type BotManagersClientServiceCreateBotManagerCall struct {
+	e *expectation
+}
+
+// ExpectCreateBotManager expects a single call to CreateBotManager with any arguments
+func (m *BotManagersClientService) ExpectCreateBotManager() *BotManagersClientServiceCreateBotManagerCall {
+	return &BotManagersClientServiceCreateBotManagerCall{m.controller.expect("CreateBotManager")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotManagersClientServiceCreateBotManagerCall) With(params waf_bot_manager.CreateBotManagerParams) *BotManagersClientServiceCreateBotManagerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceCreateBotManagerCall) Return(r0 *waf_bot_manager.ResponseObj, r1 error) *BotManagersClientServiceCreateBotManagerCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceCreateBotManagerCall) Do(
+	fn func(params waf_bot_manager.CreateBotManagerParams) (*waf_bot_manager.ResponseObj, error),
+) *BotManagersClientServiceCreateBotManagerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(waf_bot_manager.CreateBotManagerParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceCreateBotManagerCall) Times(n int) *BotManagersClientServiceCreateBotManagerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceCreateBotManagerCall) AnyTimes() *BotManagersClientServiceCreateBotManagerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CreateBotManagerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) CreateBotManagerWithContext(ctx context.Context, params waf_bot_manager.CreateBotManagerParams) (*waf_bot_manager.ResponseObj, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CreateBotManagerWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*waf_bot_manager.ResponseObj)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceCreateBotManagerWithContextCall is an expected call to CreateBotManagerWithContext
+type BotManagersClientServiceCreateBotManagerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCreateBotManagerWithContext expects a single call to CreateBotManagerWithContext with any arguments
+func (m *BotManagersClientService) ExpectCreateBotManagerWithContext() *BotManagersClientServiceCreateBotManagerWithContextCall {
+	return &BotManagersClientServiceCreateBotManagerWithContextCall{m.controller.expect("CreateBotManagerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotManagersClientServiceCreateBotManagerWithContextCall) With(params waf_bot_manager.CreateBotManagerParams) *BotManagersClientServiceCreateBotManagerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceCreateBotManagerWithContextCall) Return(r0 *waf_bot_manager.ResponseObj, r1 error) *BotManagersClientServiceCreateBotManagerWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceCreateBotManagerWithContextCall) Do(
+	fn func(ctx context.Context, params waf_bot_manager.CreateBotManagerParams) (*waf_bot_manager.ResponseObj, error),
+) *BotManagersClientServiceCreateBotManagerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(waf_bot_manager.CreateBotManagerParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceCreateBotManagerWithContextCall) Times(n int) *BotManagersClientServiceCreateBotManagerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceCreateBotManagerWithContextCall) AnyTimes() *BotManagersClientServiceCreateBotManagerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteBotManager returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) DeleteBotManager(params waf_bot_manager.DeleteBotManagerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteBotManager",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotManagersClientServiceDeleteBotManagerCall is an expected call to DeleteBotManager
+type BotManagersClientServiceDeleteBotManagerCall struct {
+	e *expectation
+}
+
+// ExpectDeleteBotManager expects a single call to DeleteBotManager with any arguments
+func (m *BotManagersClientService) ExpectDeleteBotManager() *BotManagersClientServiceDeleteBotManagerCall {
+	return &BotManagersClientServiceDeleteBotManagerCall{m.controller.expect("DeleteBotManager")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotManagersClientServiceDeleteBotManagerCall) With(params waf_bot_manager.DeleteBotManagerParams) *BotManagersClientServiceDeleteBotManagerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceDeleteBotManagerCall) Return(r0 error) *BotManagersClientServiceDeleteBotManagerCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceDeleteBotManagerCall) Do(
+	fn func(params waf_bot_manager.DeleteBotManagerParams) error,
+) *BotManagersClientServiceDeleteBotManagerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(waf_bot_manager.DeleteBotManagerParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceDeleteBotManagerCall) Times(n int) *BotManagersClientServiceDeleteBotManagerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceDeleteBotManagerCall) AnyTimes() *BotManagersClientServiceDeleteBotManagerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteBotManagerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) DeleteBotManagerWithContext(ctx context.Context, params waf_bot_manager.DeleteBotManagerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteBotManagerWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotManagersClientServiceDeleteBotManagerWithContextCall is an expected call to DeleteBotManagerWithContext
+type BotManagersClientServiceDeleteBotManagerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteBotManagerWithContext expects a single call to DeleteBotManagerWithContext with any arguments
+func (m *BotManagersClientService) ExpectDeleteBotManagerWithContext() *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	return &BotManagersClientServiceDeleteBotManagerWithContextCall{m.controller.expect("DeleteBotManagerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotManagersClientServiceDeleteBotManagerWithContextCall) With(params waf_bot_manager.DeleteBotManagerParams) *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceDeleteBotManagerWithContextCall) Return(r0 error) *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceDeleteBotManagerWithContextCall) Do(
+	fn func(ctx context.Context, params waf_bot_manager.DeleteBotManagerParams) error,
+) *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(waf_bot_manager.DeleteBotManagerParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceDeleteBotManagerWithContextCall) Times(n int) *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceDeleteBotManagerWithContextCall) AnyTimes() *BotManagersClientServiceDeleteBotManagerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotManager returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) GetBotManager(params waf_bot_manager.GetBotManagerParams) (*waf_bot_manager.BotManager, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotManager",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*waf_bot_manager.BotManager)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceGetBotManagerCall is an expected call to GetBotManager
+type BotManagersClientServiceGetBotManagerCall struct {
+	e *expectation
+}
+
+// ExpectGetBotManager expects a single call to GetBotManager with any arguments
+func (m *BotManagersClientService) ExpectGetBotManager() *BotManagersClientServiceGetBotManagerCall {
+	return &BotManagersClientServiceGetBotManagerCall{m.controller.expect("GetBotManager")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotManagersClientServiceGetBotManagerCall) With(params waf_bot_manager.GetBotManagerParams) *BotManagersClientServiceGetBotManagerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceGetBotManagerCall) Return(r0 *waf_bot_manager.BotManager, r1 error) *BotManagersClientServiceGetBotManagerCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceGetBotManagerCall) Do(
+	fn func(params waf_bot_manager.GetBotManagerParams) (*waf_bot_manager.BotManager, error),
+) *BotManagersClientServiceGetBotManagerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(waf_bot_manager.GetBotManagerParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceGetBotManagerCall) Times(n int) *BotManagersClientServiceGetBotManagerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceGetBotManagerCall) AnyTimes() *BotManagersClientServiceGetBotManagerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotManagerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) GetBotManagerWithContext(ctx context.Context, params waf_bot_manager.GetBotManagerParams) (*waf_bot_manager.BotManager, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotManagerWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*waf_bot_manager.BotManager)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceGetBotManagerWithContextCall is an expected call to GetBotManagerWithContext
+type BotManagersClientServiceGetBotManagerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetBotManagerWithContext expects a single call to GetBotManagerWithContext with any arguments
+func (m *BotManagersClientService) ExpectGetBotManagerWithContext() *BotManagersClientServiceGetBotManagerWithContextCall {
+	return &BotManagersClientServiceGetBotManagerWithContextCall{m.controller.expect("GetBotManagerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotManagersClientServiceGetBotManagerWithContextCall) With(params waf_bot_manager.GetBotManagerParams) *BotManagersClientServiceGetBotManagerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceGetBotManagerWithContextCall) Return(r0 *waf_bot_manager.BotManager, r1 error) *BotManagersClientServiceGetBotManagerWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceGetBotManagerWithContextCall) Do(
+	fn func(ctx context.Context, params waf_bot_manager.GetBotManagerParams) (*waf_bot_manager.BotManager, error),
+) *BotManagersClientServiceGetBotManagerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(waf_bot_manager.GetBotManagerParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceGetBotManagerWithContextCall) Times(n int) *BotManagersClientServiceGetBotManagerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceGetBotManagerWithContextCall) AnyTimes() *BotManagersClientServiceGetBotManagerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotManagers returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) GetBotManagers(params waf_bot_manager.GetBotManagersParams) ([]waf_bot_manager.ObjShort, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotManagers",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].([]waf_bot_manager.ObjShort)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceGetBotManagersCall is an expected call to GetBotManagers
+type BotManagersClientServiceGetBotManagersCall struct {
+	e *expectation
+}
+
+// ExpectGetBotManagers expects a single call to GetBotManagers with any arguments
+func (m *BotManagersClientService) ExpectGetBotManagers() *BotManagersClientServiceGetBotManagersCall {
+	return &BotManagersClientServiceGetBotManagersCall{m.controller.expect("GetBotManagers")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotManagersClientServiceGetBotManagersCall) With(params waf_bot_manager.GetBotManagersParams) *BotManagersClientServiceGetBotManagersCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceGetBotManagersCall) Return(r0 []waf_bot_manager.ObjShort, r1 error) *BotManagersClientServiceGetBotManagersCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceGetBotManagersCall) Do(
+	fn func(params waf_bot_manager.GetBotManagersParams) ([]waf_bot_manager.ObjShort, error),
+) *BotManagersClientServiceGetBotManagersCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(waf_bot_manager.GetBotManagersParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceGetBotManagersCall) Times(n int) *BotManagersClientServiceGetBotManagersCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceGetBotManagersCall) AnyTimes() *BotManagersClientServiceGetBotManagersCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetBotManagersWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) GetBotManagersWithContext(ctx context.Context, params waf_bot_manager.GetBotManagersParams) ([]waf_bot_manager.ObjShort, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetBotManagersWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].([]waf_bot_manager.ObjShort)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// BotManagersClientServiceGetBotManagersWithContextCall is an expected call to GetBotManagersWithContext
+type BotManagersClientServiceGetBotManagersWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetBotManagersWithContext expects a single call to GetBotManagersWithContext with any arguments
+func (m *BotManagersClientService) ExpectGetBotManagersWithContext() *BotManagersClientServiceGetBotManagersWithContextCall {
+	return &BotManagersClientServiceGetBotManagersWithContextCall{m.controller.expect("GetBotManagersWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotManagersClientServiceGetBotManagersWithContextCall) With(params waf_bot_manager.GetBotManagersParams) *BotManagersClientServiceGetBotManagersWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceGetBotManagersWithContextCall) Return(r0 []waf_bot_manager.ObjShort, r1 error) *BotManagersClientServiceGetBotManagersWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceGetBotManagersWithContextCall) Do(
+	fn func(ctx context.Context, params waf_bot_manager.GetBotManagersParams) ([]waf_bot_manager.ObjShort, error),
+) *BotManagersClientServiceGetBotManagersWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(waf_bot_manager.GetBotManagersParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceGetBotManagersWithContextCall) Times(n int) *BotManagersClientServiceGetBotManagersWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceGetBotManagersWithContextCall) AnyTimes() *BotManagersClientServiceGetBotManagersWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateBotManager returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) UpdateBotManager(params waf_bot_manager.UpdateBotManagerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateBotManager",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotManagersClientServiceUpdateBotManagerCall is an expected call to UpdateBotManager
+type BotManagersClientServiceUpdateBotManagerCall struct {
+	e *expectation
+}
+
+// ExpectUpdateBotManager expects a single call to UpdateBotManager with any arguments
+func (m *BotManagersClientService) ExpectUpdateBotManager() *BotManagersClientServiceUpdateBotManagerCall {
+	return &BotManagersClientServiceUpdateBotManagerCall{m.controller.expect("UpdateBotManager")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *BotManagersClientServiceUpdateBotManagerCall) With(params waf_bot_manager.UpdateBotManagerParams) *BotManagersClientServiceUpdateBotManagerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceUpdateBotManagerCall) Return(r0 error) *BotManagersClientServiceUpdateBotManagerCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceUpdateBotManagerCall) Do(
+	fn func(params waf_bot_manager.UpdateBotManagerParams) error,
+) *BotManagersClientServiceUpdateBotManagerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(waf_bot_manager.UpdateBotManagerParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceUpdateBotManagerCall) Times(n int) *BotManagersClientServiceUpdateBotManagerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceUpdateBotManagerCall) AnyTimes() *BotManagersClientServiceUpdateBotManagerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateBotManagerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *BotManagersClientService) UpdateBotManagerWithContext(ctx context.Context, params waf_bot_manager.UpdateBotManagerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateBotManagerWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// BotManagersClientServiceUpdateBotManagerWithContextCall is an expected call to UpdateBotManagerWithContext
+type BotManagersClientServiceUpdateBotManagerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateBotManagerWithContext expects a single call to UpdateBotManagerWithContext with any arguments
+func (m *BotManagersClientService) ExpectUpdateBotManagerWithContext() *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	return &BotManagersClientServiceUpdateBotManagerWithContextCall{m.controller.expect("UpdateBotManagerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *BotManagersClientServiceUpdateBotManagerWithContextCall) With(params waf_bot_manager.UpdateBotManagerParams) *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *BotManagersClientServiceUpdateBotManagerWithContextCall) Return(r0 error) *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *BotManagersClientServiceUpdateBotManagerWithContextCall) Do(
+	fn func(ctx context.Context, params waf_bot_manager.UpdateBotManagerParams) error,
+) *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(waf_bot_manager.UpdateBotManagerParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *BotManagersClientServiceUpdateBotManagerWithContextCall) Times(n int) *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *BotManagersClientServiceUpdateBotManagerWithContextCall) AnyTimes() *BotManagersClientServiceUpdateBotManagerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,1491 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/certificate"
+)
+
+// CertificateClientService is a mock of certificate.ClientService
+type CertificateClientService struct {
+	controller *controller
+}
+
+var _ certificate.ClientService = (*CertificateClientService)(nil)
+
+// NewCertificateClientService creates a mock of certificate.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewCertificateClientService(t TestingT) *CertificateClientService {
+	return &CertificateClientService{controller: newController(t, "CertificateClientService")}
+}
+
+// CertificateCancel returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateCancel(params certificate.CertificateCancelParams) (*certificate.CertificateCancelNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateCancel",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateCancelNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateCancelCall is an expected call to CertificateCancel
+type CertificateClientServiceCertificateCancelCall struct {
+	e *expectation
+}
+
+// ExpectCertificateCancel expects a single call to CertificateCancel with any arguments
+func (m *CertificateClientService) ExpectCertificateCancel() *CertificateClientServiceCertificateCancelCall {
+	return &CertificateClientServiceCertificateCancelCall{m.controller.expect("CertificateCancel")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateCancelCall) With(params certificate.CertificateCancelParams) *CertificateClientServiceCertificateCancelCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateCancelCall) Return(r0 *certificate.CertificateCancelNoContent, r1 error) *CertificateClientServiceCertificateCancelCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateCancelCall) Do(
+	fn func(params certificate.CertificateCancelParams) (*certificate.CertificateCancelNoContent, error),
+) *CertificateClientServiceCertificateCancelCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateCancelParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateCancelCall) Times(n int) *CertificateClientServiceCertificateCancelCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateCancelCall) AnyTimes() *CertificateClientServiceCertificateCancelCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateCancelWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateCancelWithContext(ctx context.Context, params certificate.CertificateCancelParams) (*certificate.CertificateCancelNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateCancelWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateCancelNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateCancelWithContextCall is an expected call to CertificateCancelWithContext
+type CertificateClientServiceCertificateCancelWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateCancelWithContext expects a single call to CertificateCancelWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateCancelWithContext() *CertificateClientServiceCertificateCancelWithContextCall {
+	return &CertificateClientServiceCertificateCancelWithContextCall{m.controller.expect("CertificateCancelWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateCancelWithContextCall) With(params certificate.CertificateCancelParams) *CertificateClientServiceCertificateCancelWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateCancelWithContextCall) Return(r0 *certificate.CertificateCancelNoContent, r1 error) *CertificateClientServiceCertificateCancelWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateCancelWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateCancelParams) (*certificate.CertificateCancelNoContent, error),
+) *CertificateClientServiceCertificateCancelWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateCancelParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateCancelWithContextCall) Times(n int) *CertificateClientServiceCertificateCancelWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateCancelWithContextCall) AnyTimes() *CertificateClientServiceCertificateCancelWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateDelete returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateDelete(params certificate.CertificateDeleteParams) (*certificate.CertificateDeleteNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateDelete",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateDeleteNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateDeleteCall is an expected call to CertificateDelete
+type CertificateClientServiceCertificateDeleteCall struct {
+	e *expectation
+}
+
+// ExpectCertificateDelete expects a single call to CertificateDelete with any arguments
+func (m *CertificateClientService) ExpectCertificateDelete() *CertificateClientServiceCertificateDeleteCall {
+	return &CertificateClientServiceCertificateDeleteCall{m.controller.expect("CertificateDelete")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateDeleteCall) With(params certificate.CertificateDeleteParams) *CertificateClientServiceCertificateDeleteCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateDeleteCall) Return(r0 *certificate.CertificateDeleteNoContent, r1 error) *CertificateClientServiceCertificateDeleteCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateDeleteCall) Do(
+	fn func(params certificate.CertificateDeleteParams) (*certificate.CertificateDeleteNoContent, error),
+) *CertificateClientServiceCertificateDeleteCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateDeleteParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateDeleteCall) Times(n int) *CertificateClientServiceCertificateDeleteCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateDeleteCall) AnyTimes() *CertificateClientServiceCertificateDeleteCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateDeleteWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateDeleteWithContext(ctx context.Context, params certificate.CertificateDeleteParams) (*certificate.CertificateDeleteNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateDeleteWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateDeleteNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateDeleteWithContextCall is an expected call to CertificateDeleteWithContext
+type CertificateClientServiceCertificateDeleteWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateDeleteWithContext expects a single call to CertificateDeleteWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateDeleteWithContext() *CertificateClientServiceCertificateDeleteWithContextCall {
+	return &CertificateClientServiceCertificateDeleteWithContextCall{m.controller.expect("CertificateDeleteWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateDeleteWithContextCall) With(params certificate.CertificateDeleteParams) *CertificateClientServiceCertificateDeleteWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateDeleteWithContextCall) Return(r0 *certificate.CertificateDeleteNoContent, r1 error) *CertificateClientServiceCertificateDeleteWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateDeleteWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateDeleteParams) (*certificate.CertificateDeleteNoContent, error),
+) *CertificateClientServiceCertificateDeleteWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateDeleteParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateDeleteWithContextCall) Times(n int) *CertificateClientServiceCertificateDeleteWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateDeleteWithContextCall) AnyTimes() *CertificateClientServiceCertificateDeleteWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateFind returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateFind(params certificate.CertificateFindParams) (*certificate.CertificateFindOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateFind",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateFindOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateFindCall is an expected call to CertificateFind
+type CertificateClientServiceCertificateFindCall struct {
+	e *expectation
+}
+
+// ExpectCertificateFind expects a single call to CertificateFind with any arguments
+func (m *CertificateClientService) ExpectCertificateFind() *CertificateClientServiceCertificateFindCall {
+	return &CertificateClientServiceCertificateFindCall{m.controller.expect("CertificateFind")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateFindCall) With(params certificate.CertificateFindParams) *CertificateClientServiceCertificateFindCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateFindCall) Return(r0 *certificate.CertificateFindOK, r1 error) *CertificateClientServiceCertificateFindCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateFindCall) Do(
+	fn func(params certificate.CertificateFindParams) (*certificate.CertificateFindOK, error),
+) *CertificateClientServiceCertificateFindCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateFindParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateFindCall) Times(n int) *CertificateClientServiceCertificateFindCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateFindCall) AnyTimes() *CertificateClientServiceCertificateFindCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateFindWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateFindWithContext(ctx context.Context, params certificate.CertificateFindParams) (*certificate.CertificateFindOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateFindWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateFindOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateFindWithContextCall is an expected call to CertificateFindWithContext
+type CertificateClientServiceCertificateFindWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateFindWithContext expects a single call to CertificateFindWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateFindWithContext() *CertificateClientServiceCertificateFindWithContextCall {
+	return &CertificateClientServiceCertificateFindWithContextCall{m.controller.expect("CertificateFindWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateFindWithContextCall) With(params certificate.CertificateFindParams) *CertificateClientServiceCertificateFindWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateFindWithContextCall) Return(r0 *certificate.CertificateFindOK, r1 error) *CertificateClientServiceCertificateFindWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateFindWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateFindParams) (*certificate.CertificateFindOK, error),
+) *CertificateClientServiceCertificateFindWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateFindParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateFindWithContextCall) Times(n int) *CertificateClientServiceCertificateFindWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateFindWithContextCall) AnyTimes() *CertificateClientServiceCertificateFindWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGet returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGet(params certificate.CertificateGetParams) (*certificate.CertificateGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetCall is an expected call to CertificateGet
+type CertificateClientServiceCertificateGetCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGet expects a single call to CertificateGet with any arguments
+func (m *CertificateClientService) ExpectCertificateGet() *CertificateClientServiceCertificateGetCall {
+	return &CertificateClientServiceCertificateGetCall{m.controller.expect("CertificateGet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateGetCall) With(params certificate.CertificateGetParams) *CertificateClientServiceCertificateGetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetCall) Return(r0 *certificate.CertificateGetOK, r1 error) *CertificateClientServiceCertificateGetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetCall) Do(
+	fn func(params certificate.CertificateGetParams) (*certificate.CertificateGetOK, error),
+) *CertificateClientServiceCertificateGetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateGetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetCall) Times(n int) *CertificateClientServiceCertificateGetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetCall) AnyTimes() *CertificateClientServiceCertificateGetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGetWithContext(ctx context.Context, params certificate.CertificateGetParams) (*certificate.CertificateGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetWithContextCall is an expected call to CertificateGetWithContext
+type CertificateClientServiceCertificateGetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGetWithContext expects a single call to CertificateGetWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateGetWithContext() *CertificateClientServiceCertificateGetWithContextCall {
+	return &CertificateClientServiceCertificateGetWithContextCall{m.controller.expect("CertificateGetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateGetWithContextCall) With(params certificate.CertificateGetParams) *CertificateClientServiceCertificateGetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetWithContextCall) Return(r0 *certificate.CertificateGetOK, r1 error) *CertificateClientServiceCertificateGetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateGetParams) (*certificate.CertificateGetOK, error),
+) *CertificateClientServiceCertificateGetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateGetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetWithContextCall) Times(n int) *CertificateClientServiceCertificateGetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetWithContextCall) AnyTimes() *CertificateClientServiceCertificateGetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGetCertificateStatus returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGetCertificateStatus(params certificate.CertificateGetCertificateStatusParams) (*certificate.CertificateGetCertificateStatusOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGetCertificateStatus",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetCertificateStatusOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetCertificateStatusCall is an expected call to CertificateGetCertificateStatus
+type CertificateClientServiceCertificateGetCertificateStatusCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGetCertificateStatus expects a single call to CertificateGetCertificateStatus with any arguments
+func (m *CertificateClientService) ExpectCertificateGetCertificateStatus() *CertificateClientServiceCertificateGetCertificateStatusCall {
+	return &CertificateClientServiceCertificateGetCertificateStatusCall{m.controller.expect("CertificateGetCertificateStatus")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateGetCertificateStatusCall) With(params certificate.CertificateGetCertificateStatusParams) *CertificateClientServiceCertificateGetCertificateStatusCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetCertificateStatusCall) Return(r0 *certificate.CertificateGetCertificateStatusOK, r1 error) *CertificateClientServiceCertificateGetCertificateStatusCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetCertificateStatusCall) Do(
+	fn func(params certificate.CertificateGetCertificateStatusParams) (*certificate.CertificateGetCertificateStatusOK, error),
+) *CertificateClientServiceCertificateGetCertificateStatusCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateGetCertificateStatusParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetCertificateStatusCall) Times(n int) *CertificateClientServiceCertificateGetCertificateStatusCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetCertificateStatusCall) AnyTimes() *CertificateClientServiceCertificateGetCertificateStatusCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGetCertificateStatusWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGetCertificateStatusWithContext(ctx context.Context, params certificate.CertificateGetCertificateStatusParams) (*certificate.CertificateGetCertificateStatusOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGetCertificateStatusWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetCertificateStatusOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetCertificateStatusWithContextCall is an expected call to CertificateGetCertificateStatusWithContext
+type CertificateClientServiceCertificateGetCertificateStatusWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGetCertificateStatusWithContext expects a single call to CertificateGetCertificateStatusWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateGetCertificateStatusWithContext() *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	return &CertificateClientServiceCertificateGetCertificateStatusWithContextCall{m.controller.expect("CertificateGetCertificateStatusWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateGetCertificateStatusWithContextCall) With(params certificate.CertificateGetCertificateStatusParams) *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetCertificateStatusWithContextCall) Return(r0 *certificate.CertificateGetCertificateStatusOK, r1 error) *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetCertificateStatusWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateGetCertificateStatusParams) (*certificate.CertificateGetCertificateStatusOK, error),
+) *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateGetCertificateStatusParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetCertificateStatusWithContextCall) Times(n int) *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetCertificateStatusWithContextCall) AnyTimes() *CertificateClientServiceCertificateGetCertificateStatusWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGetRequestNotifications returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGetRequestNotifications(params certificate.CertificateGetRequestNotificationsParams) (*certificate.CertificateGetRequestNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGetRequestNotifications",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetRequestNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetRequestNotificationsCall is an expected call to CertificateGetRequestNotifications
+type CertificateClientServiceCertificateGetRequestNotificationsCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGetRequestNotifications expects a single call to CertificateGetRequestNotifications with any arguments
+func (m *CertificateClientService) ExpectCertificateGetRequestNotifications() *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	return &CertificateClientServiceCertificateGetRequestNotificationsCall{m.controller.expect("CertificateGetRequestNotifications")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateGetRequestNotificationsCall) With(params certificate.CertificateGetRequestNotificationsParams) *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetRequestNotificationsCall) Return(r0 *certificate.CertificateGetRequestNotificationsOK, r1 error) *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetRequestNotificationsCall) Do(
+	fn func(params certificate.CertificateGetRequestNotificationsParams) (*certificate.CertificateGetRequestNotificationsOK, error),
+) *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateGetRequestNotificationsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetRequestNotificationsCall) Times(n int) *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetRequestNotificationsCall) AnyTimes() *CertificateClientServiceCertificateGetRequestNotificationsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateGetRequestNotificationsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateGetRequestNotificationsWithContext(ctx context.Context, params certificate.CertificateGetRequestNotificationsParams) (*certificate.CertificateGetRequestNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateGetRequestNotificationsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateGetRequestNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateGetRequestNotificationsWithContextCall is an expected call to CertificateGetRequestNotificationsWithContext
+type CertificateClientServiceCertificateGetRequestNotificationsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateGetRequestNotificationsWithContext expects a single call to CertificateGetRequestNotificationsWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateGetRequestNotificationsWithContext() *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	return &CertificateClientServiceCertificateGetRequestNotificationsWithContextCall{m.controller.expect("CertificateGetRequestNotificationsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall) With(params certificate.CertificateGetRequestNotificationsParams) *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall) Return(r0 *certificate.CertificateGetRequestNotificationsOK, r1 error) *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateGetRequestNotificationsParams) (*certificate.CertificateGetRequestNotificationsOK, error),
+) *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateGetRequestNotificationsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall) Times(n int) *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall) AnyTimes() *CertificateClientServiceCertificateGetRequestNotificationsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePatch returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePatch(params certificate.CertificatePatchParams) (*certificate.CertificatePatchOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePatch",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePatchOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePatchCall is an expected call to CertificatePatch
+type CertificateClientServiceCertificatePatchCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePatch expects a single call to CertificatePatch with any arguments
+func (m *CertificateClientService) ExpectCertificatePatch() *CertificateClientServiceCertificatePatchCall {
+	return &CertificateClientServiceCertificatePatchCall{m.controller.expect("CertificatePatch")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificatePatchCall) With(params certificate.CertificatePatchParams) *CertificateClientServiceCertificatePatchCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePatchCall) Return(r0 *certificate.CertificatePatchOK, r1 error) *CertificateClientServiceCertificatePatchCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePatchCall) Do(
+	fn func(params certificate.CertificatePatchParams) (*certificate.CertificatePatchOK, error),
+) *CertificateClientServiceCertificatePatchCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificatePatchParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePatchCall) Times(n int) *CertificateClientServiceCertificatePatchCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePatchCall) AnyTimes() *CertificateClientServiceCertificatePatchCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePatchWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePatchWithContext(ctx context.Context, params certificate.CertificatePatchParams) (*certificate.CertificatePatchOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePatchWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePatchOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePatchWithContextCall is an expected call to CertificatePatchWithContext
+type CertificateClientServiceCertificatePatchWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePatchWithContext expects a single call to CertificatePatchWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificatePatchWithContext() *CertificateClientServiceCertificatePatchWithContextCall {
+	return &CertificateClientServiceCertificatePatchWithContextCall{m.controller.expect("CertificatePatchWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificatePatchWithContextCall) With(params certificate.CertificatePatchParams) *CertificateClientServiceCertificatePatchWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePatchWithContextCall) Return(r0 *certificate.CertificatePatchOK, r1 error) *CertificateClientServiceCertificatePatchWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePatchWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificatePatchParams) (*certificate.CertificatePatchOK, error),
+) *CertificateClientServiceCertificatePatchWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificatePatchParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePatchWithContextCall) Times(n int) *CertificateClientServiceCertificatePatchWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePatchWithContextCall) AnyTimes() *CertificateClientServiceCertificatePatchWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePost returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePost(params certificate.CertificatePostParams) (*certificate.CertificatePostCreated, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePost",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePostCreated)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePostCall is an expected call to CertificatePost
+type CertificateClientServiceCertificatePostCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePost expects a single call to CertificatePost with any arguments
+func (m *CertificateClientService) ExpectCertificatePost() *CertificateClientServiceCertificatePostCall {
+	return &CertificateClientServiceCertificatePostCall{m.controller.expect("CertificatePost")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificatePostCall) With(params certificate.CertificatePostParams) *CertificateClientServiceCertificatePostCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePostCall) Return(r0 *certificate.CertificatePostCreated, r1 error) *CertificateClientServiceCertificatePostCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePostCall) Do(
+	fn func(params certificate.CertificatePostParams) (*certificate.CertificatePostCreated, error),
+) *CertificateClientServiceCertificatePostCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificatePostParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePostCall) Times(n int) *CertificateClientServiceCertificatePostCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePostCall) AnyTimes() *CertificateClientServiceCertificatePostCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePostWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePostWithContext(ctx context.Context, params certificate.CertificatePostParams) (*certificate.CertificatePostCreated, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePostWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePostCreated)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePostWithContextCall is an expected call to CertificatePostWithContext
+type CertificateClientServiceCertificatePostWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePostWithContext expects a single call to CertificatePostWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificatePostWithContext() *CertificateClientServiceCertificatePostWithContextCall {
+	return &CertificateClientServiceCertificatePostWithContextCall{m.controller.expect("CertificatePostWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificatePostWithContextCall) With(params certificate.CertificatePostParams) *CertificateClientServiceCertificatePostWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePostWithContextCall) Return(r0 *certificate.CertificatePostCreated, r1 error) *CertificateClientServiceCertificatePostWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePostWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificatePostParams) (*certificate.CertificatePostCreated, error),
+) *CertificateClientServiceCertificatePostWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificatePostParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePostWithContextCall) Times(n int) *CertificateClientServiceCertificatePostWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePostWithContextCall) AnyTimes() *CertificateClientServiceCertificatePostWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutOrganizationDetails returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutOrganizationDetails(params certificate.CertificatePutOrganizationDetailsParams) (*certificate.CertificatePutOrganizationDetailsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutOrganizationDetails",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutOrganizationDetailsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutOrganizationDetailsCall is an expected call to CertificatePutOrganizationDetails
+type CertificateClientServiceCertificatePutOrganizationDetailsCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutOrganizationDetails expects a single call to CertificatePutOrganizationDetails with any arguments
+func (m *CertificateClientService) ExpectCertificatePutOrganizationDetails() *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	return &CertificateClientServiceCertificatePutOrganizationDetailsCall{m.controller.expect("CertificatePutOrganizationDetails")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsCall) With(params certificate.CertificatePutOrganizationDetailsParams) *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsCall) Return(r0 *certificate.CertificatePutOrganizationDetailsOK, r1 error) *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsCall) Do(
+	fn func(params certificate.CertificatePutOrganizationDetailsParams) (*certificate.CertificatePutOrganizationDetailsOK, error),
+) *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificatePutOrganizationDetailsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsCall) Times(n int) *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsCall) AnyTimes() *CertificateClientServiceCertificatePutOrganizationDetailsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutOrganizationDetailsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutOrganizationDetailsWithContext(ctx context.Context, params certificate.CertificatePutOrganizationDetailsParams) (*certificate.CertificatePutOrganizationDetailsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutOrganizationDetailsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutOrganizationDetailsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall is an expected call to CertificatePutOrganizationDetailsWithContext
+type CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutOrganizationDetailsWithContext expects a single call to CertificatePutOrganizationDetailsWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificatePutOrganizationDetailsWithContext() *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	return &CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall{m.controller.expect("CertificatePutOrganizationDetailsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall) With(params certificate.CertificatePutOrganizationDetailsParams) *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall) Return(r0 *certificate.CertificatePutOrganizationDetailsOK, r1 error) *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificatePutOrganizationDetailsParams) (*certificate.CertificatePutOrganizationDetailsOK, error),
+) *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificatePutOrganizationDetailsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall) Times(n int) *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall) AnyTimes() *CertificateClientServiceCertificatePutOrganizationDetailsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutRenewal returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutRenewal(params certificate.CertificatePutRenewalParams) (*certificate.CertificatePutRenewalNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutRenewal",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutRenewalNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutRenewalCall is an expected call to CertificatePutRenewal
+type CertificateClientServiceCertificatePutRenewalCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutRenewal expects a single call to CertificatePutRenewal with any arguments
+func (m *CertificateClientService) ExpectCertificatePutRenewal() *CertificateClientServiceCertificatePutRenewalCall {
+	return &CertificateClientServiceCertificatePutRenewalCall{m.controller.expect("CertificatePutRenewal")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificatePutRenewalCall) With(params certificate.CertificatePutRenewalParams) *CertificateClientServiceCertificatePutRenewalCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutRenewalCall) Return(r0 *certificate.CertificatePutRenewalNoContent, r1 error) *CertificateClientServiceCertificatePutRenewalCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutRenewalCall) Do(
+	fn func(params certificate.CertificatePutRenewalParams) (*certificate.CertificatePutRenewalNoContent, error),
+) *CertificateClientServiceCertificatePutRenewalCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificatePutRenewalParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutRenewalCall) Times(n int) *CertificateClientServiceCertificatePutRenewalCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutRenewalCall) AnyTimes() *CertificateClientServiceCertificatePutRenewalCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutRenewalWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutRenewalWithContext(ctx context.Context, params certificate.CertificatePutRenewalParams) (*certificate.CertificatePutRenewalNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutRenewalWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutRenewalNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutRenewalWithContextCall is an expected call to CertificatePutRenewalWithContext
+type CertificateClientServiceCertificatePutRenewalWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutRenewalWithContext expects a single call to CertificatePutRenewalWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificatePutRenewalWithContext() *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	return &CertificateClientServiceCertificatePutRenewalWithContextCall{m.controller.expect("CertificatePutRenewalWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificatePutRenewalWithContextCall) With(params certificate.CertificatePutRenewalParams) *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutRenewalWithContextCall) Return(r0 *certificate.CertificatePutRenewalNoContent, r1 error) *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutRenewalWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificatePutRenewalParams) (*certificate.CertificatePutRenewalNoContent, error),
+) *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificatePutRenewalParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutRenewalWithContextCall) Times(n int) *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutRenewalWithContextCall) AnyTimes() *CertificateClientServiceCertificatePutRenewalWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutRetrigger returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutRetrigger(params certificate.CertificatePutRetriggerParams) (*certificate.CertificatePutRetriggerNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutRetrigger",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutRetriggerNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutRetriggerCall is an expected call to CertificatePutRetrigger
+type CertificateClientServiceCertificatePutRetriggerCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutRetrigger expects a single call to CertificatePutRetrigger with any arguments
+func (m *CertificateClientService) ExpectCertificatePutRetrigger() *CertificateClientServiceCertificatePutRetriggerCall {
+	return &CertificateClientServiceCertificatePutRetriggerCall{m.controller.expect("CertificatePutRetrigger")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificatePutRetriggerCall) With(params certificate.CertificatePutRetriggerParams) *CertificateClientServiceCertificatePutRetriggerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutRetriggerCall) Return(r0 *certificate.CertificatePutRetriggerNoContent, r1 error) *CertificateClientServiceCertificatePutRetriggerCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutRetriggerCall) Do(
+	fn func(params certificate.CertificatePutRetriggerParams) (*certificate.CertificatePutRetriggerNoContent, error),
+) *CertificateClientServiceCertificatePutRetriggerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificatePutRetriggerParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutRetriggerCall) Times(n int) *CertificateClientServiceCertificatePutRetriggerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutRetriggerCall) AnyTimes() *CertificateClientServiceCertificatePutRetriggerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificatePutRetriggerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificatePutRetriggerWithContext(ctx context.Context, params certificate.CertificatePutRetriggerParams) (*certificate.CertificatePutRetriggerNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificatePutRetriggerWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificatePutRetriggerNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificatePutRetriggerWithContextCall is an expected call to CertificatePutRetriggerWithContext
+type CertificateClientServiceCertificatePutRetriggerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificatePutRetriggerWithContext expects a single call to CertificatePutRetriggerWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificatePutRetriggerWithContext() *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	return &CertificateClientServiceCertificatePutRetriggerWithContextCall{m.controller.expect("CertificatePutRetriggerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificatePutRetriggerWithContextCall) With(params certificate.CertificatePutRetriggerParams) *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificatePutRetriggerWithContextCall) Return(r0 *certificate.CertificatePutRetriggerNoContent, r1 error) *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificatePutRetriggerWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificatePutRetriggerParams) (*certificate.CertificatePutRetriggerNoContent, error),
+) *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificatePutRetriggerParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificatePutRetriggerWithContextCall) Times(n int) *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificatePutRetriggerWithContextCall) AnyTimes() *CertificateClientServiceCertificatePutRetriggerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateUpdateRequestNotifications returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateUpdateRequestNotifications(params certificate.CertificateUpdateRequestNotificationsParams) (*certificate.CertificateUpdateRequestNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateUpdateRequestNotifications",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateUpdateRequestNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateUpdateRequestNotificationsCall is an expected call to CertificateUpdateRequestNotifications
+type CertificateClientServiceCertificateUpdateRequestNotificationsCall struct {
+	e *expectation
+}
+
+// ExpectCertificateUpdateRequestNotifications expects a single call to CertificateUpdateRequestNotifications with any arguments
+func (m *CertificateClientService) ExpectCertificateUpdateRequestNotifications() *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	return &CertificateClientServiceCertificateUpdateRequestNotificationsCall{m.controller.expect("CertificateUpdateRequestNotifications")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsCall) With(params certificate.CertificateUpdateRequestNotificationsParams) *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsCall) Return(r0 *certificate.CertificateUpdateRequestNotificationsOK, r1 error) *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsCall) Do(
+	fn func(params certificate.CertificateUpdateRequestNotificationsParams) (*certificate.CertificateUpdateRequestNotificationsOK, error),
+) *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(certificate.CertificateUpdateRequestNotificationsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsCall) Times(n int) *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsCall) AnyTimes() *CertificateClientServiceCertificateUpdateRequestNotificationsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CertificateUpdateRequestNotificationsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CertificateClientService) CertificateUpdateRequestNotificationsWithContext(ctx context.Context, params certificate.CertificateUpdateRequestNotificationsParams) (*certificate.CertificateUpdateRequestNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CertificateUpdateRequestNotificationsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*certificate.CertificateUpdateRequestNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall is an expected call to CertificateUpdateRequestNotificationsWithContext
+type CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCertificateUpdateRequestNotificationsWithContext expects a single call to CertificateUpdateRequestNotificationsWithContext with any arguments
+func (m *CertificateClientService) ExpectCertificateUpdateRequestNotificationsWithContext() *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	return &CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall{m.controller.expect("CertificateUpdateRequestNotificationsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall) With(params certificate.CertificateUpdateRequestNotificationsParams) *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall) Return(r0 *certificate.CertificateUpdateRequestNotificationsOK, r1 error) *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall) Do(
+	fn func(ctx context.Context, params certificate.CertificateUpdateRequestNotificationsParams) (*certificate.CertificateUpdateRequestNotificationsOK, error),
+) *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(certificate.CertificateUpdateRequestNotificationsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall) Times(n int) *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall) AnyTimes() *CertificateClientServiceCertificateUpdateRequestNotificationsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,1241 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+)
+
+// CommonClientService is a mock of originv3.CommonClientService
+type CommonClientService struct {
+	controller *controller
+}
+
+var _ originv3.CommonClientService = (*CommonClientService)(nil)
+
+// NewCommonClientService creates a mock of originv3.CommonClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewCommonClientService(t TestingT) *CommonClientService {
+	return &CommonClientService{controller: newController(t, "CommonClientService")}
+}
+
+// AddOrigin returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) AddOrigin(params originv3.AddOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceAddOriginCall is an expected call to AddOrigin
+type CommonClientServiceAddOriginCall struct {
+	e *expectation
+}
+
+// ExpectAddOrigin expects a single call to AddOrigin with any arguments
+func (m *CommonClientService) ExpectAddOrigin() *CommonClientServiceAddOriginCall {
+	return &CommonClientServiceAddOriginCall{m.controller.expect("AddOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceAddOriginCall) With(params originv3.AddOriginParams) *CommonClientServiceAddOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceAddOriginCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceAddOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceAddOriginCall) Do(
+	fn func(params originv3.AddOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceAddOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.AddOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceAddOriginCall) Times(n int) *CommonClientServiceAddOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceAddOriginCall) AnyTimes() *CommonClientServiceAddOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) AddOriginWithContext(ctx context.Context, params originv3.AddOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceAddOriginWithContextCall is an expected call to AddOriginWithContext
+type CommonClientServiceAddOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddOriginWithContext expects a single call to AddOriginWithContext with any arguments
+func (m *CommonClientService) ExpectAddOriginWithContext() *CommonClientServiceAddOriginWithContextCall {
+	return &CommonClientServiceAddOriginWithContextCall{m.controller.expect("AddOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceAddOriginWithContextCall) With(params originv3.AddOriginParams) *CommonClientServiceAddOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceAddOriginWithContextCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceAddOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceAddOriginWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.AddOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceAddOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.AddOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceAddOriginWithContextCall) Times(n int) *CommonClientServiceAddOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceAddOriginWithContextCall) AnyTimes() *CommonClientServiceAddOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteGroup returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) DeleteGroup(params originv3.DeleteGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteGroup",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceDeleteGroupCall is an expected call to DeleteGroup
+type CommonClientServiceDeleteGroupCall struct {
+	e *expectation
+}
+
+// ExpectDeleteGroup expects a single call to DeleteGroup with any arguments
+func (m *CommonClientService) ExpectDeleteGroup() *CommonClientServiceDeleteGroupCall {
+	return &CommonClientServiceDeleteGroupCall{m.controller.expect("DeleteGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceDeleteGroupCall) With(params originv3.DeleteGroupParams) *CommonClientServiceDeleteGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceDeleteGroupCall) Return(r0 error) *CommonClientServiceDeleteGroupCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceDeleteGroupCall) Do(
+	fn func(params originv3.DeleteGroupParams) error,
+) *CommonClientServiceDeleteGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.DeleteGroupParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceDeleteGroupCall) Times(n int) *CommonClientServiceDeleteGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceDeleteGroupCall) AnyTimes() *CommonClientServiceDeleteGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) DeleteGroupWithContext(ctx context.Context, params originv3.DeleteGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteGroupWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceDeleteGroupWithContextCall is an expected call to DeleteGroupWithContext
+type CommonClientServiceDeleteGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteGroupWithContext expects a single call to DeleteGroupWithContext with any arguments
+func (m *CommonClientService) ExpectDeleteGroupWithContext() *CommonClientServiceDeleteGroupWithContextCall {
+	return &CommonClientServiceDeleteGroupWithContextCall{m.controller.expect("DeleteGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceDeleteGroupWithContextCall) With(params originv3.DeleteGroupParams) *CommonClientServiceDeleteGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceDeleteGroupWithContextCall) Return(r0 error) *CommonClientServiceDeleteGroupWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceDeleteGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.DeleteGroupParams) error,
+) *CommonClientServiceDeleteGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.DeleteGroupParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceDeleteGroupWithContextCall) Times(n int) *CommonClientServiceDeleteGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceDeleteGroupWithContextCall) AnyTimes() *CommonClientServiceDeleteGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteOrigin returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) DeleteOrigin(params originv3.DeleteOriginParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteOrigin",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceDeleteOriginCall is an expected call to DeleteOrigin
+type CommonClientServiceDeleteOriginCall struct {
+	e *expectation
+}
+
+// ExpectDeleteOrigin expects a single call to DeleteOrigin with any arguments
+func (m *CommonClientService) ExpectDeleteOrigin() *CommonClientServiceDeleteOriginCall {
+	return &CommonClientServiceDeleteOriginCall{m.controller.expect("DeleteOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceDeleteOriginCall) With(params originv3.DeleteOriginParams) *CommonClientServiceDeleteOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceDeleteOriginCall) Return(r0 error) *CommonClientServiceDeleteOriginCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceDeleteOriginCall) Do(
+	fn func(params originv3.DeleteOriginParams) error,
+) *CommonClientServiceDeleteOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.DeleteOriginParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceDeleteOriginCall) Times(n int) *CommonClientServiceDeleteOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceDeleteOriginCall) AnyTimes() *CommonClientServiceDeleteOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) DeleteOriginWithContext(ctx context.Context, params originv3.DeleteOriginParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteOriginWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceDeleteOriginWithContextCall is an expected call to DeleteOriginWithContext
+type CommonClientServiceDeleteOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteOriginWithContext expects a single call to DeleteOriginWithContext with any arguments
+func (m *CommonClientService) ExpectDeleteOriginWithContext() *CommonClientServiceDeleteOriginWithContextCall {
+	return &CommonClientServiceDeleteOriginWithContextCall{m.controller.expect("DeleteOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceDeleteOriginWithContextCall) With(params originv3.DeleteOriginParams) *CommonClientServiceDeleteOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceDeleteOriginWithContextCall) Return(r0 error) *CommonClientServiceDeleteOriginWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceDeleteOriginWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.DeleteOriginParams) error,
+) *CommonClientServiceDeleteOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.DeleteOriginParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceDeleteOriginWithContextCall) Times(n int) *CommonClientServiceDeleteOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceDeleteOriginWithContextCall) AnyTimes() *CommonClientServiceDeleteOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllOrigins returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetAllOrigins(params originv3.GetAllOriginsParams) ([]originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllOrigins",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetAllOriginsCall is an expected call to GetAllOrigins
+type CommonClientServiceGetAllOriginsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllOrigins expects a single call to GetAllOrigins with any arguments
+func (m *CommonClientService) ExpectGetAllOrigins() *CommonClientServiceGetAllOriginsCall {
+	return &CommonClientServiceGetAllOriginsCall{m.controller.expect("GetAllOrigins")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceGetAllOriginsCall) With(params originv3.GetAllOriginsParams) *CommonClientServiceGetAllOriginsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetAllOriginsCall) Return(r0 []originv3.CustomerOrigin, r1 error) *CommonClientServiceGetAllOriginsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetAllOriginsCall) Do(
+	fn func(params originv3.GetAllOriginsParams) ([]originv3.CustomerOrigin, error),
+) *CommonClientServiceGetAllOriginsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetAllOriginsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetAllOriginsCall) Times(n int) *CommonClientServiceGetAllOriginsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetAllOriginsCall) AnyTimes() *CommonClientServiceGetAllOriginsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllOriginsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetAllOriginsWithContext(ctx context.Context, params originv3.GetAllOriginsParams) ([]originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllOriginsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetAllOriginsWithContextCall is an expected call to GetAllOriginsWithContext
+type CommonClientServiceGetAllOriginsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllOriginsWithContext expects a single call to GetAllOriginsWithContext with any arguments
+func (m *CommonClientService) ExpectGetAllOriginsWithContext() *CommonClientServiceGetAllOriginsWithContextCall {
+	return &CommonClientServiceGetAllOriginsWithContextCall{m.controller.expect("GetAllOriginsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceGetAllOriginsWithContextCall) With(params originv3.GetAllOriginsParams) *CommonClientServiceGetAllOriginsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetAllOriginsWithContextCall) Return(r0 []originv3.CustomerOrigin, r1 error) *CommonClientServiceGetAllOriginsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetAllOriginsWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetAllOriginsParams) ([]originv3.CustomerOrigin, error),
+) *CommonClientServiceGetAllOriginsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetAllOriginsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetAllOriginsWithContextCall) Times(n int) *CommonClientServiceGetAllOriginsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetAllOriginsWithContextCall) AnyTimes() *CommonClientServiceGetAllOriginsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeFunctions returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetEdgeFunctions(params originv3.GetEdgeFunctionsParams) ([]originv3.EdgeFunction, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeFunctions",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].([]originv3.EdgeFunction)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetEdgeFunctionsCall is an expected call to GetEdgeFunctions
+type CommonClientServiceGetEdgeFunctionsCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeFunctions expects a single call to GetEdgeFunctions with any arguments
+func (m *CommonClientService) ExpectGetEdgeFunctions() *CommonClientServiceGetEdgeFunctionsCall {
+	return &CommonClientServiceGetEdgeFunctionsCall{m.controller.expect("GetEdgeFunctions")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceGetEdgeFunctionsCall) With(params originv3.GetEdgeFunctionsParams) *CommonClientServiceGetEdgeFunctionsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetEdgeFunctionsCall) Return(r0 []originv3.EdgeFunction, r1 error) *CommonClientServiceGetEdgeFunctionsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetEdgeFunctionsCall) Do(
+	fn func(params originv3.GetEdgeFunctionsParams) ([]originv3.EdgeFunction, error),
+) *CommonClientServiceGetEdgeFunctionsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetEdgeFunctionsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetEdgeFunctionsCall) Times(n int) *CommonClientServiceGetEdgeFunctionsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetEdgeFunctionsCall) AnyTimes() *CommonClientServiceGetEdgeFunctionsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeFunctionsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetEdgeFunctionsWithContext(ctx context.Context, params originv3.GetEdgeFunctionsParams) ([]originv3.EdgeFunction, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeFunctionsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].([]originv3.EdgeFunction)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetEdgeFunctionsWithContextCall is an expected call to GetEdgeFunctionsWithContext
+type CommonClientServiceGetEdgeFunctionsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeFunctionsWithContext expects a single call to GetEdgeFunctionsWithContext with any arguments
+func (m *CommonClientService) ExpectGetEdgeFunctionsWithContext() *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	return &CommonClientServiceGetEdgeFunctionsWithContextCall{m.controller.expect("GetEdgeFunctionsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceGetEdgeFunctionsWithContextCall) With(params originv3.GetEdgeFunctionsParams) *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetEdgeFunctionsWithContextCall) Return(r0 []originv3.EdgeFunction, r1 error) *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetEdgeFunctionsWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetEdgeFunctionsParams) ([]originv3.EdgeFunction, error),
+) *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetEdgeFunctionsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetEdgeFunctionsWithContextCall) Times(n int) *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetEdgeFunctionsWithContextCall) AnyTimes() *CommonClientServiceGetEdgeFunctionsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetGroupStatus returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetGroupStatus(params originv3.GetGroupStatusParams) (*originv3.CustomerOriginStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetGroupStatus",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetGroupStatusCall is an expected call to GetGroupStatus
+type CommonClientServiceGetGroupStatusCall struct {
+	e *expectation
+}
+
+// ExpectGetGroupStatus expects a single call to GetGroupStatus with any arguments
+func (m *CommonClientService) ExpectGetGroupStatus() *CommonClientServiceGetGroupStatusCall {
+	return &CommonClientServiceGetGroupStatusCall{m.controller.expect("GetGroupStatus")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceGetGroupStatusCall) With(params originv3.GetGroupStatusParams) *CommonClientServiceGetGroupStatusCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetGroupStatusCall) Return(r0 *originv3.CustomerOriginStatus, r1 error) *CommonClientServiceGetGroupStatusCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetGroupStatusCall) Do(
+	fn func(params originv3.GetGroupStatusParams) (*originv3.CustomerOriginStatus, error),
+) *CommonClientServiceGetGroupStatusCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetGroupStatusParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetGroupStatusCall) Times(n int) *CommonClientServiceGetGroupStatusCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetGroupStatusCall) AnyTimes() *CommonClientServiceGetGroupStatusCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetGroupStatusWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetGroupStatusWithContext(ctx context.Context, params originv3.GetGroupStatusParams) (*originv3.CustomerOriginStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetGroupStatusWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetGroupStatusWithContextCall is an expected call to GetGroupStatusWithContext
+type CommonClientServiceGetGroupStatusWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetGroupStatusWithContext expects a single call to GetGroupStatusWithContext with any arguments
+func (m *CommonClientService) ExpectGetGroupStatusWithContext() *CommonClientServiceGetGroupStatusWithContextCall {
+	return &CommonClientServiceGetGroupStatusWithContextCall{m.controller.expect("GetGroupStatusWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceGetGroupStatusWithContextCall) With(params originv3.GetGroupStatusParams) *CommonClientServiceGetGroupStatusWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetGroupStatusWithContextCall) Return(r0 *originv3.CustomerOriginStatus, r1 error) *CommonClientServiceGetGroupStatusWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetGroupStatusWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetGroupStatusParams) (*originv3.CustomerOriginStatus, error),
+) *CommonClientServiceGetGroupStatusWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetGroupStatusParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetGroupStatusWithContextCall) Times(n int) *CommonClientServiceGetGroupStatusWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetGroupStatusWithContextCall) AnyTimes() *CommonClientServiceGetGroupStatusWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOrigin returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetOrigin(params originv3.GetOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetOriginCall is an expected call to GetOrigin
+type CommonClientServiceGetOriginCall struct {
+	e *expectation
+}
+
+// ExpectGetOrigin expects a single call to GetOrigin with any arguments
+func (m *CommonClientService) ExpectGetOrigin() *CommonClientServiceGetOriginCall {
+	return &CommonClientServiceGetOriginCall{m.controller.expect("GetOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceGetOriginCall) With(params originv3.GetOriginParams) *CommonClientServiceGetOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetOriginCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceGetOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetOriginCall) Do(
+	fn func(params originv3.GetOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceGetOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetOriginCall) Times(n int) *CommonClientServiceGetOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetOriginCall) AnyTimes() *CommonClientServiceGetOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetOriginWithContext(ctx context.Context, params originv3.GetOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetOriginWithContextCall is an expected call to GetOriginWithContext
+type CommonClientServiceGetOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginWithContext expects a single call to GetOriginWithContext with any arguments
+func (m *CommonClientService) ExpectGetOriginWithContext() *CommonClientServiceGetOriginWithContextCall {
+	return &CommonClientServiceGetOriginWithContextCall{m.controller.expect("GetOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceGetOriginWithContextCall) With(params originv3.GetOriginParams) *CommonClientServiceGetOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetOriginWithContextCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceGetOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetOriginWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceGetOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetOriginWithContextCall) Times(n int) *CommonClientServiceGetOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetOriginWithContextCall) AnyTimes() *CommonClientServiceGetOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginsByGroup returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetOriginsByGroup(params originv3.GetOriginsByGroupParams) ([]originv3.CustomerOriginFailoverOrder, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginsByGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginFailoverOrder)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetOriginsByGroupCall is an expected call to GetOriginsByGroup
+type CommonClientServiceGetOriginsByGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginsByGroup expects a single call to GetOriginsByGroup with any arguments
+func (m *CommonClientService) ExpectGetOriginsByGroup() *CommonClientServiceGetOriginsByGroupCall {
+	return &CommonClientServiceGetOriginsByGroupCall{m.controller.expect("GetOriginsByGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceGetOriginsByGroupCall) With(params originv3.GetOriginsByGroupParams) *CommonClientServiceGetOriginsByGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetOriginsByGroupCall) Return(r0 []originv3.CustomerOriginFailoverOrder, r1 error) *CommonClientServiceGetOriginsByGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetOriginsByGroupCall) Do(
+	fn func(params originv3.GetOriginsByGroupParams) ([]originv3.CustomerOriginFailoverOrder, error),
+) *CommonClientServiceGetOriginsByGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetOriginsByGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetOriginsByGroupCall) Times(n int) *CommonClientServiceGetOriginsByGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetOriginsByGroupCall) AnyTimes() *CommonClientServiceGetOriginsByGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginsByGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) GetOriginsByGroupWithContext(ctx context.Context, params originv3.GetOriginsByGroupParams) ([]originv3.CustomerOriginFailoverOrder, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginsByGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginFailoverOrder)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceGetOriginsByGroupWithContextCall is an expected call to GetOriginsByGroupWithContext
+type CommonClientServiceGetOriginsByGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginsByGroupWithContext expects a single call to GetOriginsByGroupWithContext with any arguments
+func (m *CommonClientService) ExpectGetOriginsByGroupWithContext() *CommonClientServiceGetOriginsByGroupWithContextCall {
+	return &CommonClientServiceGetOriginsByGroupWithContextCall{m.controller.expect("GetOriginsByGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceGetOriginsByGroupWithContextCall) With(params originv3.GetOriginsByGroupParams) *CommonClientServiceGetOriginsByGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceGetOriginsByGroupWithContextCall) Return(r0 []originv3.CustomerOriginFailoverOrder, r1 error) *CommonClientServiceGetOriginsByGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceGetOriginsByGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetOriginsByGroupParams) ([]originv3.CustomerOriginFailoverOrder, error),
+) *CommonClientServiceGetOriginsByGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetOriginsByGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceGetOriginsByGroupWithContextCall) Times(n int) *CommonClientServiceGetOriginsByGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceGetOriginsByGroupWithContextCall) AnyTimes() *CommonClientServiceGetOriginsByGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateFailoverOrder returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) UpdateFailoverOrder(params originv3.UpdateFailoverOrderParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateFailoverOrder",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceUpdateFailoverOrderCall is an expected call to UpdateFailoverOrder
+type CommonClientServiceUpdateFailoverOrderCall struct {
+	e *expectation
+}
+
+// ExpectUpdateFailoverOrder expects a single call to UpdateFailoverOrder with any arguments
+func (m *CommonClientService) ExpectUpdateFailoverOrder() *CommonClientServiceUpdateFailoverOrderCall {
+	return &CommonClientServiceUpdateFailoverOrderCall{m.controller.expect("UpdateFailoverOrder")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceUpdateFailoverOrderCall) With(params originv3.UpdateFailoverOrderParams) *CommonClientServiceUpdateFailoverOrderCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceUpdateFailoverOrderCall) Return(r0 error) *CommonClientServiceUpdateFailoverOrderCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceUpdateFailoverOrderCall) Do(
+	fn func(params originv3.UpdateFailoverOrderParams) error,
+) *CommonClientServiceUpdateFailoverOrderCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.UpdateFailoverOrderParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceUpdateFailoverOrderCall) Times(n int) *CommonClientServiceUpdateFailoverOrderCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceUpdateFailoverOrderCall) AnyTimes() *CommonClientServiceUpdateFailoverOrderCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateFailoverOrderWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) UpdateFailoverOrderWithContext(ctx context.Context, params originv3.UpdateFailoverOrderParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateFailoverOrderWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CommonClientServiceUpdateFailoverOrderWithContextCall is an expected call to UpdateFailoverOrderWithContext
+type CommonClientServiceUpdateFailoverOrderWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateFailoverOrderWithContext expects a single call to UpdateFailoverOrderWithContext with any arguments
+func (m *CommonClientService) ExpectUpdateFailoverOrderWithContext() *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	return &CommonClientServiceUpdateFailoverOrderWithContextCall{m.controller.expect("UpdateFailoverOrderWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceUpdateFailoverOrderWithContextCall) With(params originv3.UpdateFailoverOrderParams) *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceUpdateFailoverOrderWithContextCall) Return(r0 error) *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceUpdateFailoverOrderWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.UpdateFailoverOrderParams) error,
+) *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.UpdateFailoverOrderParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceUpdateFailoverOrderWithContextCall) Times(n int) *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceUpdateFailoverOrderWithContextCall) AnyTimes() *CommonClientServiceUpdateFailoverOrderWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateOrigin returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) UpdateOrigin(params originv3.UpdateOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceUpdateOriginCall is an expected call to UpdateOrigin
+type CommonClientServiceUpdateOriginCall struct {
+	e *expectation
+}
+
+// ExpectUpdateOrigin expects a single call to UpdateOrigin with any arguments
+func (m *CommonClientService) ExpectUpdateOrigin() *CommonClientServiceUpdateOriginCall {
+	return &CommonClientServiceUpdateOriginCall{m.controller.expect("UpdateOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CommonClientServiceUpdateOriginCall) With(params originv3.UpdateOriginParams) *CommonClientServiceUpdateOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceUpdateOriginCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceUpdateOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceUpdateOriginCall) Do(
+	fn func(params originv3.UpdateOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceUpdateOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.UpdateOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceUpdateOriginCall) Times(n int) *CommonClientServiceUpdateOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceUpdateOriginCall) AnyTimes() *CommonClientServiceUpdateOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CommonClientService) UpdateOriginWithContext(ctx context.Context, params originv3.UpdateOriginParams) (*originv3.CustomerOrigin, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOrigin)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CommonClientServiceUpdateOriginWithContextCall is an expected call to UpdateOriginWithContext
+type CommonClientServiceUpdateOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateOriginWithContext expects a single call to UpdateOriginWithContext with any arguments
+func (m *CommonClientService) ExpectUpdateOriginWithContext() *CommonClientServiceUpdateOriginWithContextCall {
+	return &CommonClientServiceUpdateOriginWithContextCall{m.controller.expect("UpdateOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CommonClientServiceUpdateOriginWithContextCall) With(params originv3.UpdateOriginParams) *CommonClientServiceUpdateOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CommonClientServiceUpdateOriginWithContextCall) Return(r0 *originv3.CustomerOrigin, r1 error) *CommonClientServiceUpdateOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CommonClientServiceUpdateOriginWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.UpdateOriginParams) (*originv3.CustomerOrigin, error),
+) *CommonClientServiceUpdateOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.UpdateOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CommonClientServiceUpdateOriginWithContextCall) Times(n int) *CommonClientServiceUpdateOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CommonClientServiceUpdateOriginWithContextCall) AnyTimes() *CommonClientServiceUpdateOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+/*
+	This file contains the controller shared by all mocks, which matches calls
+	to expectations and verifies that every expectation was met
+*/
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TestingT is the subset of testing.TB used by mocks
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Cleanup(func())
+}
+
+// anything matches any argument. It is expected in place of contexts, so that
+// expectations need not match them.
+type anything struct{}
+
+// controller records the expectations of a mock and the calls made to it
+type controller struct {
+	t    TestingT
+	mock string
+
+	mu           sync.Mutex
+	expectations []*expectation
+}
+
+// newController creates a controller for the mock with the given name that
+// verifies its expectations when the test finishes
+func newController(t TestingT, mock string) *controller {
+	c := &controller{t: t, mock: mock}
+	t.Cleanup(c.verify)
+	return c
+}
+
+// expectation is an expected call to a method
+type expectation struct {
+	method string
+
+	// args are the expected arguments, or nil to match any arguments
+	args []interface{}
+
+	// results are returned by matching calls, unless fn is set
+	results []interface{}
+
+	// fn computes the results of matching calls from their arguments
+	fn func(args []interface{}) []interface{}
+
+	// min and max are the expected number of calls. A max of -1 allows any
+	// number of calls.
+	min int
+	max int
+
+	calls int
+}
+
+// expect adds an expectation of a single call to method with any arguments
+func (c *controller) expect(method string) *expectation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &expectation{method: method, min: 1, max: 1}
+	c.expectations = append(c.expectations, e)
+	return e
+}
+
+// call returns the results of the first expectation that matches a call to
+// method with args and can still be called. Unexpected calls fail the test
+// and return zero values.
+func (c *controller) call(
+	method string,
+	args []interface{},
+	numResults int,
+) []interface{} {
+	c.t.Helper()
+
+	c.mu.Lock()
+	var matched *expectation
+	for _, e := range c.expectations {
+		if e.method == method &&
+			(e.max < 0 || e.calls < e.max) &&
+			e.matches(args) {
+			matched = e
+			matched.calls++
+			break
+		}
+	}
+	c.mu.Unlock()
+
+	if matched == nil {
+		c.t.Errorf(
+			"mocks: unexpected call to %s.%s(%s)",
+			c.mock,
+			method,
+			formatArgs(args))
+		return make([]interface{}, numResults)
+	}
+
+	results := matched.results
+	if matched.fn != nil {
+		results = matched.fn(args)
+	}
+	if len(results) < numResults {
+		results = append(
+			results,
+			make([]interface{}, numResults-len(results))...)
+	}
+	return results
+}
+
+// verify fails the test for each expectation that was called fewer times
+// than expected
+func (c *controller) verify() {
+	c.t.Helper()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.expectations {
+		if e.calls < e.min {
+			c.t.Errorf(
+				"mocks: expected %d call(s) to %s.%s(%s) but got %d",
+				e.min,
+				c.mock,
+				e.method,
+				formatArgs(e.args),
+				e.calls)
+		}
+	}
+}
+
+func (e *expectation) matches(args []interface{}) bool {
+	if e.args == nil {
+		return true
+	}
+
+	for i, expected := range e.args {
+		if _, ok := expected.(anything); ok {
+			continue
+		}
+		if !reflect.DeepEqual(expected, args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// with restricts the expectation to calls with the given arguments
+func (e *expectation) with(args []interface{}) {
+	e.args = args
+}
+
+// returns sets the results of matching calls
+func (e *expectation) returns(results []interface{}) {
+	e.results = results
+}
+
+// do sets a function that computes the results of matching calls
+func (e *expectation) do(fn func(args []interface{}) []interface{}) {
+	e.fn = fn
+}
+
+// times sets the exact number of expected calls
+func (e *expectation) times(n int) {
+	e.min = n
+	e.max = n
+}
+
+// anyTimes allows any number of calls, including none
+func (e *expectation) anyTimes() {
+	e.min = 0
+	e.max = -1
+}
+
+func formatArgs(args []interface{}) string {
+	if args == nil {
+		return "..."
+	}
+
+	formatted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if _, ok := arg.(anything); ok {
+			formatted = append(formatted, "_")
+		} else {
+			formatted = append(formatted, fmt.Sprintf("%+v", arg))
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
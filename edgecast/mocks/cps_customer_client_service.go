@@ -0,0 +1,393 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/customer"
+)
+
+// CPSCustomerClientService is a mock of customer.ClientService
+type CPSCustomerClientService struct {
+	controller *controller
+}
+
+var _ customer.ClientService = (*CPSCustomerClientService)(nil)
+
+// NewCPSCustomerClientService creates a mock of customer.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewCPSCustomerClientService(t TestingT) *CPSCustomerClientService {
+	return &CPSCustomerClientService{controller: newController(t, "CPSCustomerClientService")}
+}
+
+// CustomerGetCustomerCommits returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerGetCustomerCommits(params customer.CustomerGetCustomerCommitsParams) (*customer.CustomerGetCustomerCommitsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerGetCustomerCommits",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetCustomerCommitsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerGetCustomerCommitsCall is an expected call to CustomerGetCustomerCommits
+type CPSCustomerClientServiceCustomerGetCustomerCommitsCall struct {
+	e *expectation
+}
+
+// ExpectCustomerGetCustomerCommits expects a single call to CustomerGetCustomerCommits with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerGetCustomerCommits() *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	return &CPSCustomerClientServiceCustomerGetCustomerCommitsCall{m.controller.expect("CustomerGetCustomerCommits")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsCall) With(params customer.CustomerGetCustomerCommitsParams) *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsCall) Return(r0 *customer.CustomerGetCustomerCommitsOK, r1 error) *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsCall) Do(
+	fn func(params customer.CustomerGetCustomerCommitsParams) (*customer.CustomerGetCustomerCommitsOK, error),
+) *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.CustomerGetCustomerCommitsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsCall) Times(n int) *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsCall) AnyTimes() *CPSCustomerClientServiceCustomerGetCustomerCommitsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CustomerGetCustomerCommitsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerGetCustomerCommitsWithContext(ctx context.Context, params customer.CustomerGetCustomerCommitsParams) (*customer.CustomerGetCustomerCommitsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerGetCustomerCommitsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetCustomerCommitsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall is an expected call to CustomerGetCustomerCommitsWithContext
+type CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCustomerGetCustomerCommitsWithContext expects a single call to CustomerGetCustomerCommitsWithContext with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerGetCustomerCommitsWithContext() *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	return &CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall{m.controller.expect("CustomerGetCustomerCommitsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall) With(params customer.CustomerGetCustomerCommitsParams) *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall) Return(r0 *customer.CustomerGetCustomerCommitsOK, r1 error) *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall) Do(
+	fn func(ctx context.Context, params customer.CustomerGetCustomerCommitsParams) (*customer.CustomerGetCustomerCommitsOK, error),
+) *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.CustomerGetCustomerCommitsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall) Times(n int) *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall) AnyTimes() *CPSCustomerClientServiceCustomerGetCustomerCommitsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CustomerGetCustomerNotifications returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerGetCustomerNotifications(params customer.CustomerGetCustomerNotificationsParams) (*customer.CustomerGetCustomerNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerGetCustomerNotifications",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetCustomerNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerGetCustomerNotificationsCall is an expected call to CustomerGetCustomerNotifications
+type CPSCustomerClientServiceCustomerGetCustomerNotificationsCall struct {
+	e *expectation
+}
+
+// ExpectCustomerGetCustomerNotifications expects a single call to CustomerGetCustomerNotifications with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerGetCustomerNotifications() *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	return &CPSCustomerClientServiceCustomerGetCustomerNotificationsCall{m.controller.expect("CustomerGetCustomerNotifications")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall) With(params customer.CustomerGetCustomerNotificationsParams) *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall) Return(r0 *customer.CustomerGetCustomerNotificationsOK, r1 error) *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall) Do(
+	fn func(params customer.CustomerGetCustomerNotificationsParams) (*customer.CustomerGetCustomerNotificationsOK, error),
+) *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.CustomerGetCustomerNotificationsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall) Times(n int) *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall) AnyTimes() *CPSCustomerClientServiceCustomerGetCustomerNotificationsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CustomerGetCustomerNotificationsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerGetCustomerNotificationsWithContext(ctx context.Context, params customer.CustomerGetCustomerNotificationsParams) (*customer.CustomerGetCustomerNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerGetCustomerNotificationsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetCustomerNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall is an expected call to CustomerGetCustomerNotificationsWithContext
+type CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCustomerGetCustomerNotificationsWithContext expects a single call to CustomerGetCustomerNotificationsWithContext with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerGetCustomerNotificationsWithContext() *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	return &CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall{m.controller.expect("CustomerGetCustomerNotificationsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall) With(params customer.CustomerGetCustomerNotificationsParams) *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall) Return(r0 *customer.CustomerGetCustomerNotificationsOK, r1 error) *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall) Do(
+	fn func(ctx context.Context, params customer.CustomerGetCustomerNotificationsParams) (*customer.CustomerGetCustomerNotificationsOK, error),
+) *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.CustomerGetCustomerNotificationsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall) Times(n int) *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall) AnyTimes() *CPSCustomerClientServiceCustomerGetCustomerNotificationsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CustomerUpdateCustomerNotifications returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerUpdateCustomerNotifications(params customer.CustomerUpdateCustomerNotificationsParams) (*customer.CustomerUpdateCustomerNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerUpdateCustomerNotifications",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.CustomerUpdateCustomerNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall is an expected call to CustomerUpdateCustomerNotifications
+type CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall struct {
+	e *expectation
+}
+
+// ExpectCustomerUpdateCustomerNotifications expects a single call to CustomerUpdateCustomerNotifications with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerUpdateCustomerNotifications() *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	return &CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall{m.controller.expect("CustomerUpdateCustomerNotifications")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall) With(params customer.CustomerUpdateCustomerNotificationsParams) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall) Return(r0 *customer.CustomerUpdateCustomerNotificationsOK, r1 error) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall) Do(
+	fn func(params customer.CustomerUpdateCustomerNotificationsParams) (*customer.CustomerUpdateCustomerNotificationsOK, error),
+) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.CustomerUpdateCustomerNotificationsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall) Times(n int) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall) AnyTimes() *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// CustomerUpdateCustomerNotificationsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CPSCustomerClientService) CustomerUpdateCustomerNotificationsWithContext(ctx context.Context, params customer.CustomerUpdateCustomerNotificationsParams) (*customer.CustomerUpdateCustomerNotificationsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"CustomerUpdateCustomerNotificationsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.CustomerUpdateCustomerNotificationsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall is an expected call to CustomerUpdateCustomerNotificationsWithContext
+type CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectCustomerUpdateCustomerNotificationsWithContext expects a single call to CustomerUpdateCustomerNotificationsWithContext with any arguments
+func (m *CPSCustomerClientService) ExpectCustomerUpdateCustomerNotificationsWithContext() *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	return &CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall{m.controller.expect("CustomerUpdateCustomerNotificationsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall) With(params customer.CustomerUpdateCustomerNotificationsParams) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall) Return(r0 *customer.CustomerUpdateCustomerNotificationsOK, r1 error) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall) Do(
+	fn func(ctx context.Context, params customer.CustomerUpdateCustomerNotificationsParams) (*customer.CustomerUpdateCustomerNotificationsOK, error),
+) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.CustomerUpdateCustomerNotificationsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall) Times(n int) *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall) AnyTimes() *CPSCustomerClientServiceCustomerUpdateCustomerNotificationsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
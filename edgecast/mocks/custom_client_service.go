@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/custom"
+)
+
+// CustomClientService is a mock of custom.ClientService
+type CustomClientService struct {
+	controller *controller
+}
+
+var _ custom.ClientService = (*CustomClientService)(nil)
+
+// NewCustomClientService creates a mock of custom.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewCustomClientService(t TestingT) *CustomClientService {
+	return &CustomClientService{controller: newController(t, "CustomClientService")}
+}
+
+// AddCustomRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) AddCustomRuleSet(params custom.AddCustomRuleSetParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomRuleSet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceAddCustomRuleSetCall is an expected call to AddCustomRuleSet
+type CustomClientServiceAddCustomRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomRuleSet expects a single call to AddCustomRuleSet with any arguments
+func (m *CustomClientService) ExpectAddCustomRuleSet() *CustomClientServiceAddCustomRuleSetCall {
+	return &CustomClientServiceAddCustomRuleSetCall{m.controller.expect("AddCustomRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomClientServiceAddCustomRuleSetCall) With(params custom.AddCustomRuleSetParams) *CustomClientServiceAddCustomRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceAddCustomRuleSetCall) Return(r0 string, r1 error) *CustomClientServiceAddCustomRuleSetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceAddCustomRuleSetCall) Do(
+	fn func(params custom.AddCustomRuleSetParams) (string, error),
+) *CustomClientServiceAddCustomRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(custom.AddCustomRuleSetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceAddCustomRuleSetCall) Times(n int) *CustomClientServiceAddCustomRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceAddCustomRuleSetCall) AnyTimes() *CustomClientServiceAddCustomRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddCustomRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) AddCustomRuleSetWithContext(ctx context.Context, params custom.AddCustomRuleSetParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomRuleSetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceAddCustomRuleSetWithContextCall is an expected call to AddCustomRuleSetWithContext
+type CustomClientServiceAddCustomRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomRuleSetWithContext expects a single call to AddCustomRuleSetWithContext with any arguments
+func (m *CustomClientService) ExpectAddCustomRuleSetWithContext() *CustomClientServiceAddCustomRuleSetWithContextCall {
+	return &CustomClientServiceAddCustomRuleSetWithContextCall{m.controller.expect("AddCustomRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomClientServiceAddCustomRuleSetWithContextCall) With(params custom.AddCustomRuleSetParams) *CustomClientServiceAddCustomRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceAddCustomRuleSetWithContextCall) Return(r0 string, r1 error) *CustomClientServiceAddCustomRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceAddCustomRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params custom.AddCustomRuleSetParams) (string, error),
+) *CustomClientServiceAddCustomRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(custom.AddCustomRuleSetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceAddCustomRuleSetWithContextCall) Times(n int) *CustomClientServiceAddCustomRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceAddCustomRuleSetWithContextCall) AnyTimes() *CustomClientServiceAddCustomRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllCustomRuleSets returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) GetAllCustomRuleSets(params custom.GetAllCustomRuleSetsParams) (*[]custom.CustomRuleSetGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllCustomRuleSets",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]custom.CustomRuleSetGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceGetAllCustomRuleSetsCall is an expected call to GetAllCustomRuleSets
+type CustomClientServiceGetAllCustomRuleSetsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllCustomRuleSets expects a single call to GetAllCustomRuleSets with any arguments
+func (m *CustomClientService) ExpectGetAllCustomRuleSets() *CustomClientServiceGetAllCustomRuleSetsCall {
+	return &CustomClientServiceGetAllCustomRuleSetsCall{m.controller.expect("GetAllCustomRuleSets")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomClientServiceGetAllCustomRuleSetsCall) With(params custom.GetAllCustomRuleSetsParams) *CustomClientServiceGetAllCustomRuleSetsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceGetAllCustomRuleSetsCall) Return(r0 *[]custom.CustomRuleSetGetAllOK, r1 error) *CustomClientServiceGetAllCustomRuleSetsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceGetAllCustomRuleSetsCall) Do(
+	fn func(params custom.GetAllCustomRuleSetsParams) (*[]custom.CustomRuleSetGetAllOK, error),
+) *CustomClientServiceGetAllCustomRuleSetsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(custom.GetAllCustomRuleSetsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceGetAllCustomRuleSetsCall) Times(n int) *CustomClientServiceGetAllCustomRuleSetsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceGetAllCustomRuleSetsCall) AnyTimes() *CustomClientServiceGetAllCustomRuleSetsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllCustomRuleSetsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) GetAllCustomRuleSetsWithContext(ctx context.Context, params custom.GetAllCustomRuleSetsParams) (*[]custom.CustomRuleSetGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllCustomRuleSetsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]custom.CustomRuleSetGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceGetAllCustomRuleSetsWithContextCall is an expected call to GetAllCustomRuleSetsWithContext
+type CustomClientServiceGetAllCustomRuleSetsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllCustomRuleSetsWithContext expects a single call to GetAllCustomRuleSetsWithContext with any arguments
+func (m *CustomClientService) ExpectGetAllCustomRuleSetsWithContext() *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	return &CustomClientServiceGetAllCustomRuleSetsWithContextCall{m.controller.expect("GetAllCustomRuleSetsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomClientServiceGetAllCustomRuleSetsWithContextCall) With(params custom.GetAllCustomRuleSetsParams) *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceGetAllCustomRuleSetsWithContextCall) Return(r0 *[]custom.CustomRuleSetGetAllOK, r1 error) *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceGetAllCustomRuleSetsWithContextCall) Do(
+	fn func(ctx context.Context, params custom.GetAllCustomRuleSetsParams) (*[]custom.CustomRuleSetGetAllOK, error),
+) *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(custom.GetAllCustomRuleSetsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceGetAllCustomRuleSetsWithContextCall) Times(n int) *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceGetAllCustomRuleSetsWithContextCall) AnyTimes() *CustomClientServiceGetAllCustomRuleSetsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) DeleteCustomRuleSet(params custom.DeleteCustomRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomRuleSet",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomClientServiceDeleteCustomRuleSetCall is an expected call to DeleteCustomRuleSet
+type CustomClientServiceDeleteCustomRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomRuleSet expects a single call to DeleteCustomRuleSet with any arguments
+func (m *CustomClientService) ExpectDeleteCustomRuleSet() *CustomClientServiceDeleteCustomRuleSetCall {
+	return &CustomClientServiceDeleteCustomRuleSetCall{m.controller.expect("DeleteCustomRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomClientServiceDeleteCustomRuleSetCall) With(params custom.DeleteCustomRuleSetParams) *CustomClientServiceDeleteCustomRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceDeleteCustomRuleSetCall) Return(r0 error) *CustomClientServiceDeleteCustomRuleSetCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceDeleteCustomRuleSetCall) Do(
+	fn func(params custom.DeleteCustomRuleSetParams) error,
+) *CustomClientServiceDeleteCustomRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(custom.DeleteCustomRuleSetParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceDeleteCustomRuleSetCall) Times(n int) *CustomClientServiceDeleteCustomRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceDeleteCustomRuleSetCall) AnyTimes() *CustomClientServiceDeleteCustomRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) DeleteCustomRuleSetWithContext(ctx context.Context, params custom.DeleteCustomRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomRuleSetWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomClientServiceDeleteCustomRuleSetWithContextCall is an expected call to DeleteCustomRuleSetWithContext
+type CustomClientServiceDeleteCustomRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomRuleSetWithContext expects a single call to DeleteCustomRuleSetWithContext with any arguments
+func (m *CustomClientService) ExpectDeleteCustomRuleSetWithContext() *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	return &CustomClientServiceDeleteCustomRuleSetWithContextCall{m.controller.expect("DeleteCustomRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomClientServiceDeleteCustomRuleSetWithContextCall) With(params custom.DeleteCustomRuleSetParams) *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceDeleteCustomRuleSetWithContextCall) Return(r0 error) *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceDeleteCustomRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params custom.DeleteCustomRuleSetParams) error,
+) *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(custom.DeleteCustomRuleSetParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceDeleteCustomRuleSetWithContextCall) Times(n int) *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceDeleteCustomRuleSetWithContextCall) AnyTimes() *CustomClientServiceDeleteCustomRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) GetCustomRuleSet(params custom.GetCustomRuleSetParams) (*custom.CustomRuleSetGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomRuleSet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*custom.CustomRuleSetGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceGetCustomRuleSetCall is an expected call to GetCustomRuleSet
+type CustomClientServiceGetCustomRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomRuleSet expects a single call to GetCustomRuleSet with any arguments
+func (m *CustomClientService) ExpectGetCustomRuleSet() *CustomClientServiceGetCustomRuleSetCall {
+	return &CustomClientServiceGetCustomRuleSetCall{m.controller.expect("GetCustomRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomClientServiceGetCustomRuleSetCall) With(params custom.GetCustomRuleSetParams) *CustomClientServiceGetCustomRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceGetCustomRuleSetCall) Return(r0 *custom.CustomRuleSetGetOK, r1 error) *CustomClientServiceGetCustomRuleSetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceGetCustomRuleSetCall) Do(
+	fn func(params custom.GetCustomRuleSetParams) (*custom.CustomRuleSetGetOK, error),
+) *CustomClientServiceGetCustomRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(custom.GetCustomRuleSetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceGetCustomRuleSetCall) Times(n int) *CustomClientServiceGetCustomRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceGetCustomRuleSetCall) AnyTimes() *CustomClientServiceGetCustomRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) GetCustomRuleSetWithContext(ctx context.Context, params custom.GetCustomRuleSetParams) (*custom.CustomRuleSetGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomRuleSetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*custom.CustomRuleSetGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomClientServiceGetCustomRuleSetWithContextCall is an expected call to GetCustomRuleSetWithContext
+type CustomClientServiceGetCustomRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomRuleSetWithContext expects a single call to GetCustomRuleSetWithContext with any arguments
+func (m *CustomClientService) ExpectGetCustomRuleSetWithContext() *CustomClientServiceGetCustomRuleSetWithContextCall {
+	return &CustomClientServiceGetCustomRuleSetWithContextCall{m.controller.expect("GetCustomRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomClientServiceGetCustomRuleSetWithContextCall) With(params custom.GetCustomRuleSetParams) *CustomClientServiceGetCustomRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceGetCustomRuleSetWithContextCall) Return(r0 *custom.CustomRuleSetGetOK, r1 error) *CustomClientServiceGetCustomRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceGetCustomRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params custom.GetCustomRuleSetParams) (*custom.CustomRuleSetGetOK, error),
+) *CustomClientServiceGetCustomRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(custom.GetCustomRuleSetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceGetCustomRuleSetWithContextCall) Times(n int) *CustomClientServiceGetCustomRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceGetCustomRuleSetWithContextCall) AnyTimes() *CustomClientServiceGetCustomRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomRuleSet returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) UpdateCustomRuleSet(params custom.UpdateCustomRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomRuleSet",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomClientServiceUpdateCustomRuleSetCall is an expected call to UpdateCustomRuleSet
+type CustomClientServiceUpdateCustomRuleSetCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomRuleSet expects a single call to UpdateCustomRuleSet with any arguments
+func (m *CustomClientService) ExpectUpdateCustomRuleSet() *CustomClientServiceUpdateCustomRuleSetCall {
+	return &CustomClientServiceUpdateCustomRuleSetCall{m.controller.expect("UpdateCustomRuleSet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomClientServiceUpdateCustomRuleSetCall) With(params custom.UpdateCustomRuleSetParams) *CustomClientServiceUpdateCustomRuleSetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceUpdateCustomRuleSetCall) Return(r0 error) *CustomClientServiceUpdateCustomRuleSetCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceUpdateCustomRuleSetCall) Do(
+	fn func(params custom.UpdateCustomRuleSetParams) error,
+) *CustomClientServiceUpdateCustomRuleSetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(custom.UpdateCustomRuleSetParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceUpdateCustomRuleSetCall) Times(n int) *CustomClientServiceUpdateCustomRuleSetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceUpdateCustomRuleSetCall) AnyTimes() *CustomClientServiceUpdateCustomRuleSetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomRuleSetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomClientService) UpdateCustomRuleSetWithContext(ctx context.Context, params custom.UpdateCustomRuleSetParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomRuleSetWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomClientServiceUpdateCustomRuleSetWithContextCall is an expected call to UpdateCustomRuleSetWithContext
+type CustomClientServiceUpdateCustomRuleSetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomRuleSetWithContext expects a single call to UpdateCustomRuleSetWithContext with any arguments
+func (m *CustomClientService) ExpectUpdateCustomRuleSetWithContext() *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	return &CustomClientServiceUpdateCustomRuleSetWithContextCall{m.controller.expect("UpdateCustomRuleSetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomClientServiceUpdateCustomRuleSetWithContextCall) With(params custom.UpdateCustomRuleSetParams) *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomClientServiceUpdateCustomRuleSetWithContextCall) Return(r0 error) *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomClientServiceUpdateCustomRuleSetWithContextCall) Do(
+	fn func(ctx context.Context, params custom.UpdateCustomRuleSetParams) error,
+) *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(custom.UpdateCustomRuleSetParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomClientServiceUpdateCustomRuleSetWithContextCall) Times(n int) *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomClientServiceUpdateCustomRuleSetWithContextCall) AnyTimes() *CustomClientServiceUpdateCustomRuleSetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
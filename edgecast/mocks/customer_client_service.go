@@ -0,0 +1,2055 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/customer"
+)
+
+// CustomerClientService is a mock of customer.ClientService
+type CustomerClientService struct {
+	controller *controller
+}
+
+var _ customer.ClientService = (*CustomerClientService)(nil)
+
+// NewCustomerClientService creates a mock of customer.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewCustomerClientService(t TestingT) *CustomerClientService {
+	return &CustomerClientService{controller: newController(t, "CustomerClientService")}
+}
+
+// AddCustomer returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) AddCustomer(params customer.AddCustomerParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomer",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceAddCustomerCall is an expected call to AddCustomer
+type CustomerClientServiceAddCustomerCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomer expects a single call to AddCustomer with any arguments
+func (m *CustomerClientService) ExpectAddCustomer() *CustomerClientServiceAddCustomerCall {
+	return &CustomerClientServiceAddCustomerCall{m.controller.expect("AddCustomer")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceAddCustomerCall) With(params customer.AddCustomerParams) *CustomerClientServiceAddCustomerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceAddCustomerCall) Return(r0 string, r1 error) *CustomerClientServiceAddCustomerCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceAddCustomerCall) Do(
+	fn func(params customer.AddCustomerParams) (string, error),
+) *CustomerClientServiceAddCustomerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.AddCustomerParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceAddCustomerCall) Times(n int) *CustomerClientServiceAddCustomerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceAddCustomerCall) AnyTimes() *CustomerClientServiceAddCustomerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddCustomerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) AddCustomerWithContext(ctx context.Context, params customer.AddCustomerParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomerWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceAddCustomerWithContextCall is an expected call to AddCustomerWithContext
+type CustomerClientServiceAddCustomerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomerWithContext expects a single call to AddCustomerWithContext with any arguments
+func (m *CustomerClientService) ExpectAddCustomerWithContext() *CustomerClientServiceAddCustomerWithContextCall {
+	return &CustomerClientServiceAddCustomerWithContextCall{m.controller.expect("AddCustomerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceAddCustomerWithContextCall) With(params customer.AddCustomerParams) *CustomerClientServiceAddCustomerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceAddCustomerWithContextCall) Return(r0 string, r1 error) *CustomerClientServiceAddCustomerWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceAddCustomerWithContextCall) Do(
+	fn func(ctx context.Context, params customer.AddCustomerParams) (string, error),
+) *CustomerClientServiceAddCustomerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.AddCustomerParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceAddCustomerWithContextCall) Times(n int) *CustomerClientServiceAddCustomerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceAddCustomerWithContextCall) AnyTimes() *CustomerClientServiceAddCustomerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomer returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomer(params customer.GetCustomerParams) (*customer.CustomerGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomer",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerCall is an expected call to GetCustomer
+type CustomerClientServiceGetCustomerCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomer expects a single call to GetCustomer with any arguments
+func (m *CustomerClientService) ExpectGetCustomer() *CustomerClientServiceGetCustomerCall {
+	return &CustomerClientServiceGetCustomerCall{m.controller.expect("GetCustomer")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceGetCustomerCall) With(params customer.GetCustomerParams) *CustomerClientServiceGetCustomerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerCall) Return(r0 *customer.CustomerGetOK, r1 error) *CustomerClientServiceGetCustomerCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerCall) Do(
+	fn func(params customer.GetCustomerParams) (*customer.CustomerGetOK, error),
+) *CustomerClientServiceGetCustomerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.GetCustomerParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerCall) Times(n int) *CustomerClientServiceGetCustomerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerCall) AnyTimes() *CustomerClientServiceGetCustomerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerWithContext(ctx context.Context, params customer.GetCustomerParams) (*customer.CustomerGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.CustomerGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerWithContextCall is an expected call to GetCustomerWithContext
+type CustomerClientServiceGetCustomerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerWithContext expects a single call to GetCustomerWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerWithContext() *CustomerClientServiceGetCustomerWithContextCall {
+	return &CustomerClientServiceGetCustomerWithContextCall{m.controller.expect("GetCustomerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceGetCustomerWithContextCall) With(params customer.GetCustomerParams) *CustomerClientServiceGetCustomerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerWithContextCall) Return(r0 *customer.CustomerGetOK, r1 error) *CustomerClientServiceGetCustomerWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerWithContextCall) Do(
+	fn func(ctx context.Context, params customer.GetCustomerParams) (*customer.CustomerGetOK, error),
+) *CustomerClientServiceGetCustomerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.GetCustomerParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerWithContextCall) Times(n int) *CustomerClientServiceGetCustomerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomer returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomer(params customer.UpdateCustomerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomer",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerCall is an expected call to UpdateCustomer
+type CustomerClientServiceUpdateCustomerCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomer expects a single call to UpdateCustomer with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomer() *CustomerClientServiceUpdateCustomerCall {
+	return &CustomerClientServiceUpdateCustomerCall{m.controller.expect("UpdateCustomer")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerCall) With(params customer.UpdateCustomerParams) *CustomerClientServiceUpdateCustomerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerCall) Return(r0 error) *CustomerClientServiceUpdateCustomerCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerCall) Do(
+	fn func(params customer.UpdateCustomerParams) error,
+) *CustomerClientServiceUpdateCustomerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerCall) Times(n int) *CustomerClientServiceUpdateCustomerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerCall) AnyTimes() *CustomerClientServiceUpdateCustomerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerWithContext(ctx context.Context, params customer.UpdateCustomerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerWithContextCall is an expected call to UpdateCustomerWithContext
+type CustomerClientServiceUpdateCustomerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerWithContext expects a single call to UpdateCustomerWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerWithContext() *CustomerClientServiceUpdateCustomerWithContextCall {
+	return &CustomerClientServiceUpdateCustomerWithContextCall{m.controller.expect("UpdateCustomerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerWithContextCall) With(params customer.UpdateCustomerParams) *CustomerClientServiceUpdateCustomerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerParams) error,
+) *CustomerClientServiceUpdateCustomerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomer returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) DeleteCustomer(params customer.DeleteCustomerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomer",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceDeleteCustomerCall is an expected call to DeleteCustomer
+type CustomerClientServiceDeleteCustomerCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomer expects a single call to DeleteCustomer with any arguments
+func (m *CustomerClientService) ExpectDeleteCustomer() *CustomerClientServiceDeleteCustomerCall {
+	return &CustomerClientServiceDeleteCustomerCall{m.controller.expect("DeleteCustomer")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceDeleteCustomerCall) With(params customer.DeleteCustomerParams) *CustomerClientServiceDeleteCustomerCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceDeleteCustomerCall) Return(r0 error) *CustomerClientServiceDeleteCustomerCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceDeleteCustomerCall) Do(
+	fn func(params customer.DeleteCustomerParams) error,
+) *CustomerClientServiceDeleteCustomerCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.DeleteCustomerParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceDeleteCustomerCall) Times(n int) *CustomerClientServiceDeleteCustomerCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceDeleteCustomerCall) AnyTimes() *CustomerClientServiceDeleteCustomerCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomerWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) DeleteCustomerWithContext(ctx context.Context, params customer.DeleteCustomerParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomerWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceDeleteCustomerWithContextCall is an expected call to DeleteCustomerWithContext
+type CustomerClientServiceDeleteCustomerWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomerWithContext expects a single call to DeleteCustomerWithContext with any arguments
+func (m *CustomerClientService) ExpectDeleteCustomerWithContext() *CustomerClientServiceDeleteCustomerWithContextCall {
+	return &CustomerClientServiceDeleteCustomerWithContextCall{m.controller.expect("DeleteCustomerWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceDeleteCustomerWithContextCall) With(params customer.DeleteCustomerParams) *CustomerClientServiceDeleteCustomerWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceDeleteCustomerWithContextCall) Return(r0 error) *CustomerClientServiceDeleteCustomerWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceDeleteCustomerWithContextCall) Do(
+	fn func(ctx context.Context, params customer.DeleteCustomerParams) error,
+) *CustomerClientServiceDeleteCustomerWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.DeleteCustomerParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceDeleteCustomerWithContextCall) Times(n int) *CustomerClientServiceDeleteCustomerWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceDeleteCustomerWithContextCall) AnyTimes() *CustomerClientServiceDeleteCustomerWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAvailableCustomerServices returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetAvailableCustomerServices() (*[]customer.Service, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableCustomerServices",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].(*[]customer.Service)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetAvailableCustomerServicesCall is an expected call to GetAvailableCustomerServices
+type CustomerClientServiceGetAvailableCustomerServicesCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableCustomerServices expects a single call to GetAvailableCustomerServices with any arguments
+func (m *CustomerClientService) ExpectGetAvailableCustomerServices() *CustomerClientServiceGetAvailableCustomerServicesCall {
+	return &CustomerClientServiceGetAvailableCustomerServicesCall{m.controller.expect("GetAvailableCustomerServices")}
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetAvailableCustomerServicesCall) Return(r0 *[]customer.Service, r1 error) *CustomerClientServiceGetAvailableCustomerServicesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetAvailableCustomerServicesCall) Do(
+	fn func() (*[]customer.Service, error),
+) *CustomerClientServiceGetAvailableCustomerServicesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetAvailableCustomerServicesCall) Times(n int) *CustomerClientServiceGetAvailableCustomerServicesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetAvailableCustomerServicesCall) AnyTimes() *CustomerClientServiceGetAvailableCustomerServicesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAvailableCustomerServicesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetAvailableCustomerServicesWithContext(ctx context.Context) (*[]customer.Service, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableCustomerServicesWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].(*[]customer.Service)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetAvailableCustomerServicesWithContextCall is an expected call to GetAvailableCustomerServicesWithContext
+type CustomerClientServiceGetAvailableCustomerServicesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableCustomerServicesWithContext expects a single call to GetAvailableCustomerServicesWithContext with any arguments
+func (m *CustomerClientService) ExpectGetAvailableCustomerServicesWithContext() *CustomerClientServiceGetAvailableCustomerServicesWithContextCall {
+	return &CustomerClientServiceGetAvailableCustomerServicesWithContextCall{m.controller.expect("GetAvailableCustomerServicesWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetAvailableCustomerServicesWithContextCall) Return(r0 *[]customer.Service, r1 error) *CustomerClientServiceGetAvailableCustomerServicesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetAvailableCustomerServicesWithContextCall) Do(
+	fn func(ctx context.Context) (*[]customer.Service, error),
+) *CustomerClientServiceGetAvailableCustomerServicesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetAvailableCustomerServicesWithContextCall) Times(n int) *CustomerClientServiceGetAvailableCustomerServicesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetAvailableCustomerServicesWithContextCall) AnyTimes() *CustomerClientServiceGetAvailableCustomerServicesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerServices returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerServices(params customer.GetCustomerServicesParams) (*[]customer.Service, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerServices",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]customer.Service)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerServicesCall is an expected call to GetCustomerServices
+type CustomerClientServiceGetCustomerServicesCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerServices expects a single call to GetCustomerServices with any arguments
+func (m *CustomerClientService) ExpectGetCustomerServices() *CustomerClientServiceGetCustomerServicesCall {
+	return &CustomerClientServiceGetCustomerServicesCall{m.controller.expect("GetCustomerServices")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceGetCustomerServicesCall) With(params customer.GetCustomerServicesParams) *CustomerClientServiceGetCustomerServicesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerServicesCall) Return(r0 *[]customer.Service, r1 error) *CustomerClientServiceGetCustomerServicesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerServicesCall) Do(
+	fn func(params customer.GetCustomerServicesParams) (*[]customer.Service, error),
+) *CustomerClientServiceGetCustomerServicesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.GetCustomerServicesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerServicesCall) Times(n int) *CustomerClientServiceGetCustomerServicesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerServicesCall) AnyTimes() *CustomerClientServiceGetCustomerServicesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerServicesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerServicesWithContext(ctx context.Context, params customer.GetCustomerServicesParams) (*[]customer.Service, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerServicesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]customer.Service)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerServicesWithContextCall is an expected call to GetCustomerServicesWithContext
+type CustomerClientServiceGetCustomerServicesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerServicesWithContext expects a single call to GetCustomerServicesWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerServicesWithContext() *CustomerClientServiceGetCustomerServicesWithContextCall {
+	return &CustomerClientServiceGetCustomerServicesWithContextCall{m.controller.expect("GetCustomerServicesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceGetCustomerServicesWithContextCall) With(params customer.GetCustomerServicesParams) *CustomerClientServiceGetCustomerServicesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerServicesWithContextCall) Return(r0 *[]customer.Service, r1 error) *CustomerClientServiceGetCustomerServicesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerServicesWithContextCall) Do(
+	fn func(ctx context.Context, params customer.GetCustomerServicesParams) (*[]customer.Service, error),
+) *CustomerClientServiceGetCustomerServicesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.GetCustomerServicesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerServicesWithContextCall) Times(n int) *CustomerClientServiceGetCustomerServicesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerServicesWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerServicesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerServices returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerServices(params customer.UpdateCustomerServicesParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerServices",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerServicesCall is an expected call to UpdateCustomerServices
+type CustomerClientServiceUpdateCustomerServicesCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerServices expects a single call to UpdateCustomerServices with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerServices() *CustomerClientServiceUpdateCustomerServicesCall {
+	return &CustomerClientServiceUpdateCustomerServicesCall{m.controller.expect("UpdateCustomerServices")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerServicesCall) With(params customer.UpdateCustomerServicesParams) *CustomerClientServiceUpdateCustomerServicesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerServicesCall) Return(r0 error) *CustomerClientServiceUpdateCustomerServicesCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerServicesCall) Do(
+	fn func(params customer.UpdateCustomerServicesParams) error,
+) *CustomerClientServiceUpdateCustomerServicesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerServicesParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerServicesCall) Times(n int) *CustomerClientServiceUpdateCustomerServicesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerServicesCall) AnyTimes() *CustomerClientServiceUpdateCustomerServicesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerServicesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerServicesWithContext(ctx context.Context, params customer.UpdateCustomerServicesParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerServicesWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerServicesWithContextCall is an expected call to UpdateCustomerServicesWithContext
+type CustomerClientServiceUpdateCustomerServicesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerServicesWithContext expects a single call to UpdateCustomerServicesWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerServicesWithContext() *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	return &CustomerClientServiceUpdateCustomerServicesWithContextCall{m.controller.expect("UpdateCustomerServicesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerServicesWithContextCall) With(params customer.UpdateCustomerServicesParams) *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerServicesWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerServicesWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerServicesParams) error,
+) *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerServicesParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerServicesWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerServicesWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerServicesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerDeliveryRegion returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerDeliveryRegion(params customer.GetCustomerDeliveryRegionParams) (*customer.DeliveryRegion, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerDeliveryRegion",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.DeliveryRegion)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerDeliveryRegionCall is an expected call to GetCustomerDeliveryRegion
+type CustomerClientServiceGetCustomerDeliveryRegionCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerDeliveryRegion expects a single call to GetCustomerDeliveryRegion with any arguments
+func (m *CustomerClientService) ExpectGetCustomerDeliveryRegion() *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	return &CustomerClientServiceGetCustomerDeliveryRegionCall{m.controller.expect("GetCustomerDeliveryRegion")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceGetCustomerDeliveryRegionCall) With(params customer.GetCustomerDeliveryRegionParams) *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerDeliveryRegionCall) Return(r0 *customer.DeliveryRegion, r1 error) *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerDeliveryRegionCall) Do(
+	fn func(params customer.GetCustomerDeliveryRegionParams) (*customer.DeliveryRegion, error),
+) *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.GetCustomerDeliveryRegionParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerDeliveryRegionCall) Times(n int) *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerDeliveryRegionCall) AnyTimes() *CustomerClientServiceGetCustomerDeliveryRegionCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerDeliveryRegionWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerDeliveryRegionWithContext(ctx context.Context, params customer.GetCustomerDeliveryRegionParams) (*customer.DeliveryRegion, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerDeliveryRegionWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.DeliveryRegion)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerDeliveryRegionWithContextCall is an expected call to GetCustomerDeliveryRegionWithContext
+type CustomerClientServiceGetCustomerDeliveryRegionWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerDeliveryRegionWithContext expects a single call to GetCustomerDeliveryRegionWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerDeliveryRegionWithContext() *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	return &CustomerClientServiceGetCustomerDeliveryRegionWithContextCall{m.controller.expect("GetCustomerDeliveryRegionWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall) With(params customer.GetCustomerDeliveryRegionParams) *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall) Return(r0 *customer.DeliveryRegion, r1 error) *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall) Do(
+	fn func(ctx context.Context, params customer.GetCustomerDeliveryRegionParams) (*customer.DeliveryRegion, error),
+) *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.GetCustomerDeliveryRegionParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall) Times(n int) *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerDeliveryRegionWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerDeliveryRegion returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerDeliveryRegion(params customer.UpdateCustomerDeliveryRegionParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerDeliveryRegion",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerDeliveryRegionCall is an expected call to UpdateCustomerDeliveryRegion
+type CustomerClientServiceUpdateCustomerDeliveryRegionCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerDeliveryRegion expects a single call to UpdateCustomerDeliveryRegion with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerDeliveryRegion() *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	return &CustomerClientServiceUpdateCustomerDeliveryRegionCall{m.controller.expect("UpdateCustomerDeliveryRegion")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionCall) With(params customer.UpdateCustomerDeliveryRegionParams) *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionCall) Return(r0 error) *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionCall) Do(
+	fn func(params customer.UpdateCustomerDeliveryRegionParams) error,
+) *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerDeliveryRegionParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionCall) Times(n int) *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionCall) AnyTimes() *CustomerClientServiceUpdateCustomerDeliveryRegionCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerDeliveryRegionWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerDeliveryRegionWithContext(ctx context.Context, params customer.UpdateCustomerDeliveryRegionParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerDeliveryRegionWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall is an expected call to UpdateCustomerDeliveryRegionWithContext
+type CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerDeliveryRegionWithContext expects a single call to UpdateCustomerDeliveryRegionWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerDeliveryRegionWithContext() *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	return &CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall{m.controller.expect("UpdateCustomerDeliveryRegionWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall) With(params customer.UpdateCustomerDeliveryRegionParams) *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerDeliveryRegionParams) error,
+) *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerDeliveryRegionParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerDeliveryRegionWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerDomainTypes returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerDomainTypes() (*[]customer.DomainType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerDomainTypes",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].(*[]customer.DomainType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerDomainTypesCall is an expected call to GetCustomerDomainTypes
+type CustomerClientServiceGetCustomerDomainTypesCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerDomainTypes expects a single call to GetCustomerDomainTypes with any arguments
+func (m *CustomerClientService) ExpectGetCustomerDomainTypes() *CustomerClientServiceGetCustomerDomainTypesCall {
+	return &CustomerClientServiceGetCustomerDomainTypesCall{m.controller.expect("GetCustomerDomainTypes")}
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerDomainTypesCall) Return(r0 *[]customer.DomainType, r1 error) *CustomerClientServiceGetCustomerDomainTypesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerDomainTypesCall) Do(
+	fn func() (*[]customer.DomainType, error),
+) *CustomerClientServiceGetCustomerDomainTypesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerDomainTypesCall) Times(n int) *CustomerClientServiceGetCustomerDomainTypesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerDomainTypesCall) AnyTimes() *CustomerClientServiceGetCustomerDomainTypesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerDomainTypesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerDomainTypesWithContext(ctx context.Context) (*[]customer.DomainType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerDomainTypesWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].(*[]customer.DomainType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerDomainTypesWithContextCall is an expected call to GetCustomerDomainTypesWithContext
+type CustomerClientServiceGetCustomerDomainTypesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerDomainTypesWithContext expects a single call to GetCustomerDomainTypesWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerDomainTypesWithContext() *CustomerClientServiceGetCustomerDomainTypesWithContextCall {
+	return &CustomerClientServiceGetCustomerDomainTypesWithContextCall{m.controller.expect("GetCustomerDomainTypesWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerDomainTypesWithContextCall) Return(r0 *[]customer.DomainType, r1 error) *CustomerClientServiceGetCustomerDomainTypesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerDomainTypesWithContextCall) Do(
+	fn func(ctx context.Context) (*[]customer.DomainType, error),
+) *CustomerClientServiceGetCustomerDomainTypesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerDomainTypesWithContextCall) Times(n int) *CustomerClientServiceGetCustomerDomainTypesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerDomainTypesWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerDomainTypesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerDomainURL returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerDomainURL(params customer.UpdateCustomerDomainURLParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerDomainURL",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerDomainURLCall is an expected call to UpdateCustomerDomainURL
+type CustomerClientServiceUpdateCustomerDomainURLCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerDomainURL expects a single call to UpdateCustomerDomainURL with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerDomainURL() *CustomerClientServiceUpdateCustomerDomainURLCall {
+	return &CustomerClientServiceUpdateCustomerDomainURLCall{m.controller.expect("UpdateCustomerDomainURL")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerDomainURLCall) With(params customer.UpdateCustomerDomainURLParams) *CustomerClientServiceUpdateCustomerDomainURLCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerDomainURLCall) Return(r0 error) *CustomerClientServiceUpdateCustomerDomainURLCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerDomainURLCall) Do(
+	fn func(params customer.UpdateCustomerDomainURLParams) error,
+) *CustomerClientServiceUpdateCustomerDomainURLCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerDomainURLParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerDomainURLCall) Times(n int) *CustomerClientServiceUpdateCustomerDomainURLCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerDomainURLCall) AnyTimes() *CustomerClientServiceUpdateCustomerDomainURLCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerDomainURLWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerDomainURLWithContext(ctx context.Context, params customer.UpdateCustomerDomainURLParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerDomainURLWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerDomainURLWithContextCall is an expected call to UpdateCustomerDomainURLWithContext
+type CustomerClientServiceUpdateCustomerDomainURLWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerDomainURLWithContext expects a single call to UpdateCustomerDomainURLWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerDomainURLWithContext() *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	return &CustomerClientServiceUpdateCustomerDomainURLWithContextCall{m.controller.expect("UpdateCustomerDomainURLWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerDomainURLWithContextCall) With(params customer.UpdateCustomerDomainURLParams) *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerDomainURLWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerDomainURLWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerDomainURLParams) error,
+) *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerDomainURLParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerDomainURLWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerDomainURLWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerDomainURLWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerAccessModules returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerAccessModules(params customer.GetCustomerAccessModulesParams) (*[]customer.AccessModule, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerAccessModules",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]customer.AccessModule)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerAccessModulesCall is an expected call to GetCustomerAccessModules
+type CustomerClientServiceGetCustomerAccessModulesCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerAccessModules expects a single call to GetCustomerAccessModules with any arguments
+func (m *CustomerClientService) ExpectGetCustomerAccessModules() *CustomerClientServiceGetCustomerAccessModulesCall {
+	return &CustomerClientServiceGetCustomerAccessModulesCall{m.controller.expect("GetCustomerAccessModules")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceGetCustomerAccessModulesCall) With(params customer.GetCustomerAccessModulesParams) *CustomerClientServiceGetCustomerAccessModulesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerAccessModulesCall) Return(r0 *[]customer.AccessModule, r1 error) *CustomerClientServiceGetCustomerAccessModulesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerAccessModulesCall) Do(
+	fn func(params customer.GetCustomerAccessModulesParams) (*[]customer.AccessModule, error),
+) *CustomerClientServiceGetCustomerAccessModulesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.GetCustomerAccessModulesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerAccessModulesCall) Times(n int) *CustomerClientServiceGetCustomerAccessModulesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerAccessModulesCall) AnyTimes() *CustomerClientServiceGetCustomerAccessModulesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerAccessModulesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerAccessModulesWithContext(ctx context.Context, params customer.GetCustomerAccessModulesParams) (*[]customer.AccessModule, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerAccessModulesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]customer.AccessModule)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerAccessModulesWithContextCall is an expected call to GetCustomerAccessModulesWithContext
+type CustomerClientServiceGetCustomerAccessModulesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerAccessModulesWithContext expects a single call to GetCustomerAccessModulesWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerAccessModulesWithContext() *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	return &CustomerClientServiceGetCustomerAccessModulesWithContextCall{m.controller.expect("GetCustomerAccessModulesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceGetCustomerAccessModulesWithContextCall) With(params customer.GetCustomerAccessModulesParams) *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerAccessModulesWithContextCall) Return(r0 *[]customer.AccessModule, r1 error) *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerAccessModulesWithContextCall) Do(
+	fn func(ctx context.Context, params customer.GetCustomerAccessModulesParams) (*[]customer.AccessModule, error),
+) *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.GetCustomerAccessModulesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerAccessModulesWithContextCall) Times(n int) *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerAccessModulesWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerAccessModulesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerAccessModule returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerAccessModule(params customer.UpdateCustomerAccessModuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerAccessModule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerAccessModuleCall is an expected call to UpdateCustomerAccessModule
+type CustomerClientServiceUpdateCustomerAccessModuleCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerAccessModule expects a single call to UpdateCustomerAccessModule with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerAccessModule() *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	return &CustomerClientServiceUpdateCustomerAccessModuleCall{m.controller.expect("UpdateCustomerAccessModule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerAccessModuleCall) With(params customer.UpdateCustomerAccessModuleParams) *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerAccessModuleCall) Return(r0 error) *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerAccessModuleCall) Do(
+	fn func(params customer.UpdateCustomerAccessModuleParams) error,
+) *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerAccessModuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerAccessModuleCall) Times(n int) *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerAccessModuleCall) AnyTimes() *CustomerClientServiceUpdateCustomerAccessModuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerAccessModuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerAccessModuleWithContext(ctx context.Context, params customer.UpdateCustomerAccessModuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerAccessModuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerAccessModuleWithContextCall is an expected call to UpdateCustomerAccessModuleWithContext
+type CustomerClientServiceUpdateCustomerAccessModuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerAccessModuleWithContext expects a single call to UpdateCustomerAccessModuleWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerAccessModuleWithContext() *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	return &CustomerClientServiceUpdateCustomerAccessModuleWithContextCall{m.controller.expect("UpdateCustomerAccessModuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall) With(params customer.UpdateCustomerAccessModuleParams) *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerAccessModuleParams) error,
+) *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerAccessModuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerAccessModuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddCustomerUser returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) AddCustomerUser(params customer.AddCustomerUserParams) (int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomerUser",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceAddCustomerUserCall is an expected call to AddCustomerUser
+type CustomerClientServiceAddCustomerUserCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomerUser expects a single call to AddCustomerUser with any arguments
+func (m *CustomerClientService) ExpectAddCustomerUser() *CustomerClientServiceAddCustomerUserCall {
+	return &CustomerClientServiceAddCustomerUserCall{m.controller.expect("AddCustomerUser")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceAddCustomerUserCall) With(params customer.AddCustomerUserParams) *CustomerClientServiceAddCustomerUserCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceAddCustomerUserCall) Return(r0 int, r1 error) *CustomerClientServiceAddCustomerUserCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceAddCustomerUserCall) Do(
+	fn func(params customer.AddCustomerUserParams) (int, error),
+) *CustomerClientServiceAddCustomerUserCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.AddCustomerUserParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceAddCustomerUserCall) Times(n int) *CustomerClientServiceAddCustomerUserCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceAddCustomerUserCall) AnyTimes() *CustomerClientServiceAddCustomerUserCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddCustomerUserWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) AddCustomerUserWithContext(ctx context.Context, params customer.AddCustomerUserParams) (int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddCustomerUserWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceAddCustomerUserWithContextCall is an expected call to AddCustomerUserWithContext
+type CustomerClientServiceAddCustomerUserWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddCustomerUserWithContext expects a single call to AddCustomerUserWithContext with any arguments
+func (m *CustomerClientService) ExpectAddCustomerUserWithContext() *CustomerClientServiceAddCustomerUserWithContextCall {
+	return &CustomerClientServiceAddCustomerUserWithContextCall{m.controller.expect("AddCustomerUserWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceAddCustomerUserWithContextCall) With(params customer.AddCustomerUserParams) *CustomerClientServiceAddCustomerUserWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceAddCustomerUserWithContextCall) Return(r0 int, r1 error) *CustomerClientServiceAddCustomerUserWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceAddCustomerUserWithContextCall) Do(
+	fn func(ctx context.Context, params customer.AddCustomerUserParams) (int, error),
+) *CustomerClientServiceAddCustomerUserWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.AddCustomerUserParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceAddCustomerUserWithContextCall) Times(n int) *CustomerClientServiceAddCustomerUserWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceAddCustomerUserWithContextCall) AnyTimes() *CustomerClientServiceAddCustomerUserWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerUser returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerUser(params customer.GetCustomerUserParams) (*customer.CustomerUserGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerUser",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*customer.CustomerUserGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerUserCall is an expected call to GetCustomerUser
+type CustomerClientServiceGetCustomerUserCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerUser expects a single call to GetCustomerUser with any arguments
+func (m *CustomerClientService) ExpectGetCustomerUser() *CustomerClientServiceGetCustomerUserCall {
+	return &CustomerClientServiceGetCustomerUserCall{m.controller.expect("GetCustomerUser")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceGetCustomerUserCall) With(params customer.GetCustomerUserParams) *CustomerClientServiceGetCustomerUserCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerUserCall) Return(r0 *customer.CustomerUserGetOK, r1 error) *CustomerClientServiceGetCustomerUserCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerUserCall) Do(
+	fn func(params customer.GetCustomerUserParams) (*customer.CustomerUserGetOK, error),
+) *CustomerClientServiceGetCustomerUserCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.GetCustomerUserParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerUserCall) Times(n int) *CustomerClientServiceGetCustomerUserCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerUserCall) AnyTimes() *CustomerClientServiceGetCustomerUserCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCustomerUserWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) GetCustomerUserWithContext(ctx context.Context, params customer.GetCustomerUserParams) (*customer.CustomerUserGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCustomerUserWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*customer.CustomerUserGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// CustomerClientServiceGetCustomerUserWithContextCall is an expected call to GetCustomerUserWithContext
+type CustomerClientServiceGetCustomerUserWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCustomerUserWithContext expects a single call to GetCustomerUserWithContext with any arguments
+func (m *CustomerClientService) ExpectGetCustomerUserWithContext() *CustomerClientServiceGetCustomerUserWithContextCall {
+	return &CustomerClientServiceGetCustomerUserWithContextCall{m.controller.expect("GetCustomerUserWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceGetCustomerUserWithContextCall) With(params customer.GetCustomerUserParams) *CustomerClientServiceGetCustomerUserWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceGetCustomerUserWithContextCall) Return(r0 *customer.CustomerUserGetOK, r1 error) *CustomerClientServiceGetCustomerUserWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceGetCustomerUserWithContextCall) Do(
+	fn func(ctx context.Context, params customer.GetCustomerUserParams) (*customer.CustomerUserGetOK, error),
+) *CustomerClientServiceGetCustomerUserWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.GetCustomerUserParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceGetCustomerUserWithContextCall) Times(n int) *CustomerClientServiceGetCustomerUserWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceGetCustomerUserWithContextCall) AnyTimes() *CustomerClientServiceGetCustomerUserWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerUser returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerUser(params customer.UpdateCustomerUserParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerUser",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerUserCall is an expected call to UpdateCustomerUser
+type CustomerClientServiceUpdateCustomerUserCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerUser expects a single call to UpdateCustomerUser with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerUser() *CustomerClientServiceUpdateCustomerUserCall {
+	return &CustomerClientServiceUpdateCustomerUserCall{m.controller.expect("UpdateCustomerUser")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceUpdateCustomerUserCall) With(params customer.UpdateCustomerUserParams) *CustomerClientServiceUpdateCustomerUserCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerUserCall) Return(r0 error) *CustomerClientServiceUpdateCustomerUserCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerUserCall) Do(
+	fn func(params customer.UpdateCustomerUserParams) error,
+) *CustomerClientServiceUpdateCustomerUserCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.UpdateCustomerUserParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerUserCall) Times(n int) *CustomerClientServiceUpdateCustomerUserCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerUserCall) AnyTimes() *CustomerClientServiceUpdateCustomerUserCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateCustomerUserWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) UpdateCustomerUserWithContext(ctx context.Context, params customer.UpdateCustomerUserParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateCustomerUserWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceUpdateCustomerUserWithContextCall is an expected call to UpdateCustomerUserWithContext
+type CustomerClientServiceUpdateCustomerUserWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateCustomerUserWithContext expects a single call to UpdateCustomerUserWithContext with any arguments
+func (m *CustomerClientService) ExpectUpdateCustomerUserWithContext() *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	return &CustomerClientServiceUpdateCustomerUserWithContextCall{m.controller.expect("UpdateCustomerUserWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceUpdateCustomerUserWithContextCall) With(params customer.UpdateCustomerUserParams) *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceUpdateCustomerUserWithContextCall) Return(r0 error) *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceUpdateCustomerUserWithContextCall) Do(
+	fn func(ctx context.Context, params customer.UpdateCustomerUserParams) error,
+) *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.UpdateCustomerUserParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceUpdateCustomerUserWithContextCall) Times(n int) *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceUpdateCustomerUserWithContextCall) AnyTimes() *CustomerClientServiceUpdateCustomerUserWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomerUser returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) DeleteCustomerUser(params customer.DeleteCustomerUserParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomerUser",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceDeleteCustomerUserCall is an expected call to DeleteCustomerUser
+type CustomerClientServiceDeleteCustomerUserCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomerUser expects a single call to DeleteCustomerUser with any arguments
+func (m *CustomerClientService) ExpectDeleteCustomerUser() *CustomerClientServiceDeleteCustomerUserCall {
+	return &CustomerClientServiceDeleteCustomerUserCall{m.controller.expect("DeleteCustomerUser")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *CustomerClientServiceDeleteCustomerUserCall) With(params customer.DeleteCustomerUserParams) *CustomerClientServiceDeleteCustomerUserCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceDeleteCustomerUserCall) Return(r0 error) *CustomerClientServiceDeleteCustomerUserCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceDeleteCustomerUserCall) Do(
+	fn func(params customer.DeleteCustomerUserParams) error,
+) *CustomerClientServiceDeleteCustomerUserCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(customer.DeleteCustomerUserParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceDeleteCustomerUserCall) Times(n int) *CustomerClientServiceDeleteCustomerUserCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceDeleteCustomerUserCall) AnyTimes() *CustomerClientServiceDeleteCustomerUserCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteCustomerUserWithContext returns the results of the expected call that matches its
+// arguments
+func (m *CustomerClientService) DeleteCustomerUserWithContext(ctx context.Context, params customer.DeleteCustomerUserParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteCustomerUserWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// CustomerClientServiceDeleteCustomerUserWithContextCall is an expected call to DeleteCustomerUserWithContext
+type CustomerClientServiceDeleteCustomerUserWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteCustomerUserWithContext expects a single call to DeleteCustomerUserWithContext with any arguments
+func (m *CustomerClientService) ExpectDeleteCustomerUserWithContext() *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	return &CustomerClientServiceDeleteCustomerUserWithContextCall{m.controller.expect("DeleteCustomerUserWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *CustomerClientServiceDeleteCustomerUserWithContextCall) With(params customer.DeleteCustomerUserParams) *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *CustomerClientServiceDeleteCustomerUserWithContextCall) Return(r0 error) *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *CustomerClientServiceDeleteCustomerUserWithContextCall) Do(
+	fn func(ctx context.Context, params customer.DeleteCustomerUserParams) error,
+) *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(customer.DeleteCustomerUserParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *CustomerClientServiceDeleteCustomerUserWithContextCall) Times(n int) *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *CustomerClientServiceDeleteCustomerUserWithContextCall) AnyTimes() *CustomerClientServiceDeleteCustomerUserWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,637 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/dcv"
+)
+
+// DCVClientService is a mock of dcv.ClientService
+type DCVClientService struct {
+	controller *controller
+}
+
+var _ dcv.ClientService = (*DCVClientService)(nil)
+
+// NewDCVClientService creates a mock of dcv.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewDCVClientService(t TestingT) *DCVClientService {
+	return &DCVClientService{controller: newController(t, "DCVClientService")}
+}
+
+// DcvCheckDcvTokens returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvCheckDcvTokens(params dcv.DcvCheckDcvTokensParams) (*dcv.DcvCheckDcvTokensNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvCheckDcvTokens",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*dcv.DcvCheckDcvTokensNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvCheckDcvTokensCall is an expected call to DcvCheckDcvTokens
+type DCVClientServiceDcvCheckDcvTokensCall struct {
+	e *expectation
+}
+
+// ExpectDcvCheckDcvTokens expects a single call to DcvCheckDcvTokens with any arguments
+func (m *DCVClientService) ExpectDcvCheckDcvTokens() *DCVClientServiceDcvCheckDcvTokensCall {
+	return &DCVClientServiceDcvCheckDcvTokensCall{m.controller.expect("DcvCheckDcvTokens")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *DCVClientServiceDcvCheckDcvTokensCall) With(params dcv.DcvCheckDcvTokensParams) *DCVClientServiceDcvCheckDcvTokensCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvCheckDcvTokensCall) Return(r0 *dcv.DcvCheckDcvTokensNoContent, r1 error) *DCVClientServiceDcvCheckDcvTokensCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvCheckDcvTokensCall) Do(
+	fn func(params dcv.DcvCheckDcvTokensParams) (*dcv.DcvCheckDcvTokensNoContent, error),
+) *DCVClientServiceDcvCheckDcvTokensCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(dcv.DcvCheckDcvTokensParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvCheckDcvTokensCall) Times(n int) *DCVClientServiceDcvCheckDcvTokensCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvCheckDcvTokensCall) AnyTimes() *DCVClientServiceDcvCheckDcvTokensCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvCheckDcvTokensWithContext returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvCheckDcvTokensWithContext(ctx context.Context, params dcv.DcvCheckDcvTokensParams) (*dcv.DcvCheckDcvTokensNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvCheckDcvTokensWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*dcv.DcvCheckDcvTokensNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvCheckDcvTokensWithContextCall is an expected call to DcvCheckDcvTokensWithContext
+type DCVClientServiceDcvCheckDcvTokensWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDcvCheckDcvTokensWithContext expects a single call to DcvCheckDcvTokensWithContext with any arguments
+func (m *DCVClientService) ExpectDcvCheckDcvTokensWithContext() *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	return &DCVClientServiceDcvCheckDcvTokensWithContextCall{m.controller.expect("DcvCheckDcvTokensWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *DCVClientServiceDcvCheckDcvTokensWithContextCall) With(params dcv.DcvCheckDcvTokensParams) *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvCheckDcvTokensWithContextCall) Return(r0 *dcv.DcvCheckDcvTokensNoContent, r1 error) *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvCheckDcvTokensWithContextCall) Do(
+	fn func(ctx context.Context, params dcv.DcvCheckDcvTokensParams) (*dcv.DcvCheckDcvTokensNoContent, error),
+) *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(dcv.DcvCheckDcvTokensParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvCheckDcvTokensWithContextCall) Times(n int) *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvCheckDcvTokensWithContextCall) AnyTimes() *DCVClientServiceDcvCheckDcvTokensWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvGetCertificateDomainDetails returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvGetCertificateDomainDetails(params dcv.DcvGetCertificateDomainDetailsParams) (*dcv.DcvGetCertificateDomainDetailsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvGetCertificateDomainDetails",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*dcv.DcvGetCertificateDomainDetailsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvGetCertificateDomainDetailsCall is an expected call to DcvGetCertificateDomainDetails
+type DCVClientServiceDcvGetCertificateDomainDetailsCall struct {
+	e *expectation
+}
+
+// ExpectDcvGetCertificateDomainDetails expects a single call to DcvGetCertificateDomainDetails with any arguments
+func (m *DCVClientService) ExpectDcvGetCertificateDomainDetails() *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	return &DCVClientServiceDcvGetCertificateDomainDetailsCall{m.controller.expect("DcvGetCertificateDomainDetails")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsCall) With(params dcv.DcvGetCertificateDomainDetailsParams) *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsCall) Return(r0 *dcv.DcvGetCertificateDomainDetailsOK, r1 error) *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsCall) Do(
+	fn func(params dcv.DcvGetCertificateDomainDetailsParams) (*dcv.DcvGetCertificateDomainDetailsOK, error),
+) *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(dcv.DcvGetCertificateDomainDetailsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsCall) Times(n int) *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsCall) AnyTimes() *DCVClientServiceDcvGetCertificateDomainDetailsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvGetCertificateDomainDetailsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvGetCertificateDomainDetailsWithContext(ctx context.Context, params dcv.DcvGetCertificateDomainDetailsParams) (*dcv.DcvGetCertificateDomainDetailsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvGetCertificateDomainDetailsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*dcv.DcvGetCertificateDomainDetailsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall is an expected call to DcvGetCertificateDomainDetailsWithContext
+type DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDcvGetCertificateDomainDetailsWithContext expects a single call to DcvGetCertificateDomainDetailsWithContext with any arguments
+func (m *DCVClientService) ExpectDcvGetCertificateDomainDetailsWithContext() *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	return &DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall{m.controller.expect("DcvGetCertificateDomainDetailsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall) With(params dcv.DcvGetCertificateDomainDetailsParams) *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall) Return(r0 *dcv.DcvGetCertificateDomainDetailsOK, r1 error) *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall) Do(
+	fn func(ctx context.Context, params dcv.DcvGetCertificateDomainDetailsParams) (*dcv.DcvGetCertificateDomainDetailsOK, error),
+) *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(dcv.DcvGetCertificateDomainDetailsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall) Times(n int) *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall) AnyTimes() *DCVClientServiceDcvGetCertificateDomainDetailsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvPostEmailResend returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvPostEmailResend(params dcv.DcvPostEmailResendParams) (*dcv.DcvPostEmailResendNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvPostEmailResend",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*dcv.DcvPostEmailResendNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvPostEmailResendCall is an expected call to DcvPostEmailResend
+type DCVClientServiceDcvPostEmailResendCall struct {
+	e *expectation
+}
+
+// ExpectDcvPostEmailResend expects a single call to DcvPostEmailResend with any arguments
+func (m *DCVClientService) ExpectDcvPostEmailResend() *DCVClientServiceDcvPostEmailResendCall {
+	return &DCVClientServiceDcvPostEmailResendCall{m.controller.expect("DcvPostEmailResend")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *DCVClientServiceDcvPostEmailResendCall) With(params dcv.DcvPostEmailResendParams) *DCVClientServiceDcvPostEmailResendCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvPostEmailResendCall) Return(r0 *dcv.DcvPostEmailResendNoContent, r1 error) *DCVClientServiceDcvPostEmailResendCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvPostEmailResendCall) Do(
+	fn func(params dcv.DcvPostEmailResendParams) (*dcv.DcvPostEmailResendNoContent, error),
+) *DCVClientServiceDcvPostEmailResendCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(dcv.DcvPostEmailResendParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvPostEmailResendCall) Times(n int) *DCVClientServiceDcvPostEmailResendCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvPostEmailResendCall) AnyTimes() *DCVClientServiceDcvPostEmailResendCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvPostEmailResendWithContext returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvPostEmailResendWithContext(ctx context.Context, params dcv.DcvPostEmailResendParams) (*dcv.DcvPostEmailResendNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvPostEmailResendWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*dcv.DcvPostEmailResendNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvPostEmailResendWithContextCall is an expected call to DcvPostEmailResendWithContext
+type DCVClientServiceDcvPostEmailResendWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDcvPostEmailResendWithContext expects a single call to DcvPostEmailResendWithContext with any arguments
+func (m *DCVClientService) ExpectDcvPostEmailResendWithContext() *DCVClientServiceDcvPostEmailResendWithContextCall {
+	return &DCVClientServiceDcvPostEmailResendWithContextCall{m.controller.expect("DcvPostEmailResendWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *DCVClientServiceDcvPostEmailResendWithContextCall) With(params dcv.DcvPostEmailResendParams) *DCVClientServiceDcvPostEmailResendWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvPostEmailResendWithContextCall) Return(r0 *dcv.DcvPostEmailResendNoContent, r1 error) *DCVClientServiceDcvPostEmailResendWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvPostEmailResendWithContextCall) Do(
+	fn func(ctx context.Context, params dcv.DcvPostEmailResendParams) (*dcv.DcvPostEmailResendNoContent, error),
+) *DCVClientServiceDcvPostEmailResendWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(dcv.DcvPostEmailResendParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvPostEmailResendWithContextCall) Times(n int) *DCVClientServiceDcvPostEmailResendWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvPostEmailResendWithContextCall) AnyTimes() *DCVClientServiceDcvPostEmailResendWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvRegenerateDcvTokens returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvRegenerateDcvTokens(params dcv.DcvRegenerateDcvTokensParams) (*dcv.DcvRegenerateDcvTokensOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvRegenerateDcvTokens",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*dcv.DcvRegenerateDcvTokensOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvRegenerateDcvTokensCall is an expected call to DcvRegenerateDcvTokens
+type DCVClientServiceDcvRegenerateDcvTokensCall struct {
+	e *expectation
+}
+
+// ExpectDcvRegenerateDcvTokens expects a single call to DcvRegenerateDcvTokens with any arguments
+func (m *DCVClientService) ExpectDcvRegenerateDcvTokens() *DCVClientServiceDcvRegenerateDcvTokensCall {
+	return &DCVClientServiceDcvRegenerateDcvTokensCall{m.controller.expect("DcvRegenerateDcvTokens")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *DCVClientServiceDcvRegenerateDcvTokensCall) With(params dcv.DcvRegenerateDcvTokensParams) *DCVClientServiceDcvRegenerateDcvTokensCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvRegenerateDcvTokensCall) Return(r0 *dcv.DcvRegenerateDcvTokensOK, r1 error) *DCVClientServiceDcvRegenerateDcvTokensCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvRegenerateDcvTokensCall) Do(
+	fn func(params dcv.DcvRegenerateDcvTokensParams) (*dcv.DcvRegenerateDcvTokensOK, error),
+) *DCVClientServiceDcvRegenerateDcvTokensCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(dcv.DcvRegenerateDcvTokensParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvRegenerateDcvTokensCall) Times(n int) *DCVClientServiceDcvRegenerateDcvTokensCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvRegenerateDcvTokensCall) AnyTimes() *DCVClientServiceDcvRegenerateDcvTokensCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvRegenerateDcvTokensWithContext returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvRegenerateDcvTokensWithContext(ctx context.Context, params dcv.DcvRegenerateDcvTokensParams) (*dcv.DcvRegenerateDcvTokensOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvRegenerateDcvTokensWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*dcv.DcvRegenerateDcvTokensOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvRegenerateDcvTokensWithContextCall is an expected call to DcvRegenerateDcvTokensWithContext
+type DCVClientServiceDcvRegenerateDcvTokensWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDcvRegenerateDcvTokensWithContext expects a single call to DcvRegenerateDcvTokensWithContext with any arguments
+func (m *DCVClientService) ExpectDcvRegenerateDcvTokensWithContext() *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	return &DCVClientServiceDcvRegenerateDcvTokensWithContextCall{m.controller.expect("DcvRegenerateDcvTokensWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *DCVClientServiceDcvRegenerateDcvTokensWithContextCall) With(params dcv.DcvRegenerateDcvTokensParams) *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvRegenerateDcvTokensWithContextCall) Return(r0 *dcv.DcvRegenerateDcvTokensOK, r1 error) *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvRegenerateDcvTokensWithContextCall) Do(
+	fn func(ctx context.Context, params dcv.DcvRegenerateDcvTokensParams) (*dcv.DcvRegenerateDcvTokensOK, error),
+) *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(dcv.DcvRegenerateDcvTokensParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvRegenerateDcvTokensWithContextCall) Times(n int) *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvRegenerateDcvTokensWithContextCall) AnyTimes() *DCVClientServiceDcvRegenerateDcvTokensWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvSetCertificateDcvMethod returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvSetCertificateDcvMethod(params dcv.DcvSetCertificateDcvMethodParams) (*dcv.DcvSetCertificateDcvMethodNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvSetCertificateDcvMethod",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*dcv.DcvSetCertificateDcvMethodNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvSetCertificateDcvMethodCall is an expected call to DcvSetCertificateDcvMethod
+type DCVClientServiceDcvSetCertificateDcvMethodCall struct {
+	e *expectation
+}
+
+// ExpectDcvSetCertificateDcvMethod expects a single call to DcvSetCertificateDcvMethod with any arguments
+func (m *DCVClientService) ExpectDcvSetCertificateDcvMethod() *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	return &DCVClientServiceDcvSetCertificateDcvMethodCall{m.controller.expect("DcvSetCertificateDcvMethod")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *DCVClientServiceDcvSetCertificateDcvMethodCall) With(params dcv.DcvSetCertificateDcvMethodParams) *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvSetCertificateDcvMethodCall) Return(r0 *dcv.DcvSetCertificateDcvMethodNoContent, r1 error) *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvSetCertificateDcvMethodCall) Do(
+	fn func(params dcv.DcvSetCertificateDcvMethodParams) (*dcv.DcvSetCertificateDcvMethodNoContent, error),
+) *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(dcv.DcvSetCertificateDcvMethodParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvSetCertificateDcvMethodCall) Times(n int) *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvSetCertificateDcvMethodCall) AnyTimes() *DCVClientServiceDcvSetCertificateDcvMethodCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DcvSetCertificateDcvMethodWithContext returns the results of the expected call that matches its
+// arguments
+func (m *DCVClientService) DcvSetCertificateDcvMethodWithContext(ctx context.Context, params dcv.DcvSetCertificateDcvMethodParams) (*dcv.DcvSetCertificateDcvMethodNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DcvSetCertificateDcvMethodWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*dcv.DcvSetCertificateDcvMethodNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// DCVClientServiceDcvSetCertificateDcvMethodWithContextCall is an expected call to DcvSetCertificateDcvMethodWithContext
+type DCVClientServiceDcvSetCertificateDcvMethodWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDcvSetCertificateDcvMethodWithContext expects a single call to DcvSetCertificateDcvMethodWithContext with any arguments
+func (m *DCVClientService) ExpectDcvSetCertificateDcvMethodWithContext() *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	return &DCVClientServiceDcvSetCertificateDcvMethodWithContextCall{m.controller.expect("DcvSetCertificateDcvMethodWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall) With(params dcv.DcvSetCertificateDcvMethodParams) *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall) Return(r0 *dcv.DcvSetCertificateDcvMethodNoContent, r1 error) *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall) Do(
+	fn func(ctx context.Context, params dcv.DcvSetCertificateDcvMethodParams) (*dcv.DcvSetCertificateDcvMethodNoContent, error),
+) *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(dcv.DcvSetCertificateDcvMethodParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall) Times(n int) *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall) AnyTimes() *DCVClientServiceDcvSetCertificateDcvMethodWithContextCall {
+	c.e.anyTimes()
+	return c
+}
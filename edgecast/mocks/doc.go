@@ -0,0 +1,28 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+/*
+Package mocks provides mocks of the interfaces of every SDK service, for
+testing code that uses the services without calling the APIs.
+
+Create a mock with the constructor named after it, then declare the calls that
+are expected and their results. Calls that match no expectation, and
+expectations that are not met by the end of the test, fail the test:
+
+	accessRules := mocks.NewAccessClientService(t)
+	accessRules.ExpectAddAccessRule().
+		With(access.AddAccessRuleParams{AccountNumber: "ABCD"}).
+		Return("rule-id", nil)
+
+	wafService := &waf.WafService{Access: accessRules}
+
+With compares arguments with reflect.DeepEqual, ignoring contexts. Use Do to
+compute results from the arguments, and Times or AnyTimes to expect a call
+other than exactly once.
+
+The mocks are generated from template/client/mock.gotmpl by
+edgecast/internal/mockgen. Do not edit them; run go generate instead.
+*/
+package mocks
+
+//go:generate go run ../internal/mockgen -dir .. -out . -template ../../template/client/mock.gotmpl
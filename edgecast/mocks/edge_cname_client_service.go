@@ -0,0 +1,758 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/edgecname"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecmodels"
+)
+
+// EdgeCnameClientService is a mock of edgecname.ClientService
+type EdgeCnameClientService struct {
+	controller *controller
+}
+
+var _ edgecname.ClientService = (*EdgeCnameClientService)(nil)
+
+// NewEdgeCnameClientService creates a mock of edgecname.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewEdgeCnameClientService(t TestingT) *EdgeCnameClientService {
+	return &EdgeCnameClientService{controller: newController(t, "EdgeCnameClientService")}
+}
+
+// GetAllEdgeCnames returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetAllEdgeCnames(params edgecname.GetAllEdgeCnameParams) (*[]edgecname.EdgeCnameGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllEdgeCnames",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]edgecname.EdgeCnameGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetAllEdgeCnamesCall is an expected call to GetAllEdgeCnames
+type EdgeCnameClientServiceGetAllEdgeCnamesCall struct {
+	e *expectation
+}
+
+// ExpectGetAllEdgeCnames expects a single call to GetAllEdgeCnames with any arguments
+func (m *EdgeCnameClientService) ExpectGetAllEdgeCnames() *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	return &EdgeCnameClientServiceGetAllEdgeCnamesCall{m.controller.expect("GetAllEdgeCnames")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesCall) With(params edgecname.GetAllEdgeCnameParams) *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesCall) Return(r0 *[]edgecname.EdgeCnameGetOK, r1 error) *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesCall) Do(
+	fn func(params edgecname.GetAllEdgeCnameParams) (*[]edgecname.EdgeCnameGetOK, error),
+) *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.GetAllEdgeCnameParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesCall) Times(n int) *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesCall) AnyTimes() *EdgeCnameClientServiceGetAllEdgeCnamesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllEdgeCnamesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetAllEdgeCnamesWithContext(ctx context.Context, params edgecname.GetAllEdgeCnameParams) (*[]edgecname.EdgeCnameGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllEdgeCnamesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]edgecname.EdgeCnameGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall is an expected call to GetAllEdgeCnamesWithContext
+type EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllEdgeCnamesWithContext expects a single call to GetAllEdgeCnamesWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectGetAllEdgeCnamesWithContext() *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	return &EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall{m.controller.expect("GetAllEdgeCnamesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall) With(params edgecname.GetAllEdgeCnameParams) *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall) Return(r0 *[]edgecname.EdgeCnameGetOK, r1 error) *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.GetAllEdgeCnameParams) (*[]edgecname.EdgeCnameGetOK, error),
+) *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.GetAllEdgeCnameParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall) Times(n int) *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall) AnyTimes() *EdgeCnameClientServiceGetAllEdgeCnamesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddEdgeCname returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) AddEdgeCname(params edgecname.AddEdgeCnameParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddEdgeCname",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceAddEdgeCnameCall is an expected call to AddEdgeCname
+type EdgeCnameClientServiceAddEdgeCnameCall struct {
+	e *expectation
+}
+
+// ExpectAddEdgeCname expects a single call to AddEdgeCname with any arguments
+func (m *EdgeCnameClientService) ExpectAddEdgeCname() *EdgeCnameClientServiceAddEdgeCnameCall {
+	return &EdgeCnameClientServiceAddEdgeCnameCall{m.controller.expect("AddEdgeCname")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceAddEdgeCnameCall) With(params edgecname.AddEdgeCnameParams) *EdgeCnameClientServiceAddEdgeCnameCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceAddEdgeCnameCall) Return(r0 *int, r1 error) *EdgeCnameClientServiceAddEdgeCnameCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceAddEdgeCnameCall) Do(
+	fn func(params edgecname.AddEdgeCnameParams) (*int, error),
+) *EdgeCnameClientServiceAddEdgeCnameCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.AddEdgeCnameParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceAddEdgeCnameCall) Times(n int) *EdgeCnameClientServiceAddEdgeCnameCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceAddEdgeCnameCall) AnyTimes() *EdgeCnameClientServiceAddEdgeCnameCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddEdgeCnameWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) AddEdgeCnameWithContext(ctx context.Context, params edgecname.AddEdgeCnameParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddEdgeCnameWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceAddEdgeCnameWithContextCall is an expected call to AddEdgeCnameWithContext
+type EdgeCnameClientServiceAddEdgeCnameWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddEdgeCnameWithContext expects a single call to AddEdgeCnameWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectAddEdgeCnameWithContext() *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	return &EdgeCnameClientServiceAddEdgeCnameWithContextCall{m.controller.expect("AddEdgeCnameWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceAddEdgeCnameWithContextCall) With(params edgecname.AddEdgeCnameParams) *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceAddEdgeCnameWithContextCall) Return(r0 *int, r1 error) *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceAddEdgeCnameWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.AddEdgeCnameParams) (*int, error),
+) *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.AddEdgeCnameParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceAddEdgeCnameWithContextCall) Times(n int) *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceAddEdgeCnameWithContextCall) AnyTimes() *EdgeCnameClientServiceAddEdgeCnameWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeCname returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetEdgeCname(params edgecname.GetEdgeCnameParams) (*edgecname.EdgeCnameGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeCname",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*edgecname.EdgeCnameGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetEdgeCnameCall is an expected call to GetEdgeCname
+type EdgeCnameClientServiceGetEdgeCnameCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeCname expects a single call to GetEdgeCname with any arguments
+func (m *EdgeCnameClientService) ExpectGetEdgeCname() *EdgeCnameClientServiceGetEdgeCnameCall {
+	return &EdgeCnameClientServiceGetEdgeCnameCall{m.controller.expect("GetEdgeCname")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceGetEdgeCnameCall) With(params edgecname.GetEdgeCnameParams) *EdgeCnameClientServiceGetEdgeCnameCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetEdgeCnameCall) Return(r0 *edgecname.EdgeCnameGetOK, r1 error) *EdgeCnameClientServiceGetEdgeCnameCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetEdgeCnameCall) Do(
+	fn func(params edgecname.GetEdgeCnameParams) (*edgecname.EdgeCnameGetOK, error),
+) *EdgeCnameClientServiceGetEdgeCnameCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.GetEdgeCnameParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetEdgeCnameCall) Times(n int) *EdgeCnameClientServiceGetEdgeCnameCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetEdgeCnameCall) AnyTimes() *EdgeCnameClientServiceGetEdgeCnameCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeCnameWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetEdgeCnameWithContext(ctx context.Context, params edgecname.GetEdgeCnameParams) (*edgecname.EdgeCnameGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeCnameWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*edgecname.EdgeCnameGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetEdgeCnameWithContextCall is an expected call to GetEdgeCnameWithContext
+type EdgeCnameClientServiceGetEdgeCnameWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeCnameWithContext expects a single call to GetEdgeCnameWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectGetEdgeCnameWithContext() *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	return &EdgeCnameClientServiceGetEdgeCnameWithContextCall{m.controller.expect("GetEdgeCnameWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceGetEdgeCnameWithContextCall) With(params edgecname.GetEdgeCnameParams) *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetEdgeCnameWithContextCall) Return(r0 *edgecname.EdgeCnameGetOK, r1 error) *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetEdgeCnameWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.GetEdgeCnameParams) (*edgecname.EdgeCnameGetOK, error),
+) *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.GetEdgeCnameParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetEdgeCnameWithContextCall) Times(n int) *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetEdgeCnameWithContextCall) AnyTimes() *EdgeCnameClientServiceGetEdgeCnameWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateEdgeCname returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) UpdateEdgeCname(params edgecname.UpdateEdgeCnameParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateEdgeCname",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceUpdateEdgeCnameCall is an expected call to UpdateEdgeCname
+type EdgeCnameClientServiceUpdateEdgeCnameCall struct {
+	e *expectation
+}
+
+// ExpectUpdateEdgeCname expects a single call to UpdateEdgeCname with any arguments
+func (m *EdgeCnameClientService) ExpectUpdateEdgeCname() *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	return &EdgeCnameClientServiceUpdateEdgeCnameCall{m.controller.expect("UpdateEdgeCname")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceUpdateEdgeCnameCall) With(params edgecname.UpdateEdgeCnameParams) *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceUpdateEdgeCnameCall) Return(r0 *int, r1 error) *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceUpdateEdgeCnameCall) Do(
+	fn func(params edgecname.UpdateEdgeCnameParams) (*int, error),
+) *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.UpdateEdgeCnameParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceUpdateEdgeCnameCall) Times(n int) *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceUpdateEdgeCnameCall) AnyTimes() *EdgeCnameClientServiceUpdateEdgeCnameCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateEdgeCnameWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) UpdateEdgeCnameWithContext(ctx context.Context, params edgecname.UpdateEdgeCnameParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateEdgeCnameWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceUpdateEdgeCnameWithContextCall is an expected call to UpdateEdgeCnameWithContext
+type EdgeCnameClientServiceUpdateEdgeCnameWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateEdgeCnameWithContext expects a single call to UpdateEdgeCnameWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectUpdateEdgeCnameWithContext() *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	return &EdgeCnameClientServiceUpdateEdgeCnameWithContextCall{m.controller.expect("UpdateEdgeCnameWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall) With(params edgecname.UpdateEdgeCnameParams) *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall) Return(r0 *int, r1 error) *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.UpdateEdgeCnameParams) (*int, error),
+) *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.UpdateEdgeCnameParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall) Times(n int) *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall) AnyTimes() *EdgeCnameClientServiceUpdateEdgeCnameWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteEdgeCname returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) DeleteEdgeCname(params edgecname.DeleteEdgeCnameParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteEdgeCname",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// EdgeCnameClientServiceDeleteEdgeCnameCall is an expected call to DeleteEdgeCname
+type EdgeCnameClientServiceDeleteEdgeCnameCall struct {
+	e *expectation
+}
+
+// ExpectDeleteEdgeCname expects a single call to DeleteEdgeCname with any arguments
+func (m *EdgeCnameClientService) ExpectDeleteEdgeCname() *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	return &EdgeCnameClientServiceDeleteEdgeCnameCall{m.controller.expect("DeleteEdgeCname")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceDeleteEdgeCnameCall) With(params edgecname.DeleteEdgeCnameParams) *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceDeleteEdgeCnameCall) Return(r0 error) *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceDeleteEdgeCnameCall) Do(
+	fn func(params edgecname.DeleteEdgeCnameParams) error,
+) *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.DeleteEdgeCnameParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceDeleteEdgeCnameCall) Times(n int) *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceDeleteEdgeCnameCall) AnyTimes() *EdgeCnameClientServiceDeleteEdgeCnameCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteEdgeCnameWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) DeleteEdgeCnameWithContext(ctx context.Context, params edgecname.DeleteEdgeCnameParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteEdgeCnameWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// EdgeCnameClientServiceDeleteEdgeCnameWithContextCall is an expected call to DeleteEdgeCnameWithContext
+type EdgeCnameClientServiceDeleteEdgeCnameWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteEdgeCnameWithContext expects a single call to DeleteEdgeCnameWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectDeleteEdgeCnameWithContext() *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	return &EdgeCnameClientServiceDeleteEdgeCnameWithContextCall{m.controller.expect("DeleteEdgeCnameWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall) With(params edgecname.DeleteEdgeCnameParams) *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall) Return(r0 error) *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.DeleteEdgeCnameParams) error,
+) *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.DeleteEdgeCnameParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall) Times(n int) *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall) AnyTimes() *EdgeCnameClientServiceDeleteEdgeCnameWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeCnamePropagationStatus returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetEdgeCnamePropagationStatus(params edgecname.GetEdgeCnamePropagationStatus) (*ecmodels.PropagationStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeCnamePropagationStatus",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*ecmodels.PropagationStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall is an expected call to GetEdgeCnamePropagationStatus
+type EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeCnamePropagationStatus expects a single call to GetEdgeCnamePropagationStatus with any arguments
+func (m *EdgeCnameClientService) ExpectGetEdgeCnamePropagationStatus() *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	return &EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall{m.controller.expect("GetEdgeCnamePropagationStatus")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall) With(params edgecname.GetEdgeCnamePropagationStatus) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall) Return(r0 *ecmodels.PropagationStatus, r1 error) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall) Do(
+	fn func(params edgecname.GetEdgeCnamePropagationStatus) (*ecmodels.PropagationStatus, error),
+) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(edgecname.GetEdgeCnamePropagationStatus)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall) Times(n int) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall) AnyTimes() *EdgeCnameClientServiceGetEdgeCnamePropagationStatusCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetEdgeCnamePropagationStatusWithContext returns the results of the expected call that matches its
+// arguments
+func (m *EdgeCnameClientService) GetEdgeCnamePropagationStatusWithContext(ctx context.Context, params edgecname.GetEdgeCnamePropagationStatus) (*ecmodels.PropagationStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetEdgeCnamePropagationStatusWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*ecmodels.PropagationStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall is an expected call to GetEdgeCnamePropagationStatusWithContext
+type EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetEdgeCnamePropagationStatusWithContext expects a single call to GetEdgeCnamePropagationStatusWithContext with any arguments
+func (m *EdgeCnameClientService) ExpectGetEdgeCnamePropagationStatusWithContext() *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	return &EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall{m.controller.expect("GetEdgeCnamePropagationStatusWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall) With(params edgecname.GetEdgeCnamePropagationStatus) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall) Return(r0 *ecmodels.PropagationStatus, r1 error) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall) Do(
+	fn func(ctx context.Context, params edgecname.GetEdgeCnamePropagationStatus) (*ecmodels.PropagationStatus, error),
+) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(edgecname.GetEdgeCnamePropagationStatus)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall) Times(n int) *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall) AnyTimes() *EdgeCnameClientServiceGetEdgeCnamePropagationStatusWithContextCall {
+	c.e.anyTimes()
+	return c
+}
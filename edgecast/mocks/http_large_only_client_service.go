@@ -0,0 +1,622 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+)
+
+// HttpLargeOnlyClientService is a mock of originv3.HttpLargeOnlyClientService
+type HttpLargeOnlyClientService struct {
+	controller *controller
+}
+
+var _ originv3.HttpLargeOnlyClientService = (*HttpLargeOnlyClientService)(nil)
+
+// NewHttpLargeOnlyClientService creates a mock of originv3.HttpLargeOnlyClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewHttpLargeOnlyClientService(t TestingT) *HttpLargeOnlyClientService {
+	return &HttpLargeOnlyClientService{controller: newController(t, "HttpLargeOnlyClientService")}
+}
+
+// AddHttpLargeGroup returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) AddHttpLargeGroup(params originv3.AddHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddHttpLargeGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceAddHttpLargeGroupCall is an expected call to AddHttpLargeGroup
+type HttpLargeOnlyClientServiceAddHttpLargeGroupCall struct {
+	e *expectation
+}
+
+// ExpectAddHttpLargeGroup expects a single call to AddHttpLargeGroup with any arguments
+func (m *HttpLargeOnlyClientService) ExpectAddHttpLargeGroup() *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	return &HttpLargeOnlyClientServiceAddHttpLargeGroupCall{m.controller.expect("AddHttpLargeGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupCall) With(params originv3.AddHttpLargeGroupParams) *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupCall) Do(
+	fn func(params originv3.AddHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.AddHttpLargeGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupCall) Times(n int) *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupCall) AnyTimes() *HttpLargeOnlyClientServiceAddHttpLargeGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddHttpLargeGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) AddHttpLargeGroupWithContext(ctx context.Context, params originv3.AddHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddHttpLargeGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall is an expected call to AddHttpLargeGroupWithContext
+type HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddHttpLargeGroupWithContext expects a single call to AddHttpLargeGroupWithContext with any arguments
+func (m *HttpLargeOnlyClientService) ExpectAddHttpLargeGroupWithContext() *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	return &HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall{m.controller.expect("AddHttpLargeGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall) With(params originv3.AddHttpLargeGroupParams) *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.AddHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.AddHttpLargeGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall) Times(n int) *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall) AnyTimes() *HttpLargeOnlyClientServiceAddHttpLargeGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllHttpLargeGroups returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetAllHttpLargeGroups() ([]originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllHttpLargeGroups",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall is an expected call to GetAllHttpLargeGroups
+type HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllHttpLargeGroups expects a single call to GetAllHttpLargeGroups with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetAllHttpLargeGroups() *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall {
+	return &HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall{m.controller.expect("GetAllHttpLargeGroups")}
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall) Return(r0 []originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall) Do(
+	fn func() ([]originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall) Times(n int) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall) AnyTimes() *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllHttpLargeGroupsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetAllHttpLargeGroupsWithContext(ctx context.Context) ([]originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllHttpLargeGroupsWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].([]originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall is an expected call to GetAllHttpLargeGroupsWithContext
+type HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllHttpLargeGroupsWithContext expects a single call to GetAllHttpLargeGroupsWithContext with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetAllHttpLargeGroupsWithContext() *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall {
+	return &HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall{m.controller.expect("GetAllHttpLargeGroupsWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall) Return(r0 []originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall) Do(
+	fn func(ctx context.Context) ([]originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall) Times(n int) *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall) AnyTimes() *HttpLargeOnlyClientServiceGetAllHttpLargeGroupsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetHttpLargeGroup returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetHttpLargeGroup(params originv3.GetHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetHttpLargeGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetHttpLargeGroupCall is an expected call to GetHttpLargeGroup
+type HttpLargeOnlyClientServiceGetHttpLargeGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetHttpLargeGroup expects a single call to GetHttpLargeGroup with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetHttpLargeGroup() *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	return &HttpLargeOnlyClientServiceGetHttpLargeGroupCall{m.controller.expect("GetHttpLargeGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupCall) With(params originv3.GetHttpLargeGroupParams) *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupCall) Do(
+	fn func(params originv3.GetHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetHttpLargeGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupCall) Times(n int) *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupCall) AnyTimes() *HttpLargeOnlyClientServiceGetHttpLargeGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetHttpLargeGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetHttpLargeGroupWithContext(ctx context.Context, params originv3.GetHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetHttpLargeGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall is an expected call to GetHttpLargeGroupWithContext
+type HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetHttpLargeGroupWithContext expects a single call to GetHttpLargeGroupWithContext with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetHttpLargeGroupWithContext() *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	return &HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall{m.controller.expect("GetHttpLargeGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall) With(params originv3.GetHttpLargeGroupParams) *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetHttpLargeGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall) Times(n int) *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall) AnyTimes() *HttpLargeOnlyClientServiceGetHttpLargeGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginShieldPops returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetOriginShieldPops(params originv3.GetOriginShieldPopsParams) ([]originv3.OriginShieldEdgeNode, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginShieldPops",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].([]originv3.OriginShieldEdgeNode)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetOriginShieldPopsCall is an expected call to GetOriginShieldPops
+type HttpLargeOnlyClientServiceGetOriginShieldPopsCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginShieldPops expects a single call to GetOriginShieldPops with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetOriginShieldPops() *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	return &HttpLargeOnlyClientServiceGetOriginShieldPopsCall{m.controller.expect("GetOriginShieldPops")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsCall) With(params originv3.GetOriginShieldPopsParams) *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsCall) Return(r0 []originv3.OriginShieldEdgeNode, r1 error) *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsCall) Do(
+	fn func(params originv3.GetOriginShieldPopsParams) ([]originv3.OriginShieldEdgeNode, error),
+) *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.GetOriginShieldPopsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsCall) Times(n int) *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsCall) AnyTimes() *HttpLargeOnlyClientServiceGetOriginShieldPopsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginShieldPopsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) GetOriginShieldPopsWithContext(ctx context.Context, params originv3.GetOriginShieldPopsParams) ([]originv3.OriginShieldEdgeNode, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginShieldPopsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].([]originv3.OriginShieldEdgeNode)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall is an expected call to GetOriginShieldPopsWithContext
+type HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginShieldPopsWithContext expects a single call to GetOriginShieldPopsWithContext with any arguments
+func (m *HttpLargeOnlyClientService) ExpectGetOriginShieldPopsWithContext() *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	return &HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall{m.controller.expect("GetOriginShieldPopsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall) With(params originv3.GetOriginShieldPopsParams) *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall) Return(r0 []originv3.OriginShieldEdgeNode, r1 error) *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.GetOriginShieldPopsParams) ([]originv3.OriginShieldEdgeNode, error),
+) *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.GetOriginShieldPopsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall) Times(n int) *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall) AnyTimes() *HttpLargeOnlyClientServiceGetOriginShieldPopsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateHttpLargeGroup returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) UpdateHttpLargeGroup(params originv3.UpdateHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateHttpLargeGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall is an expected call to UpdateHttpLargeGroup
+type HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall struct {
+	e *expectation
+}
+
+// ExpectUpdateHttpLargeGroup expects a single call to UpdateHttpLargeGroup with any arguments
+func (m *HttpLargeOnlyClientService) ExpectUpdateHttpLargeGroup() *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	return &HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall{m.controller.expect("UpdateHttpLargeGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall) With(params originv3.UpdateHttpLargeGroupParams) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall) Do(
+	fn func(params originv3.UpdateHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(originv3.UpdateHttpLargeGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall) Times(n int) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall) AnyTimes() *HttpLargeOnlyClientServiceUpdateHttpLargeGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateHttpLargeGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *HttpLargeOnlyClientService) UpdateHttpLargeGroupWithContext(ctx context.Context, params originv3.UpdateHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateHttpLargeGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*originv3.CustomerOriginGroupHTTP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall is an expected call to UpdateHttpLargeGroupWithContext
+type HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateHttpLargeGroupWithContext expects a single call to UpdateHttpLargeGroupWithContext with any arguments
+func (m *HttpLargeOnlyClientService) ExpectUpdateHttpLargeGroupWithContext() *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	return &HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall{m.controller.expect("UpdateHttpLargeGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall) With(params originv3.UpdateHttpLargeGroupParams) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall) Return(r0 *originv3.CustomerOriginGroupHTTP, r1 error) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall) Do(
+	fn func(ctx context.Context, params originv3.UpdateHttpLargeGroupParams) (*originv3.CustomerOriginGroupHTTP, error),
+) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(originv3.UpdateHttpLargeGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall) Times(n int) *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall) AnyTimes() *HttpLargeOnlyClientServiceUpdateHttpLargeGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,1491 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/lookups"
+)
+
+// LookupsClientService is a mock of lookups.ClientService
+type LookupsClientService struct {
+	controller *controller
+}
+
+var _ lookups.ClientService = (*LookupsClientService)(nil)
+
+// NewLookupsClientService creates a mock of lookups.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewLookupsClientService(t TestingT) *LookupsClientService {
+	return &LookupsClientService{controller: newController(t, "LookupsClientService")}
+}
+
+// LookupsGetAwsRegions returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetAwsRegions(params *lookups.LookupsGetAwsRegionsParams) (*lookups.LookupsGetAwsRegionsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetAwsRegions",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetAwsRegionsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetAwsRegionsCall is an expected call to LookupsGetAwsRegions
+type LookupsClientServiceLookupsGetAwsRegionsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetAwsRegions expects a single call to LookupsGetAwsRegions with any arguments
+func (m *LookupsClientService) ExpectLookupsGetAwsRegions() *LookupsClientServiceLookupsGetAwsRegionsCall {
+	return &LookupsClientServiceLookupsGetAwsRegionsCall{m.controller.expect("LookupsGetAwsRegions")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetAwsRegionsCall) With(params *lookups.LookupsGetAwsRegionsParams) *LookupsClientServiceLookupsGetAwsRegionsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetAwsRegionsCall) Return(r0 *lookups.LookupsGetAwsRegionsOK, r1 error) *LookupsClientServiceLookupsGetAwsRegionsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetAwsRegionsCall) Do(
+	fn func(params *lookups.LookupsGetAwsRegionsParams) (*lookups.LookupsGetAwsRegionsOK, error),
+) *LookupsClientServiceLookupsGetAwsRegionsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetAwsRegionsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetAwsRegionsCall) Times(n int) *LookupsClientServiceLookupsGetAwsRegionsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetAwsRegionsCall) AnyTimes() *LookupsClientServiceLookupsGetAwsRegionsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetAwsRegionsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetAwsRegionsWithContext(ctx context.Context, params *lookups.LookupsGetAwsRegionsParams) (*lookups.LookupsGetAwsRegionsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetAwsRegionsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetAwsRegionsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetAwsRegionsWithContextCall is an expected call to LookupsGetAwsRegionsWithContext
+type LookupsClientServiceLookupsGetAwsRegionsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetAwsRegionsWithContext expects a single call to LookupsGetAwsRegionsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetAwsRegionsWithContext() *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	return &LookupsClientServiceLookupsGetAwsRegionsWithContextCall{m.controller.expect("LookupsGetAwsRegionsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetAwsRegionsWithContextCall) With(params *lookups.LookupsGetAwsRegionsParams) *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetAwsRegionsWithContextCall) Return(r0 *lookups.LookupsGetAwsRegionsOK, r1 error) *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetAwsRegionsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetAwsRegionsParams) (*lookups.LookupsGetAwsRegionsOK, error),
+) *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetAwsRegionsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetAwsRegionsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetAwsRegionsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetAwsRegionsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetAzureAccessTypes returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetAzureAccessTypes(params *lookups.LookupsGetAzureAccessTypesParams) (*lookups.LookupsGetAzureAccessTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetAzureAccessTypes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetAzureAccessTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetAzureAccessTypesCall is an expected call to LookupsGetAzureAccessTypes
+type LookupsClientServiceLookupsGetAzureAccessTypesCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetAzureAccessTypes expects a single call to LookupsGetAzureAccessTypes with any arguments
+func (m *LookupsClientService) ExpectLookupsGetAzureAccessTypes() *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	return &LookupsClientServiceLookupsGetAzureAccessTypesCall{m.controller.expect("LookupsGetAzureAccessTypes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesCall) With(params *lookups.LookupsGetAzureAccessTypesParams) *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesCall) Return(r0 *lookups.LookupsGetAzureAccessTypesOK, r1 error) *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesCall) Do(
+	fn func(params *lookups.LookupsGetAzureAccessTypesParams) (*lookups.LookupsGetAzureAccessTypesOK, error),
+) *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetAzureAccessTypesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesCall) Times(n int) *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesCall) AnyTimes() *LookupsClientServiceLookupsGetAzureAccessTypesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetAzureAccessTypesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetAzureAccessTypesWithContext(ctx context.Context, params *lookups.LookupsGetAzureAccessTypesParams) (*lookups.LookupsGetAzureAccessTypesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetAzureAccessTypesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetAzureAccessTypesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall is an expected call to LookupsGetAzureAccessTypesWithContext
+type LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetAzureAccessTypesWithContext expects a single call to LookupsGetAzureAccessTypesWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetAzureAccessTypesWithContext() *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	return &LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall{m.controller.expect("LookupsGetAzureAccessTypesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall) With(params *lookups.LookupsGetAzureAccessTypesParams) *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall) Return(r0 *lookups.LookupsGetAzureAccessTypesOK, r1 error) *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetAzureAccessTypesParams) (*lookups.LookupsGetAzureAccessTypesOK, error),
+) *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetAzureAccessTypesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall) Times(n int) *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetAzureAccessTypesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetCustomItems returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetCustomItems(params *lookups.LookupsGetCustomItemsParams) (*lookups.LookupsGetCustomItemsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetCustomItems",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetCustomItemsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetCustomItemsCall is an expected call to LookupsGetCustomItems
+type LookupsClientServiceLookupsGetCustomItemsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetCustomItems expects a single call to LookupsGetCustomItems with any arguments
+func (m *LookupsClientService) ExpectLookupsGetCustomItems() *LookupsClientServiceLookupsGetCustomItemsCall {
+	return &LookupsClientServiceLookupsGetCustomItemsCall{m.controller.expect("LookupsGetCustomItems")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetCustomItemsCall) With(params *lookups.LookupsGetCustomItemsParams) *LookupsClientServiceLookupsGetCustomItemsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetCustomItemsCall) Return(r0 *lookups.LookupsGetCustomItemsOK, r1 error) *LookupsClientServiceLookupsGetCustomItemsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetCustomItemsCall) Do(
+	fn func(params *lookups.LookupsGetCustomItemsParams) (*lookups.LookupsGetCustomItemsOK, error),
+) *LookupsClientServiceLookupsGetCustomItemsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetCustomItemsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetCustomItemsCall) Times(n int) *LookupsClientServiceLookupsGetCustomItemsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetCustomItemsCall) AnyTimes() *LookupsClientServiceLookupsGetCustomItemsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetCustomItemsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetCustomItemsWithContext(ctx context.Context, params *lookups.LookupsGetCustomItemsParams) (*lookups.LookupsGetCustomItemsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetCustomItemsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetCustomItemsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetCustomItemsWithContextCall is an expected call to LookupsGetCustomItemsWithContext
+type LookupsClientServiceLookupsGetCustomItemsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetCustomItemsWithContext expects a single call to LookupsGetCustomItemsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetCustomItemsWithContext() *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	return &LookupsClientServiceLookupsGetCustomItemsWithContextCall{m.controller.expect("LookupsGetCustomItemsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetCustomItemsWithContextCall) With(params *lookups.LookupsGetCustomItemsParams) *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetCustomItemsWithContextCall) Return(r0 *lookups.LookupsGetCustomItemsOK, r1 error) *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetCustomItemsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetCustomItemsParams) (*lookups.LookupsGetCustomItemsOK, error),
+) *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetCustomItemsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetCustomItemsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetCustomItemsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetCustomItemsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetDeliveryMethods returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetDeliveryMethods(params *lookups.LookupsGetDeliveryMethodsParams) (*lookups.LookupsGetDeliveryMethodsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetDeliveryMethods",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetDeliveryMethodsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetDeliveryMethodsCall is an expected call to LookupsGetDeliveryMethods
+type LookupsClientServiceLookupsGetDeliveryMethodsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetDeliveryMethods expects a single call to LookupsGetDeliveryMethods with any arguments
+func (m *LookupsClientService) ExpectLookupsGetDeliveryMethods() *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	return &LookupsClientServiceLookupsGetDeliveryMethodsCall{m.controller.expect("LookupsGetDeliveryMethods")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsCall) With(params *lookups.LookupsGetDeliveryMethodsParams) *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsCall) Return(r0 *lookups.LookupsGetDeliveryMethodsOK, r1 error) *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsCall) Do(
+	fn func(params *lookups.LookupsGetDeliveryMethodsParams) (*lookups.LookupsGetDeliveryMethodsOK, error),
+) *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetDeliveryMethodsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsCall) Times(n int) *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsCall) AnyTimes() *LookupsClientServiceLookupsGetDeliveryMethodsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetDeliveryMethodsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetDeliveryMethodsWithContext(ctx context.Context, params *lookups.LookupsGetDeliveryMethodsParams) (*lookups.LookupsGetDeliveryMethodsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetDeliveryMethodsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetDeliveryMethodsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall is an expected call to LookupsGetDeliveryMethodsWithContext
+type LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetDeliveryMethodsWithContext expects a single call to LookupsGetDeliveryMethodsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetDeliveryMethodsWithContext() *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	return &LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall{m.controller.expect("LookupsGetDeliveryMethodsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall) With(params *lookups.LookupsGetDeliveryMethodsParams) *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall) Return(r0 *lookups.LookupsGetDeliveryMethodsOK, r1 error) *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetDeliveryMethodsParams) (*lookups.LookupsGetDeliveryMethodsOK, error),
+) *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetDeliveryMethodsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetDeliveryMethodsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetDownsamplingRates returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetDownsamplingRates(params *lookups.LookupsGetDownsamplingRatesParams) (*lookups.LookupsGetDownsamplingRatesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetDownsamplingRates",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetDownsamplingRatesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetDownsamplingRatesCall is an expected call to LookupsGetDownsamplingRates
+type LookupsClientServiceLookupsGetDownsamplingRatesCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetDownsamplingRates expects a single call to LookupsGetDownsamplingRates with any arguments
+func (m *LookupsClientService) ExpectLookupsGetDownsamplingRates() *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	return &LookupsClientServiceLookupsGetDownsamplingRatesCall{m.controller.expect("LookupsGetDownsamplingRates")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesCall) With(params *lookups.LookupsGetDownsamplingRatesParams) *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesCall) Return(r0 *lookups.LookupsGetDownsamplingRatesOK, r1 error) *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesCall) Do(
+	fn func(params *lookups.LookupsGetDownsamplingRatesParams) (*lookups.LookupsGetDownsamplingRatesOK, error),
+) *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetDownsamplingRatesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesCall) Times(n int) *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesCall) AnyTimes() *LookupsClientServiceLookupsGetDownsamplingRatesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetDownsamplingRatesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetDownsamplingRatesWithContext(ctx context.Context, params *lookups.LookupsGetDownsamplingRatesParams) (*lookups.LookupsGetDownsamplingRatesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetDownsamplingRatesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetDownsamplingRatesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall is an expected call to LookupsGetDownsamplingRatesWithContext
+type LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetDownsamplingRatesWithContext expects a single call to LookupsGetDownsamplingRatesWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetDownsamplingRatesWithContext() *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	return &LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall{m.controller.expect("LookupsGetDownsamplingRatesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall) With(params *lookups.LookupsGetDownsamplingRatesParams) *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall) Return(r0 *lookups.LookupsGetDownsamplingRatesOK, r1 error) *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetDownsamplingRatesParams) (*lookups.LookupsGetDownsamplingRatesOK, error),
+) *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetDownsamplingRatesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall) Times(n int) *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetDownsamplingRatesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldRl returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldRl(params *lookups.LookupsGetFieldRlParams) (*lookups.LookupsGetFieldRlOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldRl",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldRlOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldRlCall is an expected call to LookupsGetFieldRl
+type LookupsClientServiceLookupsGetFieldRlCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldRl expects a single call to LookupsGetFieldRl with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldRl() *LookupsClientServiceLookupsGetFieldRlCall {
+	return &LookupsClientServiceLookupsGetFieldRlCall{m.controller.expect("LookupsGetFieldRl")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetFieldRlCall) With(params *lookups.LookupsGetFieldRlParams) *LookupsClientServiceLookupsGetFieldRlCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldRlCall) Return(r0 *lookups.LookupsGetFieldRlOK, r1 error) *LookupsClientServiceLookupsGetFieldRlCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldRlCall) Do(
+	fn func(params *lookups.LookupsGetFieldRlParams) (*lookups.LookupsGetFieldRlOK, error),
+) *LookupsClientServiceLookupsGetFieldRlCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetFieldRlParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldRlCall) Times(n int) *LookupsClientServiceLookupsGetFieldRlCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldRlCall) AnyTimes() *LookupsClientServiceLookupsGetFieldRlCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldRlWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldRlWithContext(ctx context.Context, params *lookups.LookupsGetFieldRlParams) (*lookups.LookupsGetFieldRlOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldRlWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldRlOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldRlWithContextCall is an expected call to LookupsGetFieldRlWithContext
+type LookupsClientServiceLookupsGetFieldRlWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldRlWithContext expects a single call to LookupsGetFieldRlWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldRlWithContext() *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	return &LookupsClientServiceLookupsGetFieldRlWithContextCall{m.controller.expect("LookupsGetFieldRlWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetFieldRlWithContextCall) With(params *lookups.LookupsGetFieldRlParams) *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldRlWithContextCall) Return(r0 *lookups.LookupsGetFieldRlOK, r1 error) *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldRlWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetFieldRlParams) (*lookups.LookupsGetFieldRlOK, error),
+) *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetFieldRlParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldRlWithContextCall) Times(n int) *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldRlWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetFieldRlWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldsCdn returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldsCdn(params *lookups.LookupsGetFieldsCdnParams) (*lookups.LookupsGetFieldsCdnOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldsCdn",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldsCdnOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldsCdnCall is an expected call to LookupsGetFieldsCdn
+type LookupsClientServiceLookupsGetFieldsCdnCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldsCdn expects a single call to LookupsGetFieldsCdn with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldsCdn() *LookupsClientServiceLookupsGetFieldsCdnCall {
+	return &LookupsClientServiceLookupsGetFieldsCdnCall{m.controller.expect("LookupsGetFieldsCdn")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetFieldsCdnCall) With(params *lookups.LookupsGetFieldsCdnParams) *LookupsClientServiceLookupsGetFieldsCdnCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldsCdnCall) Return(r0 *lookups.LookupsGetFieldsCdnOK, r1 error) *LookupsClientServiceLookupsGetFieldsCdnCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldsCdnCall) Do(
+	fn func(params *lookups.LookupsGetFieldsCdnParams) (*lookups.LookupsGetFieldsCdnOK, error),
+) *LookupsClientServiceLookupsGetFieldsCdnCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetFieldsCdnParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldsCdnCall) Times(n int) *LookupsClientServiceLookupsGetFieldsCdnCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldsCdnCall) AnyTimes() *LookupsClientServiceLookupsGetFieldsCdnCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldsCdnWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldsCdnWithContext(ctx context.Context, params *lookups.LookupsGetFieldsCdnParams) (*lookups.LookupsGetFieldsCdnOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldsCdnWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldsCdnOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldsCdnWithContextCall is an expected call to LookupsGetFieldsCdnWithContext
+type LookupsClientServiceLookupsGetFieldsCdnWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldsCdnWithContext expects a single call to LookupsGetFieldsCdnWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldsCdnWithContext() *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	return &LookupsClientServiceLookupsGetFieldsCdnWithContextCall{m.controller.expect("LookupsGetFieldsCdnWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetFieldsCdnWithContextCall) With(params *lookups.LookupsGetFieldsCdnParams) *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldsCdnWithContextCall) Return(r0 *lookups.LookupsGetFieldsCdnOK, r1 error) *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldsCdnWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetFieldsCdnParams) (*lookups.LookupsGetFieldsCdnOK, error),
+) *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetFieldsCdnParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldsCdnWithContextCall) Times(n int) *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldsCdnWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetFieldsCdnWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldsWaf returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldsWaf(params *lookups.LookupsGetFieldsWafParams) (*lookups.LookupsGetFieldsWafOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldsWaf",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldsWafOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldsWafCall is an expected call to LookupsGetFieldsWaf
+type LookupsClientServiceLookupsGetFieldsWafCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldsWaf expects a single call to LookupsGetFieldsWaf with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldsWaf() *LookupsClientServiceLookupsGetFieldsWafCall {
+	return &LookupsClientServiceLookupsGetFieldsWafCall{m.controller.expect("LookupsGetFieldsWaf")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetFieldsWafCall) With(params *lookups.LookupsGetFieldsWafParams) *LookupsClientServiceLookupsGetFieldsWafCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldsWafCall) Return(r0 *lookups.LookupsGetFieldsWafOK, r1 error) *LookupsClientServiceLookupsGetFieldsWafCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldsWafCall) Do(
+	fn func(params *lookups.LookupsGetFieldsWafParams) (*lookups.LookupsGetFieldsWafOK, error),
+) *LookupsClientServiceLookupsGetFieldsWafCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetFieldsWafParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldsWafCall) Times(n int) *LookupsClientServiceLookupsGetFieldsWafCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldsWafCall) AnyTimes() *LookupsClientServiceLookupsGetFieldsWafCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetFieldsWafWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetFieldsWafWithContext(ctx context.Context, params *lookups.LookupsGetFieldsWafParams) (*lookups.LookupsGetFieldsWafOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetFieldsWafWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetFieldsWafOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetFieldsWafWithContextCall is an expected call to LookupsGetFieldsWafWithContext
+type LookupsClientServiceLookupsGetFieldsWafWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetFieldsWafWithContext expects a single call to LookupsGetFieldsWafWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetFieldsWafWithContext() *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	return &LookupsClientServiceLookupsGetFieldsWafWithContextCall{m.controller.expect("LookupsGetFieldsWafWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetFieldsWafWithContextCall) With(params *lookups.LookupsGetFieldsWafParams) *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetFieldsWafWithContextCall) Return(r0 *lookups.LookupsGetFieldsWafOK, r1 error) *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetFieldsWafWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetFieldsWafParams) (*lookups.LookupsGetFieldsWafOK, error),
+) *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetFieldsWafParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetFieldsWafWithContextCall) Times(n int) *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetFieldsWafWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetFieldsWafWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetHTTPAuthenticationMethods returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetHTTPAuthenticationMethods(params *lookups.LookupsGetHTTPAuthenticationMethodsParams) (*lookups.LookupsGetHTTPAuthenticationMethodsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetHTTPAuthenticationMethods",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetHTTPAuthenticationMethodsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall is an expected call to LookupsGetHTTPAuthenticationMethods
+type LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetHTTPAuthenticationMethods expects a single call to LookupsGetHTTPAuthenticationMethods with any arguments
+func (m *LookupsClientService) ExpectLookupsGetHTTPAuthenticationMethods() *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	return &LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall{m.controller.expect("LookupsGetHTTPAuthenticationMethods")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall) With(params *lookups.LookupsGetHTTPAuthenticationMethodsParams) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall) Return(r0 *lookups.LookupsGetHTTPAuthenticationMethodsOK, r1 error) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall) Do(
+	fn func(params *lookups.LookupsGetHTTPAuthenticationMethodsParams) (*lookups.LookupsGetHTTPAuthenticationMethodsOK, error),
+) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetHTTPAuthenticationMethodsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall) Times(n int) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall) AnyTimes() *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetHTTPAuthenticationMethodsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetHTTPAuthenticationMethodsWithContext(ctx context.Context, params *lookups.LookupsGetHTTPAuthenticationMethodsParams) (*lookups.LookupsGetHTTPAuthenticationMethodsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetHTTPAuthenticationMethodsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetHTTPAuthenticationMethodsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall is an expected call to LookupsGetHTTPAuthenticationMethodsWithContext
+type LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetHTTPAuthenticationMethodsWithContext expects a single call to LookupsGetHTTPAuthenticationMethodsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetHTTPAuthenticationMethodsWithContext() *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	return &LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall{m.controller.expect("LookupsGetHTTPAuthenticationMethodsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall) With(params *lookups.LookupsGetHTTPAuthenticationMethodsParams) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall) Return(r0 *lookups.LookupsGetHTTPAuthenticationMethodsOK, r1 error) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetHTTPAuthenticationMethodsParams) (*lookups.LookupsGetHTTPAuthenticationMethodsOK, error),
+) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetHTTPAuthenticationMethodsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetHTTPAuthenticationMethodsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetLogFormats returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetLogFormats(params *lookups.LookupsGetLogFormatsParams) (*lookups.LookupsGetLogFormatsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetLogFormats",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetLogFormatsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetLogFormatsCall is an expected call to LookupsGetLogFormats
+type LookupsClientServiceLookupsGetLogFormatsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetLogFormats expects a single call to LookupsGetLogFormats with any arguments
+func (m *LookupsClientService) ExpectLookupsGetLogFormats() *LookupsClientServiceLookupsGetLogFormatsCall {
+	return &LookupsClientServiceLookupsGetLogFormatsCall{m.controller.expect("LookupsGetLogFormats")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetLogFormatsCall) With(params *lookups.LookupsGetLogFormatsParams) *LookupsClientServiceLookupsGetLogFormatsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetLogFormatsCall) Return(r0 *lookups.LookupsGetLogFormatsOK, r1 error) *LookupsClientServiceLookupsGetLogFormatsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetLogFormatsCall) Do(
+	fn func(params *lookups.LookupsGetLogFormatsParams) (*lookups.LookupsGetLogFormatsOK, error),
+) *LookupsClientServiceLookupsGetLogFormatsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetLogFormatsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetLogFormatsCall) Times(n int) *LookupsClientServiceLookupsGetLogFormatsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetLogFormatsCall) AnyTimes() *LookupsClientServiceLookupsGetLogFormatsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetLogFormatsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetLogFormatsWithContext(ctx context.Context, params *lookups.LookupsGetLogFormatsParams) (*lookups.LookupsGetLogFormatsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetLogFormatsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetLogFormatsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetLogFormatsWithContextCall is an expected call to LookupsGetLogFormatsWithContext
+type LookupsClientServiceLookupsGetLogFormatsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetLogFormatsWithContext expects a single call to LookupsGetLogFormatsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetLogFormatsWithContext() *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	return &LookupsClientServiceLookupsGetLogFormatsWithContextCall{m.controller.expect("LookupsGetLogFormatsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetLogFormatsWithContextCall) With(params *lookups.LookupsGetLogFormatsParams) *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetLogFormatsWithContextCall) Return(r0 *lookups.LookupsGetLogFormatsOK, r1 error) *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetLogFormatsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetLogFormatsParams) (*lookups.LookupsGetLogFormatsOK, error),
+) *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetLogFormatsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetLogFormatsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetLogFormatsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetLogFormatsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetPlatforms returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetPlatforms(params *lookups.LookupsGetPlatformsParams) (*lookups.LookupsGetPlatformsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetPlatforms",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetPlatformsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetPlatformsCall is an expected call to LookupsGetPlatforms
+type LookupsClientServiceLookupsGetPlatformsCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetPlatforms expects a single call to LookupsGetPlatforms with any arguments
+func (m *LookupsClientService) ExpectLookupsGetPlatforms() *LookupsClientServiceLookupsGetPlatformsCall {
+	return &LookupsClientServiceLookupsGetPlatformsCall{m.controller.expect("LookupsGetPlatforms")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetPlatformsCall) With(params *lookups.LookupsGetPlatformsParams) *LookupsClientServiceLookupsGetPlatformsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetPlatformsCall) Return(r0 *lookups.LookupsGetPlatformsOK, r1 error) *LookupsClientServiceLookupsGetPlatformsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetPlatformsCall) Do(
+	fn func(params *lookups.LookupsGetPlatformsParams) (*lookups.LookupsGetPlatformsOK, error),
+) *LookupsClientServiceLookupsGetPlatformsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetPlatformsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetPlatformsCall) Times(n int) *LookupsClientServiceLookupsGetPlatformsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetPlatformsCall) AnyTimes() *LookupsClientServiceLookupsGetPlatformsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetPlatformsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetPlatformsWithContext(ctx context.Context, params *lookups.LookupsGetPlatformsParams) (*lookups.LookupsGetPlatformsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetPlatformsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetPlatformsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetPlatformsWithContextCall is an expected call to LookupsGetPlatformsWithContext
+type LookupsClientServiceLookupsGetPlatformsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetPlatformsWithContext expects a single call to LookupsGetPlatformsWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetPlatformsWithContext() *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	return &LookupsClientServiceLookupsGetPlatformsWithContextCall{m.controller.expect("LookupsGetPlatformsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetPlatformsWithContextCall) With(params *lookups.LookupsGetPlatformsParams) *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetPlatformsWithContextCall) Return(r0 *lookups.LookupsGetPlatformsOK, r1 error) *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetPlatformsWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetPlatformsParams) (*lookups.LookupsGetPlatformsOK, error),
+) *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetPlatformsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetPlatformsWithContextCall) Times(n int) *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetPlatformsWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetPlatformsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetStatusCodes returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetStatusCodes(params *lookups.LookupsGetStatusCodesParams) (*lookups.LookupsGetStatusCodesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetStatusCodes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetStatusCodesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetStatusCodesCall is an expected call to LookupsGetStatusCodes
+type LookupsClientServiceLookupsGetStatusCodesCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetStatusCodes expects a single call to LookupsGetStatusCodes with any arguments
+func (m *LookupsClientService) ExpectLookupsGetStatusCodes() *LookupsClientServiceLookupsGetStatusCodesCall {
+	return &LookupsClientServiceLookupsGetStatusCodesCall{m.controller.expect("LookupsGetStatusCodes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *LookupsClientServiceLookupsGetStatusCodesCall) With(params *lookups.LookupsGetStatusCodesParams) *LookupsClientServiceLookupsGetStatusCodesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetStatusCodesCall) Return(r0 *lookups.LookupsGetStatusCodesOK, r1 error) *LookupsClientServiceLookupsGetStatusCodesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetStatusCodesCall) Do(
+	fn func(params *lookups.LookupsGetStatusCodesParams) (*lookups.LookupsGetStatusCodesOK, error),
+) *LookupsClientServiceLookupsGetStatusCodesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*lookups.LookupsGetStatusCodesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetStatusCodesCall) Times(n int) *LookupsClientServiceLookupsGetStatusCodesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetStatusCodesCall) AnyTimes() *LookupsClientServiceLookupsGetStatusCodesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// LookupsGetStatusCodesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *LookupsClientService) LookupsGetStatusCodesWithContext(ctx context.Context, params *lookups.LookupsGetStatusCodesParams) (*lookups.LookupsGetStatusCodesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"LookupsGetStatusCodesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*lookups.LookupsGetStatusCodesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// LookupsClientServiceLookupsGetStatusCodesWithContextCall is an expected call to LookupsGetStatusCodesWithContext
+type LookupsClientServiceLookupsGetStatusCodesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectLookupsGetStatusCodesWithContext expects a single call to LookupsGetStatusCodesWithContext with any arguments
+func (m *LookupsClientService) ExpectLookupsGetStatusCodesWithContext() *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	return &LookupsClientServiceLookupsGetStatusCodesWithContextCall{m.controller.expect("LookupsGetStatusCodesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *LookupsClientServiceLookupsGetStatusCodesWithContextCall) With(params *lookups.LookupsGetStatusCodesParams) *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *LookupsClientServiceLookupsGetStatusCodesWithContextCall) Return(r0 *lookups.LookupsGetStatusCodesOK, r1 error) *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *LookupsClientServiceLookupsGetStatusCodesWithContextCall) Do(
+	fn func(ctx context.Context, params *lookups.LookupsGetStatusCodesParams) (*lookups.LookupsGetStatusCodesOK, error),
+) *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*lookups.LookupsGetStatusCodesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *LookupsClientServiceLookupsGetStatusCodesWithContextCall) Times(n int) *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *LookupsClientServiceLookupsGetStatusCodesWithContextCall) AnyTimes() *LookupsClientServiceLookupsGetStatusCodesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
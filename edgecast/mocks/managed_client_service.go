@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/managed"
+)
+
+// ManagedClientService is a mock of managed.ClientService
+type ManagedClientService struct {
+	controller *controller
+}
+
+var _ managed.ClientService = (*ManagedClientService)(nil)
+
+// NewManagedClientService creates a mock of managed.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewManagedClientService(t TestingT) *ManagedClientService {
+	return &ManagedClientService{controller: newController(t, "ManagedClientService")}
+}
+
+// GetAllManagedRules returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) GetAllManagedRules(params managed.GetAllManagedRulesParams) (*[]managed.ManagedRuleLight, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllManagedRules",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]managed.ManagedRuleLight)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceGetAllManagedRulesCall is an expected call to GetAllManagedRules
+type ManagedClientServiceGetAllManagedRulesCall struct {
+	e *expectation
+}
+
+// ExpectGetAllManagedRules expects a single call to GetAllManagedRules with any arguments
+func (m *ManagedClientService) ExpectGetAllManagedRules() *ManagedClientServiceGetAllManagedRulesCall {
+	return &ManagedClientServiceGetAllManagedRulesCall{m.controller.expect("GetAllManagedRules")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ManagedClientServiceGetAllManagedRulesCall) With(params managed.GetAllManagedRulesParams) *ManagedClientServiceGetAllManagedRulesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceGetAllManagedRulesCall) Return(r0 *[]managed.ManagedRuleLight, r1 error) *ManagedClientServiceGetAllManagedRulesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceGetAllManagedRulesCall) Do(
+	fn func(params managed.GetAllManagedRulesParams) (*[]managed.ManagedRuleLight, error),
+) *ManagedClientServiceGetAllManagedRulesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(managed.GetAllManagedRulesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceGetAllManagedRulesCall) Times(n int) *ManagedClientServiceGetAllManagedRulesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceGetAllManagedRulesCall) AnyTimes() *ManagedClientServiceGetAllManagedRulesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllManagedRulesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) GetAllManagedRulesWithContext(ctx context.Context, params managed.GetAllManagedRulesParams) (*[]managed.ManagedRuleLight, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllManagedRulesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]managed.ManagedRuleLight)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceGetAllManagedRulesWithContextCall is an expected call to GetAllManagedRulesWithContext
+type ManagedClientServiceGetAllManagedRulesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllManagedRulesWithContext expects a single call to GetAllManagedRulesWithContext with any arguments
+func (m *ManagedClientService) ExpectGetAllManagedRulesWithContext() *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	return &ManagedClientServiceGetAllManagedRulesWithContextCall{m.controller.expect("GetAllManagedRulesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ManagedClientServiceGetAllManagedRulesWithContextCall) With(params managed.GetAllManagedRulesParams) *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceGetAllManagedRulesWithContextCall) Return(r0 *[]managed.ManagedRuleLight, r1 error) *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceGetAllManagedRulesWithContextCall) Do(
+	fn func(ctx context.Context, params managed.GetAllManagedRulesParams) (*[]managed.ManagedRuleLight, error),
+) *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(managed.GetAllManagedRulesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceGetAllManagedRulesWithContextCall) Times(n int) *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceGetAllManagedRulesWithContextCall) AnyTimes() *ManagedClientServiceGetAllManagedRulesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetManagedRule returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) GetManagedRule(params managed.GetManagedRuleParams) (*managed.ManagedRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetManagedRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*managed.ManagedRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceGetManagedRuleCall is an expected call to GetManagedRule
+type ManagedClientServiceGetManagedRuleCall struct {
+	e *expectation
+}
+
+// ExpectGetManagedRule expects a single call to GetManagedRule with any arguments
+func (m *ManagedClientService) ExpectGetManagedRule() *ManagedClientServiceGetManagedRuleCall {
+	return &ManagedClientServiceGetManagedRuleCall{m.controller.expect("GetManagedRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ManagedClientServiceGetManagedRuleCall) With(params managed.GetManagedRuleParams) *ManagedClientServiceGetManagedRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceGetManagedRuleCall) Return(r0 *managed.ManagedRuleGetOK, r1 error) *ManagedClientServiceGetManagedRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceGetManagedRuleCall) Do(
+	fn func(params managed.GetManagedRuleParams) (*managed.ManagedRuleGetOK, error),
+) *ManagedClientServiceGetManagedRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(managed.GetManagedRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceGetManagedRuleCall) Times(n int) *ManagedClientServiceGetManagedRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceGetManagedRuleCall) AnyTimes() *ManagedClientServiceGetManagedRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetManagedRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) GetManagedRuleWithContext(ctx context.Context, params managed.GetManagedRuleParams) (*managed.ManagedRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetManagedRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*managed.ManagedRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceGetManagedRuleWithContextCall is an expected call to GetManagedRuleWithContext
+type ManagedClientServiceGetManagedRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetManagedRuleWithContext expects a single call to GetManagedRuleWithContext with any arguments
+func (m *ManagedClientService) ExpectGetManagedRuleWithContext() *ManagedClientServiceGetManagedRuleWithContextCall {
+	return &ManagedClientServiceGetManagedRuleWithContextCall{m.controller.expect("GetManagedRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ManagedClientServiceGetManagedRuleWithContextCall) With(params managed.GetManagedRuleParams) *ManagedClientServiceGetManagedRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceGetManagedRuleWithContextCall) Return(r0 *managed.ManagedRuleGetOK, r1 error) *ManagedClientServiceGetManagedRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceGetManagedRuleWithContextCall) Do(
+	fn func(ctx context.Context, params managed.GetManagedRuleParams) (*managed.ManagedRuleGetOK, error),
+) *ManagedClientServiceGetManagedRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(managed.GetManagedRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceGetManagedRuleWithContextCall) Times(n int) *ManagedClientServiceGetManagedRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceGetManagedRuleWithContextCall) AnyTimes() *ManagedClientServiceGetManagedRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddManagedRule returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) AddManagedRule(params managed.AddManagedRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddManagedRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceAddManagedRuleCall is an expected call to AddManagedRule
+type ManagedClientServiceAddManagedRuleCall struct {
+	e *expectation
+}
+
+// ExpectAddManagedRule expects a single call to AddManagedRule with any arguments
+func (m *ManagedClientService) ExpectAddManagedRule() *ManagedClientServiceAddManagedRuleCall {
+	return &ManagedClientServiceAddManagedRuleCall{m.controller.expect("AddManagedRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ManagedClientServiceAddManagedRuleCall) With(params managed.AddManagedRuleParams) *ManagedClientServiceAddManagedRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceAddManagedRuleCall) Return(r0 string, r1 error) *ManagedClientServiceAddManagedRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceAddManagedRuleCall) Do(
+	fn func(params managed.AddManagedRuleParams) (string, error),
+) *ManagedClientServiceAddManagedRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(managed.AddManagedRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceAddManagedRuleCall) Times(n int) *ManagedClientServiceAddManagedRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceAddManagedRuleCall) AnyTimes() *ManagedClientServiceAddManagedRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddManagedRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) AddManagedRuleWithContext(ctx context.Context, params managed.AddManagedRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddManagedRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ManagedClientServiceAddManagedRuleWithContextCall is an expected call to AddManagedRuleWithContext
+type ManagedClientServiceAddManagedRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddManagedRuleWithContext expects a single call to AddManagedRuleWithContext with any arguments
+func (m *ManagedClientService) ExpectAddManagedRuleWithContext() *ManagedClientServiceAddManagedRuleWithContextCall {
+	return &ManagedClientServiceAddManagedRuleWithContextCall{m.controller.expect("AddManagedRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ManagedClientServiceAddManagedRuleWithContextCall) With(params managed.AddManagedRuleParams) *ManagedClientServiceAddManagedRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceAddManagedRuleWithContextCall) Return(r0 string, r1 error) *ManagedClientServiceAddManagedRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceAddManagedRuleWithContextCall) Do(
+	fn func(ctx context.Context, params managed.AddManagedRuleParams) (string, error),
+) *ManagedClientServiceAddManagedRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(managed.AddManagedRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceAddManagedRuleWithContextCall) Times(n int) *ManagedClientServiceAddManagedRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceAddManagedRuleWithContextCall) AnyTimes() *ManagedClientServiceAddManagedRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateManagedRule returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) UpdateManagedRule(params managed.UpdateManagedRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateManagedRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// ManagedClientServiceUpdateManagedRuleCall is an expected call to UpdateManagedRule
+type ManagedClientServiceUpdateManagedRuleCall struct {
+	e *expectation
+}
+
+// ExpectUpdateManagedRule expects a single call to UpdateManagedRule with any arguments
+func (m *ManagedClientService) ExpectUpdateManagedRule() *ManagedClientServiceUpdateManagedRuleCall {
+	return &ManagedClientServiceUpdateManagedRuleCall{m.controller.expect("UpdateManagedRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ManagedClientServiceUpdateManagedRuleCall) With(params managed.UpdateManagedRuleParams) *ManagedClientServiceUpdateManagedRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceUpdateManagedRuleCall) Return(r0 error) *ManagedClientServiceUpdateManagedRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceUpdateManagedRuleCall) Do(
+	fn func(params managed.UpdateManagedRuleParams) error,
+) *ManagedClientServiceUpdateManagedRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(managed.UpdateManagedRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceUpdateManagedRuleCall) Times(n int) *ManagedClientServiceUpdateManagedRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceUpdateManagedRuleCall) AnyTimes() *ManagedClientServiceUpdateManagedRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateManagedRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) UpdateManagedRuleWithContext(ctx context.Context, params managed.UpdateManagedRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateManagedRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// ManagedClientServiceUpdateManagedRuleWithContextCall is an expected call to UpdateManagedRuleWithContext
+type ManagedClientServiceUpdateManagedRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateManagedRuleWithContext expects a single call to UpdateManagedRuleWithContext with any arguments
+func (m *ManagedClientService) ExpectUpdateManagedRuleWithContext() *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	return &ManagedClientServiceUpdateManagedRuleWithContextCall{m.controller.expect("UpdateManagedRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ManagedClientServiceUpdateManagedRuleWithContextCall) With(params managed.UpdateManagedRuleParams) *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceUpdateManagedRuleWithContextCall) Return(r0 error) *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceUpdateManagedRuleWithContextCall) Do(
+	fn func(ctx context.Context, params managed.UpdateManagedRuleParams) error,
+) *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(managed.UpdateManagedRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceUpdateManagedRuleWithContextCall) Times(n int) *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceUpdateManagedRuleWithContextCall) AnyTimes() *ManagedClientServiceUpdateManagedRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteManagedRule returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) DeleteManagedRule(params managed.DeleteManagedRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteManagedRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// ManagedClientServiceDeleteManagedRuleCall is an expected call to DeleteManagedRule
+type ManagedClientServiceDeleteManagedRuleCall struct {
+	e *expectation
+}
+
+// ExpectDeleteManagedRule expects a single call to DeleteManagedRule with any arguments
+func (m *ManagedClientService) ExpectDeleteManagedRule() *ManagedClientServiceDeleteManagedRuleCall {
+	return &ManagedClientServiceDeleteManagedRuleCall{m.controller.expect("DeleteManagedRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ManagedClientServiceDeleteManagedRuleCall) With(params managed.DeleteManagedRuleParams) *ManagedClientServiceDeleteManagedRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceDeleteManagedRuleCall) Return(r0 error) *ManagedClientServiceDeleteManagedRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceDeleteManagedRuleCall) Do(
+	fn func(params managed.DeleteManagedRuleParams) error,
+) *ManagedClientServiceDeleteManagedRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(managed.DeleteManagedRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceDeleteManagedRuleCall) Times(n int) *ManagedClientServiceDeleteManagedRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceDeleteManagedRuleCall) AnyTimes() *ManagedClientServiceDeleteManagedRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteManagedRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ManagedClientService) DeleteManagedRuleWithContext(ctx context.Context, params managed.DeleteManagedRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteManagedRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// ManagedClientServiceDeleteManagedRuleWithContextCall is an expected call to DeleteManagedRuleWithContext
+type ManagedClientServiceDeleteManagedRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteManagedRuleWithContext expects a single call to DeleteManagedRuleWithContext with any arguments
+func (m *ManagedClientService) ExpectDeleteManagedRuleWithContext() *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	return &ManagedClientServiceDeleteManagedRuleWithContextCall{m.controller.expect("DeleteManagedRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ManagedClientServiceDeleteManagedRuleWithContextCall) With(params managed.DeleteManagedRuleParams) *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ManagedClientServiceDeleteManagedRuleWithContextCall) Return(r0 error) *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ManagedClientServiceDeleteManagedRuleWithContextCall) Do(
+	fn func(ctx context.Context, params managed.DeleteManagedRuleParams) error,
+) *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(managed.DeleteManagedRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ManagedClientServiceDeleteManagedRuleWithContextCall) Times(n int) *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ManagedClientServiceDeleteManagedRuleWithContextCall) AnyTimes() *ManagedClientServiceDeleteManagedRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/mocks"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
+)
+
+// fakeT records the errors of a mock and runs its cleanups on finish
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *fakeT) finish() {
+	for _, fn := range t.cleanups {
+		fn()
+	}
+}
+
+func TestReturn(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectAddAccessRule().
+		With(access.AddAccessRuleParams{AccountNumber: "ABCD"}).
+		Return("rule-id", nil)
+
+	id, err := m.AddAccessRule(access.AddAccessRuleParams{AccountNumber: "ABCD"})
+	ft.finish()
+
+	if id != "rule-id" || err != nil {
+		t.Fatalf("Expected rule-id and no error but got %s and %v", id, err)
+	}
+	if len(ft.errors) != 0 {
+		t.Fatalf("Expected no errors but got %+v", ft.errors)
+	}
+}
+
+func TestUnexpectedCall(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectAddAccessRule().
+		With(access.AddAccessRuleParams{AccountNumber: "ABCD"}).
+		Return("rule-id", nil)
+
+	id, err := m.AddAccessRule(access.AddAccessRuleParams{AccountNumber: "WXYZ"})
+
+	if id != "" || err != nil {
+		t.Fatalf("Expected zero values but got %s and %v", id, err)
+	}
+	if len(ft.errors) != 1 ||
+		!strings.Contains(ft.errors[0], "unexpected call") {
+		t.Fatalf("Expected an unexpected call error but got %+v", ft.errors)
+	}
+}
+
+func TestUnmetExpectation(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectDeleteAccessRule().Times(2)
+
+	m.DeleteAccessRule(access.DeleteAccessRuleParams{})
+	ft.finish()
+
+	if len(ft.errors) != 1 ||
+		!strings.Contains(ft.errors[0], "expected 2 call(s)") {
+		t.Fatalf("Expected an unmet expectation error but got %+v", ft.errors)
+	}
+}
+
+func TestContextIsNotCompared(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectAddAccessRuleWithContext().
+		With(access.AddAccessRuleParams{AccountNumber: "ABCD"}).
+		Return("rule-id", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	id, _ := m.AddAccessRuleWithContext(
+		ctx,
+		access.AddAccessRuleParams{AccountNumber: "ABCD"})
+	ft.finish()
+
+	if id != "rule-id" || len(ft.errors) != 0 {
+		t.Fatalf("Expected rule-id and no errors but got %s and %+v",
+			id,
+			ft.errors)
+	}
+}
+
+func TestDo(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectAddAccessRule().
+		Do(func(params access.AddAccessRuleParams) (string, error) {
+			if len(params.AccountNumber) == 0 {
+				return "", errors.New("account number required")
+			}
+			return params.AccountNumber + "-rule", nil
+		}).
+		AnyTimes()
+
+	id, err := m.AddAccessRule(access.AddAccessRuleParams{AccountNumber: "ABCD"})
+	if id != "ABCD-rule" || err != nil {
+		t.Fatalf("Expected ABCD-rule and no error but got %s and %v", id, err)
+	}
+
+	_, err = m.AddAccessRule(access.AddAccessRuleParams{})
+	if err == nil {
+		t.Fatalf("Expected an error but got nil")
+	}
+
+	ft.finish()
+	if len(ft.errors) != 0 {
+		t.Fatalf("Expected no errors but got %+v", ft.errors)
+	}
+}
+
+func TestAnyTimesAllowsNoCalls(t *testing.T) {
+	ft := &fakeT{}
+	m := mocks.NewAccessClientService(ft)
+	m.ExpectGetAccessRule().AnyTimes()
+	ft.finish()
+
+	if len(ft.errors) != 0 {
+		t.Fatalf("Expected no errors but got %+v", ft.errors)
+	}
+}
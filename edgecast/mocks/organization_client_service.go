@@ -0,0 +1,393 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/organization"
+)
+
+// OrganizationClientService is a mock of organization.ClientService
+type OrganizationClientService struct {
+	controller *controller
+}
+
+var _ organization.ClientService = (*OrganizationClientService)(nil)
+
+// NewOrganizationClientService creates a mock of organization.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewOrganizationClientService(t TestingT) *OrganizationClientService {
+	return &OrganizationClientService{controller: newController(t, "OrganizationClientService")}
+}
+
+// OrganizationFind returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationFind(params organization.OrganizationFindParams) (*organization.OrganizationFindOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationFind",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationFindOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationFindCall is an expected call to OrganizationFind
+type OrganizationClientServiceOrganizationFindCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationFind expects a single call to OrganizationFind with any arguments
+func (m *OrganizationClientService) ExpectOrganizationFind() *OrganizationClientServiceOrganizationFindCall {
+	return &OrganizationClientServiceOrganizationFindCall{m.controller.expect("OrganizationFind")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OrganizationClientServiceOrganizationFindCall) With(params organization.OrganizationFindParams) *OrganizationClientServiceOrganizationFindCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationFindCall) Return(r0 *organization.OrganizationFindOK, r1 error) *OrganizationClientServiceOrganizationFindCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationFindCall) Do(
+	fn func(params organization.OrganizationFindParams) (*organization.OrganizationFindOK, error),
+) *OrganizationClientServiceOrganizationFindCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(organization.OrganizationFindParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationFindCall) Times(n int) *OrganizationClientServiceOrganizationFindCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationFindCall) AnyTimes() *OrganizationClientServiceOrganizationFindCall {
+	c.e.anyTimes()
+	return c
+}
+
+// OrganizationFindWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationFindWithContext(ctx context.Context, params organization.OrganizationFindParams) (*organization.OrganizationFindOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationFindWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationFindOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationFindWithContextCall is an expected call to OrganizationFindWithContext
+type OrganizationClientServiceOrganizationFindWithContextCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationFindWithContext expects a single call to OrganizationFindWithContext with any arguments
+func (m *OrganizationClientService) ExpectOrganizationFindWithContext() *OrganizationClientServiceOrganizationFindWithContextCall {
+	return &OrganizationClientServiceOrganizationFindWithContextCall{m.controller.expect("OrganizationFindWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OrganizationClientServiceOrganizationFindWithContextCall) With(params organization.OrganizationFindParams) *OrganizationClientServiceOrganizationFindWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationFindWithContextCall) Return(r0 *organization.OrganizationFindOK, r1 error) *OrganizationClientServiceOrganizationFindWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationFindWithContextCall) Do(
+	fn func(ctx context.Context, params organization.OrganizationFindParams) (*organization.OrganizationFindOK, error),
+) *OrganizationClientServiceOrganizationFindWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(organization.OrganizationFindParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationFindWithContextCall) Times(n int) *OrganizationClientServiceOrganizationFindWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationFindWithContextCall) AnyTimes() *OrganizationClientServiceOrganizationFindWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// OrganizationGet returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationGet(params organization.OrganizationGetParams) (*organization.OrganizationGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationGet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationGetCall is an expected call to OrganizationGet
+type OrganizationClientServiceOrganizationGetCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationGet expects a single call to OrganizationGet with any arguments
+func (m *OrganizationClientService) ExpectOrganizationGet() *OrganizationClientServiceOrganizationGetCall {
+	return &OrganizationClientServiceOrganizationGetCall{m.controller.expect("OrganizationGet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OrganizationClientServiceOrganizationGetCall) With(params organization.OrganizationGetParams) *OrganizationClientServiceOrganizationGetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationGetCall) Return(r0 *organization.OrganizationGetOK, r1 error) *OrganizationClientServiceOrganizationGetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationGetCall) Do(
+	fn func(params organization.OrganizationGetParams) (*organization.OrganizationGetOK, error),
+) *OrganizationClientServiceOrganizationGetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(organization.OrganizationGetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationGetCall) Times(n int) *OrganizationClientServiceOrganizationGetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationGetCall) AnyTimes() *OrganizationClientServiceOrganizationGetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// OrganizationGetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationGetWithContext(ctx context.Context, params organization.OrganizationGetParams) (*organization.OrganizationGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationGetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationGetWithContextCall is an expected call to OrganizationGetWithContext
+type OrganizationClientServiceOrganizationGetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationGetWithContext expects a single call to OrganizationGetWithContext with any arguments
+func (m *OrganizationClientService) ExpectOrganizationGetWithContext() *OrganizationClientServiceOrganizationGetWithContextCall {
+	return &OrganizationClientServiceOrganizationGetWithContextCall{m.controller.expect("OrganizationGetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OrganizationClientServiceOrganizationGetWithContextCall) With(params organization.OrganizationGetParams) *OrganizationClientServiceOrganizationGetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationGetWithContextCall) Return(r0 *organization.OrganizationGetOK, r1 error) *OrganizationClientServiceOrganizationGetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationGetWithContextCall) Do(
+	fn func(ctx context.Context, params organization.OrganizationGetParams) (*organization.OrganizationGetOK, error),
+) *OrganizationClientServiceOrganizationGetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(organization.OrganizationGetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationGetWithContextCall) Times(n int) *OrganizationClientServiceOrganizationGetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationGetWithContextCall) AnyTimes() *OrganizationClientServiceOrganizationGetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// OrganizationGetDefaultOrganization returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationGetDefaultOrganization(params organization.OrganizationGetDefaultOrganizationParams) (*organization.OrganizationGetDefaultOrganizationOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationGetDefaultOrganization",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationGetDefaultOrganizationOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationGetDefaultOrganizationCall is an expected call to OrganizationGetDefaultOrganization
+type OrganizationClientServiceOrganizationGetDefaultOrganizationCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationGetDefaultOrganization expects a single call to OrganizationGetDefaultOrganization with any arguments
+func (m *OrganizationClientService) ExpectOrganizationGetDefaultOrganization() *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	return &OrganizationClientServiceOrganizationGetDefaultOrganizationCall{m.controller.expect("OrganizationGetDefaultOrganization")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationCall) With(params organization.OrganizationGetDefaultOrganizationParams) *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationCall) Return(r0 *organization.OrganizationGetDefaultOrganizationOK, r1 error) *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationCall) Do(
+	fn func(params organization.OrganizationGetDefaultOrganizationParams) (*organization.OrganizationGetDefaultOrganizationOK, error),
+) *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(organization.OrganizationGetDefaultOrganizationParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationCall) Times(n int) *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationCall) AnyTimes() *OrganizationClientServiceOrganizationGetDefaultOrganizationCall {
+	c.e.anyTimes()
+	return c
+}
+
+// OrganizationGetDefaultOrganizationWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OrganizationClientService) OrganizationGetDefaultOrganizationWithContext(ctx context.Context, params organization.OrganizationGetDefaultOrganizationParams) (*organization.OrganizationGetDefaultOrganizationOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"OrganizationGetDefaultOrganizationWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*organization.OrganizationGetDefaultOrganizationOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall is an expected call to OrganizationGetDefaultOrganizationWithContext
+type OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall struct {
+	e *expectation
+}
+
+// ExpectOrganizationGetDefaultOrganizationWithContext expects a single call to OrganizationGetDefaultOrganizationWithContext with any arguments
+func (m *OrganizationClientService) ExpectOrganizationGetDefaultOrganizationWithContext() *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	return &OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall{m.controller.expect("OrganizationGetDefaultOrganizationWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall) With(params organization.OrganizationGetDefaultOrganizationParams) *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall) Return(r0 *organization.OrganizationGetDefaultOrganizationOK, r1 error) *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall) Do(
+	fn func(ctx context.Context, params organization.OrganizationGetDefaultOrganizationParams) (*organization.OrganizationGetDefaultOrganizationOK, error),
+) *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(organization.OrganizationGetDefaultOrganizationParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall) Times(n int) *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall) AnyTimes() *OrganizationClientServiceOrganizationGetDefaultOrganizationWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,1107 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/origin"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecmodels"
+)
+
+// OriginClientService is a mock of origin.ClientService
+type OriginClientService struct {
+	controller *controller
+}
+
+var _ origin.ClientService = (*OriginClientService)(nil)
+
+// NewOriginClientService creates a mock of origin.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewOriginClientService(t TestingT) *OriginClientService {
+	return &OriginClientService{controller: newController(t, "OriginClientService")}
+}
+
+// GetAllOrigins returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetAllOrigins(params origin.GetAllOriginsParams) (*[]origin.OriginGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllOrigins",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]origin.OriginGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetAllOriginsCall is an expected call to GetAllOrigins
+type OriginClientServiceGetAllOriginsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllOrigins expects a single call to GetAllOrigins with any arguments
+func (m *OriginClientService) ExpectGetAllOrigins() *OriginClientServiceGetAllOriginsCall {
+	return &OriginClientServiceGetAllOriginsCall{m.controller.expect("GetAllOrigins")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceGetAllOriginsCall) With(params origin.GetAllOriginsParams) *OriginClientServiceGetAllOriginsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetAllOriginsCall) Return(r0 *[]origin.OriginGetOK, r1 error) *OriginClientServiceGetAllOriginsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetAllOriginsCall) Do(
+	fn func(params origin.GetAllOriginsParams) (*[]origin.OriginGetOK, error),
+) *OriginClientServiceGetAllOriginsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.GetAllOriginsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetAllOriginsCall) Times(n int) *OriginClientServiceGetAllOriginsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetAllOriginsCall) AnyTimes() *OriginClientServiceGetAllOriginsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllOriginsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetAllOriginsWithContext(ctx context.Context, params origin.GetAllOriginsParams) (*[]origin.OriginGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllOriginsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]origin.OriginGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetAllOriginsWithContextCall is an expected call to GetAllOriginsWithContext
+type OriginClientServiceGetAllOriginsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllOriginsWithContext expects a single call to GetAllOriginsWithContext with any arguments
+func (m *OriginClientService) ExpectGetAllOriginsWithContext() *OriginClientServiceGetAllOriginsWithContextCall {
+	return &OriginClientServiceGetAllOriginsWithContextCall{m.controller.expect("GetAllOriginsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceGetAllOriginsWithContextCall) With(params origin.GetAllOriginsParams) *OriginClientServiceGetAllOriginsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetAllOriginsWithContextCall) Return(r0 *[]origin.OriginGetOK, r1 error) *OriginClientServiceGetAllOriginsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetAllOriginsWithContextCall) Do(
+	fn func(ctx context.Context, params origin.GetAllOriginsParams) (*[]origin.OriginGetOK, error),
+) *OriginClientServiceGetAllOriginsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.GetAllOriginsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetAllOriginsWithContextCall) Times(n int) *OriginClientServiceGetAllOriginsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetAllOriginsWithContextCall) AnyTimes() *OriginClientServiceGetAllOriginsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddOrigin returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) AddOrigin(params origin.AddOriginParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceAddOriginCall is an expected call to AddOrigin
+type OriginClientServiceAddOriginCall struct {
+	e *expectation
+}
+
+// ExpectAddOrigin expects a single call to AddOrigin with any arguments
+func (m *OriginClientService) ExpectAddOrigin() *OriginClientServiceAddOriginCall {
+	return &OriginClientServiceAddOriginCall{m.controller.expect("AddOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceAddOriginCall) With(params origin.AddOriginParams) *OriginClientServiceAddOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceAddOriginCall) Return(r0 *int, r1 error) *OriginClientServiceAddOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceAddOriginCall) Do(
+	fn func(params origin.AddOriginParams) (*int, error),
+) *OriginClientServiceAddOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.AddOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceAddOriginCall) Times(n int) *OriginClientServiceAddOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceAddOriginCall) AnyTimes() *OriginClientServiceAddOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) AddOriginWithContext(ctx context.Context, params origin.AddOriginParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceAddOriginWithContextCall is an expected call to AddOriginWithContext
+type OriginClientServiceAddOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddOriginWithContext expects a single call to AddOriginWithContext with any arguments
+func (m *OriginClientService) ExpectAddOriginWithContext() *OriginClientServiceAddOriginWithContextCall {
+	return &OriginClientServiceAddOriginWithContextCall{m.controller.expect("AddOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceAddOriginWithContextCall) With(params origin.AddOriginParams) *OriginClientServiceAddOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceAddOriginWithContextCall) Return(r0 *int, r1 error) *OriginClientServiceAddOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceAddOriginWithContextCall) Do(
+	fn func(ctx context.Context, params origin.AddOriginParams) (*int, error),
+) *OriginClientServiceAddOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.AddOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceAddOriginWithContextCall) Times(n int) *OriginClientServiceAddOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceAddOriginWithContextCall) AnyTimes() *OriginClientServiceAddOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOrigin returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOrigin(params origin.GetOriginParams) (*origin.OriginGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*origin.OriginGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginCall is an expected call to GetOrigin
+type OriginClientServiceGetOriginCall struct {
+	e *expectation
+}
+
+// ExpectGetOrigin expects a single call to GetOrigin with any arguments
+func (m *OriginClientService) ExpectGetOrigin() *OriginClientServiceGetOriginCall {
+	return &OriginClientServiceGetOriginCall{m.controller.expect("GetOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceGetOriginCall) With(params origin.GetOriginParams) *OriginClientServiceGetOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginCall) Return(r0 *origin.OriginGetOK, r1 error) *OriginClientServiceGetOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginCall) Do(
+	fn func(params origin.GetOriginParams) (*origin.OriginGetOK, error),
+) *OriginClientServiceGetOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.GetOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginCall) Times(n int) *OriginClientServiceGetOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginCall) AnyTimes() *OriginClientServiceGetOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOriginWithContext(ctx context.Context, params origin.GetOriginParams) (*origin.OriginGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*origin.OriginGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginWithContextCall is an expected call to GetOriginWithContext
+type OriginClientServiceGetOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginWithContext expects a single call to GetOriginWithContext with any arguments
+func (m *OriginClientService) ExpectGetOriginWithContext() *OriginClientServiceGetOriginWithContextCall {
+	return &OriginClientServiceGetOriginWithContextCall{m.controller.expect("GetOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceGetOriginWithContextCall) With(params origin.GetOriginParams) *OriginClientServiceGetOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginWithContextCall) Return(r0 *origin.OriginGetOK, r1 error) *OriginClientServiceGetOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginWithContextCall) Do(
+	fn func(ctx context.Context, params origin.GetOriginParams) (*origin.OriginGetOK, error),
+) *OriginClientServiceGetOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.GetOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginWithContextCall) Times(n int) *OriginClientServiceGetOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginWithContextCall) AnyTimes() *OriginClientServiceGetOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateOrigin returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) UpdateOrigin(params origin.UpdateOriginParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateOrigin",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceUpdateOriginCall is an expected call to UpdateOrigin
+type OriginClientServiceUpdateOriginCall struct {
+	e *expectation
+}
+
+// ExpectUpdateOrigin expects a single call to UpdateOrigin with any arguments
+func (m *OriginClientService) ExpectUpdateOrigin() *OriginClientServiceUpdateOriginCall {
+	return &OriginClientServiceUpdateOriginCall{m.controller.expect("UpdateOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceUpdateOriginCall) With(params origin.UpdateOriginParams) *OriginClientServiceUpdateOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceUpdateOriginCall) Return(r0 *int, r1 error) *OriginClientServiceUpdateOriginCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceUpdateOriginCall) Do(
+	fn func(params origin.UpdateOriginParams) (*int, error),
+) *OriginClientServiceUpdateOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.UpdateOriginParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceUpdateOriginCall) Times(n int) *OriginClientServiceUpdateOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceUpdateOriginCall) AnyTimes() *OriginClientServiceUpdateOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) UpdateOriginWithContext(ctx context.Context, params origin.UpdateOriginParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateOriginWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceUpdateOriginWithContextCall is an expected call to UpdateOriginWithContext
+type OriginClientServiceUpdateOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateOriginWithContext expects a single call to UpdateOriginWithContext with any arguments
+func (m *OriginClientService) ExpectUpdateOriginWithContext() *OriginClientServiceUpdateOriginWithContextCall {
+	return &OriginClientServiceUpdateOriginWithContextCall{m.controller.expect("UpdateOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceUpdateOriginWithContextCall) With(params origin.UpdateOriginParams) *OriginClientServiceUpdateOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceUpdateOriginWithContextCall) Return(r0 *int, r1 error) *OriginClientServiceUpdateOriginWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceUpdateOriginWithContextCall) Do(
+	fn func(ctx context.Context, params origin.UpdateOriginParams) (*int, error),
+) *OriginClientServiceUpdateOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.UpdateOriginParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceUpdateOriginWithContextCall) Times(n int) *OriginClientServiceUpdateOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceUpdateOriginWithContextCall) AnyTimes() *OriginClientServiceUpdateOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteOrigin returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) DeleteOrigin(params origin.DeleteOriginParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteOrigin",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// OriginClientServiceDeleteOriginCall is an expected call to DeleteOrigin
+type OriginClientServiceDeleteOriginCall struct {
+	e *expectation
+}
+
+// ExpectDeleteOrigin expects a single call to DeleteOrigin with any arguments
+func (m *OriginClientService) ExpectDeleteOrigin() *OriginClientServiceDeleteOriginCall {
+	return &OriginClientServiceDeleteOriginCall{m.controller.expect("DeleteOrigin")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceDeleteOriginCall) With(params origin.DeleteOriginParams) *OriginClientServiceDeleteOriginCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceDeleteOriginCall) Return(r0 error) *OriginClientServiceDeleteOriginCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceDeleteOriginCall) Do(
+	fn func(params origin.DeleteOriginParams) error,
+) *OriginClientServiceDeleteOriginCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.DeleteOriginParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceDeleteOriginCall) Times(n int) *OriginClientServiceDeleteOriginCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceDeleteOriginCall) AnyTimes() *OriginClientServiceDeleteOriginCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteOriginWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) DeleteOriginWithContext(ctx context.Context, params origin.DeleteOriginParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteOriginWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// OriginClientServiceDeleteOriginWithContextCall is an expected call to DeleteOriginWithContext
+type OriginClientServiceDeleteOriginWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteOriginWithContext expects a single call to DeleteOriginWithContext with any arguments
+func (m *OriginClientService) ExpectDeleteOriginWithContext() *OriginClientServiceDeleteOriginWithContextCall {
+	return &OriginClientServiceDeleteOriginWithContextCall{m.controller.expect("DeleteOriginWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceDeleteOriginWithContextCall) With(params origin.DeleteOriginParams) *OriginClientServiceDeleteOriginWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceDeleteOriginWithContextCall) Return(r0 error) *OriginClientServiceDeleteOriginWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceDeleteOriginWithContextCall) Do(
+	fn func(ctx context.Context, params origin.DeleteOriginParams) error,
+) *OriginClientServiceDeleteOriginWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.DeleteOriginParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceDeleteOriginWithContextCall) Times(n int) *OriginClientServiceDeleteOriginWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceDeleteOriginWithContextCall) AnyTimes() *OriginClientServiceDeleteOriginWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCDNIPBlocks returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetCDNIPBlocks() (*origin.CDNIPBlocksOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCDNIPBlocks",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].(*origin.CDNIPBlocksOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetCDNIPBlocksCall is an expected call to GetCDNIPBlocks
+type OriginClientServiceGetCDNIPBlocksCall struct {
+	e *expectation
+}
+
+// ExpectGetCDNIPBlocks expects a single call to GetCDNIPBlocks with any arguments
+func (m *OriginClientService) ExpectGetCDNIPBlocks() *OriginClientServiceGetCDNIPBlocksCall {
+	return &OriginClientServiceGetCDNIPBlocksCall{m.controller.expect("GetCDNIPBlocks")}
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetCDNIPBlocksCall) Return(r0 *origin.CDNIPBlocksOK, r1 error) *OriginClientServiceGetCDNIPBlocksCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetCDNIPBlocksCall) Do(
+	fn func() (*origin.CDNIPBlocksOK, error),
+) *OriginClientServiceGetCDNIPBlocksCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetCDNIPBlocksCall) Times(n int) *OriginClientServiceGetCDNIPBlocksCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetCDNIPBlocksCall) AnyTimes() *OriginClientServiceGetCDNIPBlocksCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetCDNIPBlocksWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetCDNIPBlocksWithContext(ctx context.Context) (*origin.CDNIPBlocksOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetCDNIPBlocksWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].(*origin.CDNIPBlocksOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetCDNIPBlocksWithContextCall is an expected call to GetCDNIPBlocksWithContext
+type OriginClientServiceGetCDNIPBlocksWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetCDNIPBlocksWithContext expects a single call to GetCDNIPBlocksWithContext with any arguments
+func (m *OriginClientService) ExpectGetCDNIPBlocksWithContext() *OriginClientServiceGetCDNIPBlocksWithContextCall {
+	return &OriginClientServiceGetCDNIPBlocksWithContextCall{m.controller.expect("GetCDNIPBlocksWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetCDNIPBlocksWithContextCall) Return(r0 *origin.CDNIPBlocksOK, r1 error) *OriginClientServiceGetCDNIPBlocksWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetCDNIPBlocksWithContextCall) Do(
+	fn func(ctx context.Context) (*origin.CDNIPBlocksOK, error),
+) *OriginClientServiceGetCDNIPBlocksWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetCDNIPBlocksWithContextCall) Times(n int) *OriginClientServiceGetCDNIPBlocksWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetCDNIPBlocksWithContextCall) AnyTimes() *OriginClientServiceGetCDNIPBlocksWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginPropagationStatus returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOriginPropagationStatus(params origin.GetOriginPropagationStatusParams) (*ecmodels.PropagationStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginPropagationStatus",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*ecmodels.PropagationStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginPropagationStatusCall is an expected call to GetOriginPropagationStatus
+type OriginClientServiceGetOriginPropagationStatusCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginPropagationStatus expects a single call to GetOriginPropagationStatus with any arguments
+func (m *OriginClientService) ExpectGetOriginPropagationStatus() *OriginClientServiceGetOriginPropagationStatusCall {
+	return &OriginClientServiceGetOriginPropagationStatusCall{m.controller.expect("GetOriginPropagationStatus")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceGetOriginPropagationStatusCall) With(params origin.GetOriginPropagationStatusParams) *OriginClientServiceGetOriginPropagationStatusCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginPropagationStatusCall) Return(r0 *ecmodels.PropagationStatus, r1 error) *OriginClientServiceGetOriginPropagationStatusCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginPropagationStatusCall) Do(
+	fn func(params origin.GetOriginPropagationStatusParams) (*ecmodels.PropagationStatus, error),
+) *OriginClientServiceGetOriginPropagationStatusCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.GetOriginPropagationStatusParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginPropagationStatusCall) Times(n int) *OriginClientServiceGetOriginPropagationStatusCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginPropagationStatusCall) AnyTimes() *OriginClientServiceGetOriginPropagationStatusCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginPropagationStatusWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOriginPropagationStatusWithContext(ctx context.Context, params origin.GetOriginPropagationStatusParams) (*ecmodels.PropagationStatus, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginPropagationStatusWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*ecmodels.PropagationStatus)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginPropagationStatusWithContextCall is an expected call to GetOriginPropagationStatusWithContext
+type OriginClientServiceGetOriginPropagationStatusWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginPropagationStatusWithContext expects a single call to GetOriginPropagationStatusWithContext with any arguments
+func (m *OriginClientService) ExpectGetOriginPropagationStatusWithContext() *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	return &OriginClientServiceGetOriginPropagationStatusWithContextCall{m.controller.expect("GetOriginPropagationStatusWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceGetOriginPropagationStatusWithContextCall) With(params origin.GetOriginPropagationStatusParams) *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginPropagationStatusWithContextCall) Return(r0 *ecmodels.PropagationStatus, r1 error) *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginPropagationStatusWithContextCall) Do(
+	fn func(ctx context.Context, params origin.GetOriginPropagationStatusParams) (*ecmodels.PropagationStatus, error),
+) *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.GetOriginPropagationStatusParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginPropagationStatusWithContextCall) Times(n int) *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginPropagationStatusWithContextCall) AnyTimes() *OriginClientServiceGetOriginPropagationStatusWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginShieldPOPs returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOriginShieldPOPs(params origin.GetOriginShieldPOPsParams) (*[]origin.ShieldPOP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginShieldPOPs",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]origin.ShieldPOP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginShieldPOPsCall is an expected call to GetOriginShieldPOPs
+type OriginClientServiceGetOriginShieldPOPsCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginShieldPOPs expects a single call to GetOriginShieldPOPs with any arguments
+func (m *OriginClientService) ExpectGetOriginShieldPOPs() *OriginClientServiceGetOriginShieldPOPsCall {
+	return &OriginClientServiceGetOriginShieldPOPsCall{m.controller.expect("GetOriginShieldPOPs")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceGetOriginShieldPOPsCall) With(params origin.GetOriginShieldPOPsParams) *OriginClientServiceGetOriginShieldPOPsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginShieldPOPsCall) Return(r0 *[]origin.ShieldPOP, r1 error) *OriginClientServiceGetOriginShieldPOPsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginShieldPOPsCall) Do(
+	fn func(params origin.GetOriginShieldPOPsParams) (*[]origin.ShieldPOP, error),
+) *OriginClientServiceGetOriginShieldPOPsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.GetOriginShieldPOPsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginShieldPOPsCall) Times(n int) *OriginClientServiceGetOriginShieldPOPsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginShieldPOPsCall) AnyTimes() *OriginClientServiceGetOriginShieldPOPsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetOriginShieldPOPsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) GetOriginShieldPOPsWithContext(ctx context.Context, params origin.GetOriginShieldPOPsParams) (*[]origin.ShieldPOP, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetOriginShieldPOPsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]origin.ShieldPOP)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// OriginClientServiceGetOriginShieldPOPsWithContextCall is an expected call to GetOriginShieldPOPsWithContext
+type OriginClientServiceGetOriginShieldPOPsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetOriginShieldPOPsWithContext expects a single call to GetOriginShieldPOPsWithContext with any arguments
+func (m *OriginClientService) ExpectGetOriginShieldPOPsWithContext() *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	return &OriginClientServiceGetOriginShieldPOPsWithContextCall{m.controller.expect("GetOriginShieldPOPsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceGetOriginShieldPOPsWithContextCall) With(params origin.GetOriginShieldPOPsParams) *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceGetOriginShieldPOPsWithContextCall) Return(r0 *[]origin.ShieldPOP, r1 error) *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceGetOriginShieldPOPsWithContextCall) Do(
+	fn func(ctx context.Context, params origin.GetOriginShieldPOPsParams) (*[]origin.ShieldPOP, error),
+) *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.GetOriginShieldPOPsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceGetOriginShieldPOPsWithContextCall) Times(n int) *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceGetOriginShieldPOPsWithContextCall) AnyTimes() *OriginClientServiceGetOriginShieldPOPsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ReselectADNGateways returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) ReselectADNGateways(params origin.ReselectADNGatewaysParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ReselectADNGateways",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// OriginClientServiceReselectADNGatewaysCall is an expected call to ReselectADNGateways
+type OriginClientServiceReselectADNGatewaysCall struct {
+	e *expectation
+}
+
+// ExpectReselectADNGateways expects a single call to ReselectADNGateways with any arguments
+func (m *OriginClientService) ExpectReselectADNGateways() *OriginClientServiceReselectADNGatewaysCall {
+	return &OriginClientServiceReselectADNGatewaysCall{m.controller.expect("ReselectADNGateways")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *OriginClientServiceReselectADNGatewaysCall) With(params origin.ReselectADNGatewaysParams) *OriginClientServiceReselectADNGatewaysCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceReselectADNGatewaysCall) Return(r0 error) *OriginClientServiceReselectADNGatewaysCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceReselectADNGatewaysCall) Do(
+	fn func(params origin.ReselectADNGatewaysParams) error,
+) *OriginClientServiceReselectADNGatewaysCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(origin.ReselectADNGatewaysParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceReselectADNGatewaysCall) Times(n int) *OriginClientServiceReselectADNGatewaysCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceReselectADNGatewaysCall) AnyTimes() *OriginClientServiceReselectADNGatewaysCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ReselectADNGatewaysWithContext returns the results of the expected call that matches its
+// arguments
+func (m *OriginClientService) ReselectADNGatewaysWithContext(ctx context.Context, params origin.ReselectADNGatewaysParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ReselectADNGatewaysWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// OriginClientServiceReselectADNGatewaysWithContextCall is an expected call to ReselectADNGatewaysWithContext
+type OriginClientServiceReselectADNGatewaysWithContextCall struct {
+	e *expectation
+}
+
+// ExpectReselectADNGatewaysWithContext expects a single call to ReselectADNGatewaysWithContext with any arguments
+func (m *OriginClientService) ExpectReselectADNGatewaysWithContext() *OriginClientServiceReselectADNGatewaysWithContextCall {
+	return &OriginClientServiceReselectADNGatewaysWithContextCall{m.controller.expect("ReselectADNGatewaysWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *OriginClientServiceReselectADNGatewaysWithContextCall) With(params origin.ReselectADNGatewaysParams) *OriginClientServiceReselectADNGatewaysWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *OriginClientServiceReselectADNGatewaysWithContextCall) Return(r0 error) *OriginClientServiceReselectADNGatewaysWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *OriginClientServiceReselectADNGatewaysWithContextCall) Do(
+	fn func(ctx context.Context, params origin.ReselectADNGatewaysParams) error,
+) *OriginClientServiceReselectADNGatewaysWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(origin.ReselectADNGatewaysParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *OriginClientServiceReselectADNGatewaysWithContextCall) Times(n int) *OriginClientServiceReselectADNGatewaysWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *OriginClientServiceReselectADNGatewaysWithContextCall) AnyTimes() *OriginClientServiceReselectADNGatewaysWithContextCall {
+	c.e.anyTimes()
+	return c
+}
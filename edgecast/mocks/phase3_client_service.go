@@ -0,0 +1,241 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/originv3"
+)
+
+// Phase3ClientService is a mock of originv3.Phase3ClientService
+type Phase3ClientService struct {
+	controller *controller
+}
+
+var _ originv3.Phase3ClientService = (*Phase3ClientService)(nil)
+
+// NewPhase3ClientService creates a mock of originv3.Phase3ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewPhase3ClientService(t TestingT) *Phase3ClientService {
+	return &Phase3ClientService{controller: newController(t, "Phase3ClientService")}
+}
+
+// GetAvailableHostnameResolutionMethods returns the results of the expected call that matches its
+// arguments
+func (m *Phase3ClientService) GetAvailableHostnameResolutionMethods() ([]originv3.NetworkType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableHostnameResolutionMethods",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].([]originv3.NetworkType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall is an expected call to GetAvailableHostnameResolutionMethods
+type Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableHostnameResolutionMethods expects a single call to GetAvailableHostnameResolutionMethods with any arguments
+func (m *Phase3ClientService) ExpectGetAvailableHostnameResolutionMethods() *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall {
+	return &Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall{m.controller.expect("GetAvailableHostnameResolutionMethods")}
+}
+
+// Return sets the results of the expected call
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall) Return(r0 []originv3.NetworkType, r1 error) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall) Do(
+	fn func() ([]originv3.NetworkType, error),
+) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall) Times(n int) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall) AnyTimes() *Phase3ClientServiceGetAvailableHostnameResolutionMethodsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAvailableHostnameResolutionMethodsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *Phase3ClientService) GetAvailableHostnameResolutionMethodsWithContext(ctx context.Context) ([]originv3.NetworkType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableHostnameResolutionMethodsWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].([]originv3.NetworkType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall is an expected call to GetAvailableHostnameResolutionMethodsWithContext
+type Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableHostnameResolutionMethodsWithContext expects a single call to GetAvailableHostnameResolutionMethodsWithContext with any arguments
+func (m *Phase3ClientService) ExpectGetAvailableHostnameResolutionMethodsWithContext() *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall {
+	return &Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall{m.controller.expect("GetAvailableHostnameResolutionMethodsWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall) Return(r0 []originv3.NetworkType, r1 error) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall) Do(
+	fn func(ctx context.Context) ([]originv3.NetworkType, error),
+) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall) Times(n int) *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall) AnyTimes() *Phase3ClientServiceGetAvailableHostnameResolutionMethodsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAvailableProtocols returns the results of the expected call that matches its
+// arguments
+func (m *Phase3ClientService) GetAvailableProtocols() ([]originv3.ProtocolType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableProtocols",
+		[]interface{}{},
+		2)
+	r0, _ := results[0].([]originv3.ProtocolType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// Phase3ClientServiceGetAvailableProtocolsCall is an expected call to GetAvailableProtocols
+type Phase3ClientServiceGetAvailableProtocolsCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableProtocols expects a single call to GetAvailableProtocols with any arguments
+func (m *Phase3ClientService) ExpectGetAvailableProtocols() *Phase3ClientServiceGetAvailableProtocolsCall {
+	return &Phase3ClientServiceGetAvailableProtocolsCall{m.controller.expect("GetAvailableProtocols")}
+}
+
+// Return sets the results of the expected call
+func (c *Phase3ClientServiceGetAvailableProtocolsCall) Return(r0 []originv3.ProtocolType, r1 error) *Phase3ClientServiceGetAvailableProtocolsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *Phase3ClientServiceGetAvailableProtocolsCall) Do(
+	fn func() ([]originv3.ProtocolType, error),
+) *Phase3ClientServiceGetAvailableProtocolsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		r0, r1 := fn()
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *Phase3ClientServiceGetAvailableProtocolsCall) Times(n int) *Phase3ClientServiceGetAvailableProtocolsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *Phase3ClientServiceGetAvailableProtocolsCall) AnyTimes() *Phase3ClientServiceGetAvailableProtocolsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAvailableProtocolsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *Phase3ClientService) GetAvailableProtocolsWithContext(ctx context.Context) ([]originv3.ProtocolType, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAvailableProtocolsWithContext",
+		[]interface{}{ctx},
+		2)
+	r0, _ := results[0].([]originv3.ProtocolType)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// Phase3ClientServiceGetAvailableProtocolsWithContextCall is an expected call to GetAvailableProtocolsWithContext
+type Phase3ClientServiceGetAvailableProtocolsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAvailableProtocolsWithContext expects a single call to GetAvailableProtocolsWithContext with any arguments
+func (m *Phase3ClientService) ExpectGetAvailableProtocolsWithContext() *Phase3ClientServiceGetAvailableProtocolsWithContextCall {
+	return &Phase3ClientServiceGetAvailableProtocolsWithContextCall{m.controller.expect("GetAvailableProtocolsWithContext")}
+}
+
+// Return sets the results of the expected call
+func (c *Phase3ClientServiceGetAvailableProtocolsWithContextCall) Return(r0 []originv3.ProtocolType, r1 error) *Phase3ClientServiceGetAvailableProtocolsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *Phase3ClientServiceGetAvailableProtocolsWithContextCall) Do(
+	fn func(ctx context.Context) ([]originv3.ProtocolType, error),
+) *Phase3ClientServiceGetAvailableProtocolsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *Phase3ClientServiceGetAvailableProtocolsWithContextCall) Times(n int) *Phase3ClientServiceGetAvailableProtocolsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *Phase3ClientServiceGetAvailableProtocolsWithContextCall) AnyTimes() *Phase3ClientServiceGetAvailableProtocolsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,637 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_cdn"
+)
+
+// ProfilesCdnClientService is a mock of profiles_cdn.ClientService
+type ProfilesCdnClientService struct {
+	controller *controller
+}
+
+var _ profiles_cdn.ClientService = (*ProfilesCdnClientService)(nil)
+
+// NewProfilesCdnClientService creates a mock of profiles_cdn.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewProfilesCdnClientService(t TestingT) *ProfilesCdnClientService {
+	return &ProfilesCdnClientService{controller: newController(t, "ProfilesCdnClientService")}
+}
+
+// ProfilesAddCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesAddCustomerSetting(params *profiles_cdn.ProfilesAddCustomerSettingParams) (*profiles_cdn.ProfilesAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesAddCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesAddCustomerSettingCall is an expected call to ProfilesAddCustomerSetting
+type ProfilesCdnClientServiceProfilesAddCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesAddCustomerSetting expects a single call to ProfilesAddCustomerSetting with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesAddCustomerSetting() *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	return &ProfilesCdnClientServiceProfilesAddCustomerSettingCall{m.controller.expect("ProfilesAddCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingCall) With(params *profiles_cdn.ProfilesAddCustomerSettingParams) *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingCall) Return(r0 *profiles_cdn.ProfilesAddCustomerSettingOK, r1 error) *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingCall) Do(
+	fn func(params *profiles_cdn.ProfilesAddCustomerSettingParams) (*profiles_cdn.ProfilesAddCustomerSettingOK, error),
+) *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_cdn.ProfilesAddCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingCall) Times(n int) *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingCall) AnyTimes() *ProfilesCdnClientServiceProfilesAddCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesAddCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesAddCustomerSettingWithContext(ctx context.Context, params *profiles_cdn.ProfilesAddCustomerSettingParams) (*profiles_cdn.ProfilesAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesAddCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall is an expected call to ProfilesAddCustomerSettingWithContext
+type ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesAddCustomerSettingWithContext expects a single call to ProfilesAddCustomerSettingWithContext with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesAddCustomerSettingWithContext() *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	return &ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall{m.controller.expect("ProfilesAddCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall) With(params *profiles_cdn.ProfilesAddCustomerSettingParams) *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall) Return(r0 *profiles_cdn.ProfilesAddCustomerSettingOK, r1 error) *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_cdn.ProfilesAddCustomerSettingParams) (*profiles_cdn.ProfilesAddCustomerSettingOK, error),
+) *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_cdn.ProfilesAddCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall) Times(n int) *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall) AnyTimes() *ProfilesCdnClientServiceProfilesAddCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesDeleteCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesDeleteCustomerSettingsByID(params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) (*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesDeleteCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall is an expected call to ProfilesDeleteCustomerSettingsByID
+type ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesDeleteCustomerSettingsByID expects a single call to ProfilesDeleteCustomerSettingsByID with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesDeleteCustomerSettingsByID() *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	return &ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall{m.controller.expect("ProfilesDeleteCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall) With(params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall) Return(r0 *profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) (*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall) Times(n int) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall) AnyTimes() *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesDeleteCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) (*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesDeleteCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall is an expected call to ProfilesDeleteCustomerSettingsByIDWithContext
+type ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesDeleteCustomerSettingsByIDWithContext expects a single call to ProfilesDeleteCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesDeleteCustomerSettingsByIDWithContext() *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	return &ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesDeleteCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall) With(params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall) Return(r0 *profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams) (*profiles_cdn.ProfilesDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_cdn.ProfilesDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesCdnClientServiceProfilesDeleteCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesGetCustomerSettings returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesGetCustomerSettings(params *profiles_cdn.ProfilesGetCustomerSettingsParams) (*profiles_cdn.ProfilesGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesGetCustomerSettings",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesGetCustomerSettingsCall is an expected call to ProfilesGetCustomerSettings
+type ProfilesCdnClientServiceProfilesGetCustomerSettingsCall struct {
+	e *expectation
+}
+
+// ExpectProfilesGetCustomerSettings expects a single call to ProfilesGetCustomerSettings with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesGetCustomerSettings() *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	return &ProfilesCdnClientServiceProfilesGetCustomerSettingsCall{m.controller.expect("ProfilesGetCustomerSettings")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall) With(params *profiles_cdn.ProfilesGetCustomerSettingsParams) *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall) Return(r0 *profiles_cdn.ProfilesGetCustomerSettingsOK, r1 error) *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall) Do(
+	fn func(params *profiles_cdn.ProfilesGetCustomerSettingsParams) (*profiles_cdn.ProfilesGetCustomerSettingsOK, error),
+) *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_cdn.ProfilesGetCustomerSettingsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall) Times(n int) *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall) AnyTimes() *ProfilesCdnClientServiceProfilesGetCustomerSettingsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesGetCustomerSettingsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesGetCustomerSettingsWithContext(ctx context.Context, params *profiles_cdn.ProfilesGetCustomerSettingsParams) (*profiles_cdn.ProfilesGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesGetCustomerSettingsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall is an expected call to ProfilesGetCustomerSettingsWithContext
+type ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesGetCustomerSettingsWithContext expects a single call to ProfilesGetCustomerSettingsWithContext with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesGetCustomerSettingsWithContext() *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	return &ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall{m.controller.expect("ProfilesGetCustomerSettingsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall) With(params *profiles_cdn.ProfilesGetCustomerSettingsParams) *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall) Return(r0 *profiles_cdn.ProfilesGetCustomerSettingsOK, r1 error) *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_cdn.ProfilesGetCustomerSettingsParams) (*profiles_cdn.ProfilesGetCustomerSettingsOK, error),
+) *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_cdn.ProfilesGetCustomerSettingsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall) Times(n int) *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall) AnyTimes() *ProfilesCdnClientServiceProfilesGetCustomerSettingsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesGetCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesGetCustomerSettingsByID(params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) (*profiles_cdn.ProfilesGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesGetCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall is an expected call to ProfilesGetCustomerSettingsByID
+type ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesGetCustomerSettingsByID expects a single call to ProfilesGetCustomerSettingsByID with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesGetCustomerSettingsByID() *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	return &ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall{m.controller.expect("ProfilesGetCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall) With(params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall) Return(r0 *profiles_cdn.ProfilesGetCustomerSettingsByIDOK, r1 error) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) (*profiles_cdn.ProfilesGetCustomerSettingsByIDOK, error),
+) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_cdn.ProfilesGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall) Times(n int) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall) AnyTimes() *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesGetCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesGetCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) (*profiles_cdn.ProfilesGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesGetCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall is an expected call to ProfilesGetCustomerSettingsByIDWithContext
+type ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesGetCustomerSettingsByIDWithContext expects a single call to ProfilesGetCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesGetCustomerSettingsByIDWithContext() *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	return &ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesGetCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall) With(params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall) Return(r0 *profiles_cdn.ProfilesGetCustomerSettingsByIDOK, r1 error) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_cdn.ProfilesGetCustomerSettingsByIDParams) (*profiles_cdn.ProfilesGetCustomerSettingsByIDOK, error),
+) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_cdn.ProfilesGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesCdnClientServiceProfilesGetCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesUpdateCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesUpdateCustomerSetting(params *profiles_cdn.ProfilesUpdateCustomerSettingParams) (*profiles_cdn.ProfilesUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesUpdateCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall is an expected call to ProfilesUpdateCustomerSetting
+type ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesUpdateCustomerSetting expects a single call to ProfilesUpdateCustomerSetting with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesUpdateCustomerSetting() *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	return &ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall{m.controller.expect("ProfilesUpdateCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall) With(params *profiles_cdn.ProfilesUpdateCustomerSettingParams) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall) Return(r0 *profiles_cdn.ProfilesUpdateCustomerSettingOK, r1 error) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall) Do(
+	fn func(params *profiles_cdn.ProfilesUpdateCustomerSettingParams) (*profiles_cdn.ProfilesUpdateCustomerSettingOK, error),
+) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_cdn.ProfilesUpdateCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall) Times(n int) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall) AnyTimes() *ProfilesCdnClientServiceProfilesUpdateCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesUpdateCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesCdnClientService) ProfilesUpdateCustomerSettingWithContext(ctx context.Context, params *profiles_cdn.ProfilesUpdateCustomerSettingParams) (*profiles_cdn.ProfilesUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesUpdateCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_cdn.ProfilesUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall is an expected call to ProfilesUpdateCustomerSettingWithContext
+type ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesUpdateCustomerSettingWithContext expects a single call to ProfilesUpdateCustomerSettingWithContext with any arguments
+func (m *ProfilesCdnClientService) ExpectProfilesUpdateCustomerSettingWithContext() *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	return &ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall{m.controller.expect("ProfilesUpdateCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall) With(params *profiles_cdn.ProfilesUpdateCustomerSettingParams) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall) Return(r0 *profiles_cdn.ProfilesUpdateCustomerSettingOK, r1 error) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_cdn.ProfilesUpdateCustomerSettingParams) (*profiles_cdn.ProfilesUpdateCustomerSettingOK, error),
+) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_cdn.ProfilesUpdateCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall) Times(n int) *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall) AnyTimes() *ProfilesCdnClientServiceProfilesUpdateCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,637 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_rl"
+)
+
+// ProfilesRlClientService is a mock of profiles_rl.ClientService
+type ProfilesRlClientService struct {
+	controller *controller
+}
+
+var _ profiles_rl.ClientService = (*ProfilesRlClientService)(nil)
+
+// NewProfilesRlClientService creates a mock of profiles_rl.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewProfilesRlClientService(t TestingT) *ProfilesRlClientService {
+	return &ProfilesRlClientService{controller: newController(t, "ProfilesRlClientService")}
+}
+
+// ProfilesRateLimitingAddCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRateLimitingAddCustomerSetting(params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) (*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRateLimitingAddCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall is an expected call to ProfilesRateLimitingAddCustomerSetting
+type ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRateLimitingAddCustomerSetting expects a single call to ProfilesRateLimitingAddCustomerSetting with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRateLimitingAddCustomerSetting() *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	return &ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall{m.controller.expect("ProfilesRateLimitingAddCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall) With(params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall) Return(r0 *profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, r1 error) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall) Do(
+	fn func(params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) (*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, error),
+) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_rl.ProfilesRateLimitingAddCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall) Times(n int) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall) AnyTimes() *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRateLimitingAddCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRateLimitingAddCustomerSettingWithContext(ctx context.Context, params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) (*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRateLimitingAddCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall is an expected call to ProfilesRateLimitingAddCustomerSettingWithContext
+type ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRateLimitingAddCustomerSettingWithContext expects a single call to ProfilesRateLimitingAddCustomerSettingWithContext with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRateLimitingAddCustomerSettingWithContext() *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	return &ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall{m.controller.expect("ProfilesRateLimitingAddCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall) With(params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall) Return(r0 *profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, r1 error) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_rl.ProfilesRateLimitingAddCustomerSettingParams) (*profiles_rl.ProfilesRateLimitingAddCustomerSettingOK, error),
+) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_rl.ProfilesRateLimitingAddCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall) Times(n int) *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall) AnyTimes() *ProfilesRlClientServiceProfilesRateLimitingAddCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRateLimitingGetCustomerSettings returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRateLimitingGetCustomerSettings(params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) (*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRateLimitingGetCustomerSettings",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall is an expected call to ProfilesRateLimitingGetCustomerSettings
+type ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRateLimitingGetCustomerSettings expects a single call to ProfilesRateLimitingGetCustomerSettings with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRateLimitingGetCustomerSettings() *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	return &ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall{m.controller.expect("ProfilesRateLimitingGetCustomerSettings")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall) With(params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall) Return(r0 *profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, r1 error) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall) Do(
+	fn func(params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) (*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, error),
+) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall) Times(n int) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall) AnyTimes() *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRateLimitingGetCustomerSettingsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRateLimitingGetCustomerSettingsWithContext(ctx context.Context, params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) (*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRateLimitingGetCustomerSettingsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall is an expected call to ProfilesRateLimitingGetCustomerSettingsWithContext
+type ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRateLimitingGetCustomerSettingsWithContext expects a single call to ProfilesRateLimitingGetCustomerSettingsWithContext with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRateLimitingGetCustomerSettingsWithContext() *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	return &ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall{m.controller.expect("ProfilesRateLimitingGetCustomerSettingsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall) With(params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall) Return(r0 *profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, r1 error) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams) (*profiles_rl.ProfilesRateLimitingGetCustomerSettingsOK, error),
+) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_rl.ProfilesRateLimitingGetCustomerSettingsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall) Times(n int) *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall) AnyTimes() *ProfilesRlClientServiceProfilesRateLimitingGetCustomerSettingsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlDeleteCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlDeleteCustomerSettingsByID(params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlDeleteCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall is an expected call to ProfilesRlDeleteCustomerSettingsByID
+type ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlDeleteCustomerSettingsByID expects a single call to ProfilesRlDeleteCustomerSettingsByID with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlDeleteCustomerSettingsByID() *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	return &ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall{m.controller.expect("ProfilesRlDeleteCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall) With(params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall) Return(r0 *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall) Times(n int) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall) AnyTimes() *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlDeleteCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlDeleteCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall is an expected call to ProfilesRlDeleteCustomerSettingsByIDWithContext
+type ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlDeleteCustomerSettingsByIDWithContext expects a single call to ProfilesRlDeleteCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlDeleteCustomerSettingsByIDWithContext() *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	return &ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesRlDeleteCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall) With(params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall) Return(r0 *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_rl.ProfilesRlDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesRlClientServiceProfilesRlDeleteCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlGetCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlGetCustomerSettingsByID(params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlGetCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall is an expected call to ProfilesRlGetCustomerSettingsByID
+type ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlGetCustomerSettingsByID expects a single call to ProfilesRlGetCustomerSettingsByID with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlGetCustomerSettingsByID() *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	return &ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall{m.controller.expect("ProfilesRlGetCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall) With(params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall) Return(r0 *profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, r1 error) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, error),
+) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_rl.ProfilesRlGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall) Times(n int) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall) AnyTimes() *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlGetCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlGetCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlGetCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall is an expected call to ProfilesRlGetCustomerSettingsByIDWithContext
+type ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlGetCustomerSettingsByIDWithContext expects a single call to ProfilesRlGetCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlGetCustomerSettingsByIDWithContext() *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	return &ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesRlGetCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall) With(params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall) Return(r0 *profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, r1 error) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_rl.ProfilesRlGetCustomerSettingsByIDParams) (*profiles_rl.ProfilesRlGetCustomerSettingsByIDOK, error),
+) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_rl.ProfilesRlGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesRlClientServiceProfilesRlGetCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlUpdateCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlUpdateCustomerSetting(params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) (*profiles_rl.ProfilesRlUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlUpdateCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall is an expected call to ProfilesRlUpdateCustomerSetting
+type ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlUpdateCustomerSetting expects a single call to ProfilesRlUpdateCustomerSetting with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlUpdateCustomerSetting() *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	return &ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall{m.controller.expect("ProfilesRlUpdateCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall) With(params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall) Return(r0 *profiles_rl.ProfilesRlUpdateCustomerSettingOK, r1 error) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall) Do(
+	fn func(params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) (*profiles_rl.ProfilesRlUpdateCustomerSettingOK, error),
+) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_rl.ProfilesRlUpdateCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall) Times(n int) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall) AnyTimes() *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesRlUpdateCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesRlClientService) ProfilesRlUpdateCustomerSettingWithContext(ctx context.Context, params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) (*profiles_rl.ProfilesRlUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesRlUpdateCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_rl.ProfilesRlUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall is an expected call to ProfilesRlUpdateCustomerSettingWithContext
+type ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesRlUpdateCustomerSettingWithContext expects a single call to ProfilesRlUpdateCustomerSettingWithContext with any arguments
+func (m *ProfilesRlClientService) ExpectProfilesRlUpdateCustomerSettingWithContext() *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	return &ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall{m.controller.expect("ProfilesRlUpdateCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall) With(params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall) Return(r0 *profiles_rl.ProfilesRlUpdateCustomerSettingOK, r1 error) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_rl.ProfilesRlUpdateCustomerSettingParams) (*profiles_rl.ProfilesRlUpdateCustomerSettingOK, error),
+) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_rl.ProfilesRlUpdateCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall) Times(n int) *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall) AnyTimes() *ProfilesRlClientServiceProfilesRlUpdateCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,637 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/profiles_waf"
+)
+
+// ProfilesWafClientService is a mock of profiles_waf.ClientService
+type ProfilesWafClientService struct {
+	controller *controller
+}
+
+var _ profiles_waf.ClientService = (*ProfilesWafClientService)(nil)
+
+// NewProfilesWafClientService creates a mock of profiles_waf.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewProfilesWafClientService(t TestingT) *ProfilesWafClientService {
+	return &ProfilesWafClientService{controller: newController(t, "ProfilesWafClientService")}
+}
+
+// ProfilesWafAddCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafAddCustomerSetting(params *profiles_waf.ProfilesWafAddCustomerSettingParams) (*profiles_waf.ProfilesWafAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafAddCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafAddCustomerSettingCall is an expected call to ProfilesWafAddCustomerSetting
+type ProfilesWafClientServiceProfilesWafAddCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafAddCustomerSetting expects a single call to ProfilesWafAddCustomerSetting with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafAddCustomerSetting() *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	return &ProfilesWafClientServiceProfilesWafAddCustomerSettingCall{m.controller.expect("ProfilesWafAddCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall) With(params *profiles_waf.ProfilesWafAddCustomerSettingParams) *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall) Return(r0 *profiles_waf.ProfilesWafAddCustomerSettingOK, r1 error) *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall) Do(
+	fn func(params *profiles_waf.ProfilesWafAddCustomerSettingParams) (*profiles_waf.ProfilesWafAddCustomerSettingOK, error),
+) *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_waf.ProfilesWafAddCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall) Times(n int) *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall) AnyTimes() *ProfilesWafClientServiceProfilesWafAddCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafAddCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafAddCustomerSettingWithContext(ctx context.Context, params *profiles_waf.ProfilesWafAddCustomerSettingParams) (*profiles_waf.ProfilesWafAddCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafAddCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafAddCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall is an expected call to ProfilesWafAddCustomerSettingWithContext
+type ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafAddCustomerSettingWithContext expects a single call to ProfilesWafAddCustomerSettingWithContext with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafAddCustomerSettingWithContext() *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	return &ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall{m.controller.expect("ProfilesWafAddCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall) With(params *profiles_waf.ProfilesWafAddCustomerSettingParams) *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall) Return(r0 *profiles_waf.ProfilesWafAddCustomerSettingOK, r1 error) *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_waf.ProfilesWafAddCustomerSettingParams) (*profiles_waf.ProfilesWafAddCustomerSettingOK, error),
+) *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_waf.ProfilesWafAddCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall) Times(n int) *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall) AnyTimes() *ProfilesWafClientServiceProfilesWafAddCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafDeleteCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafDeleteCustomerSettingsByID(params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafDeleteCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall is an expected call to ProfilesWafDeleteCustomerSettingsByID
+type ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafDeleteCustomerSettingsByID expects a single call to ProfilesWafDeleteCustomerSettingsByID with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafDeleteCustomerSettingsByID() *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	return &ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall{m.controller.expect("ProfilesWafDeleteCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall) With(params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall) Return(r0 *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall) Times(n int) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall) AnyTimes() *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafDeleteCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafDeleteCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall is an expected call to ProfilesWafDeleteCustomerSettingsByIDWithContext
+type ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafDeleteCustomerSettingsByIDWithContext expects a single call to ProfilesWafDeleteCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafDeleteCustomerSettingsByIDWithContext() *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	return &ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesWafDeleteCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall) With(params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall) Return(r0 *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, r1 error) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDNoContent, error),
+) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_waf.ProfilesWafDeleteCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesWafClientServiceProfilesWafDeleteCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafGetCustomerSettings returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafGetCustomerSettings(params *profiles_waf.ProfilesWafGetCustomerSettingsParams) (*profiles_waf.ProfilesWafGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafGetCustomerSettings",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall is an expected call to ProfilesWafGetCustomerSettings
+type ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafGetCustomerSettings expects a single call to ProfilesWafGetCustomerSettings with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafGetCustomerSettings() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	return &ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall{m.controller.expect("ProfilesWafGetCustomerSettings")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall) With(params *profiles_waf.ProfilesWafGetCustomerSettingsParams) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall) Return(r0 *profiles_waf.ProfilesWafGetCustomerSettingsOK, r1 error) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall) Do(
+	fn func(params *profiles_waf.ProfilesWafGetCustomerSettingsParams) (*profiles_waf.ProfilesWafGetCustomerSettingsOK, error),
+) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_waf.ProfilesWafGetCustomerSettingsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall) Times(n int) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall) AnyTimes() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafGetCustomerSettingsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafGetCustomerSettingsWithContext(ctx context.Context, params *profiles_waf.ProfilesWafGetCustomerSettingsParams) (*profiles_waf.ProfilesWafGetCustomerSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafGetCustomerSettingsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafGetCustomerSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall is an expected call to ProfilesWafGetCustomerSettingsWithContext
+type ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafGetCustomerSettingsWithContext expects a single call to ProfilesWafGetCustomerSettingsWithContext with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafGetCustomerSettingsWithContext() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	return &ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall{m.controller.expect("ProfilesWafGetCustomerSettingsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall) With(params *profiles_waf.ProfilesWafGetCustomerSettingsParams) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall) Return(r0 *profiles_waf.ProfilesWafGetCustomerSettingsOK, r1 error) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_waf.ProfilesWafGetCustomerSettingsParams) (*profiles_waf.ProfilesWafGetCustomerSettingsOK, error),
+) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_waf.ProfilesWafGetCustomerSettingsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall) Times(n int) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall) AnyTimes() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafGetCustomerSettingsByID returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafGetCustomerSettingsByID(params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafGetCustomerSettingsByID",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall is an expected call to ProfilesWafGetCustomerSettingsByID
+type ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafGetCustomerSettingsByID expects a single call to ProfilesWafGetCustomerSettingsByID with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafGetCustomerSettingsByID() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	return &ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall{m.controller.expect("ProfilesWafGetCustomerSettingsByID")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall) With(params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall) Return(r0 *profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, r1 error) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall) Do(
+	fn func(params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, error),
+) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_waf.ProfilesWafGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall) Times(n int) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall) AnyTimes() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafGetCustomerSettingsByIDWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafGetCustomerSettingsByIDWithContext(ctx context.Context, params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafGetCustomerSettingsByIDWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall is an expected call to ProfilesWafGetCustomerSettingsByIDWithContext
+type ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafGetCustomerSettingsByIDWithContext expects a single call to ProfilesWafGetCustomerSettingsByIDWithContext with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafGetCustomerSettingsByIDWithContext() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	return &ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall{m.controller.expect("ProfilesWafGetCustomerSettingsByIDWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall) With(params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall) Return(r0 *profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, r1 error) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_waf.ProfilesWafGetCustomerSettingsByIDParams) (*profiles_waf.ProfilesWafGetCustomerSettingsByIDOK, error),
+) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_waf.ProfilesWafGetCustomerSettingsByIDParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall) Times(n int) *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall) AnyTimes() *ProfilesWafClientServiceProfilesWafGetCustomerSettingsByIDWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafUpdateCustomerSetting returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafUpdateCustomerSetting(params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) (*profiles_waf.ProfilesWafUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafUpdateCustomerSetting",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall is an expected call to ProfilesWafUpdateCustomerSetting
+type ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafUpdateCustomerSetting expects a single call to ProfilesWafUpdateCustomerSetting with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafUpdateCustomerSetting() *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	return &ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall{m.controller.expect("ProfilesWafUpdateCustomerSetting")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall) With(params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall) Return(r0 *profiles_waf.ProfilesWafUpdateCustomerSettingOK, r1 error) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall) Do(
+	fn func(params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) (*profiles_waf.ProfilesWafUpdateCustomerSettingOK, error),
+) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*profiles_waf.ProfilesWafUpdateCustomerSettingParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall) Times(n int) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall) AnyTimes() *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ProfilesWafUpdateCustomerSettingWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ProfilesWafClientService) ProfilesWafUpdateCustomerSettingWithContext(ctx context.Context, params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) (*profiles_waf.ProfilesWafUpdateCustomerSettingOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ProfilesWafUpdateCustomerSettingWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*profiles_waf.ProfilesWafUpdateCustomerSettingOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall is an expected call to ProfilesWafUpdateCustomerSettingWithContext
+type ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall struct {
+	e *expectation
+}
+
+// ExpectProfilesWafUpdateCustomerSettingWithContext expects a single call to ProfilesWafUpdateCustomerSettingWithContext with any arguments
+func (m *ProfilesWafClientService) ExpectProfilesWafUpdateCustomerSettingWithContext() *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	return &ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall{m.controller.expect("ProfilesWafUpdateCustomerSettingWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall) With(params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall) Return(r0 *profiles_waf.ProfilesWafUpdateCustomerSettingOK, r1 error) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall) Do(
+	fn func(ctx context.Context, params *profiles_waf.ProfilesWafUpdateCustomerSettingParams) (*profiles_waf.ProfilesWafUpdateCustomerSettingOK, error),
+) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*profiles_waf.ProfilesWafUpdateCustomerSettingParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall) Times(n int) *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall) AnyTimes() *ProfilesWafClientServiceProfilesWafUpdateCustomerSettingWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,633 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/rate"
+)
+
+// RateClientService is a mock of rate.ClientService
+type RateClientService struct {
+	controller *controller
+}
+
+var _ rate.ClientService = (*RateClientService)(nil)
+
+// NewRateClientService creates a mock of rate.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewRateClientService(t TestingT) *RateClientService {
+	return &RateClientService{controller: newController(t, "RateClientService")}
+}
+
+// AddRateRule returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) AddRateRule(params rate.AddRateRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddRateRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceAddRateRuleCall is an expected call to AddRateRule
+type RateClientServiceAddRateRuleCall struct {
+	e *expectation
+}
+
+// ExpectAddRateRule expects a single call to AddRateRule with any arguments
+func (m *RateClientService) ExpectAddRateRule() *RateClientServiceAddRateRuleCall {
+	return &RateClientServiceAddRateRuleCall{m.controller.expect("AddRateRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RateClientServiceAddRateRuleCall) With(params rate.AddRateRuleParams) *RateClientServiceAddRateRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceAddRateRuleCall) Return(r0 string, r1 error) *RateClientServiceAddRateRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceAddRateRuleCall) Do(
+	fn func(params rate.AddRateRuleParams) (string, error),
+) *RateClientServiceAddRateRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rate.AddRateRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceAddRateRuleCall) Times(n int) *RateClientServiceAddRateRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceAddRateRuleCall) AnyTimes() *RateClientServiceAddRateRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddRateRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) AddRateRuleWithContext(ctx context.Context, params rate.AddRateRuleParams) (string, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddRateRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(string)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceAddRateRuleWithContextCall is an expected call to AddRateRuleWithContext
+type RateClientServiceAddRateRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddRateRuleWithContext expects a single call to AddRateRuleWithContext with any arguments
+func (m *RateClientService) ExpectAddRateRuleWithContext() *RateClientServiceAddRateRuleWithContextCall {
+	return &RateClientServiceAddRateRuleWithContextCall{m.controller.expect("AddRateRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RateClientServiceAddRateRuleWithContextCall) With(params rate.AddRateRuleParams) *RateClientServiceAddRateRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceAddRateRuleWithContextCall) Return(r0 string, r1 error) *RateClientServiceAddRateRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceAddRateRuleWithContextCall) Do(
+	fn func(ctx context.Context, params rate.AddRateRuleParams) (string, error),
+) *RateClientServiceAddRateRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rate.AddRateRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceAddRateRuleWithContextCall) Times(n int) *RateClientServiceAddRateRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceAddRateRuleWithContextCall) AnyTimes() *RateClientServiceAddRateRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetRateRule returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) GetRateRule(params rate.GetRateRuleParams) (*rate.RateRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetRateRule",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*rate.RateRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceGetRateRuleCall is an expected call to GetRateRule
+type RateClientServiceGetRateRuleCall struct {
+	e *expectation
+}
+
+// ExpectGetRateRule expects a single call to GetRateRule with any arguments
+func (m *RateClientService) ExpectGetRateRule() *RateClientServiceGetRateRuleCall {
+	return &RateClientServiceGetRateRuleCall{m.controller.expect("GetRateRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RateClientServiceGetRateRuleCall) With(params rate.GetRateRuleParams) *RateClientServiceGetRateRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceGetRateRuleCall) Return(r0 *rate.RateRuleGetOK, r1 error) *RateClientServiceGetRateRuleCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceGetRateRuleCall) Do(
+	fn func(params rate.GetRateRuleParams) (*rate.RateRuleGetOK, error),
+) *RateClientServiceGetRateRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rate.GetRateRuleParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceGetRateRuleCall) Times(n int) *RateClientServiceGetRateRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceGetRateRuleCall) AnyTimes() *RateClientServiceGetRateRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetRateRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) GetRateRuleWithContext(ctx context.Context, params rate.GetRateRuleParams) (*rate.RateRuleGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetRateRuleWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*rate.RateRuleGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceGetRateRuleWithContextCall is an expected call to GetRateRuleWithContext
+type RateClientServiceGetRateRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetRateRuleWithContext expects a single call to GetRateRuleWithContext with any arguments
+func (m *RateClientService) ExpectGetRateRuleWithContext() *RateClientServiceGetRateRuleWithContextCall {
+	return &RateClientServiceGetRateRuleWithContextCall{m.controller.expect("GetRateRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RateClientServiceGetRateRuleWithContextCall) With(params rate.GetRateRuleParams) *RateClientServiceGetRateRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceGetRateRuleWithContextCall) Return(r0 *rate.RateRuleGetOK, r1 error) *RateClientServiceGetRateRuleWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceGetRateRuleWithContextCall) Do(
+	fn func(ctx context.Context, params rate.GetRateRuleParams) (*rate.RateRuleGetOK, error),
+) *RateClientServiceGetRateRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rate.GetRateRuleParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceGetRateRuleWithContextCall) Times(n int) *RateClientServiceGetRateRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceGetRateRuleWithContextCall) AnyTimes() *RateClientServiceGetRateRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateRateRule returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) UpdateRateRule(params rate.UpdateRateRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateRateRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RateClientServiceUpdateRateRuleCall is an expected call to UpdateRateRule
+type RateClientServiceUpdateRateRuleCall struct {
+	e *expectation
+}
+
+// ExpectUpdateRateRule expects a single call to UpdateRateRule with any arguments
+func (m *RateClientService) ExpectUpdateRateRule() *RateClientServiceUpdateRateRuleCall {
+	return &RateClientServiceUpdateRateRuleCall{m.controller.expect("UpdateRateRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RateClientServiceUpdateRateRuleCall) With(params rate.UpdateRateRuleParams) *RateClientServiceUpdateRateRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceUpdateRateRuleCall) Return(r0 error) *RateClientServiceUpdateRateRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceUpdateRateRuleCall) Do(
+	fn func(params rate.UpdateRateRuleParams) error,
+) *RateClientServiceUpdateRateRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rate.UpdateRateRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceUpdateRateRuleCall) Times(n int) *RateClientServiceUpdateRateRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceUpdateRateRuleCall) AnyTimes() *RateClientServiceUpdateRateRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateRateRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) UpdateRateRuleWithContext(ctx context.Context, params rate.UpdateRateRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateRateRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RateClientServiceUpdateRateRuleWithContextCall is an expected call to UpdateRateRuleWithContext
+type RateClientServiceUpdateRateRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateRateRuleWithContext expects a single call to UpdateRateRuleWithContext with any arguments
+func (m *RateClientService) ExpectUpdateRateRuleWithContext() *RateClientServiceUpdateRateRuleWithContextCall {
+	return &RateClientServiceUpdateRateRuleWithContextCall{m.controller.expect("UpdateRateRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RateClientServiceUpdateRateRuleWithContextCall) With(params rate.UpdateRateRuleParams) *RateClientServiceUpdateRateRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceUpdateRateRuleWithContextCall) Return(r0 error) *RateClientServiceUpdateRateRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceUpdateRateRuleWithContextCall) Do(
+	fn func(ctx context.Context, params rate.UpdateRateRuleParams) error,
+) *RateClientServiceUpdateRateRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rate.UpdateRateRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceUpdateRateRuleWithContextCall) Times(n int) *RateClientServiceUpdateRateRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceUpdateRateRuleWithContextCall) AnyTimes() *RateClientServiceUpdateRateRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteRateRule returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) DeleteRateRule(params rate.DeleteRateRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteRateRule",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RateClientServiceDeleteRateRuleCall is an expected call to DeleteRateRule
+type RateClientServiceDeleteRateRuleCall struct {
+	e *expectation
+}
+
+// ExpectDeleteRateRule expects a single call to DeleteRateRule with any arguments
+func (m *RateClientService) ExpectDeleteRateRule() *RateClientServiceDeleteRateRuleCall {
+	return &RateClientServiceDeleteRateRuleCall{m.controller.expect("DeleteRateRule")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RateClientServiceDeleteRateRuleCall) With(params rate.DeleteRateRuleParams) *RateClientServiceDeleteRateRuleCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceDeleteRateRuleCall) Return(r0 error) *RateClientServiceDeleteRateRuleCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceDeleteRateRuleCall) Do(
+	fn func(params rate.DeleteRateRuleParams) error,
+) *RateClientServiceDeleteRateRuleCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rate.DeleteRateRuleParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceDeleteRateRuleCall) Times(n int) *RateClientServiceDeleteRateRuleCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceDeleteRateRuleCall) AnyTimes() *RateClientServiceDeleteRateRuleCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteRateRuleWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) DeleteRateRuleWithContext(ctx context.Context, params rate.DeleteRateRuleParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteRateRuleWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RateClientServiceDeleteRateRuleWithContextCall is an expected call to DeleteRateRuleWithContext
+type RateClientServiceDeleteRateRuleWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteRateRuleWithContext expects a single call to DeleteRateRuleWithContext with any arguments
+func (m *RateClientService) ExpectDeleteRateRuleWithContext() *RateClientServiceDeleteRateRuleWithContextCall {
+	return &RateClientServiceDeleteRateRuleWithContextCall{m.controller.expect("DeleteRateRuleWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RateClientServiceDeleteRateRuleWithContextCall) With(params rate.DeleteRateRuleParams) *RateClientServiceDeleteRateRuleWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceDeleteRateRuleWithContextCall) Return(r0 error) *RateClientServiceDeleteRateRuleWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceDeleteRateRuleWithContextCall) Do(
+	fn func(ctx context.Context, params rate.DeleteRateRuleParams) error,
+) *RateClientServiceDeleteRateRuleWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rate.DeleteRateRuleParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceDeleteRateRuleWithContextCall) Times(n int) *RateClientServiceDeleteRateRuleWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceDeleteRateRuleWithContextCall) AnyTimes() *RateClientServiceDeleteRateRuleWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllRateRules returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) GetAllRateRules(params rate.GetAllRateRulesParams) (*[]rate.RateRuleGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllRateRules",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]rate.RateRuleGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceGetAllRateRulesCall is an expected call to GetAllRateRules
+type RateClientServiceGetAllRateRulesCall struct {
+	e *expectation
+}
+
+// ExpectGetAllRateRules expects a single call to GetAllRateRules with any arguments
+func (m *RateClientService) ExpectGetAllRateRules() *RateClientServiceGetAllRateRulesCall {
+	return &RateClientServiceGetAllRateRulesCall{m.controller.expect("GetAllRateRules")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RateClientServiceGetAllRateRulesCall) With(params rate.GetAllRateRulesParams) *RateClientServiceGetAllRateRulesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceGetAllRateRulesCall) Return(r0 *[]rate.RateRuleGetAllOK, r1 error) *RateClientServiceGetAllRateRulesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceGetAllRateRulesCall) Do(
+	fn func(params rate.GetAllRateRulesParams) (*[]rate.RateRuleGetAllOK, error),
+) *RateClientServiceGetAllRateRulesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rate.GetAllRateRulesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceGetAllRateRulesCall) Times(n int) *RateClientServiceGetAllRateRulesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceGetAllRateRulesCall) AnyTimes() *RateClientServiceGetAllRateRulesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllRateRulesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RateClientService) GetAllRateRulesWithContext(ctx context.Context, params rate.GetAllRateRulesParams) (*[]rate.RateRuleGetAllOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllRateRulesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]rate.RateRuleGetAllOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RateClientServiceGetAllRateRulesWithContextCall is an expected call to GetAllRateRulesWithContext
+type RateClientServiceGetAllRateRulesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllRateRulesWithContext expects a single call to GetAllRateRulesWithContext with any arguments
+func (m *RateClientService) ExpectGetAllRateRulesWithContext() *RateClientServiceGetAllRateRulesWithContextCall {
+	return &RateClientServiceGetAllRateRulesWithContextCall{m.controller.expect("GetAllRateRulesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RateClientServiceGetAllRateRulesWithContextCall) With(params rate.GetAllRateRulesParams) *RateClientServiceGetAllRateRulesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RateClientServiceGetAllRateRulesWithContextCall) Return(r0 *[]rate.RateRuleGetAllOK, r1 error) *RateClientServiceGetAllRateRulesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RateClientServiceGetAllRateRulesWithContextCall) Do(
+	fn func(ctx context.Context, params rate.GetAllRateRulesParams) (*[]rate.RateRuleGetAllOK, error),
+) *RateClientServiceGetAllRateRulesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rate.GetAllRateRulesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RateClientServiceGetAllRateRulesWithContextCall) Times(n int) *RateClientServiceGetAllRateRulesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RateClientServiceGetAllRateRulesWithContextCall) AnyTimes() *RateClientServiceGetAllRateRulesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,2329 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/routedns"
+)
+
+// RouteDNSClientService is a mock of routedns.ClientService
+type RouteDNSClientService struct {
+	controller *controller
+}
+
+var _ routedns.ClientService = (*RouteDNSClientService)(nil)
+
+// NewRouteDNSClientService creates a mock of routedns.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewRouteDNSClientService(t TestingT) *RouteDNSClientService {
+	return &RouteDNSClientService{controller: newController(t, "RouteDNSClientService")}
+}
+
+// GetGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetGroup(params routedns.GetGroupParams) (*routedns.DnsRouteGroupOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.DnsRouteGroupOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetGroupCall is an expected call to GetGroup
+type RouteDNSClientServiceGetGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetGroup expects a single call to GetGroup with any arguments
+func (m *RouteDNSClientService) ExpectGetGroup() *RouteDNSClientServiceGetGroupCall {
+	return &RouteDNSClientServiceGetGroupCall{m.controller.expect("GetGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetGroupCall) With(params routedns.GetGroupParams) *RouteDNSClientServiceGetGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetGroupCall) Return(r0 *routedns.DnsRouteGroupOK, r1 error) *RouteDNSClientServiceGetGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetGroupCall) Do(
+	fn func(params routedns.GetGroupParams) (*routedns.DnsRouteGroupOK, error),
+) *RouteDNSClientServiceGetGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetGroupCall) Times(n int) *RouteDNSClientServiceGetGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetGroupCall) AnyTimes() *RouteDNSClientServiceGetGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetGroupWithContext(ctx context.Context, params routedns.GetGroupParams) (*routedns.DnsRouteGroupOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.DnsRouteGroupOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetGroupWithContextCall is an expected call to GetGroupWithContext
+type RouteDNSClientServiceGetGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetGroupWithContext expects a single call to GetGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetGroupWithContext() *RouteDNSClientServiceGetGroupWithContextCall {
+	return &RouteDNSClientServiceGetGroupWithContextCall{m.controller.expect("GetGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetGroupWithContextCall) With(params routedns.GetGroupParams) *RouteDNSClientServiceGetGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetGroupWithContextCall) Return(r0 *routedns.DnsRouteGroupOK, r1 error) *RouteDNSClientServiceGetGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetGroupParams) (*routedns.DnsRouteGroupOK, error),
+) *RouteDNSClientServiceGetGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetGroupWithContextCall) Times(n int) *RouteDNSClientServiceGetGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetGroupWithContextCall) AnyTimes() *RouteDNSClientServiceGetGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddGroup(params routedns.AddGroupParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddGroupCall is an expected call to AddGroup
+type RouteDNSClientServiceAddGroupCall struct {
+	e *expectation
+}
+
+// ExpectAddGroup expects a single call to AddGroup with any arguments
+func (m *RouteDNSClientService) ExpectAddGroup() *RouteDNSClientServiceAddGroupCall {
+	return &RouteDNSClientServiceAddGroupCall{m.controller.expect("AddGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceAddGroupCall) With(params routedns.AddGroupParams) *RouteDNSClientServiceAddGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddGroupCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddGroupCall) Do(
+	fn func(params routedns.AddGroupParams) (*int, error),
+) *RouteDNSClientServiceAddGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.AddGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddGroupCall) Times(n int) *RouteDNSClientServiceAddGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddGroupCall) AnyTimes() *RouteDNSClientServiceAddGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddGroupWithContext(ctx context.Context, params routedns.AddGroupParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddGroupWithContextCall is an expected call to AddGroupWithContext
+type RouteDNSClientServiceAddGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddGroupWithContext expects a single call to AddGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectAddGroupWithContext() *RouteDNSClientServiceAddGroupWithContextCall {
+	return &RouteDNSClientServiceAddGroupWithContextCall{m.controller.expect("AddGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceAddGroupWithContextCall) With(params routedns.AddGroupParams) *RouteDNSClientServiceAddGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddGroupWithContextCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.AddGroupParams) (*int, error),
+) *RouteDNSClientServiceAddGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.AddGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddGroupWithContextCall) Times(n int) *RouteDNSClientServiceAddGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddGroupWithContextCall) AnyTimes() *RouteDNSClientServiceAddGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateGroup(params *routedns.UpdateGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateGroup",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateGroupCall is an expected call to UpdateGroup
+type RouteDNSClientServiceUpdateGroupCall struct {
+	e *expectation
+}
+
+// ExpectUpdateGroup expects a single call to UpdateGroup with any arguments
+func (m *RouteDNSClientService) ExpectUpdateGroup() *RouteDNSClientServiceUpdateGroupCall {
+	return &RouteDNSClientServiceUpdateGroupCall{m.controller.expect("UpdateGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceUpdateGroupCall) With(params *routedns.UpdateGroupParams) *RouteDNSClientServiceUpdateGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateGroupCall) Return(r0 error) *RouteDNSClientServiceUpdateGroupCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateGroupCall) Do(
+	fn func(params *routedns.UpdateGroupParams) error,
+) *RouteDNSClientServiceUpdateGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*routedns.UpdateGroupParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateGroupCall) Times(n int) *RouteDNSClientServiceUpdateGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateGroupCall) AnyTimes() *RouteDNSClientServiceUpdateGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateGroupWithContext(ctx context.Context, params *routedns.UpdateGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateGroupWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateGroupWithContextCall is an expected call to UpdateGroupWithContext
+type RouteDNSClientServiceUpdateGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateGroupWithContext expects a single call to UpdateGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectUpdateGroupWithContext() *RouteDNSClientServiceUpdateGroupWithContextCall {
+	return &RouteDNSClientServiceUpdateGroupWithContextCall{m.controller.expect("UpdateGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceUpdateGroupWithContextCall) With(params *routedns.UpdateGroupParams) *RouteDNSClientServiceUpdateGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateGroupWithContextCall) Return(r0 error) *RouteDNSClientServiceUpdateGroupWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateGroupWithContextCall) Do(
+	fn func(ctx context.Context, params *routedns.UpdateGroupParams) error,
+) *RouteDNSClientServiceUpdateGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*routedns.UpdateGroupParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateGroupWithContextCall) Times(n int) *RouteDNSClientServiceUpdateGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateGroupWithContextCall) AnyTimes() *RouteDNSClientServiceUpdateGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteGroup(params routedns.DeleteGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteGroup",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteGroupCall is an expected call to DeleteGroup
+type RouteDNSClientServiceDeleteGroupCall struct {
+	e *expectation
+}
+
+// ExpectDeleteGroup expects a single call to DeleteGroup with any arguments
+func (m *RouteDNSClientService) ExpectDeleteGroup() *RouteDNSClientServiceDeleteGroupCall {
+	return &RouteDNSClientServiceDeleteGroupCall{m.controller.expect("DeleteGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceDeleteGroupCall) With(params routedns.DeleteGroupParams) *RouteDNSClientServiceDeleteGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteGroupCall) Return(r0 error) *RouteDNSClientServiceDeleteGroupCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteGroupCall) Do(
+	fn func(params routedns.DeleteGroupParams) error,
+) *RouteDNSClientServiceDeleteGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.DeleteGroupParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteGroupCall) Times(n int) *RouteDNSClientServiceDeleteGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteGroupCall) AnyTimes() *RouteDNSClientServiceDeleteGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteGroupWithContext(ctx context.Context, params routedns.DeleteGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteGroupWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteGroupWithContextCall is an expected call to DeleteGroupWithContext
+type RouteDNSClientServiceDeleteGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteGroupWithContext expects a single call to DeleteGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectDeleteGroupWithContext() *RouteDNSClientServiceDeleteGroupWithContextCall {
+	return &RouteDNSClientServiceDeleteGroupWithContextCall{m.controller.expect("DeleteGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceDeleteGroupWithContextCall) With(params routedns.DeleteGroupParams) *RouteDNSClientServiceDeleteGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteGroupWithContextCall) Return(r0 error) *RouteDNSClientServiceDeleteGroupWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.DeleteGroupParams) error,
+) *RouteDNSClientServiceDeleteGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.DeleteGroupParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteGroupWithContextCall) Times(n int) *RouteDNSClientServiceDeleteGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteGroupWithContextCall) AnyTimes() *RouteDNSClientServiceDeleteGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllMasterServerGroups returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetAllMasterServerGroups(params routedns.GetAllMasterServerGroupsParams) (*[]routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllMasterServerGroups",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*[]routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetAllMasterServerGroupsCall is an expected call to GetAllMasterServerGroups
+type RouteDNSClientServiceGetAllMasterServerGroupsCall struct {
+	e *expectation
+}
+
+// ExpectGetAllMasterServerGroups expects a single call to GetAllMasterServerGroups with any arguments
+func (m *RouteDNSClientService) ExpectGetAllMasterServerGroups() *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	return &RouteDNSClientServiceGetAllMasterServerGroupsCall{m.controller.expect("GetAllMasterServerGroups")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsCall) With(params routedns.GetAllMasterServerGroupsParams) *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsCall) Return(r0 *[]routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsCall) Do(
+	fn func(params routedns.GetAllMasterServerGroupsParams) (*[]routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetAllMasterServerGroupsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsCall) Times(n int) *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsCall) AnyTimes() *RouteDNSClientServiceGetAllMasterServerGroupsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllMasterServerGroupsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetAllMasterServerGroupsWithContext(ctx context.Context, params routedns.GetAllMasterServerGroupsParams) (*[]routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllMasterServerGroupsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*[]routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall is an expected call to GetAllMasterServerGroupsWithContext
+type RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllMasterServerGroupsWithContext expects a single call to GetAllMasterServerGroupsWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetAllMasterServerGroupsWithContext() *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	return &RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall{m.controller.expect("GetAllMasterServerGroupsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall) With(params routedns.GetAllMasterServerGroupsParams) *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall) Return(r0 *[]routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetAllMasterServerGroupsParams) (*[]routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetAllMasterServerGroupsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall) Times(n int) *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall) AnyTimes() *RouteDNSClientServiceGetAllMasterServerGroupsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetMasterServerGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetMasterServerGroup(params routedns.GetMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetMasterServerGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetMasterServerGroupCall is an expected call to GetMasterServerGroup
+type RouteDNSClientServiceGetMasterServerGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetMasterServerGroup expects a single call to GetMasterServerGroup with any arguments
+func (m *RouteDNSClientService) ExpectGetMasterServerGroup() *RouteDNSClientServiceGetMasterServerGroupCall {
+	return &RouteDNSClientServiceGetMasterServerGroupCall{m.controller.expect("GetMasterServerGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetMasterServerGroupCall) With(params routedns.GetMasterServerGroupParams) *RouteDNSClientServiceGetMasterServerGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetMasterServerGroupCall) Return(r0 *routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceGetMasterServerGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetMasterServerGroupCall) Do(
+	fn func(params routedns.GetMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceGetMasterServerGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetMasterServerGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetMasterServerGroupCall) Times(n int) *RouteDNSClientServiceGetMasterServerGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetMasterServerGroupCall) AnyTimes() *RouteDNSClientServiceGetMasterServerGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetMasterServerGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetMasterServerGroupWithContext(ctx context.Context, params routedns.GetMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetMasterServerGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetMasterServerGroupWithContextCall is an expected call to GetMasterServerGroupWithContext
+type RouteDNSClientServiceGetMasterServerGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetMasterServerGroupWithContext expects a single call to GetMasterServerGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetMasterServerGroupWithContext() *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	return &RouteDNSClientServiceGetMasterServerGroupWithContextCall{m.controller.expect("GetMasterServerGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetMasterServerGroupWithContextCall) With(params routedns.GetMasterServerGroupParams) *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetMasterServerGroupWithContextCall) Return(r0 *routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetMasterServerGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetMasterServerGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetMasterServerGroupWithContextCall) Times(n int) *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetMasterServerGroupWithContextCall) AnyTimes() *RouteDNSClientServiceGetMasterServerGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddMasterServerGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddMasterServerGroup(params routedns.AddMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddMasterServerGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddMasterServerGroupCall is an expected call to AddMasterServerGroup
+type RouteDNSClientServiceAddMasterServerGroupCall struct {
+	e *expectation
+}
+
+// ExpectAddMasterServerGroup expects a single call to AddMasterServerGroup with any arguments
+func (m *RouteDNSClientService) ExpectAddMasterServerGroup() *RouteDNSClientServiceAddMasterServerGroupCall {
+	return &RouteDNSClientServiceAddMasterServerGroupCall{m.controller.expect("AddMasterServerGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceAddMasterServerGroupCall) With(params routedns.AddMasterServerGroupParams) *RouteDNSClientServiceAddMasterServerGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddMasterServerGroupCall) Return(r0 *routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceAddMasterServerGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddMasterServerGroupCall) Do(
+	fn func(params routedns.AddMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceAddMasterServerGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.AddMasterServerGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddMasterServerGroupCall) Times(n int) *RouteDNSClientServiceAddMasterServerGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddMasterServerGroupCall) AnyTimes() *RouteDNSClientServiceAddMasterServerGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddMasterServerGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddMasterServerGroupWithContext(ctx context.Context, params routedns.AddMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddMasterServerGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.MasterServerGroupAddGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddMasterServerGroupWithContextCall is an expected call to AddMasterServerGroupWithContext
+type RouteDNSClientServiceAddMasterServerGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddMasterServerGroupWithContext expects a single call to AddMasterServerGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectAddMasterServerGroupWithContext() *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	return &RouteDNSClientServiceAddMasterServerGroupWithContextCall{m.controller.expect("AddMasterServerGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceAddMasterServerGroupWithContextCall) With(params routedns.AddMasterServerGroupParams) *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddMasterServerGroupWithContextCall) Return(r0 *routedns.MasterServerGroupAddGetOK, r1 error) *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddMasterServerGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.AddMasterServerGroupParams) (*routedns.MasterServerGroupAddGetOK, error),
+) *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.AddMasterServerGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddMasterServerGroupWithContextCall) Times(n int) *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddMasterServerGroupWithContextCall) AnyTimes() *RouteDNSClientServiceAddMasterServerGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateMasterServerGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateMasterServerGroup(params routedns.UpdateMasterServerGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateMasterServerGroup",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateMasterServerGroupCall is an expected call to UpdateMasterServerGroup
+type RouteDNSClientServiceUpdateMasterServerGroupCall struct {
+	e *expectation
+}
+
+// ExpectUpdateMasterServerGroup expects a single call to UpdateMasterServerGroup with any arguments
+func (m *RouteDNSClientService) ExpectUpdateMasterServerGroup() *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	return &RouteDNSClientServiceUpdateMasterServerGroupCall{m.controller.expect("UpdateMasterServerGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceUpdateMasterServerGroupCall) With(params routedns.UpdateMasterServerGroupParams) *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateMasterServerGroupCall) Return(r0 error) *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateMasterServerGroupCall) Do(
+	fn func(params routedns.UpdateMasterServerGroupParams) error,
+) *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.UpdateMasterServerGroupParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateMasterServerGroupCall) Times(n int) *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateMasterServerGroupCall) AnyTimes() *RouteDNSClientServiceUpdateMasterServerGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateMasterServerGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateMasterServerGroupWithContext(ctx context.Context, params routedns.UpdateMasterServerGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateMasterServerGroupWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateMasterServerGroupWithContextCall is an expected call to UpdateMasterServerGroupWithContext
+type RouteDNSClientServiceUpdateMasterServerGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateMasterServerGroupWithContext expects a single call to UpdateMasterServerGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectUpdateMasterServerGroupWithContext() *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	return &RouteDNSClientServiceUpdateMasterServerGroupWithContextCall{m.controller.expect("UpdateMasterServerGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall) With(params routedns.UpdateMasterServerGroupParams) *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall) Return(r0 error) *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.UpdateMasterServerGroupParams) error,
+) *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.UpdateMasterServerGroupParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall) Times(n int) *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall) AnyTimes() *RouteDNSClientServiceUpdateMasterServerGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteMasterServerGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteMasterServerGroup(params routedns.DeleteMasterServerGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteMasterServerGroup",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteMasterServerGroupCall is an expected call to DeleteMasterServerGroup
+type RouteDNSClientServiceDeleteMasterServerGroupCall struct {
+	e *expectation
+}
+
+// ExpectDeleteMasterServerGroup expects a single call to DeleteMasterServerGroup with any arguments
+func (m *RouteDNSClientService) ExpectDeleteMasterServerGroup() *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	return &RouteDNSClientServiceDeleteMasterServerGroupCall{m.controller.expect("DeleteMasterServerGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceDeleteMasterServerGroupCall) With(params routedns.DeleteMasterServerGroupParams) *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteMasterServerGroupCall) Return(r0 error) *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteMasterServerGroupCall) Do(
+	fn func(params routedns.DeleteMasterServerGroupParams) error,
+) *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.DeleteMasterServerGroupParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteMasterServerGroupCall) Times(n int) *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteMasterServerGroupCall) AnyTimes() *RouteDNSClientServiceDeleteMasterServerGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteMasterServerGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteMasterServerGroupWithContext(ctx context.Context, params routedns.DeleteMasterServerGroupParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteMasterServerGroupWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteMasterServerGroupWithContextCall is an expected call to DeleteMasterServerGroupWithContext
+type RouteDNSClientServiceDeleteMasterServerGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteMasterServerGroupWithContext expects a single call to DeleteMasterServerGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectDeleteMasterServerGroupWithContext() *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	return &RouteDNSClientServiceDeleteMasterServerGroupWithContextCall{m.controller.expect("DeleteMasterServerGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall) With(params routedns.DeleteMasterServerGroupParams) *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall) Return(r0 error) *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.DeleteMasterServerGroupParams) error,
+) *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.DeleteMasterServerGroupParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall) Times(n int) *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall) AnyTimes() *RouteDNSClientServiceDeleteMasterServerGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetSecondaryZoneGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetSecondaryZoneGroup(params routedns.GetSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetSecondaryZoneGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.SecondaryZoneGroupResponseOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetSecondaryZoneGroupCall is an expected call to GetSecondaryZoneGroup
+type RouteDNSClientServiceGetSecondaryZoneGroupCall struct {
+	e *expectation
+}
+
+// ExpectGetSecondaryZoneGroup expects a single call to GetSecondaryZoneGroup with any arguments
+func (m *RouteDNSClientService) ExpectGetSecondaryZoneGroup() *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	return &RouteDNSClientServiceGetSecondaryZoneGroupCall{m.controller.expect("GetSecondaryZoneGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupCall) With(params routedns.GetSecondaryZoneGroupParams) *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupCall) Return(r0 *routedns.SecondaryZoneGroupResponseOK, r1 error) *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupCall) Do(
+	fn func(params routedns.GetSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error),
+) *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetSecondaryZoneGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupCall) Times(n int) *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupCall) AnyTimes() *RouteDNSClientServiceGetSecondaryZoneGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetSecondaryZoneGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetSecondaryZoneGroupWithContext(ctx context.Context, params routedns.GetSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetSecondaryZoneGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.SecondaryZoneGroupResponseOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall is an expected call to GetSecondaryZoneGroupWithContext
+type RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetSecondaryZoneGroupWithContext expects a single call to GetSecondaryZoneGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetSecondaryZoneGroupWithContext() *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	return &RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall{m.controller.expect("GetSecondaryZoneGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall) With(params routedns.GetSecondaryZoneGroupParams) *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall) Return(r0 *routedns.SecondaryZoneGroupResponseOK, r1 error) *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error),
+) *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetSecondaryZoneGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall) Times(n int) *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall) AnyTimes() *RouteDNSClientServiceGetSecondaryZoneGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddSecondaryZoneGroup returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddSecondaryZoneGroup(params routedns.AddSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddSecondaryZoneGroup",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.SecondaryZoneGroupResponseOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddSecondaryZoneGroupCall is an expected call to AddSecondaryZoneGroup
+type RouteDNSClientServiceAddSecondaryZoneGroupCall struct {
+	e *expectation
+}
+
+// ExpectAddSecondaryZoneGroup expects a single call to AddSecondaryZoneGroup with any arguments
+func (m *RouteDNSClientService) ExpectAddSecondaryZoneGroup() *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	return &RouteDNSClientServiceAddSecondaryZoneGroupCall{m.controller.expect("AddSecondaryZoneGroup")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupCall) With(params routedns.AddSecondaryZoneGroupParams) *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupCall) Return(r0 *routedns.SecondaryZoneGroupResponseOK, r1 error) *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupCall) Do(
+	fn func(params routedns.AddSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error),
+) *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.AddSecondaryZoneGroupParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupCall) Times(n int) *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupCall) AnyTimes() *RouteDNSClientServiceAddSecondaryZoneGroupCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddSecondaryZoneGroupWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddSecondaryZoneGroupWithContext(ctx context.Context, params routedns.AddSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddSecondaryZoneGroupWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.SecondaryZoneGroupResponseOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall is an expected call to AddSecondaryZoneGroupWithContext
+type RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddSecondaryZoneGroupWithContext expects a single call to AddSecondaryZoneGroupWithContext with any arguments
+func (m *RouteDNSClientService) ExpectAddSecondaryZoneGroupWithContext() *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	return &RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall{m.controller.expect("AddSecondaryZoneGroupWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall) With(params routedns.AddSecondaryZoneGroupParams) *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall) Return(r0 *routedns.SecondaryZoneGroupResponseOK, r1 error) *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.AddSecondaryZoneGroupParams) (*routedns.SecondaryZoneGroupResponseOK, error),
+) *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.AddSecondaryZoneGroupParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall) Times(n int) *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall) AnyTimes() *RouteDNSClientServiceAddSecondaryZoneGroupWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetTSIG returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetTSIG(params routedns.GetTSIGParams) (*routedns.TSIGGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetTSIG",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.TSIGGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetTSIGCall is an expected call to GetTSIG
+type RouteDNSClientServiceGetTSIGCall struct {
+	e *expectation
+}
+
+// ExpectGetTSIG expects a single call to GetTSIG with any arguments
+func (m *RouteDNSClientService) ExpectGetTSIG() *RouteDNSClientServiceGetTSIGCall {
+	return &RouteDNSClientServiceGetTSIGCall{m.controller.expect("GetTSIG")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetTSIGCall) With(params routedns.GetTSIGParams) *RouteDNSClientServiceGetTSIGCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetTSIGCall) Return(r0 *routedns.TSIGGetOK, r1 error) *RouteDNSClientServiceGetTSIGCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetTSIGCall) Do(
+	fn func(params routedns.GetTSIGParams) (*routedns.TSIGGetOK, error),
+) *RouteDNSClientServiceGetTSIGCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetTSIGParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetTSIGCall) Times(n int) *RouteDNSClientServiceGetTSIGCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetTSIGCall) AnyTimes() *RouteDNSClientServiceGetTSIGCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetTSIGWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetTSIGWithContext(ctx context.Context, params routedns.GetTSIGParams) (*routedns.TSIGGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetTSIGWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.TSIGGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetTSIGWithContextCall is an expected call to GetTSIGWithContext
+type RouteDNSClientServiceGetTSIGWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetTSIGWithContext expects a single call to GetTSIGWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetTSIGWithContext() *RouteDNSClientServiceGetTSIGWithContextCall {
+	return &RouteDNSClientServiceGetTSIGWithContextCall{m.controller.expect("GetTSIGWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetTSIGWithContextCall) With(params routedns.GetTSIGParams) *RouteDNSClientServiceGetTSIGWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetTSIGWithContextCall) Return(r0 *routedns.TSIGGetOK, r1 error) *RouteDNSClientServiceGetTSIGWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetTSIGWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetTSIGParams) (*routedns.TSIGGetOK, error),
+) *RouteDNSClientServiceGetTSIGWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetTSIGParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetTSIGWithContextCall) Times(n int) *RouteDNSClientServiceGetTSIGWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetTSIGWithContextCall) AnyTimes() *RouteDNSClientServiceGetTSIGWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddTSIG returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddTSIG(params routedns.AddTSIGParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddTSIG",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddTSIGCall is an expected call to AddTSIG
+type RouteDNSClientServiceAddTSIGCall struct {
+	e *expectation
+}
+
+// ExpectAddTSIG expects a single call to AddTSIG with any arguments
+func (m *RouteDNSClientService) ExpectAddTSIG() *RouteDNSClientServiceAddTSIGCall {
+	return &RouteDNSClientServiceAddTSIGCall{m.controller.expect("AddTSIG")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceAddTSIGCall) With(params routedns.AddTSIGParams) *RouteDNSClientServiceAddTSIGCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddTSIGCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddTSIGCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddTSIGCall) Do(
+	fn func(params routedns.AddTSIGParams) (*int, error),
+) *RouteDNSClientServiceAddTSIGCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.AddTSIGParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddTSIGCall) Times(n int) *RouteDNSClientServiceAddTSIGCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddTSIGCall) AnyTimes() *RouteDNSClientServiceAddTSIGCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddTSIGWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddTSIGWithContext(ctx context.Context, params routedns.AddTSIGParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddTSIGWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddTSIGWithContextCall is an expected call to AddTSIGWithContext
+type RouteDNSClientServiceAddTSIGWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddTSIGWithContext expects a single call to AddTSIGWithContext with any arguments
+func (m *RouteDNSClientService) ExpectAddTSIGWithContext() *RouteDNSClientServiceAddTSIGWithContextCall {
+	return &RouteDNSClientServiceAddTSIGWithContextCall{m.controller.expect("AddTSIGWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceAddTSIGWithContextCall) With(params routedns.AddTSIGParams) *RouteDNSClientServiceAddTSIGWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddTSIGWithContextCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddTSIGWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddTSIGWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.AddTSIGParams) (*int, error),
+) *RouteDNSClientServiceAddTSIGWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.AddTSIGParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddTSIGWithContextCall) Times(n int) *RouteDNSClientServiceAddTSIGWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddTSIGWithContextCall) AnyTimes() *RouteDNSClientServiceAddTSIGWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateTSIG returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateTSIG(params routedns.UpdateTSIGParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateTSIG",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateTSIGCall is an expected call to UpdateTSIG
+type RouteDNSClientServiceUpdateTSIGCall struct {
+	e *expectation
+}
+
+// ExpectUpdateTSIG expects a single call to UpdateTSIG with any arguments
+func (m *RouteDNSClientService) ExpectUpdateTSIG() *RouteDNSClientServiceUpdateTSIGCall {
+	return &RouteDNSClientServiceUpdateTSIGCall{m.controller.expect("UpdateTSIG")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceUpdateTSIGCall) With(params routedns.UpdateTSIGParams) *RouteDNSClientServiceUpdateTSIGCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateTSIGCall) Return(r0 error) *RouteDNSClientServiceUpdateTSIGCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateTSIGCall) Do(
+	fn func(params routedns.UpdateTSIGParams) error,
+) *RouteDNSClientServiceUpdateTSIGCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.UpdateTSIGParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateTSIGCall) Times(n int) *RouteDNSClientServiceUpdateTSIGCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateTSIGCall) AnyTimes() *RouteDNSClientServiceUpdateTSIGCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateTSIGWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateTSIGWithContext(ctx context.Context, params routedns.UpdateTSIGParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateTSIGWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateTSIGWithContextCall is an expected call to UpdateTSIGWithContext
+type RouteDNSClientServiceUpdateTSIGWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateTSIGWithContext expects a single call to UpdateTSIGWithContext with any arguments
+func (m *RouteDNSClientService) ExpectUpdateTSIGWithContext() *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	return &RouteDNSClientServiceUpdateTSIGWithContextCall{m.controller.expect("UpdateTSIGWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceUpdateTSIGWithContextCall) With(params routedns.UpdateTSIGParams) *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateTSIGWithContextCall) Return(r0 error) *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateTSIGWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.UpdateTSIGParams) error,
+) *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.UpdateTSIGParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateTSIGWithContextCall) Times(n int) *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateTSIGWithContextCall) AnyTimes() *RouteDNSClientServiceUpdateTSIGWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteTSIG returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteTSIG(params routedns.DeleteTSIGParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteTSIG",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteTSIGCall is an expected call to DeleteTSIG
+type RouteDNSClientServiceDeleteTSIGCall struct {
+	e *expectation
+}
+
+// ExpectDeleteTSIG expects a single call to DeleteTSIG with any arguments
+func (m *RouteDNSClientService) ExpectDeleteTSIG() *RouteDNSClientServiceDeleteTSIGCall {
+	return &RouteDNSClientServiceDeleteTSIGCall{m.controller.expect("DeleteTSIG")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceDeleteTSIGCall) With(params routedns.DeleteTSIGParams) *RouteDNSClientServiceDeleteTSIGCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteTSIGCall) Return(r0 error) *RouteDNSClientServiceDeleteTSIGCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteTSIGCall) Do(
+	fn func(params routedns.DeleteTSIGParams) error,
+) *RouteDNSClientServiceDeleteTSIGCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.DeleteTSIGParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteTSIGCall) Times(n int) *RouteDNSClientServiceDeleteTSIGCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteTSIGCall) AnyTimes() *RouteDNSClientServiceDeleteTSIGCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteTSIGWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteTSIGWithContext(ctx context.Context, params routedns.DeleteTSIGParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteTSIGWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteTSIGWithContextCall is an expected call to DeleteTSIGWithContext
+type RouteDNSClientServiceDeleteTSIGWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteTSIGWithContext expects a single call to DeleteTSIGWithContext with any arguments
+func (m *RouteDNSClientService) ExpectDeleteTSIGWithContext() *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	return &RouteDNSClientServiceDeleteTSIGWithContextCall{m.controller.expect("DeleteTSIGWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceDeleteTSIGWithContextCall) With(params routedns.DeleteTSIGParams) *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteTSIGWithContextCall) Return(r0 error) *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteTSIGWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.DeleteTSIGParams) error,
+) *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.DeleteTSIGParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteTSIGWithContextCall) Times(n int) *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteTSIGWithContextCall) AnyTimes() *RouteDNSClientServiceDeleteTSIGWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetZone returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetZone(params routedns.GetZoneParams) (*routedns.ZoneGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetZone",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*routedns.ZoneGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetZoneCall is an expected call to GetZone
+type RouteDNSClientServiceGetZoneCall struct {
+	e *expectation
+}
+
+// ExpectGetZone expects a single call to GetZone with any arguments
+func (m *RouteDNSClientService) ExpectGetZone() *RouteDNSClientServiceGetZoneCall {
+	return &RouteDNSClientServiceGetZoneCall{m.controller.expect("GetZone")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceGetZoneCall) With(params routedns.GetZoneParams) *RouteDNSClientServiceGetZoneCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetZoneCall) Return(r0 *routedns.ZoneGetOK, r1 error) *RouteDNSClientServiceGetZoneCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetZoneCall) Do(
+	fn func(params routedns.GetZoneParams) (*routedns.ZoneGetOK, error),
+) *RouteDNSClientServiceGetZoneCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.GetZoneParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetZoneCall) Times(n int) *RouteDNSClientServiceGetZoneCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetZoneCall) AnyTimes() *RouteDNSClientServiceGetZoneCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetZoneWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) GetZoneWithContext(ctx context.Context, params routedns.GetZoneParams) (*routedns.ZoneGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetZoneWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*routedns.ZoneGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceGetZoneWithContextCall is an expected call to GetZoneWithContext
+type RouteDNSClientServiceGetZoneWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetZoneWithContext expects a single call to GetZoneWithContext with any arguments
+func (m *RouteDNSClientService) ExpectGetZoneWithContext() *RouteDNSClientServiceGetZoneWithContextCall {
+	return &RouteDNSClientServiceGetZoneWithContextCall{m.controller.expect("GetZoneWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceGetZoneWithContextCall) With(params routedns.GetZoneParams) *RouteDNSClientServiceGetZoneWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceGetZoneWithContextCall) Return(r0 *routedns.ZoneGetOK, r1 error) *RouteDNSClientServiceGetZoneWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceGetZoneWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.GetZoneParams) (*routedns.ZoneGetOK, error),
+) *RouteDNSClientServiceGetZoneWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.GetZoneParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceGetZoneWithContextCall) Times(n int) *RouteDNSClientServiceGetZoneWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceGetZoneWithContextCall) AnyTimes() *RouteDNSClientServiceGetZoneWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddZone returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddZone(params routedns.AddZoneParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddZone",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddZoneCall is an expected call to AddZone
+type RouteDNSClientServiceAddZoneCall struct {
+	e *expectation
+}
+
+// ExpectAddZone expects a single call to AddZone with any arguments
+func (m *RouteDNSClientService) ExpectAddZone() *RouteDNSClientServiceAddZoneCall {
+	return &RouteDNSClientServiceAddZoneCall{m.controller.expect("AddZone")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceAddZoneCall) With(params routedns.AddZoneParams) *RouteDNSClientServiceAddZoneCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddZoneCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddZoneCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddZoneCall) Do(
+	fn func(params routedns.AddZoneParams) (*int, error),
+) *RouteDNSClientServiceAddZoneCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.AddZoneParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddZoneCall) Times(n int) *RouteDNSClientServiceAddZoneCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddZoneCall) AnyTimes() *RouteDNSClientServiceAddZoneCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddZoneWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) AddZoneWithContext(ctx context.Context, params routedns.AddZoneParams) (*int, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddZoneWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*int)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RouteDNSClientServiceAddZoneWithContextCall is an expected call to AddZoneWithContext
+type RouteDNSClientServiceAddZoneWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddZoneWithContext expects a single call to AddZoneWithContext with any arguments
+func (m *RouteDNSClientService) ExpectAddZoneWithContext() *RouteDNSClientServiceAddZoneWithContextCall {
+	return &RouteDNSClientServiceAddZoneWithContextCall{m.controller.expect("AddZoneWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceAddZoneWithContextCall) With(params routedns.AddZoneParams) *RouteDNSClientServiceAddZoneWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceAddZoneWithContextCall) Return(r0 *int, r1 error) *RouteDNSClientServiceAddZoneWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceAddZoneWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.AddZoneParams) (*int, error),
+) *RouteDNSClientServiceAddZoneWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.AddZoneParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceAddZoneWithContextCall) Times(n int) *RouteDNSClientServiceAddZoneWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceAddZoneWithContextCall) AnyTimes() *RouteDNSClientServiceAddZoneWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateZone returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateZone(params routedns.UpdateZoneParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateZone",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateZoneCall is an expected call to UpdateZone
+type RouteDNSClientServiceUpdateZoneCall struct {
+	e *expectation
+}
+
+// ExpectUpdateZone expects a single call to UpdateZone with any arguments
+func (m *RouteDNSClientService) ExpectUpdateZone() *RouteDNSClientServiceUpdateZoneCall {
+	return &RouteDNSClientServiceUpdateZoneCall{m.controller.expect("UpdateZone")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceUpdateZoneCall) With(params routedns.UpdateZoneParams) *RouteDNSClientServiceUpdateZoneCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateZoneCall) Return(r0 error) *RouteDNSClientServiceUpdateZoneCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateZoneCall) Do(
+	fn func(params routedns.UpdateZoneParams) error,
+) *RouteDNSClientServiceUpdateZoneCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.UpdateZoneParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateZoneCall) Times(n int) *RouteDNSClientServiceUpdateZoneCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateZoneCall) AnyTimes() *RouteDNSClientServiceUpdateZoneCall {
+	c.e.anyTimes()
+	return c
+}
+
+// UpdateZoneWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) UpdateZoneWithContext(ctx context.Context, params routedns.UpdateZoneParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"UpdateZoneWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceUpdateZoneWithContextCall is an expected call to UpdateZoneWithContext
+type RouteDNSClientServiceUpdateZoneWithContextCall struct {
+	e *expectation
+}
+
+// ExpectUpdateZoneWithContext expects a single call to UpdateZoneWithContext with any arguments
+func (m *RouteDNSClientService) ExpectUpdateZoneWithContext() *RouteDNSClientServiceUpdateZoneWithContextCall {
+	return &RouteDNSClientServiceUpdateZoneWithContextCall{m.controller.expect("UpdateZoneWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceUpdateZoneWithContextCall) With(params routedns.UpdateZoneParams) *RouteDNSClientServiceUpdateZoneWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceUpdateZoneWithContextCall) Return(r0 error) *RouteDNSClientServiceUpdateZoneWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceUpdateZoneWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.UpdateZoneParams) error,
+) *RouteDNSClientServiceUpdateZoneWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.UpdateZoneParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceUpdateZoneWithContextCall) Times(n int) *RouteDNSClientServiceUpdateZoneWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceUpdateZoneWithContextCall) AnyTimes() *RouteDNSClientServiceUpdateZoneWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteZone returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteZone(params routedns.DeleteZoneParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteZone",
+		[]interface{}{params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteZoneCall is an expected call to DeleteZone
+type RouteDNSClientServiceDeleteZoneCall struct {
+	e *expectation
+}
+
+// ExpectDeleteZone expects a single call to DeleteZone with any arguments
+func (m *RouteDNSClientService) ExpectDeleteZone() *RouteDNSClientServiceDeleteZoneCall {
+	return &RouteDNSClientServiceDeleteZoneCall{m.controller.expect("DeleteZone")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RouteDNSClientServiceDeleteZoneCall) With(params routedns.DeleteZoneParams) *RouteDNSClientServiceDeleteZoneCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteZoneCall) Return(r0 error) *RouteDNSClientServiceDeleteZoneCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteZoneCall) Do(
+	fn func(params routedns.DeleteZoneParams) error,
+) *RouteDNSClientServiceDeleteZoneCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(routedns.DeleteZoneParams)
+		r0 := fn(a0)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteZoneCall) Times(n int) *RouteDNSClientServiceDeleteZoneCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteZoneCall) AnyTimes() *RouteDNSClientServiceDeleteZoneCall {
+	c.e.anyTimes()
+	return c
+}
+
+// DeleteZoneWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RouteDNSClientService) DeleteZoneWithContext(ctx context.Context, params routedns.DeleteZoneParams) error {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"DeleteZoneWithContext",
+		[]interface{}{ctx, params},
+		1)
+	r0, _ := results[0].(error)
+	return r0
+}
+
+// RouteDNSClientServiceDeleteZoneWithContextCall is an expected call to DeleteZoneWithContext
+type RouteDNSClientServiceDeleteZoneWithContextCall struct {
+	e *expectation
+}
+
+// ExpectDeleteZoneWithContext expects a single call to DeleteZoneWithContext with any arguments
+func (m *RouteDNSClientService) ExpectDeleteZoneWithContext() *RouteDNSClientServiceDeleteZoneWithContextCall {
+	return &RouteDNSClientServiceDeleteZoneWithContextCall{m.controller.expect("DeleteZoneWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RouteDNSClientServiceDeleteZoneWithContextCall) With(params routedns.DeleteZoneParams) *RouteDNSClientServiceDeleteZoneWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RouteDNSClientServiceDeleteZoneWithContextCall) Return(r0 error) *RouteDNSClientServiceDeleteZoneWithContextCall {
+	c.e.returns([]interface{}{r0})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RouteDNSClientServiceDeleteZoneWithContextCall) Do(
+	fn func(ctx context.Context, params routedns.DeleteZoneParams) error,
+) *RouteDNSClientServiceDeleteZoneWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(routedns.DeleteZoneParams)
+		r0 := fn(a0, a1)
+		return []interface{}{r0}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RouteDNSClientServiceDeleteZoneWithContextCall) Times(n int) *RouteDNSClientServiceDeleteZoneWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RouteDNSClientServiceDeleteZoneWithContextCall) AnyTimes() *RouteDNSClientServiceDeleteZoneWithContextCall {
+	c.e.anyTimes()
+	return c
+}
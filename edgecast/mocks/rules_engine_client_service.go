@@ -0,0 +1,393 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rulesengine"
+)
+
+// RulesEngineClientService is a mock of rulesengine.ClientService
+type RulesEngineClientService struct {
+	controller *controller
+}
+
+var _ rulesengine.ClientService = (*RulesEngineClientService)(nil)
+
+// NewRulesEngineClientService creates a mock of rulesengine.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewRulesEngineClientService(t TestingT) *RulesEngineClientService {
+	return &RulesEngineClientService{controller: newController(t, "RulesEngineClientService")}
+}
+
+// AddPolicy returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) AddPolicy(params rulesengine.AddPolicyParams) (*rulesengine.PolicyResponse, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddPolicy",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*rulesengine.PolicyResponse)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceAddPolicyCall is an expected call to AddPolicy
+type RulesEngineClientServiceAddPolicyCall struct {
+	e *expectation
+}
+
+// ExpectAddPolicy expects a single call to AddPolicy with any arguments
+func (m *RulesEngineClientService) ExpectAddPolicy() *RulesEngineClientServiceAddPolicyCall {
+	return &RulesEngineClientServiceAddPolicyCall{m.controller.expect("AddPolicy")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RulesEngineClientServiceAddPolicyCall) With(params rulesengine.AddPolicyParams) *RulesEngineClientServiceAddPolicyCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceAddPolicyCall) Return(r0 *rulesengine.PolicyResponse, r1 error) *RulesEngineClientServiceAddPolicyCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceAddPolicyCall) Do(
+	fn func(params rulesengine.AddPolicyParams) (*rulesengine.PolicyResponse, error),
+) *RulesEngineClientServiceAddPolicyCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rulesengine.AddPolicyParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceAddPolicyCall) Times(n int) *RulesEngineClientServiceAddPolicyCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceAddPolicyCall) AnyTimes() *RulesEngineClientServiceAddPolicyCall {
+	c.e.anyTimes()
+	return c
+}
+
+// AddPolicyWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) AddPolicyWithContext(ctx context.Context, params rulesengine.AddPolicyParams) (*rulesengine.PolicyResponse, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"AddPolicyWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*rulesengine.PolicyResponse)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceAddPolicyWithContextCall is an expected call to AddPolicyWithContext
+type RulesEngineClientServiceAddPolicyWithContextCall struct {
+	e *expectation
+}
+
+// ExpectAddPolicyWithContext expects a single call to AddPolicyWithContext with any arguments
+func (m *RulesEngineClientService) ExpectAddPolicyWithContext() *RulesEngineClientServiceAddPolicyWithContextCall {
+	return &RulesEngineClientServiceAddPolicyWithContextCall{m.controller.expect("AddPolicyWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RulesEngineClientServiceAddPolicyWithContextCall) With(params rulesengine.AddPolicyParams) *RulesEngineClientServiceAddPolicyWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceAddPolicyWithContextCall) Return(r0 *rulesengine.PolicyResponse, r1 error) *RulesEngineClientServiceAddPolicyWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceAddPolicyWithContextCall) Do(
+	fn func(ctx context.Context, params rulesengine.AddPolicyParams) (*rulesengine.PolicyResponse, error),
+) *RulesEngineClientServiceAddPolicyWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rulesengine.AddPolicyParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceAddPolicyWithContextCall) Times(n int) *RulesEngineClientServiceAddPolicyWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceAddPolicyWithContextCall) AnyTimes() *RulesEngineClientServiceAddPolicyWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetPolicy returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) GetPolicy(params rulesengine.GetPolicyParams) (map[string]interface{}, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetPolicy",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(map[string]interface{})
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceGetPolicyCall is an expected call to GetPolicy
+type RulesEngineClientServiceGetPolicyCall struct {
+	e *expectation
+}
+
+// ExpectGetPolicy expects a single call to GetPolicy with any arguments
+func (m *RulesEngineClientService) ExpectGetPolicy() *RulesEngineClientServiceGetPolicyCall {
+	return &RulesEngineClientServiceGetPolicyCall{m.controller.expect("GetPolicy")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RulesEngineClientServiceGetPolicyCall) With(params rulesengine.GetPolicyParams) *RulesEngineClientServiceGetPolicyCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceGetPolicyCall) Return(r0 map[string]interface{}, r1 error) *RulesEngineClientServiceGetPolicyCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceGetPolicyCall) Do(
+	fn func(params rulesengine.GetPolicyParams) (map[string]interface{}, error),
+) *RulesEngineClientServiceGetPolicyCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rulesengine.GetPolicyParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceGetPolicyCall) Times(n int) *RulesEngineClientServiceGetPolicyCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceGetPolicyCall) AnyTimes() *RulesEngineClientServiceGetPolicyCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetPolicyWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) GetPolicyWithContext(ctx context.Context, params rulesengine.GetPolicyParams) (map[string]interface{}, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetPolicyWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(map[string]interface{})
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceGetPolicyWithContextCall is an expected call to GetPolicyWithContext
+type RulesEngineClientServiceGetPolicyWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetPolicyWithContext expects a single call to GetPolicyWithContext with any arguments
+func (m *RulesEngineClientService) ExpectGetPolicyWithContext() *RulesEngineClientServiceGetPolicyWithContextCall {
+	return &RulesEngineClientServiceGetPolicyWithContextCall{m.controller.expect("GetPolicyWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RulesEngineClientServiceGetPolicyWithContextCall) With(params rulesengine.GetPolicyParams) *RulesEngineClientServiceGetPolicyWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceGetPolicyWithContextCall) Return(r0 map[string]interface{}, r1 error) *RulesEngineClientServiceGetPolicyWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceGetPolicyWithContextCall) Do(
+	fn func(ctx context.Context, params rulesengine.GetPolicyParams) (map[string]interface{}, error),
+) *RulesEngineClientServiceGetPolicyWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rulesengine.GetPolicyParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceGetPolicyWithContextCall) Times(n int) *RulesEngineClientServiceGetPolicyWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceGetPolicyWithContextCall) AnyTimes() *RulesEngineClientServiceGetPolicyWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SubmitDeployRequest returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) SubmitDeployRequest(params rulesengine.SubmitDeployRequestParams) (*rulesengine.DeployRequestOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SubmitDeployRequest",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*rulesengine.DeployRequestOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceSubmitDeployRequestCall is an expected call to SubmitDeployRequest
+type RulesEngineClientServiceSubmitDeployRequestCall struct {
+	e *expectation
+}
+
+// ExpectSubmitDeployRequest expects a single call to SubmitDeployRequest with any arguments
+func (m *RulesEngineClientService) ExpectSubmitDeployRequest() *RulesEngineClientServiceSubmitDeployRequestCall {
+	return &RulesEngineClientServiceSubmitDeployRequestCall{m.controller.expect("SubmitDeployRequest")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *RulesEngineClientServiceSubmitDeployRequestCall) With(params rulesengine.SubmitDeployRequestParams) *RulesEngineClientServiceSubmitDeployRequestCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceSubmitDeployRequestCall) Return(r0 *rulesengine.DeployRequestOK, r1 error) *RulesEngineClientServiceSubmitDeployRequestCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceSubmitDeployRequestCall) Do(
+	fn func(params rulesengine.SubmitDeployRequestParams) (*rulesengine.DeployRequestOK, error),
+) *RulesEngineClientServiceSubmitDeployRequestCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(rulesengine.SubmitDeployRequestParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceSubmitDeployRequestCall) Times(n int) *RulesEngineClientServiceSubmitDeployRequestCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceSubmitDeployRequestCall) AnyTimes() *RulesEngineClientServiceSubmitDeployRequestCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SubmitDeployRequestWithContext returns the results of the expected call that matches its
+// arguments
+func (m *RulesEngineClientService) SubmitDeployRequestWithContext(ctx context.Context, params rulesengine.SubmitDeployRequestParams) (*rulesengine.DeployRequestOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SubmitDeployRequestWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*rulesengine.DeployRequestOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// RulesEngineClientServiceSubmitDeployRequestWithContextCall is an expected call to SubmitDeployRequestWithContext
+type RulesEngineClientServiceSubmitDeployRequestWithContextCall struct {
+	e *expectation
+}
+
+// ExpectSubmitDeployRequestWithContext expects a single call to SubmitDeployRequestWithContext with any arguments
+func (m *RulesEngineClientService) ExpectSubmitDeployRequestWithContext() *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	return &RulesEngineClientServiceSubmitDeployRequestWithContextCall{m.controller.expect("SubmitDeployRequestWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *RulesEngineClientServiceSubmitDeployRequestWithContextCall) With(params rulesengine.SubmitDeployRequestParams) *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *RulesEngineClientServiceSubmitDeployRequestWithContextCall) Return(r0 *rulesengine.DeployRequestOK, r1 error) *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *RulesEngineClientServiceSubmitDeployRequestWithContextCall) Do(
+	fn func(ctx context.Context, params rulesengine.SubmitDeployRequestParams) (*rulesengine.DeployRequestOK, error),
+) *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(rulesengine.SubmitDeployRequestParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *RulesEngineClientServiceSubmitDeployRequestWithContextCall) Times(n int) *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *RulesEngineClientServiceSubmitDeployRequestWithContextCall) AnyTimes() *RulesEngineClientServiceSubmitDeployRequestWithContextCall {
+	c.e.anyTimes()
+	return c
+}
@@ -0,0 +1,271 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/scopes"
+)
+
+// ScopesClientService is a mock of scopes.ClientService
+type ScopesClientService struct {
+	controller *controller
+}
+
+var _ scopes.ClientService = (*ScopesClientService)(nil)
+
+// NewScopesClientService creates a mock of scopes.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewScopesClientService(t TestingT) *ScopesClientService {
+	return &ScopesClientService{controller: newController(t, "ScopesClientService")}
+}
+
+// GetAllScopes returns the results of the expected call that matches its
+// arguments
+func (m *ScopesClientService) GetAllScopes(params scopes.GetAllScopesParams) (*scopes.Scopes, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllScopes",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*scopes.Scopes)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ScopesClientServiceGetAllScopesCall is an expected call to GetAllScopes
+type ScopesClientServiceGetAllScopesCall struct {
+	e *expectation
+}
+
+// ExpectGetAllScopes expects a single call to GetAllScopes with any arguments
+func (m *ScopesClientService) ExpectGetAllScopes() *ScopesClientServiceGetAllScopesCall {
+	return &ScopesClientServiceGetAllScopesCall{m.controller.expect("GetAllScopes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ScopesClientServiceGetAllScopesCall) With(params scopes.GetAllScopesParams) *ScopesClientServiceGetAllScopesCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ScopesClientServiceGetAllScopesCall) Return(r0 *scopes.Scopes, r1 error) *ScopesClientServiceGetAllScopesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ScopesClientServiceGetAllScopesCall) Do(
+	fn func(params scopes.GetAllScopesParams) (*scopes.Scopes, error),
+) *ScopesClientServiceGetAllScopesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(scopes.GetAllScopesParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ScopesClientServiceGetAllScopesCall) Times(n int) *ScopesClientServiceGetAllScopesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ScopesClientServiceGetAllScopesCall) AnyTimes() *ScopesClientServiceGetAllScopesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// GetAllScopesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ScopesClientService) GetAllScopesWithContext(ctx context.Context, params scopes.GetAllScopesParams) (*scopes.Scopes, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"GetAllScopesWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*scopes.Scopes)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ScopesClientServiceGetAllScopesWithContextCall is an expected call to GetAllScopesWithContext
+type ScopesClientServiceGetAllScopesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectGetAllScopesWithContext expects a single call to GetAllScopesWithContext with any arguments
+func (m *ScopesClientService) ExpectGetAllScopesWithContext() *ScopesClientServiceGetAllScopesWithContextCall {
+	return &ScopesClientServiceGetAllScopesWithContextCall{m.controller.expect("GetAllScopesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ScopesClientServiceGetAllScopesWithContextCall) With(params scopes.GetAllScopesParams) *ScopesClientServiceGetAllScopesWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ScopesClientServiceGetAllScopesWithContextCall) Return(r0 *scopes.Scopes, r1 error) *ScopesClientServiceGetAllScopesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ScopesClientServiceGetAllScopesWithContextCall) Do(
+	fn func(ctx context.Context, params scopes.GetAllScopesParams) (*scopes.Scopes, error),
+) *ScopesClientServiceGetAllScopesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(scopes.GetAllScopesParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ScopesClientServiceGetAllScopesWithContextCall) Times(n int) *ScopesClientServiceGetAllScopesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ScopesClientServiceGetAllScopesWithContextCall) AnyTimes() *ScopesClientServiceGetAllScopesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ModifyAllScopes returns the results of the expected call that matches its
+// arguments
+func (m *ScopesClientService) ModifyAllScopes(scopesParam scopes.Scopes) (*scopes.ModifyAllScopesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ModifyAllScopes",
+		[]interface{}{scopesParam},
+		2)
+	r0, _ := results[0].(*scopes.ModifyAllScopesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ScopesClientServiceModifyAllScopesCall is an expected call to ModifyAllScopes
+type ScopesClientServiceModifyAllScopesCall struct {
+	e *expectation
+}
+
+// ExpectModifyAllScopes expects a single call to ModifyAllScopes with any arguments
+func (m *ScopesClientService) ExpectModifyAllScopes() *ScopesClientServiceModifyAllScopesCall {
+	return &ScopesClientServiceModifyAllScopesCall{m.controller.expect("ModifyAllScopes")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *ScopesClientServiceModifyAllScopesCall) With(scopesParam scopes.Scopes) *ScopesClientServiceModifyAllScopesCall {
+	c.e.with([]interface{}{scopesParam})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ScopesClientServiceModifyAllScopesCall) Return(r0 *scopes.ModifyAllScopesOK, r1 error) *ScopesClientServiceModifyAllScopesCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ScopesClientServiceModifyAllScopesCall) Do(
+	fn func(scopesParam scopes.Scopes) (*scopes.ModifyAllScopesOK, error),
+) *ScopesClientServiceModifyAllScopesCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(scopes.Scopes)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ScopesClientServiceModifyAllScopesCall) Times(n int) *ScopesClientServiceModifyAllScopesCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ScopesClientServiceModifyAllScopesCall) AnyTimes() *ScopesClientServiceModifyAllScopesCall {
+	c.e.anyTimes()
+	return c
+}
+
+// ModifyAllScopesWithContext returns the results of the expected call that matches its
+// arguments
+func (m *ScopesClientService) ModifyAllScopesWithContext(ctx context.Context, scopesParam scopes.Scopes) (*scopes.ModifyAllScopesOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"ModifyAllScopesWithContext",
+		[]interface{}{ctx, scopesParam},
+		2)
+	r0, _ := results[0].(*scopes.ModifyAllScopesOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// ScopesClientServiceModifyAllScopesWithContextCall is an expected call to ModifyAllScopesWithContext
+type ScopesClientServiceModifyAllScopesWithContextCall struct {
+	e *expectation
+}
+
+// ExpectModifyAllScopesWithContext expects a single call to ModifyAllScopesWithContext with any arguments
+func (m *ScopesClientService) ExpectModifyAllScopesWithContext() *ScopesClientServiceModifyAllScopesWithContextCall {
+	return &ScopesClientServiceModifyAllScopesWithContextCall{m.controller.expect("ModifyAllScopesWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *ScopesClientServiceModifyAllScopesWithContextCall) With(scopesParam scopes.Scopes) *ScopesClientServiceModifyAllScopesWithContextCall {
+	c.e.with([]interface{}{anything{}, scopesParam})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *ScopesClientServiceModifyAllScopesWithContextCall) Return(r0 *scopes.ModifyAllScopesOK, r1 error) *ScopesClientServiceModifyAllScopesWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *ScopesClientServiceModifyAllScopesWithContextCall) Do(
+	fn func(ctx context.Context, scopesParam scopes.Scopes) (*scopes.ModifyAllScopesOK, error),
+) *ScopesClientServiceModifyAllScopesWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(scopes.Scopes)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *ScopesClientServiceModifyAllScopesWithContextCall) Times(n int) *ScopesClientServiceModifyAllScopesWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *ScopesClientServiceModifyAllScopesWithContextCall) AnyTimes() *ScopesClientServiceModifyAllScopesWithContextCall {
+	c.e.anyTimes()
+	return c
+}
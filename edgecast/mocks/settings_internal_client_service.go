@@ -0,0 +1,393 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/settings_internal"
+)
+
+// SettingsInternalClientService is a mock of settings_internal.ClientService
+type SettingsInternalClientService struct {
+	controller *controller
+}
+
+var _ settings_internal.ClientService = (*SettingsInternalClientService)(nil)
+
+// NewSettingsInternalClientService creates a mock of settings_internal.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewSettingsInternalClientService(t TestingT) *SettingsInternalClientService {
+	return &SettingsInternalClientService{controller: newController(t, "SettingsInternalClientService")}
+}
+
+// SettingsGetRlSettings returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetRlSettings(params *settings_internal.SettingsGetRlSettingsParams) (*settings_internal.SettingsGetRlSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetRlSettings",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetRlSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetRlSettingsCall is an expected call to SettingsGetRlSettings
+type SettingsInternalClientServiceSettingsGetRlSettingsCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetRlSettings expects a single call to SettingsGetRlSettings with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetRlSettings() *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	return &SettingsInternalClientServiceSettingsGetRlSettingsCall{m.controller.expect("SettingsGetRlSettings")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsCall) With(params *settings_internal.SettingsGetRlSettingsParams) *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsCall) Return(r0 *settings_internal.SettingsGetRlSettingsOK, r1 error) *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsCall) Do(
+	fn func(params *settings_internal.SettingsGetRlSettingsParams) (*settings_internal.SettingsGetRlSettingsOK, error),
+) *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*settings_internal.SettingsGetRlSettingsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsCall) Times(n int) *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsCall) AnyTimes() *SettingsInternalClientServiceSettingsGetRlSettingsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SettingsGetRlSettingsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetRlSettingsWithContext(ctx context.Context, params *settings_internal.SettingsGetRlSettingsParams) (*settings_internal.SettingsGetRlSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetRlSettingsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetRlSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall is an expected call to SettingsGetRlSettingsWithContext
+type SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetRlSettingsWithContext expects a single call to SettingsGetRlSettingsWithContext with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetRlSettingsWithContext() *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	return &SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall{m.controller.expect("SettingsGetRlSettingsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall) With(params *settings_internal.SettingsGetRlSettingsParams) *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall) Return(r0 *settings_internal.SettingsGetRlSettingsOK, r1 error) *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall) Do(
+	fn func(ctx context.Context, params *settings_internal.SettingsGetRlSettingsParams) (*settings_internal.SettingsGetRlSettingsOK, error),
+) *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*settings_internal.SettingsGetRlSettingsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall) Times(n int) *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall) AnyTimes() *SettingsInternalClientServiceSettingsGetRlSettingsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SettingsGetSettingsByPlatform returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetSettingsByPlatform(params *settings_internal.SettingsGetSettingsByPlatformParams) (*settings_internal.SettingsGetSettingsByPlatformOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetSettingsByPlatform",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetSettingsByPlatformOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetSettingsByPlatformCall is an expected call to SettingsGetSettingsByPlatform
+type SettingsInternalClientServiceSettingsGetSettingsByPlatformCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetSettingsByPlatform expects a single call to SettingsGetSettingsByPlatform with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetSettingsByPlatform() *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	return &SettingsInternalClientServiceSettingsGetSettingsByPlatformCall{m.controller.expect("SettingsGetSettingsByPlatform")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall) With(params *settings_internal.SettingsGetSettingsByPlatformParams) *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall) Return(r0 *settings_internal.SettingsGetSettingsByPlatformOK, r1 error) *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall) Do(
+	fn func(params *settings_internal.SettingsGetSettingsByPlatformParams) (*settings_internal.SettingsGetSettingsByPlatformOK, error),
+) *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*settings_internal.SettingsGetSettingsByPlatformParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall) Times(n int) *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall) AnyTimes() *SettingsInternalClientServiceSettingsGetSettingsByPlatformCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SettingsGetSettingsByPlatformWithContext returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetSettingsByPlatformWithContext(ctx context.Context, params *settings_internal.SettingsGetSettingsByPlatformParams) (*settings_internal.SettingsGetSettingsByPlatformOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetSettingsByPlatformWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetSettingsByPlatformOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall is an expected call to SettingsGetSettingsByPlatformWithContext
+type SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetSettingsByPlatformWithContext expects a single call to SettingsGetSettingsByPlatformWithContext with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetSettingsByPlatformWithContext() *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	return &SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall{m.controller.expect("SettingsGetSettingsByPlatformWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall) With(params *settings_internal.SettingsGetSettingsByPlatformParams) *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall) Return(r0 *settings_internal.SettingsGetSettingsByPlatformOK, r1 error) *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall) Do(
+	fn func(ctx context.Context, params *settings_internal.SettingsGetSettingsByPlatformParams) (*settings_internal.SettingsGetSettingsByPlatformOK, error),
+) *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*settings_internal.SettingsGetSettingsByPlatformParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall) Times(n int) *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall) AnyTimes() *SettingsInternalClientServiceSettingsGetSettingsByPlatformWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SettingsGetWafSettings returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetWafSettings(params *settings_internal.SettingsGetWafSettingsParams) (*settings_internal.SettingsGetWafSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetWafSettings",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetWafSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetWafSettingsCall is an expected call to SettingsGetWafSettings
+type SettingsInternalClientServiceSettingsGetWafSettingsCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetWafSettings expects a single call to SettingsGetWafSettings with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetWafSettings() *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	return &SettingsInternalClientServiceSettingsGetWafSettingsCall{m.controller.expect("SettingsGetWafSettings")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsCall) With(params *settings_internal.SettingsGetWafSettingsParams) *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsCall) Return(r0 *settings_internal.SettingsGetWafSettingsOK, r1 error) *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsCall) Do(
+	fn func(params *settings_internal.SettingsGetWafSettingsParams) (*settings_internal.SettingsGetWafSettingsOK, error),
+) *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(*settings_internal.SettingsGetWafSettingsParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsCall) Times(n int) *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsCall) AnyTimes() *SettingsInternalClientServiceSettingsGetWafSettingsCall {
+	c.e.anyTimes()
+	return c
+}
+
+// SettingsGetWafSettingsWithContext returns the results of the expected call that matches its
+// arguments
+func (m *SettingsInternalClientService) SettingsGetWafSettingsWithContext(ctx context.Context, params *settings_internal.SettingsGetWafSettingsParams) (*settings_internal.SettingsGetWafSettingsOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"SettingsGetWafSettingsWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*settings_internal.SettingsGetWafSettingsOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall is an expected call to SettingsGetWafSettingsWithContext
+type SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall struct {
+	e *expectation
+}
+
+// ExpectSettingsGetWafSettingsWithContext expects a single call to SettingsGetWafSettingsWithContext with any arguments
+func (m *SettingsInternalClientService) ExpectSettingsGetWafSettingsWithContext() *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	return &SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall{m.controller.expect("SettingsGetWafSettingsWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall) With(params *settings_internal.SettingsGetWafSettingsParams) *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall) Return(r0 *settings_internal.SettingsGetWafSettingsOK, r1 error) *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall) Do(
+	fn func(ctx context.Context, params *settings_internal.SettingsGetWafSettingsParams) (*settings_internal.SettingsGetWafSettingsOK, error),
+) *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(*settings_internal.SettingsGetWafSettingsParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall) Times(n int) *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall) AnyTimes() *SettingsInternalClientServiceSettingsGetWafSettingsWithContextCall {
+	c.e.anyTimes()
+	return c
+}
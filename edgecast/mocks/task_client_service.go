@@ -0,0 +1,515 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package mocks
+
+// This file was generated by mockgen from template/client/mock.gotmpl.
+// Any changes made to this file will be overwritten.
+
+import (
+	"context"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/cps/task"
+)
+
+// TaskClientService is a mock of task.ClientService
+type TaskClientService struct {
+	controller *controller
+}
+
+var _ task.ClientService = (*TaskClientService)(nil)
+
+// NewTaskClientService creates a mock of task.ClientService
+//
+// Each call must match an expectation, and each expectation must be met by the
+// end of the test.
+func NewTaskClientService(t TestingT) *TaskClientService {
+	return &TaskClientService{controller: newController(t, "TaskClientService")}
+}
+
+// TaskDelete returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskDelete(params task.TaskDeleteParams) (*task.TaskDeleteNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskDelete",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*task.TaskDeleteNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskDeleteCall is an expected call to TaskDelete
+type TaskClientServiceTaskDeleteCall struct {
+	e *expectation
+}
+
+// ExpectTaskDelete expects a single call to TaskDelete with any arguments
+func (m *TaskClientService) ExpectTaskDelete() *TaskClientServiceTaskDeleteCall {
+	return &TaskClientServiceTaskDeleteCall{m.controller.expect("TaskDelete")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *TaskClientServiceTaskDeleteCall) With(params task.TaskDeleteParams) *TaskClientServiceTaskDeleteCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskDeleteCall) Return(r0 *task.TaskDeleteNoContent, r1 error) *TaskClientServiceTaskDeleteCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskDeleteCall) Do(
+	fn func(params task.TaskDeleteParams) (*task.TaskDeleteNoContent, error),
+) *TaskClientServiceTaskDeleteCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(task.TaskDeleteParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskDeleteCall) Times(n int) *TaskClientServiceTaskDeleteCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskDeleteCall) AnyTimes() *TaskClientServiceTaskDeleteCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskDeleteWithContext returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskDeleteWithContext(ctx context.Context, params task.TaskDeleteParams) (*task.TaskDeleteNoContent, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskDeleteWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*task.TaskDeleteNoContent)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskDeleteWithContextCall is an expected call to TaskDeleteWithContext
+type TaskClientServiceTaskDeleteWithContextCall struct {
+	e *expectation
+}
+
+// ExpectTaskDeleteWithContext expects a single call to TaskDeleteWithContext with any arguments
+func (m *TaskClientService) ExpectTaskDeleteWithContext() *TaskClientServiceTaskDeleteWithContextCall {
+	return &TaskClientServiceTaskDeleteWithContextCall{m.controller.expect("TaskDeleteWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *TaskClientServiceTaskDeleteWithContextCall) With(params task.TaskDeleteParams) *TaskClientServiceTaskDeleteWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskDeleteWithContextCall) Return(r0 *task.TaskDeleteNoContent, r1 error) *TaskClientServiceTaskDeleteWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskDeleteWithContextCall) Do(
+	fn func(ctx context.Context, params task.TaskDeleteParams) (*task.TaskDeleteNoContent, error),
+) *TaskClientServiceTaskDeleteWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(task.TaskDeleteParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskDeleteWithContextCall) Times(n int) *TaskClientServiceTaskDeleteWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskDeleteWithContextCall) AnyTimes() *TaskClientServiceTaskDeleteWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskGet returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskGet(params task.TaskGetParams) (*task.TaskGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskGet",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*task.TaskGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskGetCall is an expected call to TaskGet
+type TaskClientServiceTaskGetCall struct {
+	e *expectation
+}
+
+// ExpectTaskGet expects a single call to TaskGet with any arguments
+func (m *TaskClientService) ExpectTaskGet() *TaskClientServiceTaskGetCall {
+	return &TaskClientServiceTaskGetCall{m.controller.expect("TaskGet")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *TaskClientServiceTaskGetCall) With(params task.TaskGetParams) *TaskClientServiceTaskGetCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskGetCall) Return(r0 *task.TaskGetOK, r1 error) *TaskClientServiceTaskGetCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskGetCall) Do(
+	fn func(params task.TaskGetParams) (*task.TaskGetOK, error),
+) *TaskClientServiceTaskGetCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(task.TaskGetParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskGetCall) Times(n int) *TaskClientServiceTaskGetCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskGetCall) AnyTimes() *TaskClientServiceTaskGetCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskGetWithContext returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskGetWithContext(ctx context.Context, params task.TaskGetParams) (*task.TaskGetOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskGetWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*task.TaskGetOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskGetWithContextCall is an expected call to TaskGetWithContext
+type TaskClientServiceTaskGetWithContextCall struct {
+	e *expectation
+}
+
+// ExpectTaskGetWithContext expects a single call to TaskGetWithContext with any arguments
+func (m *TaskClientService) ExpectTaskGetWithContext() *TaskClientServiceTaskGetWithContextCall {
+	return &TaskClientServiceTaskGetWithContextCall{m.controller.expect("TaskGetWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *TaskClientServiceTaskGetWithContextCall) With(params task.TaskGetParams) *TaskClientServiceTaskGetWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskGetWithContextCall) Return(r0 *task.TaskGetOK, r1 error) *TaskClientServiceTaskGetWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskGetWithContextCall) Do(
+	fn func(ctx context.Context, params task.TaskGetParams) (*task.TaskGetOK, error),
+) *TaskClientServiceTaskGetWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(task.TaskGetParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskGetWithContextCall) Times(n int) *TaskClientServiceTaskGetWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskGetWithContextCall) AnyTimes() *TaskClientServiceTaskGetWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskGetByStatus returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskGetByStatus(params task.TaskGetByStatusParams) (*task.TaskGetByStatusOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskGetByStatus",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*task.TaskGetByStatusOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskGetByStatusCall is an expected call to TaskGetByStatus
+type TaskClientServiceTaskGetByStatusCall struct {
+	e *expectation
+}
+
+// ExpectTaskGetByStatus expects a single call to TaskGetByStatus with any arguments
+func (m *TaskClientService) ExpectTaskGetByStatus() *TaskClientServiceTaskGetByStatusCall {
+	return &TaskClientServiceTaskGetByStatusCall{m.controller.expect("TaskGetByStatus")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *TaskClientServiceTaskGetByStatusCall) With(params task.TaskGetByStatusParams) *TaskClientServiceTaskGetByStatusCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskGetByStatusCall) Return(r0 *task.TaskGetByStatusOK, r1 error) *TaskClientServiceTaskGetByStatusCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskGetByStatusCall) Do(
+	fn func(params task.TaskGetByStatusParams) (*task.TaskGetByStatusOK, error),
+) *TaskClientServiceTaskGetByStatusCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(task.TaskGetByStatusParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskGetByStatusCall) Times(n int) *TaskClientServiceTaskGetByStatusCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskGetByStatusCall) AnyTimes() *TaskClientServiceTaskGetByStatusCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskGetByStatusWithContext returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskGetByStatusWithContext(ctx context.Context, params task.TaskGetByStatusParams) (*task.TaskGetByStatusOK, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskGetByStatusWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*task.TaskGetByStatusOK)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskGetByStatusWithContextCall is an expected call to TaskGetByStatusWithContext
+type TaskClientServiceTaskGetByStatusWithContextCall struct {
+	e *expectation
+}
+
+// ExpectTaskGetByStatusWithContext expects a single call to TaskGetByStatusWithContext with any arguments
+func (m *TaskClientService) ExpectTaskGetByStatusWithContext() *TaskClientServiceTaskGetByStatusWithContextCall {
+	return &TaskClientServiceTaskGetByStatusWithContextCall{m.controller.expect("TaskGetByStatusWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *TaskClientServiceTaskGetByStatusWithContextCall) With(params task.TaskGetByStatusParams) *TaskClientServiceTaskGetByStatusWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskGetByStatusWithContextCall) Return(r0 *task.TaskGetByStatusOK, r1 error) *TaskClientServiceTaskGetByStatusWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskGetByStatusWithContextCall) Do(
+	fn func(ctx context.Context, params task.TaskGetByStatusParams) (*task.TaskGetByStatusOK, error),
+) *TaskClientServiceTaskGetByStatusWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(task.TaskGetByStatusParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskGetByStatusWithContextCall) Times(n int) *TaskClientServiceTaskGetByStatusWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskGetByStatusWithContextCall) AnyTimes() *TaskClientServiceTaskGetByStatusWithContextCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskPost returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskPost(params task.TaskPostParams) (*task.TaskPostCreated, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskPost",
+		[]interface{}{params},
+		2)
+	r0, _ := results[0].(*task.TaskPostCreated)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskPostCall is an expected call to TaskPost
+type TaskClientServiceTaskPostCall struct {
+	e *expectation
+}
+
+// ExpectTaskPost expects a single call to TaskPost with any arguments
+func (m *TaskClientService) ExpectTaskPost() *TaskClientServiceTaskPostCall {
+	return &TaskClientServiceTaskPostCall{m.controller.expect("TaskPost")}
+}
+
+// With restricts the expected call to the given arguments
+func (c *TaskClientServiceTaskPostCall) With(params task.TaskPostParams) *TaskClientServiceTaskPostCall {
+	c.e.with([]interface{}{params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskPostCall) Return(r0 *task.TaskPostCreated, r1 error) *TaskClientServiceTaskPostCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskPostCall) Do(
+	fn func(params task.TaskPostParams) (*task.TaskPostCreated, error),
+) *TaskClientServiceTaskPostCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(task.TaskPostParams)
+		r0, r1 := fn(a0)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskPostCall) Times(n int) *TaskClientServiceTaskPostCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskPostCall) AnyTimes() *TaskClientServiceTaskPostCall {
+	c.e.anyTimes()
+	return c
+}
+
+// TaskPostWithContext returns the results of the expected call that matches its
+// arguments
+func (m *TaskClientService) TaskPostWithContext(ctx context.Context, params task.TaskPostParams) (*task.TaskPostCreated, error) {
+	m.controller.t.Helper()
+	results := m.controller.call(
+		"TaskPostWithContext",
+		[]interface{}{ctx, params},
+		2)
+	r0, _ := results[0].(*task.TaskPostCreated)
+	r1, _ := results[1].(error)
+	return r0, r1
+}
+
+// TaskClientServiceTaskPostWithContextCall is an expected call to TaskPostWithContext
+type TaskClientServiceTaskPostWithContextCall struct {
+	e *expectation
+}
+
+// ExpectTaskPostWithContext expects a single call to TaskPostWithContext with any arguments
+func (m *TaskClientService) ExpectTaskPostWithContext() *TaskClientServiceTaskPostWithContextCall {
+	return &TaskClientServiceTaskPostWithContextCall{m.controller.expect("TaskPostWithContext")}
+}
+
+// With restricts the expected call to the given arguments. The context is not
+// compared.
+func (c *TaskClientServiceTaskPostWithContextCall) With(params task.TaskPostParams) *TaskClientServiceTaskPostWithContextCall {
+	c.e.with([]interface{}{anything{}, params})
+	return c
+}
+
+// Return sets the results of the expected call
+func (c *TaskClientServiceTaskPostWithContextCall) Return(r0 *task.TaskPostCreated, r1 error) *TaskClientServiceTaskPostWithContextCall {
+	c.e.returns([]interface{}{r0, r1})
+	return c
+}
+
+// Do makes the expected call return the results of fn, which is passed the
+// arguments of the call
+func (c *TaskClientServiceTaskPostWithContextCall) Do(
+	fn func(ctx context.Context, params task.TaskPostParams) (*task.TaskPostCreated, error),
+) *TaskClientServiceTaskPostWithContextCall {
+	c.e.do(func(args []interface{}) []interface{} {
+		a0, _ := args[0].(context.Context)
+		a1, _ := args[1].(task.TaskPostParams)
+		r0, r1 := fn(a0, a1)
+		return []interface{}{r0, r1}
+	})
+	return c
+}
+
+// Times sets the number of times the call is expected
+func (c *TaskClientServiceTaskPostWithContextCall) Times(n int) *TaskClientServiceTaskPostWithContextCall {
+	c.e.times(n)
+	return c
+}
+
+// AnyTimes allows the call any number of times, including none
+func (c *TaskClientServiceTaskPostWithContextCall) AnyTimes() *TaskClientServiceTaskPostWithContextCall {
+	c.e.anyTimes()
+	return c
+}
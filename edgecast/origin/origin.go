@@ -3,6 +3,7 @@
 package origin
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,17 +15,28 @@ import (
 // with the provided platform.
 func (svc *OriginService) GetAllOrigins(
 	params GetAllOriginsParams,
+) (*[]OriginGetOK, error) {
+	return svc.GetAllOriginsWithContext(context.Background(), params)
+}
+
+// GetAllOriginsWithContext is the same as GetAllOrigins with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) GetAllOriginsWithContext(
+	ctx context.Context,
+	params GetAllOriginsParams,
 ) (*[]OriginGetOK, error) {
 	parsedResponse := &[]OriginGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetAllOrigins: %w", err)
 	}
@@ -33,17 +45,28 @@ func (svc *OriginService) GetAllOrigins(
 
 // AddOrigin adds a customer origin to the specified platform.
 func (svc *OriginService) AddOrigin(params AddOriginParams) (*int, error) {
+	return svc.AddOriginWithContext(context.Background(), params)
+}
+
+// AddOriginWithContext is the same as AddOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) AddOriginWithContext(
+	ctx context.Context,
+	params AddOriginParams,
+) (*int, error) {
 	parsedResponse := &AddUpdateOriginOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
-		},
-		RawBody:        params.Origin,
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
+			},
+			RawBody:        params.Origin,
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("AddOrigin: %w", err)
 	}
@@ -53,18 +76,29 @@ func (svc *OriginService) AddOrigin(params AddOriginParams) (*int, error) {
 // GetOrigin retrieves the properties of a customer origin configuration.
 func (svc *OriginService) GetOrigin(
 	params GetOriginParams,
+) (*OriginGetOK, error) {
+	return svc.GetOriginWithContext(context.Background(), params)
+}
+
+// GetOriginWithContext is the same as GetOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) GetOriginWithContext(
+	ctx context.Context,
+	params GetOriginParams,
 ) (*OriginGetOK, error) {
 	parsedResponse := &OriginGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
-			"origin_id":      strconv.Itoa(params.CustomerOriginID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
+				"origin_id":      strconv.Itoa(params.CustomerOriginID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetOrigin: %w", err)
 	}
@@ -74,19 +108,30 @@ func (svc *OriginService) GetOrigin(
 // UpdateOrigin sets the properties for a customer origin.
 func (svc *OriginService) UpdateOrigin(
 	params UpdateOriginParams,
+) (*int, error) {
+	return svc.UpdateOriginWithContext(context.Background(), params)
+}
+
+// UpdateOriginWithContext is the same as UpdateOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) UpdateOriginWithContext(
+	ctx context.Context,
+	params UpdateOriginParams,
 ) (*int, error) {
 	parsedResponse := &AddUpdateOriginOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.Origin.MediaTypeID.StringWithoutHyphen(),
-			"origin_id":      strconv.Itoa(params.Origin.ID),
-		},
-		RawBody:        params.Origin,
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.Origin.MediaTypeID.StringWithoutHyphen(),
+				"origin_id":      strconv.Itoa(params.Origin.ID),
+			},
+			RawBody:        params.Origin,
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("UpdateOrigin: %w", err)
 	}
@@ -95,14 +140,25 @@ func (svc *OriginService) UpdateOrigin(
 
 // DeleteOrigin deletes a customer origin.
 func (svc *OriginService) DeleteOrigin(params DeleteOriginParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/origins/{origin_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"origin_id":      strconv.Itoa(params.Origin.ID),
-		},
-	})
+	return svc.DeleteOriginWithContext(context.Background(), params)
+}
+
+// DeleteOriginWithContext is the same as DeleteOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) DeleteOriginWithContext(
+	ctx context.Context,
+	params DeleteOriginParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/origins/{origin_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"origin_id":      strconv.Itoa(params.Origin.ID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("DeleteOrigin: %w", err)
 	}
@@ -113,12 +169,22 @@ func (svc *OriginService) DeleteOrigin(params DeleteOriginParams) error {
 // service. Ensure that our CDN may communicate with your web servers by
 // allowlisting these IP blocks on your firewall.
 func (svc *OriginService) GetCDNIPBlocks() (*CDNIPBlocksOK, error) {
+	return svc.GetCDNIPBlocksWithContext(context.Background())
+}
+
+// GetCDNIPBlocksWithContext is the same as GetCDNIPBlocks with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (svc *OriginService) GetCDNIPBlocksWithContext(
+	ctx context.Context,
+) (*CDNIPBlocksOK, error) {
 	parsedResponse := &CDNIPBlocksOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:         ecclient.Get,
-		Path:           "v2/mcc/customers/superblocks",
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:         ecclient.Get,
+			Path:           "v2/mcc/customers/superblocks",
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetOrigin: %w", err)
 	}
@@ -130,17 +196,29 @@ func (svc *OriginService) GetCDNIPBlocks() (*CDNIPBlocksOK, error) {
 // allowlisting these IP blocks on your firewall.
 func (svc *OriginService) GetOriginPropagationStatus(
 	params GetOriginPropagationStatusParams,
+) (*ecmodels.PropagationStatus, error) {
+	return svc.GetOriginPropagationStatusWithContext(context.Background(), params)
+}
+
+// GetOriginPropagationStatusWithContext is the same as
+// GetOriginPropagationStatus with the addition of a context.Context that
+// governs cancellation and deadlines for the call.
+func (svc *OriginService) GetOriginPropagationStatusWithContext(
+	ctx context.Context,
+	params GetOriginPropagationStatusParams,
 ) (*ecmodels.PropagationStatus, error) {
 	parsedResponse := &ecmodels.PropagationStatus{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/origins/{origin_id}/status",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"origin_id":      strconv.Itoa(params.CustomerOriginID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/origins/{origin_id}/status",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"origin_id":      strconv.Itoa(params.CustomerOriginID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetOriginPropagationStatus: %w", err)
 	}
@@ -155,17 +233,29 @@ func (svc *OriginService) GetOriginPropagationStatus(
 // This applies to HTTPLarge and HTTPSmall platform origins
 func (svc *OriginService) GetOriginShieldPOPs(
 	params GetOriginShieldPOPsParams,
+) (*[]ShieldPOP, error) {
+	return svc.GetOriginShieldPOPsWithContext(context.Background(), params)
+}
+
+// GetOriginShieldPOPsWithContext is the same as GetOriginShieldPOPs with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *OriginService) GetOriginShieldPOPsWithContext(
+	ctx context.Context,
+	params GetOriginShieldPOPsParams,
 ) (*[]ShieldPOP, error) {
 	parsedResponse := &[]ShieldPOP{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/shieldpops",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/shieldpops",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("GetOriginShieldPOPs: %w", err)
 	}
@@ -178,15 +268,27 @@ func (svc *OriginService) GetOriginShieldPOPs(
 func (svc *OriginService) ReselectADNGateways(
 	params ReselectADNGatewaysParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}/reselect",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
-			"origin_id":      strconv.Itoa(params.CustomerOriginID),
-		},
-	})
+	return svc.ReselectADNGatewaysWithContext(context.Background(), params)
+}
+
+// ReselectADNGatewaysWithContext is the same as ReselectADNGateways with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *OriginService) ReselectADNGatewaysWithContext(
+	ctx context.Context,
+	params ReselectADNGatewaysParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "v2/mcc/customers/{account_number}/origins/{platform_id}/{origin_id}/reselect",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"platform_id":    params.MediaTypeID.StringWithoutHyphen(),
+				"origin_id":      strconv.Itoa(params.CustomerOriginID),
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("ReselectADNGateways: %w", err)
 	}
@@ -4,12 +4,13 @@
 package origin
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecmodels"
 )
 
 // Origin service interacts with the EdgeCast API for managing Origins
@@ -18,20 +19,113 @@ type OriginService struct {
 	logger eclog.Logger
 }
 
+// ClientService is the interface for OriginService methods
+type ClientService interface {
+	GetAllOrigins(
+		params GetAllOriginsParams,
+	) (*[]OriginGetOK, error)
+
+	GetAllOriginsWithContext(
+		ctx context.Context,
+		params GetAllOriginsParams,
+	) (*[]OriginGetOK, error)
+
+	AddOrigin(params AddOriginParams) (*int, error)
+
+	AddOriginWithContext(
+		ctx context.Context,
+		params AddOriginParams,
+	) (*int, error)
+
+	GetOrigin(
+		params GetOriginParams,
+	) (*OriginGetOK, error)
+
+	GetOriginWithContext(
+		ctx context.Context,
+		params GetOriginParams,
+	) (*OriginGetOK, error)
+
+	UpdateOrigin(
+		params UpdateOriginParams,
+	) (*int, error)
+
+	UpdateOriginWithContext(
+		ctx context.Context,
+		params UpdateOriginParams,
+	) (*int, error)
+
+	DeleteOrigin(params DeleteOriginParams) error
+
+	DeleteOriginWithContext(
+		ctx context.Context,
+		params DeleteOriginParams,
+	) error
+
+	GetCDNIPBlocks() (*CDNIPBlocksOK, error)
+
+	GetCDNIPBlocksWithContext(
+		ctx context.Context,
+	) (*CDNIPBlocksOK, error)
+
+	GetOriginPropagationStatus(
+		params GetOriginPropagationStatusParams,
+	) (*ecmodels.PropagationStatus, error)
+
+	GetOriginPropagationStatusWithContext(
+		ctx context.Context,
+		params GetOriginPropagationStatusParams,
+	) (*ecmodels.PropagationStatus, error)
+
+	GetOriginShieldPOPs(
+		params GetOriginShieldPOPsParams,
+	) (*[]ShieldPOP, error)
+
+	GetOriginShieldPOPsWithContext(
+		ctx context.Context,
+		params GetOriginShieldPOPsParams,
+	) (*[]ShieldPOP, error)
+
+	ReselectADNGateways(
+		params ReselectADNGatewaysParams,
+	) error
+
+	ReselectADNGatewaysWithContext(
+		ctx context.Context,
+		params ReselectADNGatewaysParams,
+	) error
+}
+
+var _ ClientService = (*OriginService)(nil)
+
 // New creates a new Origin service
 func New(config edgecast.SDKConfig) (*OriginService, error) {
 
-	authProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("origin.New(): %w", err)
+	}
 
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "origin", Token: true})
 	if err != nil {
-		return nil, fmt.Errorf("origin.New(): %v", err)
+		return nil, fmt.Errorf("origin.New(): %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURLLegacy,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURLLegacy,
+		ServiceName:     "origin",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &OriginService{
@@ -15,6 +15,7 @@ Contact: portals-streaming@edgecast.com
 package originv3
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -41,15 +42,34 @@ type AdnOnlyClientService interface {
 		params AddAdnGroupParams,
 	) (*CustomerOriginGroupADN, error)
 
+	AddAdnGroupWithContext(
+		ctx context.Context,
+		params AddAdnGroupParams,
+	) (*CustomerOriginGroupADN, error)
+
 	GetAdnGroup(
 		params GetAdnGroupParams,
 	) (*CustomerOriginGroupADN, error)
 
+	GetAdnGroupWithContext(
+		ctx context.Context,
+		params GetAdnGroupParams,
+	) (*CustomerOriginGroupADN, error)
+
 	GetAllAdnGroups() ([]CustomerOriginGroupADN, error)
 
+	GetAllAdnGroupsWithContext(
+		ctx context.Context,
+	) ([]CustomerOriginGroupADN, error)
+
 	UpdateAdnGroup(
 		params UpdateAdnGroupParams,
 	) (*CustomerOriginGroupADN, error)
+
+	UpdateAdnGroupWithContext(
+		ctx context.Context,
+		params UpdateAdnGroupParams,
+	) (*CustomerOriginGroupADN, error)
 }
 
 // AddAdnGroupParams contains the parameters for AddAdnGroup
@@ -67,6 +87,15 @@ func NewAddAdnGroupParams() AddAdnGroupParams {
 //	Create Adn new Customer Origin Group
 func (c AdnOnlyClient) AddAdnGroup(
 	params AddAdnGroupParams,
+) (*CustomerOriginGroupADN, error) {
+	return c.AddAdnGroupWithContext(context.Background(), params)
+}
+
+// AddAdnGroupWithContext is the same as AddAdnGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c AdnOnlyClient) AddAdnGroupWithContext(
+	ctx context.Context,
+	params AddAdnGroupParams,
 ) (*CustomerOriginGroupADN, error) {
 	req, err := buildAddAdnGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -76,7 +105,7 @@ func (c AdnOnlyClient) AddAdnGroup(
 	parsedResponse := CustomerOriginGroupADN{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("AddAdnGroup: %w", err)
@@ -124,6 +153,15 @@ func NewGetAdnGroupParams() GetAdnGroupParams {
 //	Get an individual Adn Customer Origin Group
 func (c AdnOnlyClient) GetAdnGroup(
 	params GetAdnGroupParams,
+) (*CustomerOriginGroupADN, error) {
+	return c.GetAdnGroupWithContext(context.Background(), params)
+}
+
+// GetAdnGroupWithContext is the same as GetAdnGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c AdnOnlyClient) GetAdnGroupWithContext(
+	ctx context.Context,
+	params GetAdnGroupParams,
 ) (*CustomerOriginGroupADN, error) {
 	req, err := buildGetAdnGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -133,7 +171,7 @@ func (c AdnOnlyClient) GetAdnGroup(
 	parsedResponse := CustomerOriginGroupADN{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAdnGroup: %w", err)
@@ -170,6 +208,14 @@ func buildGetAdnGroupRequest(
 //
 //	Get list of Adn Customer Origin Groups
 func (c AdnOnlyClient) GetAllAdnGroups() ([]CustomerOriginGroupADN, error) {
+	return c.GetAllAdnGroupsWithContext(context.Background())
+}
+
+// GetAllAdnGroupsWithContext is the same as GetAllAdnGroups with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c AdnOnlyClient) GetAllAdnGroupsWithContext(
+	ctx context.Context,
+) ([]CustomerOriginGroupADN, error) {
 	req, err := buildGetAllAdnGroupsRequest(c.baseAPIURL)
 	if err != nil {
 		return nil, err
@@ -178,7 +224,7 @@ func (c AdnOnlyClient) GetAllAdnGroups() ([]CustomerOriginGroupADN, error) {
 	parsedResponse := make([]CustomerOriginGroupADN, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAllAdnGroups: %w", err)
@@ -226,6 +272,15 @@ func NewUpdateAdnGroupParams() UpdateAdnGroupParams {
 //	Update an individual Adn Customer Origin Group
 func (c AdnOnlyClient) UpdateAdnGroup(
 	params UpdateAdnGroupParams,
+) (*CustomerOriginGroupADN, error) {
+	return c.UpdateAdnGroupWithContext(context.Background(), params)
+}
+
+// UpdateAdnGroupWithContext is the same as UpdateAdnGroup with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c AdnOnlyClient) UpdateAdnGroupWithContext(
+	ctx context.Context,
+	params UpdateAdnGroupParams,
 ) (*CustomerOriginGroupADN, error) {
 	req, err := buildUpdateAdnGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -235,7 +290,7 @@ func (c AdnOnlyClient) UpdateAdnGroup(
 	parsedResponse := CustomerOriginGroupADN{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("UpdateAdnGroup: %w", err)
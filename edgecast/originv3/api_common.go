@@ -15,6 +15,7 @@ Contact: portals-streaming@edgecast.com
 package originv3
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -41,41 +42,91 @@ type CommonClientService interface {
 		params AddOriginParams,
 	) (*CustomerOrigin, error)
 
+	AddOriginWithContext(
+		ctx context.Context,
+		params AddOriginParams,
+	) (*CustomerOrigin, error)
+
 	DeleteGroup(
 		params DeleteGroupParams,
 	) error
 
+	DeleteGroupWithContext(
+		ctx context.Context,
+		params DeleteGroupParams,
+	) error
+
 	DeleteOrigin(
 		params DeleteOriginParams,
 	) error
 
+	DeleteOriginWithContext(
+		ctx context.Context,
+		params DeleteOriginParams,
+	) error
+
 	GetAllOrigins(
 		params GetAllOriginsParams,
 	) ([]CustomerOrigin, error)
 
+	GetAllOriginsWithContext(
+		ctx context.Context,
+		params GetAllOriginsParams,
+	) ([]CustomerOrigin, error)
+
 	GetEdgeFunctions(
 		params GetEdgeFunctionsParams,
 	) ([]EdgeFunction, error)
 
+	GetEdgeFunctionsWithContext(
+		ctx context.Context,
+		params GetEdgeFunctionsParams,
+	) ([]EdgeFunction, error)
+
 	GetGroupStatus(
 		params GetGroupStatusParams,
 	) (*CustomerOriginStatus, error)
 
+	GetGroupStatusWithContext(
+		ctx context.Context,
+		params GetGroupStatusParams,
+	) (*CustomerOriginStatus, error)
+
 	GetOrigin(
 		params GetOriginParams,
 	) (*CustomerOrigin, error)
 
+	GetOriginWithContext(
+		ctx context.Context,
+		params GetOriginParams,
+	) (*CustomerOrigin, error)
+
 	GetOriginsByGroup(
 		params GetOriginsByGroupParams,
 	) ([]CustomerOriginFailoverOrder, error)
 
+	GetOriginsByGroupWithContext(
+		ctx context.Context,
+		params GetOriginsByGroupParams,
+	) ([]CustomerOriginFailoverOrder, error)
+
 	UpdateFailoverOrder(
 		params UpdateFailoverOrderParams,
 	) error
 
+	UpdateFailoverOrderWithContext(
+		ctx context.Context,
+		params UpdateFailoverOrderParams,
+	) error
+
 	UpdateOrigin(
 		params UpdateOriginParams,
 	) (*CustomerOrigin, error)
+
+	UpdateOriginWithContext(
+		ctx context.Context,
+		params UpdateOriginParams,
+	) (*CustomerOrigin, error)
 }
 
 // AddOriginParams contains the parameters for AddOrigin
@@ -97,6 +148,15 @@ func NewAddOriginParams() AddOriginParams {
 //	Create new Customer Origin
 func (c CommonClient) AddOrigin(
 	params AddOriginParams,
+) (*CustomerOrigin, error) {
+	return c.AddOriginWithContext(context.Background(), params)
+}
+
+// AddOriginWithContext is the same as AddOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) AddOriginWithContext(
+	ctx context.Context,
+	params AddOriginParams,
 ) (*CustomerOrigin, error) {
 	req, err := buildAddOriginRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -106,7 +166,7 @@ func (c CommonClient) AddOrigin(
 	parsedResponse := CustomerOrigin{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("AddOrigin: %w", err)
@@ -160,13 +220,22 @@ func NewDeleteGroupParams() DeleteGroupParams {
 //	Delete a Customer Origin Group by id
 func (c CommonClient) DeleteGroup(
 	params DeleteGroupParams,
+) error {
+	return c.DeleteGroupWithContext(context.Background(), params)
+}
+
+// DeleteGroupWithContext is the same as DeleteGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) DeleteGroupWithContext(
+	ctx context.Context,
+	params DeleteGroupParams,
 ) error {
 	req, err := buildDeleteGroupRequest(params, c.baseAPIURL)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return fmt.Errorf("DeleteGroup: %w", err)
@@ -220,13 +289,22 @@ func NewDeleteOriginParams() DeleteOriginParams {
 //	Delete an individual Customer Origin
 func (c CommonClient) DeleteOrigin(
 	params DeleteOriginParams,
+) error {
+	return c.DeleteOriginWithContext(context.Background(), params)
+}
+
+// DeleteOriginWithContext is the same as DeleteOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) DeleteOriginWithContext(
+	ctx context.Context,
+	params DeleteOriginParams,
 ) error {
 	req, err := buildDeleteOriginRequest(params, c.baseAPIURL)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return fmt.Errorf("DeleteOrigin: %w", err)
@@ -288,6 +366,15 @@ func NewGetAllOriginsParams() GetAllOriginsParams {
 //	Get list of Customer Origin
 func (c CommonClient) GetAllOrigins(
 	params GetAllOriginsParams,
+) ([]CustomerOrigin, error) {
+	return c.GetAllOriginsWithContext(context.Background(), params)
+}
+
+// GetAllOriginsWithContext is the same as GetAllOrigins with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) GetAllOriginsWithContext(
+	ctx context.Context,
+	params GetAllOriginsParams,
 ) ([]CustomerOrigin, error) {
 	req, err := buildGetAllOriginsRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -297,7 +384,7 @@ func (c CommonClient) GetAllOrigins(
 	parsedResponse := make([]CustomerOrigin, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAllOrigins: %w", err)
@@ -354,6 +441,15 @@ func NewGetEdgeFunctionsParams() GetEdgeFunctionsParams {
 //	Get Edge Functions list to be used as Customer Origin
 func (c CommonClient) GetEdgeFunctions(
 	params GetEdgeFunctionsParams,
+) ([]EdgeFunction, error) {
+	return c.GetEdgeFunctionsWithContext(context.Background(), params)
+}
+
+// GetEdgeFunctionsWithContext is the same as GetEdgeFunctions with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) GetEdgeFunctionsWithContext(
+	ctx context.Context,
+	params GetEdgeFunctionsParams,
 ) ([]EdgeFunction, error) {
 	req, err := buildGetEdgeFunctionsRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -363,7 +459,7 @@ func (c CommonClient) GetEdgeFunctions(
 	parsedResponse := make([]EdgeFunction, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetEdgeFunctions: %w", err)
@@ -415,6 +511,15 @@ func NewGetGroupStatusParams() GetGroupStatusParams {
 //	This API operation gets a customer origin status.
 func (c CommonClient) GetGroupStatus(
 	params GetGroupStatusParams,
+) (*CustomerOriginStatus, error) {
+	return c.GetGroupStatusWithContext(context.Background(), params)
+}
+
+// GetGroupStatusWithContext is the same as GetGroupStatus with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) GetGroupStatusWithContext(
+	ctx context.Context,
+	params GetGroupStatusParams,
 ) (*CustomerOriginStatus, error) {
 	req, err := buildGetGroupStatusRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -424,7 +529,7 @@ func (c CommonClient) GetGroupStatus(
 	parsedResponse := CustomerOriginStatus{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetGroupStatus: %w", err)
@@ -478,6 +583,15 @@ func NewGetOriginParams() GetOriginParams {
 //	Get an individual Customer Origin
 func (c CommonClient) GetOrigin(
 	params GetOriginParams,
+) (*CustomerOrigin, error) {
+	return c.GetOriginWithContext(context.Background(), params)
+}
+
+// GetOriginWithContext is the same as GetOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) GetOriginWithContext(
+	ctx context.Context,
+	params GetOriginParams,
 ) (*CustomerOrigin, error) {
 	req, err := buildGetOriginRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -487,7 +601,7 @@ func (c CommonClient) GetOrigin(
 	parsedResponse := CustomerOrigin{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetOrigin: %w", err)
@@ -541,6 +655,16 @@ func NewGetOriginsByGroupParams() GetOriginsByGroupParams {
 //	This API operation gets all customer origins in a group.
 func (c CommonClient) GetOriginsByGroup(
 	params GetOriginsByGroupParams,
+) ([]CustomerOriginFailoverOrder, error) {
+	return c.GetOriginsByGroupWithContext(context.Background(), params)
+}
+
+// GetOriginsByGroupWithContext is the same as GetOriginsByGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c CommonClient) GetOriginsByGroupWithContext(
+	ctx context.Context,
+	params GetOriginsByGroupParams,
 ) ([]CustomerOriginFailoverOrder, error) {
 	req, err := buildGetOriginsByGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -550,7 +674,7 @@ func (c CommonClient) GetOriginsByGroup(
 	parsedResponse := make([]CustomerOriginFailoverOrder, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetOriginsByGroup: %w", err)
@@ -606,13 +730,23 @@ func NewUpdateFailoverOrderParams() UpdateFailoverOrderParams {
 //	Modify the Failover Order in Group
 func (c CommonClient) UpdateFailoverOrder(
 	params UpdateFailoverOrderParams,
+) error {
+	return c.UpdateFailoverOrderWithContext(context.Background(), params)
+}
+
+// UpdateFailoverOrderWithContext is the same as UpdateFailoverOrder with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c CommonClient) UpdateFailoverOrderWithContext(
+	ctx context.Context,
+	params UpdateFailoverOrderParams,
 ) error {
 	req, err := buildUpdateFailoverOrderRequest(params, c.baseAPIURL)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return fmt.Errorf("UpdateFailoverOrder: %w", err)
@@ -671,6 +805,15 @@ func NewUpdateOriginParams() UpdateOriginParams {
 //	Update an individual Customer Origin
 func (c CommonClient) UpdateOrigin(
 	params UpdateOriginParams,
+) (*CustomerOrigin, error) {
+	return c.UpdateOriginWithContext(context.Background(), params)
+}
+
+// UpdateOriginWithContext is the same as UpdateOrigin with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c CommonClient) UpdateOriginWithContext(
+	ctx context.Context,
+	params UpdateOriginParams,
 ) (*CustomerOrigin, error) {
 	req, err := buildUpdateOriginRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -680,7 +823,7 @@ func (c CommonClient) UpdateOrigin(
 	parsedResponse := CustomerOrigin{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("UpdateOrigin: %w", err)
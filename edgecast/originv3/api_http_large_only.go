@@ -15,6 +15,7 @@ Contact: portals-streaming@edgecast.com
 package originv3
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -41,19 +42,43 @@ type HttpLargeOnlyClientService interface {
 		params AddHttpLargeGroupParams,
 	) (*CustomerOriginGroupHTTP, error)
 
+	AddHttpLargeGroupWithContext(
+		ctx context.Context,
+		params AddHttpLargeGroupParams,
+	) (*CustomerOriginGroupHTTP, error)
+
 	GetAllHttpLargeGroups() ([]CustomerOriginGroupHTTP, error)
 
+	GetAllHttpLargeGroupsWithContext(
+		ctx context.Context,
+	) ([]CustomerOriginGroupHTTP, error)
+
 	GetHttpLargeGroup(
 		params GetHttpLargeGroupParams,
 	) (*CustomerOriginGroupHTTP, error)
 
+	GetHttpLargeGroupWithContext(
+		ctx context.Context,
+		params GetHttpLargeGroupParams,
+	) (*CustomerOriginGroupHTTP, error)
+
 	GetOriginShieldPops(
 		params GetOriginShieldPopsParams,
 	) ([]OriginShieldEdgeNode, error)
 
+	GetOriginShieldPopsWithContext(
+		ctx context.Context,
+		params GetOriginShieldPopsParams,
+	) ([]OriginShieldEdgeNode, error)
+
 	UpdateHttpLargeGroup(
 		params UpdateHttpLargeGroupParams,
 	) (*CustomerOriginGroupHTTP, error)
+
+	UpdateHttpLargeGroupWithContext(
+		ctx context.Context,
+		params UpdateHttpLargeGroupParams,
+	) (*CustomerOriginGroupHTTP, error)
 }
 
 // AddHttpLargeGroupParams contains the parameters for AddHttpLargeGroup
@@ -71,6 +96,16 @@ func NewAddHttpLargeGroupParams() AddHttpLargeGroupParams {
 //	Create new Http Large Customer Origin Group
 func (c HttpLargeOnlyClient) AddHttpLargeGroup(
 	params AddHttpLargeGroupParams,
+) (*CustomerOriginGroupHTTP, error) {
+	return c.AddHttpLargeGroupWithContext(context.Background(), params)
+}
+
+// AddHttpLargeGroupWithContext is the same as AddHttpLargeGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c HttpLargeOnlyClient) AddHttpLargeGroupWithContext(
+	ctx context.Context,
+	params AddHttpLargeGroupParams,
 ) (*CustomerOriginGroupHTTP, error) {
 	req, err := buildAddHttpLargeGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -80,7 +115,7 @@ func (c HttpLargeOnlyClient) AddHttpLargeGroup(
 	parsedResponse := CustomerOriginGroupHTTP{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("AddHttpLargeGroup: %w", err)
@@ -116,6 +151,15 @@ func buildAddHttpLargeGroupRequest(
 //
 //	Get list of Http Large Customer Origin Groups
 func (c HttpLargeOnlyClient) GetAllHttpLargeGroups() ([]CustomerOriginGroupHTTP, error) {
+	return c.GetAllHttpLargeGroupsWithContext(context.Background())
+}
+
+// GetAllHttpLargeGroupsWithContext is the same as GetAllHttpLargeGroups with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (c HttpLargeOnlyClient) GetAllHttpLargeGroupsWithContext(
+	ctx context.Context,
+) ([]CustomerOriginGroupHTTP, error) {
 	req, err := buildGetAllHttpLargeGroupsRequest(c.baseAPIURL)
 	if err != nil {
 		return nil, err
@@ -124,7 +168,7 @@ func (c HttpLargeOnlyClient) GetAllHttpLargeGroups() ([]CustomerOriginGroupHTTP,
 	parsedResponse := make([]CustomerOriginGroupHTTP, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAllHttpLargeGroups: %w", err)
@@ -170,6 +214,16 @@ func NewGetHttpLargeGroupParams() GetHttpLargeGroupParams {
 //	Get an individual Http Large Customer Origin Group
 func (c HttpLargeOnlyClient) GetHttpLargeGroup(
 	params GetHttpLargeGroupParams,
+) (*CustomerOriginGroupHTTP, error) {
+	return c.GetHttpLargeGroupWithContext(context.Background(), params)
+}
+
+// GetHttpLargeGroupWithContext is the same as GetHttpLargeGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c HttpLargeOnlyClient) GetHttpLargeGroupWithContext(
+	ctx context.Context,
+	params GetHttpLargeGroupParams,
 ) (*CustomerOriginGroupHTTP, error) {
 	req, err := buildGetHttpLargeGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -179,7 +233,7 @@ func (c HttpLargeOnlyClient) GetHttpLargeGroup(
 	parsedResponse := CustomerOriginGroupHTTP{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetHttpLargeGroup: %w", err)
@@ -227,6 +281,16 @@ func NewGetOriginShieldPopsParams() GetOriginShieldPopsParams {
 //	Get list of Origin Shield Pops. This API should work only for http-large Origin
 func (c HttpLargeOnlyClient) GetOriginShieldPops(
 	params GetOriginShieldPopsParams,
+) ([]OriginShieldEdgeNode, error) {
+	return c.GetOriginShieldPopsWithContext(context.Background(), params)
+}
+
+// GetOriginShieldPopsWithContext is the same as GetOriginShieldPops with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c HttpLargeOnlyClient) GetOriginShieldPopsWithContext(
+	ctx context.Context,
+	params GetOriginShieldPopsParams,
 ) ([]OriginShieldEdgeNode, error) {
 	req, err := buildGetOriginShieldPopsRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -236,7 +300,7 @@ func (c HttpLargeOnlyClient) GetOriginShieldPops(
 	parsedResponse := make([]OriginShieldEdgeNode, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetOriginShieldPops: %w", err)
@@ -287,6 +351,16 @@ func NewUpdateHttpLargeGroupParams() UpdateHttpLargeGroupParams {
 //	Update an individual Http Large Customer Origin Group
 func (c HttpLargeOnlyClient) UpdateHttpLargeGroup(
 	params UpdateHttpLargeGroupParams,
+) (*CustomerOriginGroupHTTP, error) {
+	return c.UpdateHttpLargeGroupWithContext(context.Background(), params)
+}
+
+// UpdateHttpLargeGroupWithContext is the same as UpdateHttpLargeGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c HttpLargeOnlyClient) UpdateHttpLargeGroupWithContext(
+	ctx context.Context,
+	params UpdateHttpLargeGroupParams,
 ) (*CustomerOriginGroupHTTP, error) {
 	req, err := buildUpdateHttpLargeGroupRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -296,7 +370,7 @@ func (c HttpLargeOnlyClient) UpdateHttpLargeGroup(
 	parsedResponse := CustomerOriginGroupHTTP{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("UpdateHttpLargeGroup: %w", err)
@@ -15,6 +15,7 @@ Contact: portals-streaming@edgecast.com
 package originv3
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -39,13 +40,30 @@ func NewPhase3Client(
 type Phase3ClientService interface {
 	GetAvailableHostnameResolutionMethods() ([]NetworkType, error)
 
+	GetAvailableHostnameResolutionMethodsWithContext(
+		ctx context.Context,
+	) ([]NetworkType, error)
+
 	GetAvailableProtocols() ([]ProtocolType, error)
+
+	GetAvailableProtocolsWithContext(
+		ctx context.Context,
+	) ([]ProtocolType, error)
 }
 
 // GetAvailableHostnameResolutionMethods - Get Network Types
 //
 //	Get Origin Network Regions
 func (c Phase3Client) GetAvailableHostnameResolutionMethods() ([]NetworkType, error) {
+	return c.GetAvailableHostnameResolutionMethodsWithContext(context.Background())
+}
+
+// GetAvailableHostnameResolutionMethodsWithContext is the same as
+// GetAvailableHostnameResolutionMethods with the addition of a context.Context
+// that governs cancellation and deadlines for the call.
+func (c Phase3Client) GetAvailableHostnameResolutionMethodsWithContext(
+	ctx context.Context,
+) ([]NetworkType, error) {
 	req, err := buildGetAvailableHostnameResolutionMethodsRequest(c.baseAPIURL)
 	if err != nil {
 		return nil, err
@@ -54,7 +72,7 @@ func (c Phase3Client) GetAvailableHostnameResolutionMethods() ([]NetworkType, er
 	parsedResponse := make([]NetworkType, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAvailableHostnameResolutionMethods: %w", err)
@@ -88,6 +106,15 @@ func buildGetAvailableHostnameResolutionMethodsRequest(
 //
 //	Get Protocol Types
 func (c Phase3Client) GetAvailableProtocols() ([]ProtocolType, error) {
+	return c.GetAvailableProtocolsWithContext(context.Background())
+}
+
+// GetAvailableProtocolsWithContext is the same as GetAvailableProtocols with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (c Phase3Client) GetAvailableProtocolsWithContext(
+	ctx context.Context,
+) ([]ProtocolType, error) {
 	req, err := buildGetAvailableProtocolsRequest(c.baseAPIURL)
 	if err != nil {
 		return nil, err
@@ -96,7 +123,7 @@ func (c Phase3Client) GetAvailableProtocols() ([]ProtocolType, error) {
 	parsedResponse := make([]ProtocolType, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAvailableProtocols: %w", err)
@@ -20,7 +20,6 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
@@ -53,25 +52,36 @@ func New(config edgecast.SDKConfig) (*Service, error) {
 		return nil, fmt.Errorf("originv3.New(): %w", err)
 	}
 
-	var auth ecauth.AuthorizationProvider
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("originv3.New(): %w", err)
+	}
 
-	auth, err = ecauth.NewIDSAuthorizationProvider(
-		config.BaseIDSURL,
-		ecauth.OAuth2Credentials(config.IDSCredentials))
+	auth, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{
+			Service: "originv3",
+			IDS:     true,
+			Token:   true,
+			Scopes:  requiredScopes,
+		})
 	if err != nil {
-		// Fall back to token authentication
-		auth, err = ecauth.NewTokenAuthorizationProvider(config.APIToken)
-		if err != nil {
-			return nil,
-				fmt.Errorf("error initializing originv3 Service: %w", err)
-		}
+		return nil, fmt.Errorf("error initializing originv3 Service: %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		BaseAPIURL:   *apiURL,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
-		AuthProvider: auth,
+		BaseAPIURL:      *apiURL,
+		ServiceName:     "originv3",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		AuthProvider:    auth,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &Service{
@@ -0,0 +1,14 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package originv3
+
+// requiredScopes cover every Origin V3 endpoint, including origin groups and
+// failover ordering
+var requiredScopes = []string{"cdn.origins"}
+
+// RequiredScopes returns the default IDS scopes of the Origin V3 service,
+// which SDKConfig.ServiceScopes["originv3"] overrides
+func RequiredScopes() []string {
+	return append([]string{}, requiredScopes...)
+}
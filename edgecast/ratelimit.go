@@ -0,0 +1,10 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient/ecratelimit"
+
+// RateLimitConfig controls client-side rate limiting and concurrency for each
+// API host. The zero value disables both.
+type RateLimitConfig = ecratelimit.Config
@@ -0,0 +1,19 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecredact"
+
+// RedactionConfig adds JSON fields, JSON paths and headers whose values are
+// masked before requests and responses are logged
+type RedactionConfig = ecredact.Config
+
+var (
+	// DefaultRedactedFields are the JSON, form and query field names that are
+	// always masked, e.g. "password", "api_key" and "client_secret"
+	DefaultRedactedFields = ecredact.DefaultFields
+
+	// DefaultRedactedHeaders are the headers that are always masked
+	DefaultRedactedHeaders = ecredact.DefaultHeaders
+)
@@ -0,0 +1,138 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package routedns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast"
+)
+
+const dryRunAccountNumber = "ABCD"
+
+// newDryRunService creates a service in dry-run mode whose API fails every
+// request that is sent, and returns the methods of the requests sent
+func newDryRunService(
+	t *testing.T,
+	plan *edgecast.DryRunPlan,
+) (*RouteDNSService, func() []string) {
+	var mu sync.Mutex
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			methods = append(methods, r.Method)
+			mu.Unlock()
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+	t.Cleanup(server.Close)
+
+	serverURL, _ := url.Parse(server.URL)
+	config := edgecast.NewSDKConfig()
+	config.BaseAPIURLLegacy = *serverURL
+	config.APIToken = "token"
+	config.DryRun = edgecast.DryRunConfig{Enabled: true, Plan: plan}
+
+	svc, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	return svc, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string{}, methods...)
+	}
+}
+
+func TestDryRunAdd(t *testing.T) {
+	plan := &edgecast.DryRunPlan{}
+	svc, sent := newDryRunService(t, plan)
+
+	// Route DNS responds to creates with a bare ID, which is simulated with
+	// a placeholder
+	zoneID, err := svc.AddZone(AddZoneParams{
+		AccountNumber: dryRunAccountNumber,
+		Zone:          Zone{DomainName: "example.com."},
+	})
+	if err != nil || *zoneID != 0 {
+		t.Fatalf("AddZone: Expected the placeholder ID but got %v", err)
+	}
+
+	groupID, err := svc.AddGroup(AddGroupParams{
+		AccountNumber: dryRunAccountNumber,
+		Group: DnsRouteGroup{
+			Name:             "lb",
+			GroupProductType: LoadBalancing,
+		},
+	})
+	if err != nil || *groupID != 0 {
+		t.Fatalf("AddGroup: Expected the placeholder ID but got %v", err)
+	}
+
+	tsigID, err := svc.AddTSIG(AddTSIGParams{
+		AccountNumber: dryRunAccountNumber,
+		TSIG:          TSIG{Alias: "key"},
+	})
+	if err != nil || *tsigID != 0 {
+		t.Fatalf("AddTSIG: Expected the placeholder ID but got %v", err)
+	}
+
+	group, err := svc.AddMasterServerGroup(AddMasterServerGroupParams{
+		AccountNumber: dryRunAccountNumber,
+		MasterServerGroup: MasterServerGroupAddRequest{
+			Name:    "masters",
+			Masters: []MasterServer{{Name: "ns1"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddMasterServerGroup: %v", err)
+	}
+	if group.MasterGroupID != 0 ||
+		group.Name != "masters" ||
+		len(group.Masters) != 1 {
+		t.Fatalf(
+			"AddMasterServerGroup: Expected the requested group without an "+
+				"ID but got %+v",
+			group)
+	}
+
+	if got := len(plan.Requests()); got != 4 {
+		t.Fatalf("Expected 4 planned requests but got %d", got)
+	}
+	if methods := sent(); len(methods) != 0 {
+		t.Fatalf("Expected no requests to be sent but got %+v", methods)
+	}
+}
+
+func TestDryRunUpdateGroup(t *testing.T) {
+	plan := &edgecast.DryRunPlan{}
+	svc, sent := newDryRunService(t, plan)
+
+	params := &UpdateGroupParams{
+		AccountNumber: dryRunAccountNumber,
+		Group:         &DnsRouteGroupOK{GroupID: 12},
+	}
+	if err := svc.UpdateGroup(params); err != nil {
+		t.Fatalf("UpdateGroup: %v", err)
+	}
+
+	if params.Group.GroupID != 12 {
+		t.Fatalf(
+			"Expected the group ID to be left as %d but got %d",
+			12,
+			params.Group.GroupID)
+	}
+	if got := len(plan.Requests()); got != 1 {
+		t.Fatalf("Expected 1 planned request but got %d", got)
+	}
+	if methods := sent(); len(methods) != 0 {
+		t.Fatalf("Expected no requests to be sent but got %+v", methods)
+	}
+}
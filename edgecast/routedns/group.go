@@ -4,6 +4,7 @@
 package routedns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -16,20 +17,31 @@ import (
 // GetGroup retrieves group information of the provided groupID.
 func (svc *RouteDNSService) GetGroup(
 	params GetGroupParams,
+) (*DnsRouteGroupOK, error) {
+	return svc.GetGroupWithContext(context.Background(), params)
+}
+
+// GetGroupWithContext is the same as GetGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) GetGroupWithContext(
+	ctx context.Context,
+	params GetGroupParams,
 ) (*DnsRouteGroupOK, error) {
 	parsedResponse := &DnsRouteGroupOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/group",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		QueryParams: map[string]string{
-			"id":        strconv.Itoa(params.GroupID),
-			"groupType": params.GroupProductType.String(),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/group",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			QueryParams: map[string]string{
+				"id":        strconv.Itoa(params.GroupID),
+				"groupType": params.GroupProductType.String(),
+			},
+			ParsedResponse: parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetGroup: %w", err)
@@ -40,14 +52,25 @@ func (svc *RouteDNSService) GetGroup(
 
 // AddGroup creates a new load balanced or failover group.
 func (svc *RouteDNSService) AddGroup(params AddGroupParams) (*int, error) {
-	resp, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/group",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.Group,
-	})
+	return svc.AddGroupWithContext(context.Background(), params)
+}
+
+// AddGroupWithContext is the same as AddGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) AddGroupWithContext(
+	ctx context.Context,
+	params AddGroupParams,
+) (*int, error) {
+	resp, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/group",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.Group,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("AddGroup: %w", err)
@@ -71,6 +94,12 @@ func (svc *RouteDNSService) AddGroup(params AddGroupParams) (*int, error) {
 		)
 	}
 
+	// A group created in dry-run mode does not exist, so it cannot be
+	// checked for
+	if resp.DryRun {
+		return &groupID, nil
+	}
+
 	// Bug exists where adding group returns ID but group does not exist. This
 	// is a temporary workaround to identify the issue and return an error
 	// allowing the user to try again. Checking for a group too soon will also
@@ -85,7 +114,7 @@ func (svc *RouteDNSService) AddGroup(params AddGroupParams) (*int, error) {
 	getParams.GroupProductType = params.Group.GroupProductType
 
 	for i := 0; i < maxRetries; i++ {
-		group, err := svc.GetGroup(*getParams)
+		group, err := svc.GetGroupWithContext(ctx, *getParams)
 
 		// GetGroup will return an error if the group is not found or groupID
 		// will be 0 or -1 in the error condition.
@@ -100,7 +129,11 @@ func (svc *RouteDNSService) AddGroup(params AddGroupParams) (*int, error) {
 			`AddGroup->GetGroup Error retrieving group after group creation. 
 			Sleeping %s seconds and retrying`, sleepInterval.String())
 
-		time.Sleep(sleepInterval)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("AddGroup: %w", ctx.Err())
+		case <-time.After(sleepInterval):
+		}
 	}
 
 	return nil, fmt.Errorf(`AddGroup->Group was not successfully created. 
@@ -109,19 +142,35 @@ func (svc *RouteDNSService) AddGroup(params AddGroupParams) (*int, error) {
 
 // UpdateGroup updates the provided group.
 func (svc *RouteDNSService) UpdateGroup(params *UpdateGroupParams) error {
-	resp, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/group",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.Group,
-	})
+	return svc.UpdateGroupWithContext(context.Background(), params)
+}
+
+// UpdateGroupWithContext is the same as UpdateGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) UpdateGroupWithContext(
+	ctx context.Context,
+	params *UpdateGroupParams,
+) error {
+	resp, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/group",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.Group,
+		})
 
 	if err != nil {
 		return fmt.Errorf("UpdateGroup: %w", err)
 	}
 
+	// The placeholder ID of a dry run must not replace the caller's
+	if resp.DryRun {
+		return nil
+	}
+
 	if len(resp.Data) == 0 {
 		return errors.New("UpdateGroup: api returned no Group ID")
 	}
@@ -142,18 +191,29 @@ func (svc *RouteDNSService) UpdateGroup(params *UpdateGroupParams) error {
 
 // DeleteGroup deletes the provided group.
 func (svc *RouteDNSService) DeleteGroup(params DeleteGroupParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/dns/group",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		QueryParams: map[string]string{
-			"id":        strconv.Itoa(params.Group.GroupID),
-			"groupType": params.Group.GroupProductType.String(),
-		},
-		RawBody: params.Group,
-	})
+	return svc.DeleteGroupWithContext(context.Background(), params)
+}
+
+// DeleteGroupWithContext is the same as DeleteGroup with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) DeleteGroupWithContext(
+	ctx context.Context,
+	params DeleteGroupParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/dns/group",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			QueryParams: map[string]string{
+				"id":        strconv.Itoa(params.Group.GroupID),
+				"groupType": params.Group.GroupProductType.String(),
+			},
+			RawBody: params.Group,
+		})
 
 	if err != nil {
 		return fmt.Errorf("DeleteGroup: %w", err)
@@ -4,6 +4,7 @@
 package routedns
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,16 +15,28 @@ import (
 // TODO: Refactor this GetAll and singular Get methods into one
 func (svc *RouteDNSService) GetAllMasterServerGroups(
 	params GetAllMasterServerGroupsParams,
+) (*[]MasterServerGroupAddGetOK, error) {
+	return svc.GetAllMasterServerGroupsWithContext(context.Background(), params)
+}
+
+// GetAllMasterServerGroupsWithContext is the same as GetAllMasterServerGroups
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *RouteDNSService) GetAllMasterServerGroupsWithContext(
+	ctx context.Context,
+	params GetAllMasterServerGroupsParams,
 ) (*[]MasterServerGroupAddGetOK, error) {
 	parsedResponse := &[]MasterServerGroupAddGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/mastergroups",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/mastergroups",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetAllMasterServerGroups: %w", err)
@@ -35,19 +48,31 @@ func (svc *RouteDNSService) GetAllMasterServerGroups(
 // GetMasterServerGroup retrieves a single master server group.
 func (svc *RouteDNSService) GetMasterServerGroup(
 	params GetMasterServerGroupParams,
+) (*MasterServerGroupAddGetOK, error) {
+	return svc.GetMasterServerGroupWithContext(context.Background(), params)
+}
+
+// GetMasterServerGroupWithContext is the same as GetMasterServerGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *RouteDNSService) GetMasterServerGroupWithContext(
+	ctx context.Context,
+	params GetMasterServerGroupParams,
 ) (*MasterServerGroupAddGetOK, error) {
 	parsedResponse := []MasterServerGroupAddGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/mastergroups",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		QueryParams: map[string]string{
-			"id": strconv.Itoa(params.MasterServerGroupID),
-		},
-		ParsedResponse: &parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/mastergroups",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			QueryParams: map[string]string{
+				"id": strconv.Itoa(params.MasterServerGroupID),
+			},
+			ParsedResponse: &parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetMasterServerGroup: %w", err)
@@ -67,22 +92,45 @@ func (svc *RouteDNSService) GetMasterServerGroup(
 // AddMasterServerGroup creates a master server group.
 func (svc *RouteDNSService) AddMasterServerGroup(
 	params AddMasterServerGroupParams,
+) (*MasterServerGroupAddGetOK, error) {
+	return svc.AddMasterServerGroupWithContext(context.Background(), params)
+}
+
+// AddMasterServerGroupWithContext is the same as AddMasterServerGroup with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *RouteDNSService) AddMasterServerGroupWithContext(
+	ctx context.Context,
+	params AddMasterServerGroupParams,
 ) (*MasterServerGroupAddGetOK, error) {
 	parsedResponse := []MasterServerGroupAddGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: &parsedResponse,
-		RawBody:        params.MasterServerGroup,
-	})
+	resp, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: &parsedResponse,
+			RawBody:        params.MasterServerGroup,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("AddMasterServerGroup: %w", err)
 	}
 
+	// Nothing was created in dry-run mode, so the requested group is
+	// returned without an ID
+	if resp.DryRun {
+		return &MasterServerGroupAddGetOK{
+			MasterServerGroup: MasterServerGroup{
+				Name:    params.MasterServerGroup.Name,
+				Masters: params.MasterServerGroup.Masters,
+			},
+		}, nil
+	}
+
 	// Add operation should always return an array of one
 	length := len(parsedResponse)
 	if length != 1 {
@@ -98,14 +146,26 @@ func (svc *RouteDNSService) AddMasterServerGroup(
 func (svc *RouteDNSService) UpdateMasterServerGroup(
 	params UpdateMasterServerGroupParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.MasterServerGroup,
-	})
+	return svc.UpdateMasterServerGroupWithContext(context.Background(), params)
+}
+
+// UpdateMasterServerGroupWithContext is the same as UpdateMasterServerGroup
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *RouteDNSService) UpdateMasterServerGroupWithContext(
+	ctx context.Context,
+	params UpdateMasterServerGroupParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.MasterServerGroup,
+		})
 
 	if err != nil {
 		return fmt.Errorf("UpdateMasterServerGroup: %w", err)
@@ -118,14 +178,26 @@ func (svc *RouteDNSService) UpdateMasterServerGroup(
 func (svc *RouteDNSService) DeleteMasterServerGroup(
 	params DeleteMasterServerGroupParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup/{msg_id}",
-		PathParams: map[string]string{
-			"msg_id":         strconv.Itoa(params.MasterServerGroup.MasterGroupID),
-			"account_number": params.AccountNumber,
-		},
-	})
+	return svc.DeleteMasterServerGroupWithContext(context.Background(), params)
+}
+
+// DeleteMasterServerGroupWithContext is the same as DeleteMasterServerGroup
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc *RouteDNSService) DeleteMasterServerGroupWithContext(
+	ctx context.Context,
+	params DeleteMasterServerGroupParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/dns/mastergroup/{msg_id}",
+			PathParams: map[string]string{
+				"msg_id":         strconv.Itoa(params.MasterServerGroup.MasterGroupID),
+				"account_number": params.AccountNumber,
+			},
+		})
 
 	if err != nil {
 		return fmt.Errorf("DeleteMasterServerGroup: %w", err)
@@ -4,6 +4,7 @@
 package routedns
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -14,19 +15,31 @@ import (
 // secondary zones.
 func (svc *RouteDNSService) GetSecondaryZoneGroup(
 	params GetSecondaryZoneGroupParams,
+) (*SecondaryZoneGroupResponseOK, error) {
+	return svc.GetSecondaryZoneGroupWithContext(context.Background(), params)
+}
+
+// GetSecondaryZoneGroupWithContext is the same as GetSecondaryZoneGroup with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (svc *RouteDNSService) GetSecondaryZoneGroupWithContext(
+	ctx context.Context,
+	params GetSecondaryZoneGroupParams,
 ) (*SecondaryZoneGroupResponseOK, error) {
 	parsedResponse := []SecondaryZoneGroupResponseOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		QueryParams: map[string]string{
-			"id": strconv.Itoa(params.ID),
-		},
-		ParsedResponse: &parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			QueryParams: map[string]string{
+				"id": strconv.Itoa(params.ID),
+			},
+			ParsedResponse: &parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetSecondaryZoneGroup: %w", err)
@@ -47,17 +60,29 @@ func (svc *RouteDNSService) GetSecondaryZoneGroup(
 // secondary zones.
 func (svc *RouteDNSService) AddSecondaryZoneGroup(
 	params AddSecondaryZoneGroupParams,
+) (*SecondaryZoneGroupResponseOK, error) {
+	return svc.AddSecondaryZoneGroupWithContext(context.Background(), params)
+}
+
+// AddSecondaryZoneGroupWithContext is the same as AddSecondaryZoneGroup with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call.
+func (svc *RouteDNSService) AddSecondaryZoneGroupWithContext(
+	ctx context.Context,
+	params AddSecondaryZoneGroupParams,
 ) (*SecondaryZoneGroupResponseOK, error) {
 	parsedResponse := SecondaryZoneGroupResponseOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: &parsedResponse,
-		RawBody:        params.SecondaryZoneGroup,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: &parsedResponse,
+			RawBody:        params.SecondaryZoneGroup,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("AddSecondaryZoneGroup: %w", err)
@@ -71,14 +96,26 @@ func (svc *RouteDNSService) AddSecondaryZoneGroup(
 func (svc RouteDNSService) UpdateSecondaryZoneGroup(
 	params UpdateSecondaryZoneGroupParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.SecondaryZoneGroup,
-	})
+	return svc.UpdateSecondaryZoneGroupWithContext(context.Background(), params)
+}
+
+// UpdateSecondaryZoneGroupWithContext is the same as UpdateSecondaryZoneGroup
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc RouteDNSService) UpdateSecondaryZoneGroupWithContext(
+	ctx context.Context,
+	params UpdateSecondaryZoneGroupParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.SecondaryZoneGroup,
+		})
 
 	if err != nil {
 		return fmt.Errorf("UpdateSecondaryZoneGroup: %w", err)
@@ -92,16 +129,28 @@ func (svc RouteDNSService) UpdateSecondaryZoneGroup(
 func (svc RouteDNSService) DeleteSecondaryZoneGroup(
 	params DeleteSecondaryZoneGroupParams,
 ) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		QueryParams: map[string]string{
-			"id": strconv.Itoa(params.SecondaryZoneGroup.ID),
-		},
-	})
+	return svc.DeleteSecondaryZoneGroupWithContext(context.Background(), params)
+}
+
+// DeleteSecondaryZoneGroupWithContext is the same as DeleteSecondaryZoneGroup
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call.
+func (svc RouteDNSService) DeleteSecondaryZoneGroupWithContext(
+	ctx context.Context,
+	params DeleteSecondaryZoneGroupParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/dns/secondarygroup",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			QueryParams: map[string]string{
+				"id": strconv.Itoa(params.SecondaryZoneGroup.ID),
+			},
+		})
 
 	if err != nil {
 		return fmt.Errorf("DeleteSecondaryZoneGroup: %w", err)
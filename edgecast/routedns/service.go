@@ -4,11 +4,11 @@
 package routedns
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
@@ -19,20 +19,192 @@ type RouteDNSService struct {
 	logger eclog.Logger
 }
 
+// ClientService is the interface for RouteDNSService methods
+type ClientService interface {
+	GetGroup(
+		params GetGroupParams,
+	) (*DnsRouteGroupOK, error)
+
+	GetGroupWithContext(
+		ctx context.Context,
+		params GetGroupParams,
+	) (*DnsRouteGroupOK, error)
+
+	AddGroup(params AddGroupParams) (*int, error)
+
+	AddGroupWithContext(
+		ctx context.Context,
+		params AddGroupParams,
+	) (*int, error)
+
+	UpdateGroup(params *UpdateGroupParams) error
+
+	UpdateGroupWithContext(
+		ctx context.Context,
+		params *UpdateGroupParams,
+	) error
+
+	DeleteGroup(params DeleteGroupParams) error
+
+	DeleteGroupWithContext(
+		ctx context.Context,
+		params DeleteGroupParams,
+	) error
+
+	GetAllMasterServerGroups(
+		params GetAllMasterServerGroupsParams,
+	) (*[]MasterServerGroupAddGetOK, error)
+
+	GetAllMasterServerGroupsWithContext(
+		ctx context.Context,
+		params GetAllMasterServerGroupsParams,
+	) (*[]MasterServerGroupAddGetOK, error)
+
+	GetMasterServerGroup(
+		params GetMasterServerGroupParams,
+	) (*MasterServerGroupAddGetOK, error)
+
+	GetMasterServerGroupWithContext(
+		ctx context.Context,
+		params GetMasterServerGroupParams,
+	) (*MasterServerGroupAddGetOK, error)
+
+	AddMasterServerGroup(
+		params AddMasterServerGroupParams,
+	) (*MasterServerGroupAddGetOK, error)
+
+	AddMasterServerGroupWithContext(
+		ctx context.Context,
+		params AddMasterServerGroupParams,
+	) (*MasterServerGroupAddGetOK, error)
+
+	UpdateMasterServerGroup(
+		params UpdateMasterServerGroupParams,
+	) error
+
+	UpdateMasterServerGroupWithContext(
+		ctx context.Context,
+		params UpdateMasterServerGroupParams,
+	) error
+
+	DeleteMasterServerGroup(
+		params DeleteMasterServerGroupParams,
+	) error
+
+	DeleteMasterServerGroupWithContext(
+		ctx context.Context,
+		params DeleteMasterServerGroupParams,
+	) error
+
+	GetSecondaryZoneGroup(
+		params GetSecondaryZoneGroupParams,
+	) (*SecondaryZoneGroupResponseOK, error)
+
+	GetSecondaryZoneGroupWithContext(
+		ctx context.Context,
+		params GetSecondaryZoneGroupParams,
+	) (*SecondaryZoneGroupResponseOK, error)
+
+	AddSecondaryZoneGroup(
+		params AddSecondaryZoneGroupParams,
+	) (*SecondaryZoneGroupResponseOK, error)
+
+	AddSecondaryZoneGroupWithContext(
+		ctx context.Context,
+		params AddSecondaryZoneGroupParams,
+	) (*SecondaryZoneGroupResponseOK, error)
+
+	GetTSIG(
+		params GetTSIGParams,
+	) (*TSIGGetOK, error)
+
+	GetTSIGWithContext(
+		ctx context.Context,
+		params GetTSIGParams,
+	) (*TSIGGetOK, error)
+
+	AddTSIG(params AddTSIGParams) (*int, error)
+
+	AddTSIGWithContext(
+		ctx context.Context,
+		params AddTSIGParams,
+	) (*int, error)
+
+	UpdateTSIG(params UpdateTSIGParams) error
+
+	UpdateTSIGWithContext(
+		ctx context.Context,
+		params UpdateTSIGParams,
+	) error
+
+	DeleteTSIG(params DeleteTSIGParams) error
+
+	DeleteTSIGWithContext(
+		ctx context.Context,
+		params DeleteTSIGParams,
+	) error
+
+	GetZone(
+		params GetZoneParams,
+	) (*ZoneGetOK, error)
+
+	GetZoneWithContext(
+		ctx context.Context,
+		params GetZoneParams,
+	) (*ZoneGetOK, error)
+
+	AddZone(params AddZoneParams) (*int, error)
+
+	AddZoneWithContext(
+		ctx context.Context,
+		params AddZoneParams,
+	) (*int, error)
+
+	UpdateZone(params UpdateZoneParams) error
+
+	UpdateZoneWithContext(
+		ctx context.Context,
+		params UpdateZoneParams,
+	) error
+
+	DeleteZone(params DeleteZoneParams) error
+
+	DeleteZoneWithContext(
+		ctx context.Context,
+		params DeleteZoneParams,
+	) error
+}
+
+var _ ClientService = (*RouteDNSService)(nil)
+
 // New creates a new Route DNS service
 func New(config edgecast.SDKConfig) (*RouteDNSService, error) {
 
-	authProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("RouteDNS.New(): %w", err)
+	}
 
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "routedns", Token: true})
 	if err != nil {
 		return nil, fmt.Errorf("RouteDNS.New(): %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURLLegacy,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURLLegacy,
+		ServiceName:     "routedns",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &RouteDNSService{
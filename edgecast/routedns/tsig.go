@@ -4,6 +4,7 @@
 package routedns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -14,17 +15,28 @@ import (
 // GetTSIG retrieves a TSIG.
 func (svc *RouteDNSService) GetTSIG(
 	params GetTSIGParams,
+) (*TSIGGetOK, error) {
+	return svc.GetTSIGWithContext(context.Background(), params)
+}
+
+// GetTSIGWithContext is the same as GetTSIG with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) GetTSIGWithContext(
+	ctx context.Context,
+	params GetTSIGParams,
 ) (*TSIGGetOK, error) {
 	parsedResponse := &TSIGGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"id":             strconv.Itoa(params.TSIGID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"id":             strconv.Itoa(params.TSIGID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetTsig: %w", err)
@@ -35,14 +47,25 @@ func (svc *RouteDNSService) GetTSIG(
 
 // AddTSIG creates a new TSIG.
 func (svc *RouteDNSService) AddTSIG(params AddTSIGParams) (*int, error) {
-	resp, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/tsig",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.TSIG,
-	})
+	return svc.AddTSIGWithContext(context.Background(), params)
+}
+
+// AddTSIGWithContext is the same as AddTSIG with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) AddTSIGWithContext(
+	ctx context.Context,
+	params AddTSIGParams,
+) (*int, error) {
+	resp, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/tsig",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.TSIG,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("AddTSIG: %w", err)
@@ -65,15 +88,26 @@ func (svc *RouteDNSService) AddTSIG(params AddTSIGParams) (*int, error) {
 
 // UpdateTSIG updates an existing TSIG.
 func (svc *RouteDNSService) UpdateTSIG(params UpdateTSIGParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Put,
-		Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"id":             strconv.Itoa(params.TSIG.ID),
-		},
-		RawBody: params.TSIG,
-	})
+	return svc.UpdateTSIGWithContext(context.Background(), params)
+}
+
+// UpdateTSIGWithContext is the same as UpdateTSIG with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) UpdateTSIGWithContext(
+	ctx context.Context,
+	params UpdateTSIGParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Put,
+			Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"id":             strconv.Itoa(params.TSIG.ID),
+			},
+			RawBody: params.TSIG,
+		})
 
 	if err != nil {
 		return fmt.Errorf("UpdateTSIG: %w", err)
@@ -84,14 +118,25 @@ func (svc *RouteDNSService) UpdateTSIG(params UpdateTSIGParams) error {
 
 // DeleteTSIG deletes an existing TSIG.
 func (svc *RouteDNSService) DeleteTSIG(params DeleteTSIGParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"id":             strconv.Itoa(params.TSIG.ID),
-		},
-	})
+	return svc.DeleteTSIGWithContext(context.Background(), params)
+}
+
+// DeleteTSIGWithContext is the same as DeleteTSIG with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) DeleteTSIGWithContext(
+	ctx context.Context,
+	params DeleteTSIGParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/dns/tsigs/{id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"id":             strconv.Itoa(params.TSIG.ID),
+			},
+		})
 
 	if err != nil {
 		return fmt.Errorf("DeleteTSIG: %w", err)
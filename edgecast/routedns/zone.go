@@ -4,6 +4,7 @@
 package routedns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -14,17 +15,28 @@ import (
 // GetZone retrieves information of the provided ZoneID which includes all dns
 // records, failover servers, and loadbalancing servers if any exists.
 func (svc *RouteDNSService) GetZone(params GetZoneParams,
+) (*ZoneGetOK, error) {
+	return svc.GetZoneWithContext(context.Background(), params)
+}
+
+// GetZoneWithContext is the same as GetZone with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) GetZoneWithContext(
+	ctx context.Context,
+	params GetZoneParams,
 ) (*ZoneGetOK, error) {
 	parsedResponse := &ZoneGetOK{}
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/dns/zone/{id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"id":             strconv.Itoa(params.ZoneID),
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/dns/zone/{id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"id":             strconv.Itoa(params.ZoneID),
+			},
+			ParsedResponse: parsedResponse,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("GetZone: %w", err)
@@ -35,14 +47,25 @@ func (svc *RouteDNSService) GetZone(params GetZoneParams,
 
 // AddZone creates a primary zone.
 func (svc *RouteDNSService) AddZone(params AddZoneParams) (*int, error) {
-	resp, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/zone",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.Zone,
-	})
+	return svc.AddZoneWithContext(context.Background(), params)
+}
+
+// AddZoneWithContext is the same as AddZone with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) AddZoneWithContext(
+	ctx context.Context,
+	params AddZoneParams,
+) (*int, error) {
+	resp, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/zone",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.Zone,
+		})
 
 	if err != nil {
 		return nil, fmt.Errorf("AddZone: %w", err)
@@ -66,14 +89,25 @@ func (svc *RouteDNSService) AddZone(params AddZoneParams) (*int, error) {
 
 // UpdateZone updates a primary zone
 func (svc *RouteDNSService) UpdateZone(params UpdateZoneParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/dns/zone",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		RawBody: params.Zone,
-	})
+	return svc.UpdateZoneWithContext(context.Background(), params)
+}
+
+// UpdateZoneWithContext is the same as UpdateZone with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) UpdateZoneWithContext(
+	ctx context.Context,
+	params UpdateZoneParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/dns/zone",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			RawBody: params.Zone,
+		})
 
 	if err != nil {
 		return fmt.Errorf("UpdateZone: %w", err)
@@ -84,14 +118,25 @@ func (svc *RouteDNSService) UpdateZone(params UpdateZoneParams) error {
 
 // DeleteZone deletes a primary zone
 func (svc *RouteDNSService) DeleteZone(params DeleteZoneParams) error {
-	_, err := svc.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/dns/routezone/{id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"id":             strconv.Itoa(params.Zone.FixedZoneID),
-		},
-	})
+	return svc.DeleteZoneWithContext(context.Background(), params)
+}
+
+// DeleteZoneWithContext is the same as DeleteZone with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RouteDNSService) DeleteZoneWithContext(
+	ctx context.Context,
+	params DeleteZoneParams,
+) error {
+	_, err := svc.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/dns/routezone/{id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"id":             strconv.Itoa(params.Zone.FixedZoneID),
+			},
+		})
 
 	if err != nil {
 		return fmt.Errorf("DeleteZone: %w", err)
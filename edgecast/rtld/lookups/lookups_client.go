@@ -6,6 +6,7 @@ package lookups
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -28,33 +29,69 @@ type Client struct {
 type ClientService interface {
 	LookupsGetAwsRegions(params *LookupsGetAwsRegionsParams) (*LookupsGetAwsRegionsOK, error)
 
+	LookupsGetAwsRegionsWithContext(ctx context.Context, params *LookupsGetAwsRegionsParams) (*LookupsGetAwsRegionsOK, error)
+
 	LookupsGetAzureAccessTypes(params *LookupsGetAzureAccessTypesParams) (*LookupsGetAzureAccessTypesOK, error)
 
+	LookupsGetAzureAccessTypesWithContext(ctx context.Context, params *LookupsGetAzureAccessTypesParams) (*LookupsGetAzureAccessTypesOK, error)
+
 	LookupsGetCustomItems(params *LookupsGetCustomItemsParams) (*LookupsGetCustomItemsOK, error)
 
+	LookupsGetCustomItemsWithContext(ctx context.Context, params *LookupsGetCustomItemsParams) (*LookupsGetCustomItemsOK, error)
+
 	LookupsGetDeliveryMethods(params *LookupsGetDeliveryMethodsParams) (*LookupsGetDeliveryMethodsOK, error)
 
+	LookupsGetDeliveryMethodsWithContext(ctx context.Context, params *LookupsGetDeliveryMethodsParams) (*LookupsGetDeliveryMethodsOK, error)
+
 	LookupsGetDownsamplingRates(params *LookupsGetDownsamplingRatesParams) (*LookupsGetDownsamplingRatesOK, error)
 
+	LookupsGetDownsamplingRatesWithContext(ctx context.Context, params *LookupsGetDownsamplingRatesParams) (*LookupsGetDownsamplingRatesOK, error)
+
 	LookupsGetFieldRl(params *LookupsGetFieldRlParams) (*LookupsGetFieldRlOK, error)
 
+	LookupsGetFieldRlWithContext(ctx context.Context, params *LookupsGetFieldRlParams) (*LookupsGetFieldRlOK, error)
+
 	LookupsGetFieldsCdn(params *LookupsGetFieldsCdnParams) (*LookupsGetFieldsCdnOK, error)
 
+	LookupsGetFieldsCdnWithContext(ctx context.Context, params *LookupsGetFieldsCdnParams) (*LookupsGetFieldsCdnOK, error)
+
 	LookupsGetFieldsWaf(params *LookupsGetFieldsWafParams) (*LookupsGetFieldsWafOK, error)
 
+	LookupsGetFieldsWafWithContext(ctx context.Context, params *LookupsGetFieldsWafParams) (*LookupsGetFieldsWafOK, error)
+
 	LookupsGetHTTPAuthenticationMethods(params *LookupsGetHTTPAuthenticationMethodsParams) (*LookupsGetHTTPAuthenticationMethodsOK, error)
 
+	LookupsGetHTTPAuthenticationMethodsWithContext(ctx context.Context, params *LookupsGetHTTPAuthenticationMethodsParams) (*LookupsGetHTTPAuthenticationMethodsOK, error)
+
 	LookupsGetLogFormats(params *LookupsGetLogFormatsParams) (*LookupsGetLogFormatsOK, error)
 
+	LookupsGetLogFormatsWithContext(ctx context.Context, params *LookupsGetLogFormatsParams) (*LookupsGetLogFormatsOK, error)
+
 	LookupsGetPlatforms(params *LookupsGetPlatformsParams) (*LookupsGetPlatformsOK, error)
 
+	LookupsGetPlatformsWithContext(ctx context.Context, params *LookupsGetPlatformsParams) (*LookupsGetPlatformsOK, error)
+
 	LookupsGetStatusCodes(params *LookupsGetStatusCodesParams) (*LookupsGetStatusCodesOK, error)
+
+	LookupsGetStatusCodesWithContext(ctx context.Context, params *LookupsGetStatusCodesParams) (*LookupsGetStatusCodesOK, error)
 }
 
 /*
   LookupsGetAwsRegions lookups get aws regions API
 */
 func (a *Client) LookupsGetAwsRegions(params *LookupsGetAwsRegionsParams) (*LookupsGetAwsRegionsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetAwsRegionsWithContext(ctx, params)
+}
+
+// LookupsGetAwsRegionsWithContext is the same as LookupsGetAwsRegions with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetAwsRegionsWithContext(ctx context.Context, params *LookupsGetAwsRegionsParams) (*LookupsGetAwsRegionsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetAwsRegionsParams()
@@ -68,12 +105,12 @@ func (a *Client) LookupsGetAwsRegions(params *LookupsGetAwsRegionsParams) (*Look
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetAwsRegions: %v", err)
+		return nil, fmt.Errorf("LookupsGetAwsRegions: %w", err)
 	}
 
 	parsedResponse := &LookupsGetAwsRegionsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/aws-regions",
 		RawBody:        results.Body,
@@ -83,7 +120,7 @@ func (a *Client) LookupsGetAwsRegions(params *LookupsGetAwsRegionsParams) (*Look
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetAwsRegions: %v", err)
+		return nil, fmt.Errorf("LookupsGetAwsRegions: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -93,6 +130,19 @@ func (a *Client) LookupsGetAwsRegions(params *LookupsGetAwsRegionsParams) (*Look
   LookupsGetAzureAccessTypes lookups get azure access types API
 */
 func (a *Client) LookupsGetAzureAccessTypes(params *LookupsGetAzureAccessTypesParams) (*LookupsGetAzureAccessTypesOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetAzureAccessTypesWithContext(ctx, params)
+}
+
+// LookupsGetAzureAccessTypesWithContext is the same as
+// LookupsGetAzureAccessTypes with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) LookupsGetAzureAccessTypesWithContext(ctx context.Context, params *LookupsGetAzureAccessTypesParams) (*LookupsGetAzureAccessTypesOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetAzureAccessTypesParams()
@@ -106,12 +156,12 @@ func (a *Client) LookupsGetAzureAccessTypes(params *LookupsGetAzureAccessTypesPa
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetAzureAccessTypes: %v", err)
+		return nil, fmt.Errorf("LookupsGetAzureAccessTypes: %w", err)
 	}
 
 	parsedResponse := &LookupsGetAzureAccessTypesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/azure-access-types",
 		RawBody:        results.Body,
@@ -121,7 +171,7 @@ func (a *Client) LookupsGetAzureAccessTypes(params *LookupsGetAzureAccessTypesPa
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetAzureAccessTypes: %v", err)
+		return nil, fmt.Errorf("LookupsGetAzureAccessTypes: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -131,6 +181,18 @@ func (a *Client) LookupsGetAzureAccessTypes(params *LookupsGetAzureAccessTypesPa
   LookupsGetCustomItems lookups get custom items API
 */
 func (a *Client) LookupsGetCustomItems(params *LookupsGetCustomItemsParams) (*LookupsGetCustomItemsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetCustomItemsWithContext(ctx, params)
+}
+
+// LookupsGetCustomItemsWithContext is the same as LookupsGetCustomItems with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) LookupsGetCustomItemsWithContext(ctx context.Context, params *LookupsGetCustomItemsParams) (*LookupsGetCustomItemsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetCustomItemsParams()
@@ -144,12 +206,12 @@ func (a *Client) LookupsGetCustomItems(params *LookupsGetCustomItemsParams) (*Lo
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetCustomItems: %v", err)
+		return nil, fmt.Errorf("LookupsGetCustomItems: %w", err)
 	}
 
 	parsedResponse := &LookupsGetCustomItemsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/custom-items",
 		RawBody:        results.Body,
@@ -159,7 +221,7 @@ func (a *Client) LookupsGetCustomItems(params *LookupsGetCustomItemsParams) (*Lo
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetCustomItems: %v", err)
+		return nil, fmt.Errorf("LookupsGetCustomItems: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -169,6 +231,18 @@ func (a *Client) LookupsGetCustomItems(params *LookupsGetCustomItemsParams) (*Lo
   LookupsGetDeliveryMethods lookups get delivery methods API
 */
 func (a *Client) LookupsGetDeliveryMethods(params *LookupsGetDeliveryMethodsParams) (*LookupsGetDeliveryMethodsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetDeliveryMethodsWithContext(ctx, params)
+}
+
+// LookupsGetDeliveryMethodsWithContext is the same as LookupsGetDeliveryMethods
+// with the addition of a context.Context that governs cancellation and
+// deadlines for the call. The Context field of params is ignored.
+func (a *Client) LookupsGetDeliveryMethodsWithContext(ctx context.Context, params *LookupsGetDeliveryMethodsParams) (*LookupsGetDeliveryMethodsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetDeliveryMethodsParams()
@@ -182,12 +256,12 @@ func (a *Client) LookupsGetDeliveryMethods(params *LookupsGetDeliveryMethodsPara
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetDeliveryMethods: %v", err)
+		return nil, fmt.Errorf("LookupsGetDeliveryMethods: %w", err)
 	}
 
 	parsedResponse := &LookupsGetDeliveryMethodsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/delivery-methods",
 		RawBody:        results.Body,
@@ -197,7 +271,7 @@ func (a *Client) LookupsGetDeliveryMethods(params *LookupsGetDeliveryMethodsPara
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetDeliveryMethods: %v", err)
+		return nil, fmt.Errorf("LookupsGetDeliveryMethods: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -207,6 +281,19 @@ func (a *Client) LookupsGetDeliveryMethods(params *LookupsGetDeliveryMethodsPara
   LookupsGetDownsamplingRates lookups get downsampling rates API
 */
 func (a *Client) LookupsGetDownsamplingRates(params *LookupsGetDownsamplingRatesParams) (*LookupsGetDownsamplingRatesOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetDownsamplingRatesWithContext(ctx, params)
+}
+
+// LookupsGetDownsamplingRatesWithContext is the same as
+// LookupsGetDownsamplingRates with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) LookupsGetDownsamplingRatesWithContext(ctx context.Context, params *LookupsGetDownsamplingRatesParams) (*LookupsGetDownsamplingRatesOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetDownsamplingRatesParams()
@@ -220,12 +307,12 @@ func (a *Client) LookupsGetDownsamplingRates(params *LookupsGetDownsamplingRates
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetDownsamplingRates: %v", err)
+		return nil, fmt.Errorf("LookupsGetDownsamplingRates: %w", err)
 	}
 
 	parsedResponse := &LookupsGetDownsamplingRatesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/downsampling-rates",
 		RawBody:        results.Body,
@@ -235,7 +322,7 @@ func (a *Client) LookupsGetDownsamplingRates(params *LookupsGetDownsamplingRates
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetDownsamplingRates: %v", err)
+		return nil, fmt.Errorf("LookupsGetDownsamplingRates: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -245,6 +332,18 @@ func (a *Client) LookupsGetDownsamplingRates(params *LookupsGetDownsamplingRates
   LookupsGetFieldRl lookups get field rl API
 */
 func (a *Client) LookupsGetFieldRl(params *LookupsGetFieldRlParams) (*LookupsGetFieldRlOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetFieldRlWithContext(ctx, params)
+}
+
+// LookupsGetFieldRlWithContext is the same as LookupsGetFieldRl with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetFieldRlWithContext(ctx context.Context, params *LookupsGetFieldRlParams) (*LookupsGetFieldRlOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetFieldRlParams()
@@ -258,12 +357,12 @@ func (a *Client) LookupsGetFieldRl(params *LookupsGetFieldRlParams) (*LookupsGet
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldRl: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldRl: %w", err)
 	}
 
 	parsedResponse := &LookupsGetFieldRlOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/fields",
 		RawBody:        results.Body,
@@ -273,7 +372,7 @@ func (a *Client) LookupsGetFieldRl(params *LookupsGetFieldRlParams) (*LookupsGet
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldRl: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldRl: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -283,6 +382,18 @@ func (a *Client) LookupsGetFieldRl(params *LookupsGetFieldRlParams) (*LookupsGet
   LookupsGetFieldsCdn lookups get fields cdn API
 */
 func (a *Client) LookupsGetFieldsCdn(params *LookupsGetFieldsCdnParams) (*LookupsGetFieldsCdnOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetFieldsCdnWithContext(ctx, params)
+}
+
+// LookupsGetFieldsCdnWithContext is the same as LookupsGetFieldsCdn with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetFieldsCdnWithContext(ctx context.Context, params *LookupsGetFieldsCdnParams) (*LookupsGetFieldsCdnOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetFieldsCdnParams()
@@ -296,12 +407,12 @@ func (a *Client) LookupsGetFieldsCdn(params *LookupsGetFieldsCdnParams) (*Lookup
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldsCdn: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldsCdn: %w", err)
 	}
 
 	parsedResponse := &LookupsGetFieldsCdnOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/fields",
 		RawBody:        results.Body,
@@ -311,7 +422,7 @@ func (a *Client) LookupsGetFieldsCdn(params *LookupsGetFieldsCdnParams) (*Lookup
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldsCdn: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldsCdn: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -321,6 +432,18 @@ func (a *Client) LookupsGetFieldsCdn(params *LookupsGetFieldsCdnParams) (*Lookup
   LookupsGetFieldsWaf lookups get fields waf API
 */
 func (a *Client) LookupsGetFieldsWaf(params *LookupsGetFieldsWafParams) (*LookupsGetFieldsWafOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetFieldsWafWithContext(ctx, params)
+}
+
+// LookupsGetFieldsWafWithContext is the same as LookupsGetFieldsWaf with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetFieldsWafWithContext(ctx context.Context, params *LookupsGetFieldsWafParams) (*LookupsGetFieldsWafOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetFieldsWafParams()
@@ -334,12 +457,12 @@ func (a *Client) LookupsGetFieldsWaf(params *LookupsGetFieldsWafParams) (*Lookup
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldsWaf: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldsWaf: %w", err)
 	}
 
 	parsedResponse := &LookupsGetFieldsWafOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/fields",
 		RawBody:        results.Body,
@@ -349,7 +472,7 @@ func (a *Client) LookupsGetFieldsWaf(params *LookupsGetFieldsWafParams) (*Lookup
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetFieldsWaf: %v", err)
+		return nil, fmt.Errorf("LookupsGetFieldsWaf: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -359,6 +482,19 @@ func (a *Client) LookupsGetFieldsWaf(params *LookupsGetFieldsWafParams) (*Lookup
   LookupsGetHTTPAuthenticationMethods lookups get Http authentication methods API
 */
 func (a *Client) LookupsGetHTTPAuthenticationMethods(params *LookupsGetHTTPAuthenticationMethodsParams) (*LookupsGetHTTPAuthenticationMethodsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetHTTPAuthenticationMethodsWithContext(ctx, params)
+}
+
+// LookupsGetHTTPAuthenticationMethodsWithContext is the same as
+// LookupsGetHTTPAuthenticationMethods with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) LookupsGetHTTPAuthenticationMethodsWithContext(ctx context.Context, params *LookupsGetHTTPAuthenticationMethodsParams) (*LookupsGetHTTPAuthenticationMethodsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetHTTPAuthenticationMethodsParams()
@@ -372,12 +508,12 @@ func (a *Client) LookupsGetHTTPAuthenticationMethods(params *LookupsGetHTTPAuthe
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetHTTPAuthenticationMethods: %v", err)
+		return nil, fmt.Errorf("LookupsGetHTTPAuthenticationMethods: %w", err)
 	}
 
 	parsedResponse := &LookupsGetHTTPAuthenticationMethodsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/http-authentication-methods",
 		RawBody:        results.Body,
@@ -387,7 +523,7 @@ func (a *Client) LookupsGetHTTPAuthenticationMethods(params *LookupsGetHTTPAuthe
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetHTTPAuthenticationMethods: %v", err)
+		return nil, fmt.Errorf("LookupsGetHTTPAuthenticationMethods: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -397,6 +533,18 @@ func (a *Client) LookupsGetHTTPAuthenticationMethods(params *LookupsGetHTTPAuthe
   LookupsGetLogFormats lookups get log formats API
 */
 func (a *Client) LookupsGetLogFormats(params *LookupsGetLogFormatsParams) (*LookupsGetLogFormatsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetLogFormatsWithContext(ctx, params)
+}
+
+// LookupsGetLogFormatsWithContext is the same as LookupsGetLogFormats with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetLogFormatsWithContext(ctx context.Context, params *LookupsGetLogFormatsParams) (*LookupsGetLogFormatsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetLogFormatsParams()
@@ -410,12 +558,12 @@ func (a *Client) LookupsGetLogFormats(params *LookupsGetLogFormatsParams) (*Look
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetLogFormats: %v", err)
+		return nil, fmt.Errorf("LookupsGetLogFormats: %w", err)
 	}
 
 	parsedResponse := &LookupsGetLogFormatsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/log-formats",
 		RawBody:        results.Body,
@@ -425,7 +573,7 @@ func (a *Client) LookupsGetLogFormats(params *LookupsGetLogFormatsParams) (*Look
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetLogFormats: %v", err)
+		return nil, fmt.Errorf("LookupsGetLogFormats: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -435,6 +583,18 @@ func (a *Client) LookupsGetLogFormats(params *LookupsGetLogFormatsParams) (*Look
   LookupsGetPlatforms lookups get platforms API
 */
 func (a *Client) LookupsGetPlatforms(params *LookupsGetPlatformsParams) (*LookupsGetPlatformsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetPlatformsWithContext(ctx, params)
+}
+
+// LookupsGetPlatformsWithContext is the same as LookupsGetPlatforms with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call. The Context field of params is ignored.
+func (a *Client) LookupsGetPlatformsWithContext(ctx context.Context, params *LookupsGetPlatformsParams) (*LookupsGetPlatformsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetPlatformsParams()
@@ -448,12 +608,12 @@ func (a *Client) LookupsGetPlatforms(params *LookupsGetPlatformsParams) (*Lookup
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetPlatforms: %v", err)
+		return nil, fmt.Errorf("LookupsGetPlatforms: %w", err)
 	}
 
 	parsedResponse := &LookupsGetPlatformsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/platforms",
 		RawBody:        results.Body,
@@ -463,7 +623,7 @@ func (a *Client) LookupsGetPlatforms(params *LookupsGetPlatformsParams) (*Lookup
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetPlatforms: %v", err)
+		return nil, fmt.Errorf("LookupsGetPlatforms: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -473,6 +633,18 @@ func (a *Client) LookupsGetPlatforms(params *LookupsGetPlatformsParams) (*Lookup
   LookupsGetStatusCodes lookups get status codes API
 */
 func (a *Client) LookupsGetStatusCodes(params *LookupsGetStatusCodesParams) (*LookupsGetStatusCodesOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.LookupsGetStatusCodesWithContext(ctx, params)
+}
+
+// LookupsGetStatusCodesWithContext is the same as LookupsGetStatusCodes with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) LookupsGetStatusCodesWithContext(ctx context.Context, params *LookupsGetStatusCodesParams) (*LookupsGetStatusCodesOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewLookupsGetStatusCodesParams()
@@ -486,12 +658,12 @@ func (a *Client) LookupsGetStatusCodes(params *LookupsGetStatusCodesParams) (*Lo
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetStatusCodes: %v", err)
+		return nil, fmt.Errorf("LookupsGetStatusCodes: %w", err)
 	}
 
 	parsedResponse := &LookupsGetStatusCodesOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/status-codes",
 		RawBody:        results.Body,
@@ -501,7 +673,7 @@ func (a *Client) LookupsGetStatusCodes(params *LookupsGetStatusCodesParams) (*Lo
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("LookupsGetStatusCodes: %v", err)
+		return nil, fmt.Errorf("LookupsGetStatusCodes: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -6,6 +6,7 @@ package profiles_cdn
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -28,19 +29,42 @@ type Client struct {
 type ClientService interface {
 	ProfilesAddCustomerSetting(params *ProfilesAddCustomerSettingParams) (*ProfilesAddCustomerSettingOK, error)
 
+	ProfilesAddCustomerSettingWithContext(ctx context.Context, params *ProfilesAddCustomerSettingParams) (*ProfilesAddCustomerSettingOK, error)
+
 	ProfilesDeleteCustomerSettingsByID(params *ProfilesDeleteCustomerSettingsByIDParams) (*ProfilesDeleteCustomerSettingsByIDNoContent, error)
 
+	ProfilesDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesDeleteCustomerSettingsByIDParams) (*ProfilesDeleteCustomerSettingsByIDNoContent, error)
+
 	ProfilesGetCustomerSettings(params *ProfilesGetCustomerSettingsParams) (*ProfilesGetCustomerSettingsOK, error)
 
+	ProfilesGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesGetCustomerSettingsParams) (*ProfilesGetCustomerSettingsOK, error)
+
 	ProfilesGetCustomerSettingsByID(params *ProfilesGetCustomerSettingsByIDParams) (*ProfilesGetCustomerSettingsByIDOK, error)
 
+	ProfilesGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesGetCustomerSettingsByIDParams) (*ProfilesGetCustomerSettingsByIDOK, error)
+
 	ProfilesUpdateCustomerSetting(params *ProfilesUpdateCustomerSettingParams) (*ProfilesUpdateCustomerSettingOK, error)
+
+	ProfilesUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesUpdateCustomerSettingParams) (*ProfilesUpdateCustomerSettingOK, error)
 }
 
 /*
   ProfilesAddCustomerSetting profiles add customer setting API
 */
 func (a *Client) ProfilesAddCustomerSetting(params *ProfilesAddCustomerSettingParams) (*ProfilesAddCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesAddCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesAddCustomerSettingWithContext is the same as
+// ProfilesAddCustomerSetting with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesAddCustomerSettingWithContext(ctx context.Context, params *ProfilesAddCustomerSettingParams) (*ProfilesAddCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesAddCustomerSettingParams()
@@ -54,12 +78,12 @@ func (a *Client) ProfilesAddCustomerSetting(params *ProfilesAddCustomerSettingPa
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesAddCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesAddCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/profiles",
 		RawBody:        results.Body,
@@ -69,7 +93,7 @@ func (a *Client) ProfilesAddCustomerSetting(params *ProfilesAddCustomerSettingPa
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesAddCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -79,6 +103,19 @@ func (a *Client) ProfilesAddCustomerSetting(params *ProfilesAddCustomerSettingPa
   ProfilesDeleteCustomerSettingsByID profiles delete customer settings by Id API
 */
 func (a *Client) ProfilesDeleteCustomerSettingsByID(params *ProfilesDeleteCustomerSettingsByIDParams) (*ProfilesDeleteCustomerSettingsByIDNoContent, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesDeleteCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesDeleteCustomerSettingsByIDWithContext is the same as
+// ProfilesDeleteCustomerSettingsByID with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) ProfilesDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesDeleteCustomerSettingsByIDParams) (*ProfilesDeleteCustomerSettingsByIDNoContent, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesDeleteCustomerSettingsByIDParams()
@@ -92,12 +129,12 @@ func (a *Client) ProfilesDeleteCustomerSettingsByID(params *ProfilesDeleteCustom
 
 	method, err := ecclient.ToHTTPMethod("DELETE")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesDeleteCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesDeleteCustomerSettingsByIDNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/profiles/{id}",
 		RawBody:        results.Body,
@@ -107,7 +144,7 @@ func (a *Client) ProfilesDeleteCustomerSettingsByID(params *ProfilesDeleteCustom
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesDeleteCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -117,6 +154,19 @@ func (a *Client) ProfilesDeleteCustomerSettingsByID(params *ProfilesDeleteCustom
   ProfilesGetCustomerSettings profiles get customer settings API
 */
 func (a *Client) ProfilesGetCustomerSettings(params *ProfilesGetCustomerSettingsParams) (*ProfilesGetCustomerSettingsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesGetCustomerSettingsWithContext(ctx, params)
+}
+
+// ProfilesGetCustomerSettingsWithContext is the same as
+// ProfilesGetCustomerSettings with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesGetCustomerSettingsParams) (*ProfilesGetCustomerSettingsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesGetCustomerSettingsParams()
@@ -130,12 +180,12 @@ func (a *Client) ProfilesGetCustomerSettings(params *ProfilesGetCustomerSettings
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesGetCustomerSettings: %w", err)
 	}
 
 	parsedResponse := &ProfilesGetCustomerSettingsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/profiles",
 		RawBody:        results.Body,
@@ -145,7 +195,7 @@ func (a *Client) ProfilesGetCustomerSettings(params *ProfilesGetCustomerSettings
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesGetCustomerSettings: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -155,6 +205,19 @@ func (a *Client) ProfilesGetCustomerSettings(params *ProfilesGetCustomerSettings
   ProfilesGetCustomerSettingsByID profiles get customer settings by Id API
 */
 func (a *Client) ProfilesGetCustomerSettingsByID(params *ProfilesGetCustomerSettingsByIDParams) (*ProfilesGetCustomerSettingsByIDOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesGetCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesGetCustomerSettingsByIDWithContext is the same as
+// ProfilesGetCustomerSettingsByID with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesGetCustomerSettingsByIDParams) (*ProfilesGetCustomerSettingsByIDOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesGetCustomerSettingsByIDParams()
@@ -168,12 +231,12 @@ func (a *Client) ProfilesGetCustomerSettingsByID(params *ProfilesGetCustomerSett
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesGetCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesGetCustomerSettingsByIDOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/profiles/{id}",
 		RawBody:        results.Body,
@@ -183,7 +246,7 @@ func (a *Client) ProfilesGetCustomerSettingsByID(params *ProfilesGetCustomerSett
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesGetCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -193,6 +256,19 @@ func (a *Client) ProfilesGetCustomerSettingsByID(params *ProfilesGetCustomerSett
   ProfilesUpdateCustomerSetting profiles update customer setting API
 */
 func (a *Client) ProfilesUpdateCustomerSetting(params *ProfilesUpdateCustomerSettingParams) (*ProfilesUpdateCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesUpdateCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesUpdateCustomerSettingWithContext is the same as
+// ProfilesUpdateCustomerSetting with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesUpdateCustomerSettingParams) (*ProfilesUpdateCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesUpdateCustomerSettingParams()
@@ -206,12 +282,12 @@ func (a *Client) ProfilesUpdateCustomerSetting(params *ProfilesUpdateCustomerSet
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesUpdateCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesUpdateCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/cdn/profiles/{id}",
 		RawBody:        results.Body,
@@ -221,7 +297,7 @@ func (a *Client) ProfilesUpdateCustomerSetting(params *ProfilesUpdateCustomerSet
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesUpdateCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
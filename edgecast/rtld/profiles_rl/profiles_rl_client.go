@@ -6,6 +6,7 @@ package profiles_rl
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -28,19 +29,42 @@ type Client struct {
 type ClientService interface {
 	ProfilesRateLimitingAddCustomerSetting(params *ProfilesRateLimitingAddCustomerSettingParams) (*ProfilesRateLimitingAddCustomerSettingOK, error)
 
+	ProfilesRateLimitingAddCustomerSettingWithContext(ctx context.Context, params *ProfilesRateLimitingAddCustomerSettingParams) (*ProfilesRateLimitingAddCustomerSettingOK, error)
+
 	ProfilesRateLimitingGetCustomerSettings(params *ProfilesRateLimitingGetCustomerSettingsParams) (*ProfilesRateLimitingGetCustomerSettingsOK, error)
 
+	ProfilesRateLimitingGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesRateLimitingGetCustomerSettingsParams) (*ProfilesRateLimitingGetCustomerSettingsOK, error)
+
 	ProfilesRlDeleteCustomerSettingsByID(params *ProfilesRlDeleteCustomerSettingsByIDParams) (*ProfilesRlDeleteCustomerSettingsByIDNoContent, error)
 
+	ProfilesRlDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesRlDeleteCustomerSettingsByIDParams) (*ProfilesRlDeleteCustomerSettingsByIDNoContent, error)
+
 	ProfilesRlGetCustomerSettingsByID(params *ProfilesRlGetCustomerSettingsByIDParams) (*ProfilesRlGetCustomerSettingsByIDOK, error)
 
+	ProfilesRlGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesRlGetCustomerSettingsByIDParams) (*ProfilesRlGetCustomerSettingsByIDOK, error)
+
 	ProfilesRlUpdateCustomerSetting(params *ProfilesRlUpdateCustomerSettingParams) (*ProfilesRlUpdateCustomerSettingOK, error)
+
+	ProfilesRlUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesRlUpdateCustomerSettingParams) (*ProfilesRlUpdateCustomerSettingOK, error)
 }
 
 /*
   ProfilesRateLimitingAddCustomerSetting profiles rate limiting add customer setting API
 */
 func (a *Client) ProfilesRateLimitingAddCustomerSetting(params *ProfilesRateLimitingAddCustomerSettingParams) (*ProfilesRateLimitingAddCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesRateLimitingAddCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesRateLimitingAddCustomerSettingWithContext is the same as
+// ProfilesRateLimitingAddCustomerSetting with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) ProfilesRateLimitingAddCustomerSettingWithContext(ctx context.Context, params *ProfilesRateLimitingAddCustomerSettingParams) (*ProfilesRateLimitingAddCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesRateLimitingAddCustomerSettingParams()
@@ -54,12 +78,12 @@ func (a *Client) ProfilesRateLimitingAddCustomerSetting(params *ProfilesRateLimi
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRateLimitingAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesRateLimitingAddCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesRateLimitingAddCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/profiles",
 		RawBody:        results.Body,
@@ -69,7 +93,7 @@ func (a *Client) ProfilesRateLimitingAddCustomerSetting(params *ProfilesRateLimi
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRateLimitingAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesRateLimitingAddCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -79,6 +103,19 @@ func (a *Client) ProfilesRateLimitingAddCustomerSetting(params *ProfilesRateLimi
   ProfilesRateLimitingGetCustomerSettings profiles rate limiting get customer settings API
 */
 func (a *Client) ProfilesRateLimitingGetCustomerSettings(params *ProfilesRateLimitingGetCustomerSettingsParams) (*ProfilesRateLimitingGetCustomerSettingsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesRateLimitingGetCustomerSettingsWithContext(ctx, params)
+}
+
+// ProfilesRateLimitingGetCustomerSettingsWithContext is the same as
+// ProfilesRateLimitingGetCustomerSettings with the addition of a
+// context.Context that governs cancellation and deadlines for the call. The
+// Context field of params is ignored.
+func (a *Client) ProfilesRateLimitingGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesRateLimitingGetCustomerSettingsParams) (*ProfilesRateLimitingGetCustomerSettingsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesRateLimitingGetCustomerSettingsParams()
@@ -92,12 +129,12 @@ func (a *Client) ProfilesRateLimitingGetCustomerSettings(params *ProfilesRateLim
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRateLimitingGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesRateLimitingGetCustomerSettings: %w", err)
 	}
 
 	parsedResponse := &ProfilesRateLimitingGetCustomerSettingsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/profiles",
 		RawBody:        results.Body,
@@ -107,7 +144,7 @@ func (a *Client) ProfilesRateLimitingGetCustomerSettings(params *ProfilesRateLim
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRateLimitingGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesRateLimitingGetCustomerSettings: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -117,6 +154,19 @@ func (a *Client) ProfilesRateLimitingGetCustomerSettings(params *ProfilesRateLim
   ProfilesRlDeleteCustomerSettingsByID profiles rl delete customer settings by Id API
 */
 func (a *Client) ProfilesRlDeleteCustomerSettingsByID(params *ProfilesRlDeleteCustomerSettingsByIDParams) (*ProfilesRlDeleteCustomerSettingsByIDNoContent, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesRlDeleteCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesRlDeleteCustomerSettingsByIDWithContext is the same as
+// ProfilesRlDeleteCustomerSettingsByID with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) ProfilesRlDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesRlDeleteCustomerSettingsByIDParams) (*ProfilesRlDeleteCustomerSettingsByIDNoContent, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesRlDeleteCustomerSettingsByIDParams()
@@ -130,12 +180,12 @@ func (a *Client) ProfilesRlDeleteCustomerSettingsByID(params *ProfilesRlDeleteCu
 
 	method, err := ecclient.ToHTTPMethod("DELETE")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesRlDeleteCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesRlDeleteCustomerSettingsByIDNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/profiles/{id}",
 		RawBody:        results.Body,
@@ -145,7 +195,7 @@ func (a *Client) ProfilesRlDeleteCustomerSettingsByID(params *ProfilesRlDeleteCu
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesRlDeleteCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -155,6 +205,19 @@ func (a *Client) ProfilesRlDeleteCustomerSettingsByID(params *ProfilesRlDeleteCu
   ProfilesRlGetCustomerSettingsByID profiles rl get customer settings by Id API
 */
 func (a *Client) ProfilesRlGetCustomerSettingsByID(params *ProfilesRlGetCustomerSettingsByIDParams) (*ProfilesRlGetCustomerSettingsByIDOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesRlGetCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesRlGetCustomerSettingsByIDWithContext is the same as
+// ProfilesRlGetCustomerSettingsByID with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesRlGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesRlGetCustomerSettingsByIDParams) (*ProfilesRlGetCustomerSettingsByIDOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesRlGetCustomerSettingsByIDParams()
@@ -168,12 +231,12 @@ func (a *Client) ProfilesRlGetCustomerSettingsByID(params *ProfilesRlGetCustomer
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesRlGetCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesRlGetCustomerSettingsByIDOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/profiles/{id}",
 		RawBody:        results.Body,
@@ -183,7 +246,7 @@ func (a *Client) ProfilesRlGetCustomerSettingsByID(params *ProfilesRlGetCustomer
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesRlGetCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -193,6 +256,19 @@ func (a *Client) ProfilesRlGetCustomerSettingsByID(params *ProfilesRlGetCustomer
   ProfilesRlUpdateCustomerSetting profiles rl update customer setting API
 */
 func (a *Client) ProfilesRlUpdateCustomerSetting(params *ProfilesRlUpdateCustomerSettingParams) (*ProfilesRlUpdateCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesRlUpdateCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesRlUpdateCustomerSettingWithContext is the same as
+// ProfilesRlUpdateCustomerSetting with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesRlUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesRlUpdateCustomerSettingParams) (*ProfilesRlUpdateCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesRlUpdateCustomerSettingParams()
@@ -206,12 +282,12 @@ func (a *Client) ProfilesRlUpdateCustomerSetting(params *ProfilesRlUpdateCustome
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesRlUpdateCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesRlUpdateCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/profiles/{id}",
 		RawBody:        results.Body,
@@ -221,7 +297,7 @@ func (a *Client) ProfilesRlUpdateCustomerSetting(params *ProfilesRlUpdateCustome
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesRlUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesRlUpdateCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -6,6 +6,7 @@ package profiles_waf
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -28,19 +29,42 @@ type Client struct {
 type ClientService interface {
 	ProfilesWafAddCustomerSetting(params *ProfilesWafAddCustomerSettingParams) (*ProfilesWafAddCustomerSettingOK, error)
 
+	ProfilesWafAddCustomerSettingWithContext(ctx context.Context, params *ProfilesWafAddCustomerSettingParams) (*ProfilesWafAddCustomerSettingOK, error)
+
 	ProfilesWafDeleteCustomerSettingsByID(params *ProfilesWafDeleteCustomerSettingsByIDParams) (*ProfilesWafDeleteCustomerSettingsByIDNoContent, error)
 
+	ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesWafDeleteCustomerSettingsByIDParams) (*ProfilesWafDeleteCustomerSettingsByIDNoContent, error)
+
 	ProfilesWafGetCustomerSettings(params *ProfilesWafGetCustomerSettingsParams) (*ProfilesWafGetCustomerSettingsOK, error)
 
+	ProfilesWafGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesWafGetCustomerSettingsParams) (*ProfilesWafGetCustomerSettingsOK, error)
+
 	ProfilesWafGetCustomerSettingsByID(params *ProfilesWafGetCustomerSettingsByIDParams) (*ProfilesWafGetCustomerSettingsByIDOK, error)
 
+	ProfilesWafGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesWafGetCustomerSettingsByIDParams) (*ProfilesWafGetCustomerSettingsByIDOK, error)
+
 	ProfilesWafUpdateCustomerSetting(params *ProfilesWafUpdateCustomerSettingParams) (*ProfilesWafUpdateCustomerSettingOK, error)
+
+	ProfilesWafUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesWafUpdateCustomerSettingParams) (*ProfilesWafUpdateCustomerSettingOK, error)
 }
 
 /*
   ProfilesWafAddCustomerSetting profiles waf add customer setting API
 */
 func (a *Client) ProfilesWafAddCustomerSetting(params *ProfilesWafAddCustomerSettingParams) (*ProfilesWafAddCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesWafAddCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesWafAddCustomerSettingWithContext is the same as
+// ProfilesWafAddCustomerSetting with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesWafAddCustomerSettingWithContext(ctx context.Context, params *ProfilesWafAddCustomerSettingParams) (*ProfilesWafAddCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesWafAddCustomerSettingParams()
@@ -54,12 +78,12 @@ func (a *Client) ProfilesWafAddCustomerSetting(params *ProfilesWafAddCustomerSet
 
 	method, err := ecclient.ToHTTPMethod("POST")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesWafAddCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesWafAddCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/profiles",
 		RawBody:        results.Body,
@@ -69,7 +93,7 @@ func (a *Client) ProfilesWafAddCustomerSetting(params *ProfilesWafAddCustomerSet
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafAddCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesWafAddCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -79,6 +103,19 @@ func (a *Client) ProfilesWafAddCustomerSetting(params *ProfilesWafAddCustomerSet
   ProfilesWafDeleteCustomerSettingsByID profiles waf delete customer settings by Id API
 */
 func (a *Client) ProfilesWafDeleteCustomerSettingsByID(params *ProfilesWafDeleteCustomerSettingsByIDParams) (*ProfilesWafDeleteCustomerSettingsByIDNoContent, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesWafDeleteCustomerSettingsByIDWithContext is the same as
+// ProfilesWafDeleteCustomerSettingsByID with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) ProfilesWafDeleteCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesWafDeleteCustomerSettingsByIDParams) (*ProfilesWafDeleteCustomerSettingsByIDNoContent, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesWafDeleteCustomerSettingsByIDParams()
@@ -92,12 +129,12 @@ func (a *Client) ProfilesWafDeleteCustomerSettingsByID(params *ProfilesWafDelete
 
 	method, err := ecclient.ToHTTPMethod("DELETE")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesWafDeleteCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesWafDeleteCustomerSettingsByIDNoContent{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/profiles/{id}",
 		RawBody:        results.Body,
@@ -107,7 +144,7 @@ func (a *Client) ProfilesWafDeleteCustomerSettingsByID(params *ProfilesWafDelete
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafDeleteCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesWafDeleteCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -117,6 +154,19 @@ func (a *Client) ProfilesWafDeleteCustomerSettingsByID(params *ProfilesWafDelete
   ProfilesWafGetCustomerSettings profiles waf get customer settings API
 */
 func (a *Client) ProfilesWafGetCustomerSettings(params *ProfilesWafGetCustomerSettingsParams) (*ProfilesWafGetCustomerSettingsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesWafGetCustomerSettingsWithContext(ctx, params)
+}
+
+// ProfilesWafGetCustomerSettingsWithContext is the same as
+// ProfilesWafGetCustomerSettings with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesWafGetCustomerSettingsWithContext(ctx context.Context, params *ProfilesWafGetCustomerSettingsParams) (*ProfilesWafGetCustomerSettingsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesWafGetCustomerSettingsParams()
@@ -130,12 +180,12 @@ func (a *Client) ProfilesWafGetCustomerSettings(params *ProfilesWafGetCustomerSe
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesWafGetCustomerSettings: %w", err)
 	}
 
 	parsedResponse := &ProfilesWafGetCustomerSettingsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/profiles",
 		RawBody:        results.Body,
@@ -145,7 +195,7 @@ func (a *Client) ProfilesWafGetCustomerSettings(params *ProfilesWafGetCustomerSe
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafGetCustomerSettings: %v", err)
+		return nil, fmt.Errorf("ProfilesWafGetCustomerSettings: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -155,6 +205,19 @@ func (a *Client) ProfilesWafGetCustomerSettings(params *ProfilesWafGetCustomerSe
   ProfilesWafGetCustomerSettingsByID profiles waf get customer settings by Id API
 */
 func (a *Client) ProfilesWafGetCustomerSettingsByID(params *ProfilesWafGetCustomerSettingsByIDParams) (*ProfilesWafGetCustomerSettingsByIDOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesWafGetCustomerSettingsByIDWithContext(ctx, params)
+}
+
+// ProfilesWafGetCustomerSettingsByIDWithContext is the same as
+// ProfilesWafGetCustomerSettingsByID with the addition of a context.Context
+// that governs cancellation and deadlines for the call. The Context field of
+// params is ignored.
+func (a *Client) ProfilesWafGetCustomerSettingsByIDWithContext(ctx context.Context, params *ProfilesWafGetCustomerSettingsByIDParams) (*ProfilesWafGetCustomerSettingsByIDOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesWafGetCustomerSettingsByIDParams()
@@ -168,12 +231,12 @@ func (a *Client) ProfilesWafGetCustomerSettingsByID(params *ProfilesWafGetCustom
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesWafGetCustomerSettingsByID: %w", err)
 	}
 
 	parsedResponse := &ProfilesWafGetCustomerSettingsByIDOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/profiles/{id}",
 		RawBody:        results.Body,
@@ -183,7 +246,7 @@ func (a *Client) ProfilesWafGetCustomerSettingsByID(params *ProfilesWafGetCustom
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafGetCustomerSettingsByID: %v", err)
+		return nil, fmt.Errorf("ProfilesWafGetCustomerSettingsByID: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -193,6 +256,19 @@ func (a *Client) ProfilesWafGetCustomerSettingsByID(params *ProfilesWafGetCustom
   ProfilesWafUpdateCustomerSetting profiles waf update customer setting API
 */
 func (a *Client) ProfilesWafUpdateCustomerSetting(params *ProfilesWafUpdateCustomerSettingParams) (*ProfilesWafUpdateCustomerSettingOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.ProfilesWafUpdateCustomerSettingWithContext(ctx, params)
+}
+
+// ProfilesWafUpdateCustomerSettingWithContext is the same as
+// ProfilesWafUpdateCustomerSetting with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) ProfilesWafUpdateCustomerSettingWithContext(ctx context.Context, params *ProfilesWafUpdateCustomerSettingParams) (*ProfilesWafUpdateCustomerSettingOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewProfilesWafUpdateCustomerSettingParams()
@@ -206,12 +282,12 @@ func (a *Client) ProfilesWafUpdateCustomerSetting(params *ProfilesWafUpdateCusto
 
 	method, err := ecclient.ToHTTPMethod("PUT")
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesWafUpdateCustomerSetting: %w", err)
 	}
 
 	parsedResponse := &ProfilesWafUpdateCustomerSettingOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/profiles/{id}",
 		RawBody:        results.Body,
@@ -221,7 +297,7 @@ func (a *Client) ProfilesWafUpdateCustomerSetting(params *ProfilesWafUpdateCusto
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("ProfilesWafUpdateCustomerSetting: %v", err)
+		return nil, fmt.Errorf("ProfilesWafUpdateCustomerSetting: %w", err)
 	}
 
 	return parsedResponse, nil
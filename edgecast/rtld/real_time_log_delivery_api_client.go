@@ -11,7 +11,6 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/rtld/lookups"
@@ -38,54 +37,50 @@ func New(config edgecast.SDKConfig) (*RtldService, error) {
 
 	apiURL, err := url.Parse(config.BaseAPIURL.String() + DefaultBasePath)
 	if err != nil {
-		return nil, fmt.Errorf("RtldService.New(): %v", err)
+		return nil, fmt.Errorf("RtldService.New(): %w", err)
 	}
 
-	// OAuth2 authentication
-	authProvider, err := ecauth.NewIDSAuthorizationProvider(config.BaseIDSURL, ecauth.OAuth2Credentials(config.IDSCredentials))
+	httpClient, err := config.NewHTTPClient()
 	if err != nil {
+		return nil, fmt.Errorf("RtldService.New(): %w", err)
+	}
 
-		//Token authentication
-		authTokenProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
-		if err != nil {
-			return nil, fmt.Errorf("RtldService.New(): %v", err)
-		}
-		c := ecclient.New(ecclient.ClientConfig{
-			BaseAPIURL:   *apiURL,
-			UserAgent:    config.UserAgent,
-			Logger:       config.Logger,
-			AuthProvider: authTokenProvider,
-		})
-
-		return &RtldService{
-			client:           c,
-			Logger:           config.Logger,
-			Lookups:          lookups.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesCdn:      profiles_cdn.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesRl:       profiles_rl.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesWaf:      profiles_waf.New(c, c.Config.BaseAPIURL.String()),
-			SettingsInternal: settings_internal.New(c, c.Config.BaseAPIURL.String()),
-		}, nil
-
-	} else {
-
-		c := ecclient.New(ecclient.ClientConfig{
-			BaseAPIURL:   *apiURL,
-			UserAgent:    config.UserAgent,
-			Logger:       config.Logger,
-			AuthProvider: authProvider,
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{
+			Service: "rtld",
+			IDS:     true,
+			Token:   true,
+			Scopes:  requiredScopes,
 		})
-
-		return &RtldService{
-			client:           c,
-			Logger:           config.Logger,
-			Lookups:          lookups.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesCdn:      profiles_cdn.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesRl:       profiles_rl.New(c, c.Config.BaseAPIURL.String()),
-			ProfilesWaf:      profiles_waf.New(c, c.Config.BaseAPIURL.String()),
-			SettingsInternal: settings_internal.New(c, c.Config.BaseAPIURL.String()),
-		}, nil
+	if err != nil {
+		return nil, fmt.Errorf("RtldService.New(): %w", err)
 	}
+
+	c := ecclient.New(ecclient.ClientConfig{
+		BaseAPIURL:      *apiURL,
+		ServiceName:     "rtld",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
+		AuthProvider:    authProvider,
+	})
+
+	return &RtldService{
+		client:           c,
+		Logger:           config.Logger,
+		Lookups:          lookups.New(c, c.Config.BaseAPIURL.String()),
+		ProfilesCdn:      profiles_cdn.New(c, c.Config.BaseAPIURL.String()),
+		ProfilesRl:       profiles_rl.New(c, c.Config.BaseAPIURL.String()),
+		ProfilesWaf:      profiles_waf.New(c, c.Config.BaseAPIURL.String()),
+		SettingsInternal: settings_internal.New(c, c.Config.BaseAPIURL.String()),
+	}, nil
 }
 
 // RtldService is a client for real time log delivery API
@@ -0,0 +1,13 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package rtld
+
+// requiredScopes are the IDS scopes of Real-Time Log Delivery API tokens
+var requiredScopes = []string{"cdn.rtld"}
+
+// RequiredScopes returns the IDS scopes that New requests Real-Time Log
+// Delivery tokens for, unless replaced by SDKConfig.ServiceScopes["rtld"]
+func RequiredScopes() []string {
+	return append([]string{}, requiredScopes...)
+}
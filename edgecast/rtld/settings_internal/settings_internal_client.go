@@ -6,6 +6,7 @@ package settings_internal
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -28,15 +29,33 @@ type Client struct {
 type ClientService interface {
 	SettingsGetRlSettings(params *SettingsGetRlSettingsParams) (*SettingsGetRlSettingsOK, error)
 
+	SettingsGetRlSettingsWithContext(ctx context.Context, params *SettingsGetRlSettingsParams) (*SettingsGetRlSettingsOK, error)
+
 	SettingsGetSettingsByPlatform(params *SettingsGetSettingsByPlatformParams) (*SettingsGetSettingsByPlatformOK, error)
 
+	SettingsGetSettingsByPlatformWithContext(ctx context.Context, params *SettingsGetSettingsByPlatformParams) (*SettingsGetSettingsByPlatformOK, error)
+
 	SettingsGetWafSettings(params *SettingsGetWafSettingsParams) (*SettingsGetWafSettingsOK, error)
+
+	SettingsGetWafSettingsWithContext(ctx context.Context, params *SettingsGetWafSettingsParams) (*SettingsGetWafSettingsOK, error)
 }
 
 /*
   SettingsGetRlSettings settings get rl settings API
 */
 func (a *Client) SettingsGetRlSettings(params *SettingsGetRlSettingsParams) (*SettingsGetRlSettingsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.SettingsGetRlSettingsWithContext(ctx, params)
+}
+
+// SettingsGetRlSettingsWithContext is the same as SettingsGetRlSettings with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) SettingsGetRlSettingsWithContext(ctx context.Context, params *SettingsGetRlSettingsParams) (*SettingsGetRlSettingsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewSettingsGetRlSettingsParams()
@@ -50,12 +69,12 @@ func (a *Client) SettingsGetRlSettings(params *SettingsGetRlSettingsParams) (*Se
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetRlSettings: %v", err)
+		return nil, fmt.Errorf("SettingsGetRlSettings: %w", err)
 	}
 
 	parsedResponse := &SettingsGetRlSettingsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/rl/settings",
 		RawBody:        results.Body,
@@ -65,7 +84,7 @@ func (a *Client) SettingsGetRlSettings(params *SettingsGetRlSettingsParams) (*Se
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetRlSettings: %v", err)
+		return nil, fmt.Errorf("SettingsGetRlSettings: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -75,6 +94,19 @@ func (a *Client) SettingsGetRlSettings(params *SettingsGetRlSettingsParams) (*Se
   SettingsGetSettingsByPlatform settings get settings by platform API
 */
 func (a *Client) SettingsGetSettingsByPlatform(params *SettingsGetSettingsByPlatformParams) (*SettingsGetSettingsByPlatformOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.SettingsGetSettingsByPlatformWithContext(ctx, params)
+}
+
+// SettingsGetSettingsByPlatformWithContext is the same as
+// SettingsGetSettingsByPlatform with the addition of a context.Context that
+// governs cancellation and deadlines for the call. The Context field of params
+// is ignored.
+func (a *Client) SettingsGetSettingsByPlatformWithContext(ctx context.Context, params *SettingsGetSettingsByPlatformParams) (*SettingsGetSettingsByPlatformOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewSettingsGetSettingsByPlatformParams()
@@ -88,12 +120,12 @@ func (a *Client) SettingsGetSettingsByPlatform(params *SettingsGetSettingsByPlat
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetSettingsByPlatform: %v", err)
+		return nil, fmt.Errorf("SettingsGetSettingsByPlatform: %w", err)
 	}
 
 	parsedResponse := &SettingsGetSettingsByPlatformOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/platforms/{platformId}/settings",
 		RawBody:        results.Body,
@@ -103,7 +135,7 @@ func (a *Client) SettingsGetSettingsByPlatform(params *SettingsGetSettingsByPlat
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetSettingsByPlatform: %v", err)
+		return nil, fmt.Errorf("SettingsGetSettingsByPlatform: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -113,6 +145,18 @@ func (a *Client) SettingsGetSettingsByPlatform(params *SettingsGetSettingsByPlat
   SettingsGetWafSettings settings get waf settings API
 */
 func (a *Client) SettingsGetWafSettings(params *SettingsGetWafSettingsParams) (*SettingsGetWafSettingsOK, error) {
+	ctx := context.Background()
+	if params != nil && params.Context != nil {
+		ctx = params.Context
+	}
+
+	return a.SettingsGetWafSettingsWithContext(ctx, params)
+}
+
+// SettingsGetWafSettingsWithContext is the same as SettingsGetWafSettings with
+// the addition of a context.Context that governs cancellation and deadlines for
+// the call. The Context field of params is ignored.
+func (a *Client) SettingsGetWafSettingsWithContext(ctx context.Context, params *SettingsGetWafSettingsParams) (*SettingsGetWafSettingsOK, error) {
 	// Validate the params before sending
 	if params == nil {
 		params = NewSettingsGetWafSettingsParams()
@@ -126,12 +170,12 @@ func (a *Client) SettingsGetWafSettings(params *SettingsGetWafSettingsParams) (*
 
 	method, err := ecclient.ToHTTPMethod("GET")
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetWafSettings: %v", err)
+		return nil, fmt.Errorf("SettingsGetWafSettings: %w", err)
 	}
 
 	parsedResponse := &SettingsGetWafSettingsOK{}
 
-	_, err = a.client.SubmitRequest(ecclient.SubmitRequestParams{
+	_, err = a.client.SubmitRequestWithContext(ctx, ecclient.SubmitRequestParams{
 		Method:         method,
 		Path:           a.baseAPIURL + "/v1.0/waf/settings",
 		RawBody:        results.Body,
@@ -141,7 +185,7 @@ func (a *Client) SettingsGetWafSettings(params *SettingsGetWafSettingsParams) (*
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("SettingsGetWafSettings: %v", err)
+		return nil, fmt.Errorf("SettingsGetWafSettings: %w", err)
 	}
 
 	return parsedResponse, nil
@@ -0,0 +1,153 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package settings_internal
+
+import (
+	"context"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/rtldmodels"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+	"github.com/go-openapi/swag"
+)
+
+// NewSettingsGetRlSettingsIterator creates an iterator over the results of
+// SettingsGetRlSettings, fetching pages as they are needed. Paging starts at
+// params.Page, or the first page if it is not set, and uses params.PageSize
+// items per page, or options.PageSize if it is not set.
+func NewSettingsGetRlSettingsIterator(
+	ctx context.Context,
+	client ClientService,
+	params *SettingsGetRlSettingsParams,
+	options ecpaging.Options,
+) *ecpaging.Iterator[*rtldmodels.HyperionCollectionRtldRateLimitingSettingDtoItemsItems0] {
+	if params == nil {
+		params = NewSettingsGetRlSettingsParams()
+	}
+
+	// Copy params so that the caller's value is left untouched
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.PageSize == nil && options.PageSize > 0 {
+		params.PageSize = swag.Int32(int32(options.PageSize))
+	}
+
+	fetch := func(
+		ctx context.Context,
+		page int,
+	) (ecpaging.Page[*rtldmodels.HyperionCollectionRtldRateLimitingSettingDtoItemsItems0], error) {
+		params.Page = swag.Int32(int32(page))
+
+		resp, err := client.SettingsGetRlSettingsWithContext(ctx, params)
+		if err != nil {
+			return ecpaging.Page[*rtldmodels.HyperionCollectionRtldRateLimitingSettingDtoItemsItems0]{}, err
+		}
+
+		return ecpaging.Page[*rtldmodels.HyperionCollectionRtldRateLimitingSettingDtoItemsItems0]{
+			Items:      resp.Items,
+			TotalItems: int(resp.TotalItems),
+			PageSize:   int(swag.Int32Value(params.PageSize)),
+		}, nil
+	}
+
+	return ecpaging.NewIterator(
+		ctx,
+		fetch,
+		int(swag.Int32Value(params.Page)),
+		options)
+}
+
+// NewSettingsGetSettingsByPlatformIterator creates an iterator over the results
+// of SettingsGetSettingsByPlatform, fetching pages as they are needed. Paging
+// starts at params.Page, or the first page if it is not set, and uses
+// params.PageSize items per page, or options.PageSize if it is not set.
+func NewSettingsGetSettingsByPlatformIterator(
+	ctx context.Context,
+	client ClientService,
+	params *SettingsGetSettingsByPlatformParams,
+	options ecpaging.Options,
+) *ecpaging.Iterator[*rtldmodels.RtldPlatformSettingDto] {
+	if params == nil {
+		params = NewSettingsGetSettingsByPlatformParams()
+	}
+
+	// Copy params so that the caller's value is left untouched
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.PageSize == nil && options.PageSize > 0 {
+		params.PageSize = swag.Int32(int32(options.PageSize))
+	}
+
+	fetch := func(
+		ctx context.Context,
+		page int,
+	) (ecpaging.Page[*rtldmodels.RtldPlatformSettingDto], error) {
+		params.Page = swag.Int32(int32(page))
+
+		resp, err := client.SettingsGetSettingsByPlatformWithContext(ctx, params)
+		if err != nil {
+			return ecpaging.Page[*rtldmodels.RtldPlatformSettingDto]{}, err
+		}
+
+		return ecpaging.Page[*rtldmodels.RtldPlatformSettingDto]{
+			Items:      resp.Items,
+			TotalItems: int(resp.TotalItems),
+			PageSize:   int(swag.Int32Value(params.PageSize)),
+		}, nil
+	}
+
+	return ecpaging.NewIterator(
+		ctx,
+		fetch,
+		int(swag.Int32Value(params.Page)),
+		options)
+}
+
+// NewSettingsGetWafSettingsIterator creates an iterator over the results of
+// SettingsGetWafSettings, fetching pages as they are needed. Paging starts at
+// params.Page, or the first page if it is not set, and uses params.PageSize
+// items per page, or options.PageSize if it is not set.
+func NewSettingsGetWafSettingsIterator(
+	ctx context.Context,
+	client ClientService,
+	params *SettingsGetWafSettingsParams,
+	options ecpaging.Options,
+) *ecpaging.Iterator[*rtldmodels.RtldPlatformSettingDto] {
+	if params == nil {
+		params = NewSettingsGetWafSettingsParams()
+	}
+
+	// Copy params so that the caller's value is left untouched
+	paramsCopy := *params
+	params = &paramsCopy
+
+	if params.PageSize == nil && options.PageSize > 0 {
+		params.PageSize = swag.Int32(int32(options.PageSize))
+	}
+
+	fetch := func(
+		ctx context.Context,
+		page int,
+	) (ecpaging.Page[*rtldmodels.RtldPlatformSettingDto], error) {
+		params.Page = swag.Int32(int32(page))
+
+		resp, err := client.SettingsGetWafSettingsWithContext(ctx, params)
+		if err != nil {
+			return ecpaging.Page[*rtldmodels.RtldPlatformSettingDto]{}, err
+		}
+
+		return ecpaging.Page[*rtldmodels.RtldPlatformSettingDto]{
+			Items:      resp.Items,
+			TotalItems: int(resp.TotalItems),
+			PageSize:   int(swag.Int32Value(params.PageSize)),
+		}, nil
+	}
+
+	return ecpaging.NewIterator(
+		ctx,
+		fetch,
+		int(swag.Int32Value(params.Page)),
+		options)
+}
@@ -1,6 +1,7 @@
 package rulesengine
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -10,6 +11,15 @@ import (
 // AddPolicy creates a draft or a locked policy.
 func (svc *RulesEngineService) AddPolicy(
 	params AddPolicyParams,
+) (*PolicyResponse, error) {
+	return svc.AddPolicyWithContext(context.Background(), params)
+}
+
+// AddPolicyWithContext is the same as AddPolicy with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RulesEngineService) AddPolicyWithContext(
+	ctx context.Context,
+	params AddPolicyParams,
 ) (*PolicyResponse, error) {
 	parsedResponse := &PolicyResponse{}
 	reqParams := ecclient.SubmitRequestParams{
@@ -29,7 +39,7 @@ func (svc *RulesEngineService) AddPolicy(
 	}
 
 	reqParams.Headers = headers
-	_, err = svc.client.SubmitRequest(reqParams)
+	_, err = svc.client.SubmitRequestWithContext(ctx, reqParams)
 	if err != nil {
 		return nil, fmt.Errorf("AddPolicy: %w", err)
 	}
@@ -40,6 +50,15 @@ func (svc *RulesEngineService) AddPolicy(
 // GetPolicy returns a policy including all of its rules.
 func (svc *RulesEngineService) GetPolicy(
 	params GetPolicyParams,
+) (map[string]interface{}, error) {
+	return svc.GetPolicyWithContext(context.Background(), params)
+}
+
+// GetPolicyWithContext is the same as GetPolicy with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (svc *RulesEngineService) GetPolicyWithContext(
+	ctx context.Context,
+	params GetPolicyParams,
 ) (map[string]interface{}, error) {
 	parsedResponse := make(map[string]interface{})
 	reqParams := ecclient.SubmitRequestParams{
@@ -61,7 +80,7 @@ func (svc *RulesEngineService) GetPolicy(
 	}
 
 	reqParams.Headers = headers
-	_, err = svc.client.SubmitRequest(reqParams)
+	_, err = svc.client.SubmitRequestWithContext(ctx, reqParams)
 	if err != nil {
 		return nil, fmt.Errorf("GetPolicy: %w", err)
 	}
@@ -73,6 +92,16 @@ func (svc *RulesEngineService) GetPolicy(
 // policy to either your production or staging environment.
 func (svc *RulesEngineService) SubmitDeployRequest(
 	params SubmitDeployRequestParams,
+) (*DeployRequestOK, error) {
+	return svc.SubmitDeployRequestWithContext(context.Background(), params)
+}
+
+// SubmitDeployRequestWithContext is the same as SubmitDeployRequest with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (svc *RulesEngineService) SubmitDeployRequestWithContext(
+	ctx context.Context,
+	params SubmitDeployRequestParams,
 ) (*DeployRequestOK, error) {
 	parsedResponse := &DeployRequestOK{}
 	reqParams := ecclient.SubmitRequestParams{
@@ -92,7 +121,7 @@ func (svc *RulesEngineService) SubmitDeployRequest(
 	}
 
 	reqParams.Headers = headers
-	_, err = svc.client.SubmitRequest(reqParams)
+	_, err = svc.client.SubmitRequestWithContext(ctx, reqParams)
 	if err != nil {
 		return nil, fmt.Errorf("SubmitDeployRequest: %w", err)
 	}
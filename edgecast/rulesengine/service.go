@@ -4,11 +4,11 @@
 package rulesengine
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
@@ -18,27 +18,65 @@ type RulesEngineService struct {
 	logger eclog.Logger
 }
 
+// ClientService is the interface for RulesEngineService methods
+type ClientService interface {
+	AddPolicy(
+		params AddPolicyParams,
+	) (*PolicyResponse, error)
+
+	AddPolicyWithContext(
+		ctx context.Context,
+		params AddPolicyParams,
+	) (*PolicyResponse, error)
+
+	GetPolicy(
+		params GetPolicyParams,
+	) (map[string]interface{}, error)
+
+	GetPolicyWithContext(
+		ctx context.Context,
+		params GetPolicyParams,
+	) (map[string]interface{}, error)
+
+	SubmitDeployRequest(
+		params SubmitDeployRequestParams,
+	) (*DeployRequestOK, error)
+
+	SubmitDeployRequestWithContext(
+		ctx context.Context,
+		params SubmitDeployRequestParams,
+	) (*DeployRequestOK, error)
+}
+
+var _ ClientService = (*RulesEngineService)(nil)
+
 // New creates a new Rules Engine service
 func New(config edgecast.SDKConfig) (*RulesEngineService, error) {
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("rulesengine.New(): %w", err)
+	}
 
-	authProvider, err := ecauth.NewIDSAuthorizationProvider(
-		config.BaseIDSURL,
-		ecauth.OAuth2Credentials{
-			ClientID:     config.IDSCredentials.ClientID,
-			ClientSecret: config.IDSCredentials.ClientSecret,
-			Scope:        config.IDSCredentials.Scope,
-		},
-	)
-
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "rulesengine", IDS: true})
 	if err != nil {
-		return nil, fmt.Errorf("rulesengine.New(): %v", err)
+		return nil, fmt.Errorf("rulesengine.New(): %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURL,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURL,
+		ServiceName:     "rulesengine",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &RulesEngineService{
@@ -0,0 +1,191 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+// Package ecpaging provides iterators that walk every page of a paged API
+// endpoint, fetching each page only when it is needed.
+package ecpaging
+
+import (
+	"context"
+	"errors"
+)
+
+// Page is a single page of results returned by a PageFunc
+type Page[T any] struct {
+	// Items are the results on this page
+	Items []T
+
+	// TotalItems is the total number of results across all pages, or zero if
+	// the API did not report it. Iteration stops once this many items have
+	// been fetched, counting a full page for every page before the first one
+	// fetched.
+	TotalItems int
+
+	// PageSize is the number of items requested per page, or zero if the API
+	// default was used. A page with fewer items is the last one.
+	PageSize int
+
+	// Last indicates that no further pages exist
+	Last bool
+}
+
+// PageFunc fetches the page with the given number. Page numbers start at 1.
+type PageFunc[T any] func(ctx context.Context, page int) (Page[T], error)
+
+// Options controls an Iterator
+type Options struct {
+	// MaxItems is the maximum number of items returned. Zero means no limit.
+	MaxItems int
+
+	// PageSize is the number of items requested per page. The iterators of
+	// API endpoints send it to the API unless their parameters already set a
+	// page size. It is also used for pages that do not report their PageSize.
+	// If neither is set, the size of the first page fetched is used.
+	PageSize int
+}
+
+// Iterator walks the items of a paged endpoint. Pages are fetched lazily as
+// Next is called, so callers that stop early never fetch the remaining pages.
+//
+//	it := certificate.NewCertificateFindIterator(ctx, svc.Certificate, params,
+//		ecpaging.Options{})
+//	for it.Next() {
+//		cert := it.Item()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	ctx     context.Context
+	fetch   PageFunc[T]
+	options Options
+
+	firstPage int
+	page      int
+	items     []T
+	index     int
+	returned  int
+	fetched   int
+	done      bool
+	item      T
+	err       error
+}
+
+// NewIterator creates an Iterator that calls fetch for each page, starting
+// with firstPage. A firstPage below 1 starts at page 1.
+func NewIterator[T any](
+	ctx context.Context,
+	fetch PageFunc[T],
+	firstPage int,
+	options Options,
+) *Iterator[T] {
+	if firstPage < 1 {
+		firstPage = 1
+	}
+
+	return &Iterator[T]{
+		ctx:       ctx,
+		fetch:     fetch,
+		options:   options,
+		firstPage: firstPage,
+		page:      firstPage,
+	}
+}
+
+// Next advances to the next item, fetching the next page if needed. It
+// returns false once all items have been returned, MaxItems has been reached,
+// ctx is done, or an error occurs. Check Err after Next returns false.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.options.MaxItems > 0 && it.returned >= it.options.MaxItems {
+		return false
+	}
+
+	if it.ctx == nil {
+		it.err = errors.New("ecpaging: nil context")
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	for it.index >= len(it.items) {
+		if it.done {
+			return false
+		}
+
+		if !it.fetchPage() {
+			return false
+		}
+	}
+
+	it.item = it.items[it.index]
+	it.index++
+	it.returned++
+	return true
+}
+
+// Item returns the current item. It is only valid after Next returns true.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All returns every remaining item, up to MaxItems
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// fetchPage retrieves the next page, reporting whether iteration may
+// continue
+func (it *Iterator[T]) fetchPage() bool {
+	page, err := it.fetch(it.ctx, it.page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	pageSize := page.PageSize
+	if pageSize == 0 {
+		pageSize = it.options.PageSize
+	}
+
+	if it.page == it.firstPage {
+		// Account for the pages that were skipped. The first page fetched may
+		// be the last and hold fewer items, so its length is used only if the
+		// page size is unknown.
+		skippedPageSize := pageSize
+		if skippedPageSize == 0 {
+			skippedPageSize = len(page.Items)
+		}
+		it.fetched = (it.firstPage - 1) * skippedPageSize
+	}
+
+	it.page++
+	it.items = page.Items
+	it.index = 0
+	it.fetched += len(page.Items)
+
+	if page.Last ||
+		len(page.Items) == 0 ||
+		pageSize > 0 && len(page.Items) < pageSize ||
+		page.TotalItems > 0 && it.fetched >= page.TotalItems {
+		it.done = true
+	}
+
+	return true
+}
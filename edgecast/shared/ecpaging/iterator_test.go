@@ -0,0 +1,204 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package ecpaging_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/shared/ecpaging"
+)
+
+// testPages serves the numbers 1 to total, pageSize at a time, and records
+// the pages requested
+type testPages struct {
+	total     int
+	pageSize  int
+	reportAll bool
+	requested []int
+}
+
+func (p *testPages) fetch(
+	ctx context.Context,
+	page int,
+) (ecpaging.Page[int], error) {
+	p.requested = append(p.requested, page)
+
+	var items []int
+	for i := (page-1)*p.pageSize + 1; i <= page*p.pageSize && i <= p.total; i++ {
+		items = append(items, i)
+	}
+
+	result := ecpaging.Page[int]{Items: items}
+	if p.reportAll {
+		result.TotalItems = p.total
+	}
+	return result, nil
+}
+
+func TestIterator(t *testing.T) {
+	cases := []struct {
+		name              string
+		pages             testPages
+		firstPage         int
+		options           ecpaging.Options
+		expectedItems     []int
+		expectedRequested []int
+	}{
+		{
+			name:              "stops at an empty page",
+			pages:             testPages{total: 5, pageSize: 2},
+			expectedItems:     []int{1, 2, 3, 4, 5},
+			expectedRequested: []int{1, 2, 3, 4},
+		},
+		{
+			name:              "stops at TotalItems",
+			pages:             testPages{total: 4, pageSize: 2, reportAll: true},
+			expectedItems:     []int{1, 2, 3, 4},
+			expectedRequested: []int{1, 2},
+		},
+		{
+			name:              "starts at firstPage",
+			pages:             testPages{total: 6, pageSize: 2, reportAll: true},
+			firstPage:         2,
+			expectedItems:     []int{3, 4, 5, 6},
+			expectedRequested: []int{2, 3},
+		},
+		{
+			name:              "stops at MaxItems without fetching more pages",
+			pages:             testPages{total: 10, pageSize: 2},
+			options:           ecpaging.Options{MaxItems: 3},
+			expectedItems:     []int{1, 2, 3},
+			expectedRequested: []int{1, 2},
+		},
+		{
+			name:              "empty",
+			pages:             testPages{total: 0, pageSize: 2},
+			expectedRequested: []int{1},
+		},
+	}
+
+	for _, c := range cases {
+		pages := c.pages
+		it := ecpaging.NewIterator(
+			context.Background(),
+			pages.fetch,
+			c.firstPage,
+			c.options)
+
+		actual, err := it.All()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(c.expectedItems, actual) {
+			t.Fatalf("%s: Expected %+v but got %+v", c.name, c.expectedItems, actual)
+		}
+		if !reflect.DeepEqual(c.expectedRequested, pages.requested) {
+			t.Fatalf(
+				"%s: Expected pages %+v but got %+v",
+				c.name,
+				c.expectedRequested,
+				pages.requested)
+		}
+	}
+}
+
+func TestIteratorPageSize(t *testing.T) {
+	requested := 0
+	fetch := func(ctx context.Context, page int) (ecpaging.Page[int], error) {
+		requested++
+		return ecpaging.Page[int]{Items: []int{1}, PageSize: 2}, nil
+	}
+
+	it := ecpaging.NewIterator(context.Background(), fetch, 1, ecpaging.Options{})
+	it.All()
+
+	if requested != 1 {
+		t.Fatalf("Expected a short page to be the last but got %d requests", requested)
+	}
+}
+
+func TestIteratorPartialFirstPage(t *testing.T) {
+	pages := testPages{total: 5, pageSize: 2, reportAll: true}
+	it := ecpaging.NewIterator(
+		context.Background(),
+		pages.fetch,
+		3,
+		ecpaging.Options{PageSize: 2})
+
+	items, err := it.All()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual([]int{5}, items) {
+		t.Fatalf("Expected %+v but got %+v", []int{5}, items)
+	}
+
+	// The skipped pages hold 4 items, so the partial page reaches TotalItems
+	if !reflect.DeepEqual([]int{3}, pages.requested) {
+		t.Fatalf("Expected pages %+v but got %+v", []int{3}, pages.requested)
+	}
+}
+
+func TestIteratorEarlyBreak(t *testing.T) {
+	pages := testPages{total: 10, pageSize: 2}
+	it := ecpaging.NewIterator(
+		context.Background(),
+		pages.fetch,
+		1,
+		ecpaging.Options{})
+
+	for it.Next() {
+		if it.Item() == 3 {
+			break
+		}
+	}
+
+	if !reflect.DeepEqual([]int{1, 2}, pages.requested) {
+		t.Fatalf("Expected pages %+v but got %+v", []int{1, 2}, pages.requested)
+	}
+}
+
+func TestIteratorCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pages := testPages{total: 10, pageSize: 2}
+	it := ecpaging.NewIterator(ctx, pages.fetch, 1, ecpaging.Options{})
+
+	if !it.Next() {
+		t.Fatalf("unexpected error: %v", it.Err())
+	}
+	cancel()
+
+	if it.Next() {
+		t.Fatalf("Expected Next to return false after cancellation")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("Expected %v but got %v", context.Canceled, it.Err())
+	}
+	if len(pages.requested) != 1 {
+		t.Fatalf("Expected 1 page request but got %d", len(pages.requested))
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	fetchErr := errors.New("fetch failed")
+	fetch := func(ctx context.Context, page int) (ecpaging.Page[int], error) {
+		if page == 2 {
+			return ecpaging.Page[int]{}, fetchErr
+		}
+		return ecpaging.Page[int]{Items: []int{page}}, nil
+	}
+
+	it := ecpaging.NewIterator(context.Background(), fetch, 1, ecpaging.Options{})
+	items, err := it.All()
+
+	if !errors.Is(err, fetchErr) {
+		t.Fatalf("Expected %v but got %v", fetchErr, err)
+	}
+	if !reflect.DeepEqual([]int{1}, items) {
+		t.Fatalf("Expected %+v but got %+v", []int{1}, items)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package edgecast
+
+import "github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
+
+// TokenCache stores IDS tokens so that short-lived processes can reuse a
+// token retrieved by an earlier process. Implementations must be safe for
+// concurrent use.
+type TokenCache = ecauth.TokenCache
+
+// TokenCacheKey identifies the token of a client for a set of scopes
+type TokenCacheKey = ecauth.TokenCacheKey
+
+// IDSToken holds an IDS access token and its expiration time
+type IDSToken = ecauth.IDSToken
+
+// FileTokenCache is a TokenCache that stores each token in its own file,
+// readable only by its owner. It is safe for several processes to share a
+// directory.
+type FileTokenCache = ecauth.FileTokenCache
+
+// NewFileTokenCache creates a FileTokenCache that stores tokens in dir, which
+// is created if it does not exist. If dir is empty, an edgecast/tokens
+// directory under the user's cache directory is used.
+func NewFileTokenCache(dir string) (*FileTokenCache, error) {
+	return ecauth.NewFileTokenCache(dir)
+}
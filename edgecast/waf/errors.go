@@ -0,0 +1,70 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package waf
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules"
+)
+
+// decodeWAFError stores the list of errors reported by the WAF API in
+// APIError.Details as a []rules.WAFError
+func decodeWAFError(apiErr *ecclient.APIError) {
+	resp := rules.WAFResponse{}
+	err := json.Unmarshal(apiErr.Body, &resp)
+	if err == nil && len(resp.Errors) > 0 {
+		apiErr.Details = resp.Errors
+	}
+}
+
+// WAFErrors retrieves the errors reported by the WAF API from err, if err was
+// caused by a failed WAF API call
+func WAFErrors(err error) ([]rules.WAFError, bool) {
+	apiErr, ok := ecclient.AsAPIError(err)
+	if !ok {
+		return nil, false
+	}
+	wafErrors, ok := apiErr.Details.([]rules.WAFError)
+	return wafErrors, ok
+}
+
+// ruleNotProcessedMessage is part of the message of the error returned by the
+// WAF API when a rule referenced by a scope has not been processed yet, e.g.
+// "One or more rules referenced by the configuration have not been processed
+// yet". The API reports it with the generic code "400", so only the message
+// identifies it.
+const ruleNotProcessedMessage = "not been processed"
+
+// IsRuleNotProcessed determines whether err was caused by the WAF API
+// rejecting a change to Scopes because one or more of the rules it references
+// have not been fully processed by the CDN yet. The operation may be retried.
+// Other errors, including those whose body could not be decoded, are not
+// matched.
+func IsRuleNotProcessed(err error) bool {
+	apiErr, ok := ecclient.AsAPIError(err)
+	if !ok ||
+		apiErr.StatusCode != http.StatusBadRequest ||
+		apiErr.Method != http.MethodPost ||
+		!strings.Contains(apiErr.URL, "waf/v1.0/scopes") {
+		return false
+	}
+
+	wafErrors, ok := apiErr.Details.([]rules.WAFError)
+	if !ok {
+		return false
+	}
+
+	for _, e := range wafErrors {
+		message := strings.ToLower(e.Message)
+		if strings.Contains(message, ruleNotProcessedMessage) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package waf
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
+)
+
+func TestIsRuleNotProcessed(t *testing.T) {
+	scopesURL := "https://api.example.com/waf/v1.0/scopes"
+	notProcessed := `{"success":false,"status":"error","errors":[{` +
+		`"code":"400","message":"One or more rules referenced by the ` +
+		`configuration have not been processed yet"}]}`
+	generic := `{"success":false,"status":"error","errors":[{` +
+		`"code":"400","message":"Invalid scope: name is required"}]}`
+
+	cases := []struct {
+		name     string
+		method   string
+		url      string
+		status   int
+		body     string
+		expected bool
+	}{
+		{
+			name:     "rule not processed",
+			method:   http.MethodPost,
+			url:      scopesURL,
+			status:   http.StatusBadRequest,
+			body:     notProcessed,
+			expected: true,
+		},
+		{
+			name:     "generic bad request",
+			method:   http.MethodPost,
+			url:      scopesURL,
+			status:   http.StatusBadRequest,
+			body:     generic,
+			expected: false,
+		},
+		{
+			name:     "undecodable body",
+			method:   http.MethodPost,
+			url:      scopesURL,
+			status:   http.StatusBadRequest,
+			body:     "<html>Bad Request</html>",
+			expected: false,
+		},
+		{
+			name:     "other endpoint",
+			method:   http.MethodPost,
+			url:      "https://api.example.com/waf/v1.0/acl",
+			status:   http.StatusBadRequest,
+			body:     notProcessed,
+			expected: false,
+		},
+		{
+			name:     "other status",
+			method:   http.MethodPost,
+			url:      scopesURL,
+			status:   http.StatusInternalServerError,
+			body:     notProcessed,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		apiErr := &ecclient.APIError{
+			StatusCode: c.status,
+			Method:     c.method,
+			URL:        c.url,
+			Body:       []byte(c.body),
+		}
+		decodeWAFError(apiErr)
+		err := fmt.Errorf("ModifyAllScopes: %w", apiErr)
+
+		if actual := IsRuleNotProcessed(err); actual != c.expected {
+			t.Fatalf("%s: Expected %v but got %v", c.name, c.expected, actual)
+		}
+	}
+}
@@ -14,6 +14,7 @@ package access
 */
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -34,38 +35,74 @@ type Client struct {
 type ClientService interface {
 	AddAccessRule(params AddAccessRuleParams) (string, error)
 
+	AddAccessRuleWithContext(
+		ctx context.Context,
+		params AddAccessRuleParams,
+	) (string, error)
+
 	GetAllAccessRules(
 		params GetAllAccessRulesParams,
 	) (*[]AccessRuleGetAllOK, error)
 
+	GetAllAccessRulesWithContext(
+		ctx context.Context,
+		params GetAllAccessRulesParams,
+	) (*[]AccessRuleGetAllOK, error)
+
 	GetAccessRule(
 		params GetAccessRuleParams,
 	) (*AccessRuleGetOK, error)
 
+	GetAccessRuleWithContext(
+		ctx context.Context,
+		params GetAccessRuleParams,
+	) (*AccessRuleGetOK, error)
+
 	UpdateAccessRule(
 		params UpdateAccessRuleParams,
 	) error
 
+	UpdateAccessRuleWithContext(
+		ctx context.Context,
+		params UpdateAccessRuleParams,
+	) error
+
 	DeleteAccessRule(
 		params DeleteAccessRuleParams,
 	) error
+
+	DeleteAccessRuleWithContext(
+		ctx context.Context,
+		params DeleteAccessRuleParams,
+	) error
 }
 
 // AddAccessRule creates a new Access Rule for the provided account number
 // and returns the new rule's system-generated ID
 func (c Client) AddAccessRule(
 	params AddAccessRuleParams,
+) (string, error) {
+	return c.AddAccessRuleWithContext(context.Background(), params)
+}
+
+// AddAccessRuleWithContext is the same as AddAccessRule with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) AddAccessRuleWithContext(
+	ctx context.Context,
+	params AddAccessRuleParams,
 ) (string, error) {
 	parsedResponse := &AccessRuleAddOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/acl",
-		RawBody: params.AccessRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: &parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/acl",
+			RawBody: params.AccessRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: &parsedResponse,
+		})
 	if err != nil {
 		return "", fmt.Errorf("error creating access rule: %w", err)
 	}
@@ -76,16 +113,28 @@ func (c Client) AddAccessRule(
 // account number.
 func (c Client) GetAllAccessRules(
 	params GetAllAccessRulesParams,
+) (*[]AccessRuleGetAllOK, error) {
+	return c.GetAllAccessRulesWithContext(context.Background(), params)
+}
+
+// GetAllAccessRulesWithContext is the same as GetAllAccessRules with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) GetAllAccessRulesWithContext(
+	ctx context.Context,
+	params GetAllAccessRulesParams,
 ) (*[]AccessRuleGetAllOK, error) {
 	parsedResponse := &[]AccessRuleGetAllOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting all access rules: %w", err)
 	}
@@ -96,17 +145,28 @@ func (c Client) GetAllAccessRules(
 // with the provided Access Rule ID.
 func (c Client) GetAccessRule(
 	params GetAccessRuleParams,
+) (*AccessRuleGetOK, error) {
+	return c.GetAccessRuleWithContext(context.Background(), params)
+}
+
+// GetAccessRuleWithContext is the same as GetAccessRule with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetAccessRuleWithContext(
+	ctx context.Context,
+	params GetAccessRuleParams,
 ) (*AccessRuleGetOK, error) {
 	parsedResponse := &AccessRuleGetOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.AccessRuleID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.AccessRuleID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting access rule: %w", err)
 	}
@@ -118,17 +178,28 @@ func (c Client) GetAccessRule(
 func (c Client) UpdateAccessRule(
 	params UpdateAccessRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
-		RawBody: params.AccessRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.AccessRuleID,
-		},
-	})
+	return c.UpdateAccessRuleWithContext(context.Background(), params)
+}
+
+// UpdateAccessRuleWithContext is the same as UpdateAccessRule with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) UpdateAccessRuleWithContext(
+	ctx context.Context,
+	params UpdateAccessRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
+			RawBody: params.AccessRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.AccessRuleID,
+			},
+		})
 	if err != nil {
-		return fmt.Errorf("error updating access rule: %v", err)
+		return fmt.Errorf("error updating access rule: %w", err)
 	}
 
 	return nil
@@ -139,16 +210,27 @@ func (c Client) UpdateAccessRule(
 func (c Client) DeleteAccessRule(
 	params DeleteAccessRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.AccessRuleID,
-		},
-	})
+	return c.DeleteAccessRuleWithContext(context.Background(), params)
+}
+
+// DeleteAccessRuleWithContext is the same as DeleteAccessRule with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) DeleteAccessRuleWithContext(
+	ctx context.Context,
+	params DeleteAccessRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/acl/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.AccessRuleID,
+			},
+		})
 	if err != nil {
-		return fmt.Errorf("error deleting access rule: %v", err)
+		return fmt.Errorf("error deleting access rule: %w", err)
 	}
 
 	return nil
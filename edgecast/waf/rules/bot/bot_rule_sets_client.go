@@ -4,6 +4,7 @@
 package bot
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -40,38 +41,74 @@ type ClientService interface {
 		params AddBotRuleSetParams,
 	) (string, error)
 
+	AddBotRuleSetWithContext(
+		ctx context.Context,
+		params AddBotRuleSetParams,
+	) (string, error)
+
 	GetAllBotRuleSets(
 		params GetAllBotRuleSetsParams,
 	) (*[]BotRuleSetGetAllOK, error)
 
+	GetAllBotRuleSetsWithContext(
+		ctx context.Context,
+		params GetAllBotRuleSetsParams,
+	) (*[]BotRuleSetGetAllOK, error)
+
 	DeleteBotRuleSet(
 		params DeleteBotRuleSetParams,
 	) error
 
+	DeleteBotRuleSetWithContext(
+		ctx context.Context,
+		params DeleteBotRuleSetParams,
+	) error
+
 	GetBotRuleSet(
 		params GetBotRuleSetParams,
 	) (*BotRuleSetGetOK, error)
 
+	GetBotRuleSetWithContext(
+		ctx context.Context,
+		params GetBotRuleSetParams,
+	) (*BotRuleSetGetOK, error)
+
 	UpdateBotRuleSet(
 		params UpdateBotRuleSetParams,
 	) error
+
+	UpdateBotRuleSetWithContext(
+		ctx context.Context,
+		params UpdateBotRuleSetParams,
+	) error
 }
 
 // AddBotRuleSet creates a Bot Rule Set for the provided account number and
 // returns the new rule set's system-generated ID
 func (c Client) AddBotRuleSet(
 	params AddBotRuleSetParams,
+) (string, error) {
+	return c.AddBotRuleSetWithContext(context.Background(), params)
+}
+
+// AddBotRuleSetWithContext is the same as AddBotRuleSet with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) AddBotRuleSetWithContext(
+	ctx context.Context,
+	params AddBotRuleSetParams,
 ) (string, error) {
 	parsedResponse := &BotRuleSetAddOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/bots",
-		RawBody: params.BotRuleSet,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/bots",
+			RawBody: params.BotRuleSet,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return "", fmt.Errorf("error creating bot rule set: %w", err)
 	}
@@ -82,16 +119,28 @@ func (c Client) AddBotRuleSet(
 // account number.
 func (c Client) GetAllBotRuleSets(
 	params GetAllBotRuleSetsParams,
+) (*[]BotRuleSetGetAllOK, error) {
+	return c.GetAllBotRuleSetsWithContext(context.Background(), params)
+}
+
+// GetAllBotRuleSetsWithContext is the same as GetAllBotRuleSets with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) GetAllBotRuleSetsWithContext(
+	ctx context.Context,
+	params GetAllBotRuleSetsParams,
 ) (*[]BotRuleSetGetAllOK, error) {
 	parsedResponse := &[]BotRuleSetGetAllOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting all bot rule sets: %w", err)
 	}
@@ -103,14 +152,25 @@ func (c Client) GetAllBotRuleSets(
 func (c Client) DeleteBotRuleSet(
 	params DeleteBotRuleSetParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.BotRuleSetID,
-		},
-	})
+	return c.DeleteBotRuleSetWithContext(context.Background(), params)
+}
+
+// DeleteBotRuleSetWithContext is the same as DeleteBotRuleSet with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) DeleteBotRuleSetWithContext(
+	ctx context.Context,
+	params DeleteBotRuleSetParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.BotRuleSetID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error deleting bot rule set: %w", err)
 	}
@@ -121,17 +181,28 @@ func (c Client) DeleteBotRuleSet(
 // with the provided Bot Rule Set ID.
 func (c Client) GetBotRuleSet(
 	params GetBotRuleSetParams,
+) (*BotRuleSetGetOK, error) {
+	return c.GetBotRuleSetWithContext(context.Background(), params)
+}
+
+// GetBotRuleSetWithContext is the same as GetBotRuleSet with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetBotRuleSetWithContext(
+	ctx context.Context,
+	params GetBotRuleSetParams,
 ) (*BotRuleSetGetOK, error) {
 	parsedResponse := &BotRuleSetGetOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.BotRuleSetID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.BotRuleSetID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting bot rule set: %w", err)
 	}
@@ -143,15 +214,26 @@ func (c Client) GetBotRuleSet(
 func (c Client) UpdateBotRuleSet(
 	params UpdateBotRuleSetParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
-		RawBody: params.BotRuleSet,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.BotRuleSetID,
-		},
-	})
+	return c.UpdateBotRuleSetWithContext(context.Background(), params)
+}
+
+// UpdateBotRuleSetWithContext is the same as UpdateBotRuleSet with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) UpdateBotRuleSetWithContext(
+	ctx context.Context,
+	params UpdateBotRuleSetParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/bots/{rule_id}",
+			RawBody: params.BotRuleSet,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.BotRuleSetID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error updating bot rule set: %w", err)
 	}
@@ -17,6 +17,7 @@ package custom
 */
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -39,38 +40,74 @@ type ClientService interface {
 		params AddCustomRuleSetParams,
 	) (string, error)
 
+	AddCustomRuleSetWithContext(
+		ctx context.Context,
+		params AddCustomRuleSetParams,
+	) (string, error)
+
 	GetAllCustomRuleSets(
 		params GetAllCustomRuleSetsParams,
 	) (*[]CustomRuleSetGetAllOK, error)
 
+	GetAllCustomRuleSetsWithContext(
+		ctx context.Context,
+		params GetAllCustomRuleSetsParams,
+	) (*[]CustomRuleSetGetAllOK, error)
+
 	DeleteCustomRuleSet(
 		params DeleteCustomRuleSetParams,
 	) error
 
+	DeleteCustomRuleSetWithContext(
+		ctx context.Context,
+		params DeleteCustomRuleSetParams,
+	) error
+
 	GetCustomRuleSet(
 		params GetCustomRuleSetParams,
 	) (*CustomRuleSetGetOK, error)
 
+	GetCustomRuleSetWithContext(
+		ctx context.Context,
+		params GetCustomRuleSetParams,
+	) (*CustomRuleSetGetOK, error)
+
 	UpdateCustomRuleSet(
 		params UpdateCustomRuleSetParams,
 	) error
+
+	UpdateCustomRuleSetWithContext(
+		ctx context.Context,
+		params UpdateCustomRuleSetParams,
+	) error
 }
 
 // AddCustomRuleSet creates a Custom Rule Set for the provided account number
 // and returns the new rule's system-generated ID
 func (c Client) AddCustomRuleSet(
 	params AddCustomRuleSetParams,
+) (string, error) {
+	return c.AddCustomRuleSetWithContext(context.Background(), params)
+}
+
+// AddCustomRuleSetWithContext is the same as AddCustomRuleSet with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) AddCustomRuleSetWithContext(
+	ctx context.Context,
+	params AddCustomRuleSetParams,
 ) (string, error) {
 	parsedResponse := &CustomRuleSetAddOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/rules",
-		RawBody: params.CustomRuleSet,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/rules",
+			RawBody: params.CustomRuleSet,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return "", fmt.Errorf("error adding custom rule set: %w", err)
 	}
@@ -81,16 +118,28 @@ func (c Client) AddCustomRuleSet(
 // account number.
 func (c Client) GetAllCustomRuleSets(
 	params GetAllCustomRuleSetsParams,
+) (*[]CustomRuleSetGetAllOK, error) {
+	return c.GetAllCustomRuleSetsWithContext(context.Background(), params)
+}
+
+// GetAllCustomRuleSetsWithContext is the same as GetAllCustomRuleSets with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) GetAllCustomRuleSetsWithContext(
+	ctx context.Context,
+	params GetAllCustomRuleSetsParams,
 ) (*[]CustomRuleSetGetAllOK, error) {
 	parsedResponse := &[]CustomRuleSetGetAllOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting all custom rule sets: %w", err)
 	}
@@ -102,14 +151,26 @@ func (c Client) GetAllCustomRuleSets(
 func (c Client) DeleteCustomRuleSet(
 	params DeleteCustomRuleSetParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.CustomRuleSetID,
-		},
-	})
+	return c.DeleteCustomRuleSetWithContext(context.Background(), params)
+}
+
+// DeleteCustomRuleSetWithContext is the same as DeleteCustomRuleSet with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) DeleteCustomRuleSetWithContext(
+	ctx context.Context,
+	params DeleteCustomRuleSetParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.CustomRuleSetID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error deleting custom rule set: %w", err)
 	}
@@ -120,17 +181,28 @@ func (c Client) DeleteCustomRuleSet(
 // with the provided Custom Rule Set ID.
 func (c Client) GetCustomRuleSet(
 	params GetCustomRuleSetParams,
+) (*CustomRuleSetGetOK, error) {
+	return c.GetCustomRuleSetWithContext(context.Background(), params)
+}
+
+// GetCustomRuleSetWithContext is the same as GetCustomRuleSet with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetCustomRuleSetWithContext(
+	ctx context.Context,
+	params GetCustomRuleSetParams,
 ) (*CustomRuleSetGetOK, error) {
 	parsedResponse := &CustomRuleSetGetOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.CustomRuleSetID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.CustomRuleSetID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting custom rule set: %w", err)
 	}
@@ -142,15 +214,27 @@ func (c Client) GetCustomRuleSet(
 func (c Client) UpdateCustomRuleSet(
 	params UpdateCustomRuleSetParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
-		RawBody: params.CustomRuleSet,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.CustomRuleSetID,
-		},
-	})
+	return c.UpdateCustomRuleSetWithContext(context.Background(), params)
+}
+
+// UpdateCustomRuleSetWithContext is the same as UpdateCustomRuleSet with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) UpdateCustomRuleSetWithContext(
+	ctx context.Context,
+	params UpdateCustomRuleSetParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/rules/{rule_id}",
+			RawBody: params.CustomRuleSet,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.CustomRuleSetID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error updating custom rule set: %w", err)
 	}
@@ -14,6 +14,7 @@ package managed
 */
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -36,37 +37,74 @@ type ClientService interface {
 		params GetAllManagedRulesParams,
 	) (*[]ManagedRuleLight, error)
 
+	GetAllManagedRulesWithContext(
+		ctx context.Context,
+		params GetAllManagedRulesParams,
+	) (*[]ManagedRuleLight, error)
+
 	GetManagedRule(
 		params GetManagedRuleParams,
 	) (*ManagedRuleGetOK, error)
 
+	GetManagedRuleWithContext(
+		ctx context.Context,
+		params GetManagedRuleParams,
+	) (*ManagedRuleGetOK, error)
+
 	AddManagedRule(
 		params AddManagedRuleParams,
 	) (string, error)
 
+	AddManagedRuleWithContext(
+		ctx context.Context,
+		params AddManagedRuleParams,
+	) (string, error)
+
 	UpdateManagedRule(
 		params UpdateManagedRuleParams,
 	) error
 
+	UpdateManagedRuleWithContext(
+		ctx context.Context,
+		params UpdateManagedRuleParams,
+	) error
+
 	DeleteManagedRule(
 		params DeleteManagedRuleParams,
 	) error
+
+	DeleteManagedRuleWithContext(
+		ctx context.Context,
+		params DeleteManagedRuleParams,
+	) error
 }
 
 // GetAllManagedRules retrieves all of the Managed Rules for the provided
 // account number
 func (c Client) GetAllManagedRules(
 	params GetAllManagedRulesParams,
+) (*[]ManagedRuleLight, error) {
+	return c.GetAllManagedRulesWithContext(context.Background(), params)
+}
+
+// GetAllManagedRulesWithContext is the same as GetAllManagedRules with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) GetAllManagedRulesWithContext(
+	ctx context.Context,
+	params GetAllManagedRulesParams,
 ) (*[]ManagedRuleLight, error) {
 	parsedResponse := &[]ManagedRuleLight{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting all managed rules: %w", err)
 	}
@@ -77,17 +115,28 @@ func (c Client) GetAllManagedRules(
 // number using the provided Managed Rule ID.
 func (c Client) GetManagedRule(
 	params GetManagedRuleParams,
+) (*ManagedRuleGetOK, error) {
+	return c.GetManagedRuleWithContext(context.Background(), params)
+}
+
+// GetManagedRuleWithContext is the same as GetManagedRule with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetManagedRuleWithContext(
+	ctx context.Context,
+	params GetManagedRuleParams,
 ) (*ManagedRuleGetOK, error) {
 	parsedResponse := &ManagedRuleGetOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.ManagedRuleID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.ManagedRuleID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting managed rule: %w", err)
 	}
@@ -98,17 +147,28 @@ func (c Client) GetManagedRule(
 // and returns the new rule's system-generated ID
 func (c Client) AddManagedRule(
 	params AddManagedRuleParams,
+) (string, error) {
+	return c.AddManagedRuleWithContext(context.Background(), params)
+}
+
+// AddManagedRuleWithContext is the same as AddManagedRule with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c Client) AddManagedRuleWithContext(
+	ctx context.Context,
+	params AddManagedRuleParams,
 ) (string, error) {
 	parsedResponse := &AddManagedRuleOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/profile",
-		RawBody: params.ManagedRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/profile",
+			RawBody: params.ManagedRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return "", fmt.Errorf("error creating managed rule: %w", err)
 	}
@@ -120,15 +180,27 @@ func (c Client) AddManagedRule(
 func (c Client) UpdateManagedRule(
 	params UpdateManagedRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
-		RawBody: params.ManagedRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.ManagedRuleID,
-		},
-	})
+	return c.UpdateManagedRuleWithContext(context.Background(), params)
+}
+
+// UpdateManagedRuleWithContext is the same as UpdateManagedRule with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) UpdateManagedRuleWithContext(
+	ctx context.Context,
+	params UpdateManagedRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
+			RawBody: params.ManagedRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.ManagedRuleID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error updating managed rule: %w", err)
 	}
@@ -140,14 +212,26 @@ func (c Client) UpdateManagedRule(
 func (c Client) DeleteManagedRule(
 	params DeleteManagedRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.ManagedRuleID,
-		},
-	})
+	return c.DeleteManagedRuleWithContext(context.Background(), params)
+}
+
+// DeleteManagedRuleWithContext is the same as DeleteManagedRule with the
+// addition of a context.Context that governs cancellation and deadlines for the
+// call.
+func (c Client) DeleteManagedRuleWithContext(
+	ctx context.Context,
+	params DeleteManagedRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "v2/mcc/customers/{account_number}/waf/v1.0/profile/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.ManagedRuleID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error deleting managed rule: %w", err)
 	}
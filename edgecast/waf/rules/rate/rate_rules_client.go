@@ -17,6 +17,7 @@ package rate
 */
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -39,38 +40,74 @@ type ClientService interface {
 		params AddRateRuleParams,
 	) (string, error)
 
+	AddRateRuleWithContext(
+		ctx context.Context,
+		params AddRateRuleParams,
+	) (string, error)
+
 	GetRateRule(
 		params GetRateRuleParams,
 	) (*RateRuleGetOK, error)
 
+	GetRateRuleWithContext(
+		ctx context.Context,
+		params GetRateRuleParams,
+	) (*RateRuleGetOK, error)
+
 	UpdateRateRule(
 		params UpdateRateRuleParams,
 	) error
 
+	UpdateRateRuleWithContext(
+		ctx context.Context,
+		params UpdateRateRuleParams,
+	) error
+
 	DeleteRateRule(
 		params DeleteRateRuleParams,
 	) error
 
+	DeleteRateRuleWithContext(
+		ctx context.Context,
+		params DeleteRateRuleParams,
+	) error
+
 	GetAllRateRules(
 		params GetAllRateRulesParams,
 	) (*[]RateRuleGetAllOK, error)
+
+	GetAllRateRulesWithContext(
+		ctx context.Context,
+		params GetAllRateRulesParams,
+	) (*[]RateRuleGetAllOK, error)
 }
 
 // AddRateRule creates a rate rule for the provided account number
 // and returns the new rule's system-generated ID
 func (c Client) AddRateRule(
 	params AddRateRuleParams,
+) (string, error) {
+	return c.AddRateRuleWithContext(context.Background(), params)
+}
+
+// AddRateRuleWithContext is the same as AddRateRule with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) AddRateRuleWithContext(
+	ctx context.Context,
+	params AddRateRuleParams,
 ) (string, error) {
 	parsedResponse := &RateRuleAddOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Post,
-		Path:    "/v2/mcc/customers/{account_number}/waf/v1.0/limit",
-		RawBody: params.RateRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Post,
+			Path:    "/v2/mcc/customers/{account_number}/waf/v1.0/limit",
+			RawBody: params.RateRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return "", fmt.Errorf("error creating rate rule: %w", err)
 	}
@@ -81,17 +118,28 @@ func (c Client) AddRateRule(
 // Rate Rule ID
 func (c Client) GetRateRule(
 	params GetRateRuleParams,
+) (*RateRuleGetOK, error) {
+	return c.GetRateRuleWithContext(context.Background(), params)
+}
+
+// GetRateRuleWithContext is the same as GetRateRule with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetRateRuleWithContext(
+	ctx context.Context,
+	params GetRateRuleParams,
 ) (*RateRuleGetOK, error) {
 	parsedResponse := &RateRuleGetOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.RateRuleID,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.RateRuleID,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting rate rule: %w", err)
 	}
@@ -103,15 +151,26 @@ func (c Client) GetRateRule(
 func (c Client) UpdateRateRule(
 	params UpdateRateRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method:  ecclient.Put,
-		Path:    "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
-		RawBody: params.RateRule,
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.RateRuleID,
-		},
-	})
+	return c.UpdateRateRuleWithContext(context.Background(), params)
+}
+
+// UpdateRateRuleWithContext is the same as UpdateRateRule with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c Client) UpdateRateRuleWithContext(
+	ctx context.Context,
+	params UpdateRateRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method:  ecclient.Put,
+			Path:    "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
+			RawBody: params.RateRule,
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.RateRuleID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error updating rate rule: %w", err)
 	}
@@ -123,14 +182,25 @@ func (c Client) UpdateRateRule(
 func (c Client) DeleteRateRule(
 	params DeleteRateRuleParams,
 ) error {
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Delete,
-		Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-			"rule_id":        params.RateRuleID,
-		},
-	})
+	return c.DeleteRateRuleWithContext(context.Background(), params)
+}
+
+// DeleteRateRuleWithContext is the same as DeleteRateRule with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c Client) DeleteRateRuleWithContext(
+	ctx context.Context,
+	params DeleteRateRuleParams,
+) error {
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Delete,
+			Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit/{rule_id}",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+				"rule_id":        params.RateRuleID,
+			},
+		})
 	if err != nil {
 		return fmt.Errorf("error deleting rate rule: %w", err)
 	}
@@ -141,16 +211,27 @@ func (c Client) DeleteRateRule(
 // number.
 func (c Client) GetAllRateRules(
 	params GetAllRateRulesParams,
+) (*[]RateRuleGetAllOK, error) {
+	return c.GetAllRateRulesWithContext(context.Background(), params)
+}
+
+// GetAllRateRulesWithContext is the same as GetAllRateRules with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetAllRateRulesWithContext(
+	ctx context.Context,
+	params GetAllRateRulesParams,
 ) (*[]RateRuleGetAllOK, error) {
 	parsedResponse := &[]RateRuleGetAllOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/limit",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
 		return nil, fmt.Errorf("error getting all rate rules: %w", err)
 	}
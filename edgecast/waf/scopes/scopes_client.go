@@ -36,6 +36,7 @@ package scopes
 */
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -59,30 +60,51 @@ type ClientService interface {
 		params GetAllScopesParams,
 	) (*Scopes, error)
 
+	GetAllScopesWithContext(
+		ctx context.Context,
+		params GetAllScopesParams,
+	) (*Scopes, error)
+
 	ModifyAllScopes(
 		scopes Scopes,
 	) (*ModifyAllScopesOK, error)
+
+	ModifyAllScopesWithContext(
+		ctx context.Context,
+		scopes Scopes,
+	) (*ModifyAllScopesOK, error)
 }
 
 // Retrieves the set of Security Application Manager configurations (Scopes)
 // and their properties for a customer
 func (c Client) GetAllScopes(
 	params GetAllScopesParams,
+) (*Scopes, error) {
+	return c.GetAllScopesWithContext(context.Background(), params)
+}
+
+// GetAllScopesWithContext is the same as GetAllScopes with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c Client) GetAllScopesWithContext(
+	ctx context.Context,
+	params GetAllScopesParams,
 ) (*Scopes, error) {
 	if len(params.AccountNumber) == 0 {
 		return nil, errors.New("params.AccountNumber is required")
 	}
 	parsedResponse := &Scopes{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Get,
-		Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/scopes",
-		PathParams: map[string]string{
-			"account_number": params.AccountNumber,
-		},
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Get,
+			Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/scopes",
+			PathParams: map[string]string{
+				"account_number": params.AccountNumber,
+			},
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
-		return nil, fmt.Errorf("error getting scopes: %v", err)
+		return nil, fmt.Errorf("error getting scopes: %w", err)
 	}
 	return parsedResponse, nil
 }
@@ -105,22 +127,33 @@ func (c Client) GetAllScopes(
 // If this occurs, try again.
 func (c Client) ModifyAllScopes(
 	scopes Scopes,
+) (*ModifyAllScopesOK, error) {
+	return c.ModifyAllScopesWithContext(context.Background(), scopes)
+}
+
+// ModifyAllScopesWithContext is the same as ModifyAllScopes with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c Client) ModifyAllScopesWithContext(
+	ctx context.Context,
+	scopes Scopes,
 ) (*ModifyAllScopesOK, error) {
 	if len(scopes.CustomerID) == 0 {
 		return nil, errors.New("scopes.CustomerID is required")
 	}
 	parsedResponse := &ModifyAllScopesOK{}
-	_, err := c.client.SubmitRequest(ecclient.SubmitRequestParams{
-		Method: ecclient.Post,
-		Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/scopes",
-		PathParams: map[string]string{
-			"account_number": scopes.CustomerID,
-		},
-		RawBody:        scopes,
-		ParsedResponse: parsedResponse,
-	})
+	_, err := c.client.SubmitRequestWithContext(
+		ctx,
+		ecclient.SubmitRequestParams{
+			Method: ecclient.Post,
+			Path:   "/v2/mcc/customers/{account_number}/waf/v1.0/scopes",
+			PathParams: map[string]string{
+				"account_number": scopes.CustomerID,
+			},
+			RawBody:        scopes,
+			ParsedResponse: parsedResponse,
+		})
 	if err != nil {
-		return nil, fmt.Errorf("error modifying scopes: %v", err)
+		return nil, fmt.Errorf("error modifying scopes: %w", err)
 	}
 	return parsedResponse, nil
 }
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/access"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/waf/rules/bot"
@@ -33,17 +32,33 @@ type WafService struct {
 
 // New creates a new instance of WafService using the provided configuration
 func New(config edgecast.SDKConfig) (*WafService, error) {
-	authProvider, err := ecauth.NewTokenAuthorizationProvider(config.APIToken)
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating WafService: %w", err)
+	}
+
+	authProvider, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{Service: "waf", Token: true})
 	if err != nil {
 		return nil, fmt.Errorf("error creating WafService: %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		AuthProvider: authProvider,
-		BaseAPIURL:   config.BaseAPIURLLegacy,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
-		CheckRetry:   checkRetryForWAFScopes,
+		AuthProvider:    authProvider,
+		BaseAPIURL:      config.BaseAPIURLLegacy,
+		ServiceName:     "waf",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
+		CheckRetry:      checkRetryForWAFScopes,
+		ErrorDecoder:    decodeWAFError,
 	})
 
 	return &WafService{
@@ -14,6 +14,7 @@ API version: 1.0
 package waf_bot_manager
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
@@ -40,21 +41,46 @@ type BotManagersClientService interface {
 		params CreateBotManagerParams,
 	) (*ResponseObj, error)
 
+	CreateBotManagerWithContext(
+		ctx context.Context,
+		params CreateBotManagerParams,
+	) (*ResponseObj, error)
+
 	DeleteBotManager(
 		params DeleteBotManagerParams,
 	) error
 
+	DeleteBotManagerWithContext(
+		ctx context.Context,
+		params DeleteBotManagerParams,
+	) error
+
 	GetBotManager(
 		params GetBotManagerParams,
 	) (*BotManager, error)
 
+	GetBotManagerWithContext(
+		ctx context.Context,
+		params GetBotManagerParams,
+	) (*BotManager, error)
+
 	GetBotManagers(
 		params GetBotManagersParams,
 	) ([]ObjShort, error)
 
+	GetBotManagersWithContext(
+		ctx context.Context,
+		params GetBotManagersParams,
+	) ([]ObjShort, error)
+
 	UpdateBotManager(
 		params UpdateBotManagerParams,
 	) error
+
+	UpdateBotManagerWithContext(
+		ctx context.Context,
+		params UpdateBotManagerParams,
+	) error
 }
 
 // CreateBotManagerParams contains the parameters for CreateBotManager
@@ -75,6 +101,15 @@ func NewCreateBotManagerParams() CreateBotManagerParams {
 //	Create a new Bot Manager for a given customer.
 func (c BotManagersClient) CreateBotManager(
 	params CreateBotManagerParams,
+) (*ResponseObj, error) {
+	return c.CreateBotManagerWithContext(context.Background(), params)
+}
+
+// CreateBotManagerWithContext is the same as CreateBotManager with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c BotManagersClient) CreateBotManagerWithContext(
+	ctx context.Context,
+	params CreateBotManagerParams,
 ) (*ResponseObj, error) {
 	req, err := buildCreateBotManagerRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -84,7 +119,7 @@ func (c BotManagersClient) CreateBotManager(
 	parsedResponse := ResponseObj{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("CreateBotManager: %w", err)
@@ -138,13 +173,22 @@ func NewDeleteBotManagerParams() DeleteBotManagerParams {
 //	Delete a Bot Manager object identified by id.
 func (c BotManagersClient) DeleteBotManager(
 	params DeleteBotManagerParams,
+) error {
+	return c.DeleteBotManagerWithContext(context.Background(), params)
+}
+
+// DeleteBotManagerWithContext is the same as DeleteBotManager with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c BotManagersClient) DeleteBotManagerWithContext(
+	ctx context.Context,
+	params DeleteBotManagerParams,
 ) error {
 	req, err := buildDeleteBotManagerRequest(params, c.baseAPIURL)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return fmt.Errorf("DeleteBotManager: %w", err)
@@ -198,6 +242,15 @@ func NewGetBotManagerParams() GetBotManagerParams {
 //	Get a Bot Manager object from a Bot Manager id.
 func (c BotManagersClient) GetBotManager(
 	params GetBotManagerParams,
+) (*BotManager, error) {
+	return c.GetBotManagerWithContext(context.Background(), params)
+}
+
+// GetBotManagerWithContext is the same as GetBotManager with the addition of a
+// context.Context that governs cancellation and deadlines for the call.
+func (c BotManagersClient) GetBotManagerWithContext(
+	ctx context.Context,
+	params GetBotManagerParams,
 ) (*BotManager, error) {
 	req, err := buildGetBotManagerRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -207,7 +260,7 @@ func (c BotManagersClient) GetBotManager(
 	parsedResponse := BotManager{}
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetBotManager: %w", err)
@@ -258,6 +311,15 @@ func NewGetBotManagersParams() GetBotManagersParams {
 //	List all Bot Managers for a given customer.
 func (c BotManagersClient) GetBotManagers(
 	params GetBotManagersParams,
+) ([]ObjShort, error) {
+	return c.GetBotManagersWithContext(context.Background(), params)
+}
+
+// GetBotManagersWithContext is the same as GetBotManagers with the addition of
+// a context.Context that governs cancellation and deadlines for the call.
+func (c BotManagersClient) GetBotManagersWithContext(
+	ctx context.Context,
+	params GetBotManagersParams,
 ) ([]ObjShort, error) {
 	req, err := buildGetBotManagersRequest(params, c.baseAPIURL)
 	if err != nil {
@@ -267,7 +329,7 @@ func (c BotManagersClient) GetBotManagers(
 	parsedResponse := make([]ObjShort, 0)
 	req.ParsedResponse = &parsedResponse
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return nil, fmt.Errorf("GetBotManagers: %w", err)
@@ -321,13 +383,22 @@ func NewUpdateBotManagerParams() UpdateBotManagerParams {
 //	Modify a Bot Manager object identified by id.
 func (c BotManagersClient) UpdateBotManager(
 	params UpdateBotManagerParams,
+) error {
+	return c.UpdateBotManagerWithContext(context.Background(), params)
+}
+
+// UpdateBotManagerWithContext is the same as UpdateBotManager with the addition
+// of a context.Context that governs cancellation and deadlines for the call.
+func (c BotManagersClient) UpdateBotManagerWithContext(
+	ctx context.Context,
+	params UpdateBotManagerParams,
 ) error {
 	req, err := buildUpdateBotManagerRequest(params, c.baseAPIURL)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.apiClient.SubmitRequest(*req)
+	_, err = c.apiClient.SubmitRequestWithContext(ctx, *req)
 
 	if err != nil {
 		return fmt.Errorf("UpdateBotManager: %w", err)
@@ -19,7 +19,6 @@ import (
 
 	"github.com/EdgeCast/ec-sdk-go/edgecast"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/eclog"
-	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecauth"
 	"github.com/EdgeCast/ec-sdk-go/edgecast/internal/ecclient"
 )
 
@@ -46,25 +45,36 @@ func New(config edgecast.SDKConfig) (*Service, error) {
 		return nil, fmt.Errorf("waf_bot_manager.New(): %w", err)
 	}
 
-	var auth ecauth.AuthorizationProvider
+	httpClient, err := config.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("waf_bot_manager.New(): %w", err)
+	}
 
-	auth, err = ecauth.NewIDSAuthorizationProvider(
-		config.BaseIDSURL,
-		ecauth.OAuth2Credentials(config.IDSCredentials))
+	auth, err := config.ServiceAuthorizationProvider(
+		httpClient,
+		edgecast.ServiceAuth{
+			Service: "waf_bot_manager",
+			IDS:     true,
+			Token:   true,
+			Scopes:  requiredScopes,
+		})
 	if err != nil {
-		// Fall back to token authentication
-		auth, err = ecauth.NewTokenAuthorizationProvider(config.APIToken)
-		if err != nil {
-			return nil,
-				fmt.Errorf("error initializing waf_bot_manager Service: %w", err)
-		}
+		return nil, fmt.Errorf("error initializing waf_bot_manager Service: %w", err)
 	}
 
 	c := ecclient.New(ecclient.ClientConfig{
-		BaseAPIURL:   *apiURL,
-		UserAgent:    config.UserAgent,
-		Logger:       config.Logger,
-		AuthProvider: auth,
+		BaseAPIURL:      *apiURL,
+		ServiceName:     "waf_bot_manager",
+		UserAgent:       config.UserAgent,
+		Logger:          config.Logger,
+		AuthProvider:    auth,
+		HTTPClient:      httpClient,
+		Interceptors:    config.Interceptors,
+		Instrumentation: config.Instrumentation,
+		RateLimit:       config.RateLimit,
+		CircuitBreaker:  config.CircuitBreaker,
+		DryRun:          config.DryRun,
+		Redaction:       config.Redaction,
 	})
 
 	return &Service{
@@ -0,0 +1,15 @@
+// Copyright 2022 Edgecast Inc., Licensed under the terms of the Apache 2.0
+// license. See LICENSE file in project root for terms.
+
+package waf_bot_manager
+
+// requiredScopes are needed to manage bot managers and their known bots
+var requiredScopes = []string{"sec.waf.bot_manager"}
+
+// RequiredScopes returns the IDS scopes that bot manager tokens carry. New
+// fails with an error matching edgecast.ErrMissingScope if
+// IDSCredentials.Scope lacks any of them, unless
+// SDKConfig.ServiceScopes["waf_bot_manager"] lists others.
+func RequiredScopes() []string {
+	return append([]string{}, requiredScopes...)
+}
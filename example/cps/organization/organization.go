@@ -15,7 +15,7 @@ func main() {
 	idsCredentials := edgecast.IDSCredentials{
 		ClientID:     "CLIENT_ID",
 		ClientSecret: "CLIENT_SECRET",
-		Scope:        "SCOPE",
+		Scope:        "sec.cps.certificates",
 	}
 
 	sdkConfig := edgecast.NewSDKConfig()
@@ -17,7 +17,7 @@ func main() {
 	idsCredentials := edgecast.IDSCredentials{
 		ClientID:     "CLIENT_ID",
 		ClientSecret: "CLIENT_SECRET",
-		Scope:        "SCOPE",
+		Scope:        "cdn.origins",
 	}
 
 	sdkConfig := edgecast.NewSDKConfig()
@@ -24,7 +24,7 @@ func main() {
 	idsCredentials := edgecast.IDSCredentials{
 		ClientID:     "CLIENT_ID",
 		ClientSecret: "CLIENT_SECRET",
-		Scope:        "SCOPE",
+		Scope:        "cdn.rtld",
 	}
 
 	sdkConfig := edgecast.NewSDKConfig()